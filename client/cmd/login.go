@@ -59,6 +59,9 @@ var loginCmd = &cobra.Command{
 			if rootCmd.PersistentFlags().Changed(preSharedKeyFlag) {
 				ic.PreSharedKey = &preSharedKey
 			}
+			if rootCmd.PersistentFlags().Changed(peerTagsFlag) {
+				ic.Tags = peerTags
+			}
 
 			config, err := internal.UpdateOrCreateConfig(ic)
 			if err != nil {
@@ -96,6 +99,10 @@ var loginCmd = &cobra.Command{
 			loginRequest.OptionalPreSharedKey = &preSharedKey
 		}
 
+		if rootCmd.PersistentFlags().Changed(peerTagsFlag) {
+			loginRequest.Tags = peerTags
+		}
+
 		var loginErr error
 
 		var loginResp *proto.LoginResponse
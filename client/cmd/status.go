@@ -84,6 +84,42 @@ type nsServerGroupStateOutput struct {
 	Error   string   `json:"error" yaml:"error"`
 }
 
+type diskEncryptionOutput struct {
+	Path      string `json:"path" yaml:"path"`
+	Encrypted bool   `json:"encrypted" yaml:"encrypted"`
+}
+
+type postureCheckOutput struct {
+	Type    string `json:"type" yaml:"type"`
+	Path    string `json:"path" yaml:"path"`
+	Pattern string `json:"pattern" yaml:"pattern"`
+	Value   string `json:"value" yaml:"value"`
+}
+
+// postureStateCompliant is the state of the posture checks passed by the peer
+const postureStateCompliant = "compliant"
+
+type postureCheckResultOutput struct {
+	Name           string    `json:"name" yaml:"name"`
+	State          string    `json:"state" yaml:"state"`
+	Reasons        []string  `json:"reasons" yaml:"reasons"`
+	GraceExpiresAt time.Time `json:"graceExpiresAt,omitempty" yaml:"graceExpiresAt,omitempty"`
+}
+
+type postureStateOutput struct {
+	Version         string                 `json:"version" yaml:"version"`
+	OS              string                 `json:"os" yaml:"os"`
+	OSVersion       string                 `json:"osVersion" yaml:"osVersion"`
+	KernelVersion   string                 `json:"kernelVersion" yaml:"kernelVersion"`
+	FirewallEnabled bool                   `json:"firewallEnabled" yaml:"firewallEnabled"`
+	DiskEncryption  []diskEncryptionOutput `json:"diskEncryption" yaml:"diskEncryption"`
+	Checks          []postureCheckOutput   `json:"checks" yaml:"checks"`
+	UpdatedAt       time.Time              `json:"updatedAt" yaml:"updatedAt"`
+	// State and Results are the compliance status evaluated by the management service
+	State   string                     `json:"state" yaml:"state"`
+	Results []postureCheckResultOutput `json:"results" yaml:"results"`
+}
+
 type statusOutputOverview struct {
 	Peers               peersStateOutput           `json:"peers" yaml:"peers"`
 	CliVersion          string                     `json:"cliVersion" yaml:"cliVersion"`
@@ -95,10 +131,12 @@ type statusOutputOverview struct {
 	PubKey              string                     `json:"publicKey" yaml:"publicKey"`
 	KernelInterface     bool                       `json:"usesKernelInterface" yaml:"usesKernelInterface"`
 	FQDN                string                     `json:"fqdn" yaml:"fqdn"`
+	RequiresApproval    bool                       `json:"requiresApproval" yaml:"requiresApproval"`
 	RosenpassEnabled    bool                       `json:"quantumResistance" yaml:"quantumResistance"`
 	RosenpassPermissive bool                       `json:"quantumResistancePermissive" yaml:"quantumResistancePermissive"`
 	Routes              []string                   `json:"routes" yaml:"routes"`
 	NSServerGroups      []nsServerGroupStateOutput `json:"dnsServers" yaml:"dnsServers"`
+	Posture             postureStateOutput         `json:"posture" yaml:"posture"`
 }
 
 var (
@@ -280,10 +318,12 @@ func convertToStatusOutputOverview(resp *proto.StatusResponse) statusOutputOverv
 		PubKey:              pbFullStatus.GetLocalPeerState().GetPubKey(),
 		KernelInterface:     pbFullStatus.GetLocalPeerState().GetKernelInterface(),
 		FQDN:                pbFullStatus.GetLocalPeerState().GetFqdn(),
+		RequiresApproval:    pbFullStatus.GetLocalPeerState().GetRequiresApproval(),
 		RosenpassEnabled:    pbFullStatus.GetLocalPeerState().GetRosenpassEnabled(),
 		RosenpassPermissive: pbFullStatus.GetLocalPeerState().GetRosenpassPermissive(),
 		Routes:              pbFullStatus.GetLocalPeerState().GetRoutes(),
 		NSServerGroups:      mapNSGroups(pbFullStatus.GetDnsServers()),
+		Posture:             mapPostureState(pbFullStatus.GetPosture()),
 	}
 
 	if anonymizeFlag {
@@ -333,6 +373,54 @@ func mapNSGroups(servers []*proto.NSGroupState) []nsServerGroupStateOutput {
 	return mappedNSGroups
 }
 
+func mapPostureState(posture *proto.PostureState) postureStateOutput {
+	postureState := postureStateOutput{
+		Version:         posture.GetVersion(),
+		OS:              posture.GetOS(),
+		OSVersion:       posture.GetOSVersion(),
+		KernelVersion:   posture.GetKernelVersion(),
+		FirewallEnabled: posture.GetFirewallEnabled(),
+		DiskEncryption:  make([]diskEncryptionOutput, 0, len(posture.GetDiskEncryption())),
+		Checks:          make([]postureCheckOutput, 0, len(posture.GetChecks())),
+		State:           posture.GetState(),
+		Results:         make([]postureCheckResultOutput, 0, len(posture.GetResults())),
+	}
+
+	if posture.GetUpdatedAt() != nil {
+		postureState.UpdatedAt = posture.GetUpdatedAt().AsTime().Local()
+	}
+
+	for _, volume := range posture.GetDiskEncryption() {
+		postureState.DiskEncryption = append(postureState.DiskEncryption, diskEncryptionOutput{
+			Path:      volume.GetPath(),
+			Encrypted: volume.GetEncrypted(),
+		})
+	}
+
+	for _, check := range posture.GetChecks() {
+		postureState.Checks = append(postureState.Checks, postureCheckOutput{
+			Type:    check.GetType(),
+			Path:    check.GetPath(),
+			Pattern: check.GetPattern(),
+			Value:   check.GetValue(),
+		})
+	}
+
+	for _, result := range posture.GetResults() {
+		resultOutput := postureCheckResultOutput{
+			Name:    result.GetName(),
+			State:   result.GetState(),
+			Reasons: result.GetReasons(),
+		}
+		if result.GetGraceExpiresAt() != nil {
+			resultOutput.GraceExpiresAt = result.GetGraceExpiresAt().AsTime().Local()
+		}
+		postureState.Results = append(postureState.Results, resultOutput)
+	}
+
+	return postureState
+}
+
 func mapPeers(peers []*proto.PeerState) peersStateOutput {
 	var peersStateDetail []peerStateDetailOutput
 	peersConnected := 0
@@ -447,6 +535,9 @@ func parseGeneralSummary(overview statusOutputOverview, showURL bool, showRelays
 		if showURL {
 			managementConnString = fmt.Sprintf("%s to %s", managementConnString, overview.ManagementState.URL)
 		}
+		if overview.RequiresApproval {
+			managementConnString += ", peer is awaiting approval by an administrator"
+		}
 	} else {
 		managementConnString = "Disconnected"
 		if overview.ManagementState.Error != "" {
@@ -577,16 +668,115 @@ func parseGeneralSummary(overview statusOutputOverview, showURL bool, showRelays
 func parseToFullDetailSummary(overview statusOutputOverview) string {
 	parsedPeersString := parsePeers(overview.Peers, overview.RosenpassEnabled, overview.RosenpassPermissive)
 	summary := parseGeneralSummary(overview, true, true, true)
+	parsedPostureString := parsePosture(overview.Posture)
 
 	return fmt.Sprintf(
 		"Peers detail:"+
 			"%s\n"+
+			"%s"+
 			"%s",
 		parsedPeersString,
 		summary,
+		parsedPostureString,
 	)
 }
 
+// parsePosture shows the posture checks evaluated by the management service for this peer and the values collected
+// for them
+func parsePosture(posture postureStateOutput) string {
+	if posture.UpdatedAt.IsZero() {
+		return ""
+	}
+
+	firewall := "Disabled"
+	if posture.FirewallEnabled {
+		firewall = "Enabled"
+	}
+
+	var diskEncryptionString string
+	for _, volume := range posture.DiskEncryption {
+		encrypted := "Encrypted"
+		if !volume.Encrypted {
+			encrypted = "Not encrypted"
+		}
+		diskEncryptionString += fmt.Sprintf("\n    [%s] is %s", volume.Path, encrypted)
+	}
+	if diskEncryptionString == "" {
+		diskEncryptionString = " -"
+	}
+
+	compliance := posture.State
+	if compliance == "" {
+		compliance = "not evaluated"
+	}
+
+	var failing int
+	var resultsString string
+	for _, result := range posture.Results {
+		resultString := fmt.Sprintf("[%s] is Passing", result.Name)
+		if result.State != postureStateCompliant {
+			failing++
+			resultString = fmt.Sprintf("[%s] is Failing", result.Name)
+			if !result.GraceExpiresAt.IsZero() {
+				resultString = fmt.Sprintf("%s, grace period until %s", resultString, result.GraceExpiresAt.Format("2006-01-02 15:04:05"))
+			}
+			if len(result.Reasons) > 0 {
+				resultString = fmt.Sprintf("%s, reason: %s", resultString, strings.Join(result.Reasons, ", "))
+			}
+		}
+		resultsString += "\n    " + resultString
+	}
+
+	var checksString string
+	for _, check := range posture.Checks {
+		checkString := fmt.Sprintf("[%s] %s", check.Type, check.Path)
+		if check.Pattern != "" {
+			checkString = fmt.Sprintf("%s matching %q", checkString, check.Pattern)
+		}
+		checksString += fmt.Sprintf("\n    %s: %s", checkString, postureCheckValue(check))
+	}
+	if checksString == "" {
+		checksString = " -"
+	}
+
+	return fmt.Sprintf(
+		"Posture:\n"+
+			"  NetBird version: %s\n"+
+			"  OS: %s %s\n"+
+			"  Kernel version: %s\n"+
+			"  Firewall: %s\n"+
+			"  Disk encryption:%s\n"+
+			"  Compliance: %s\n"+
+			"  Checks: %d/%d Failing%s\n"+
+			"  Collected values:%s\n"+
+			"  Last update: %s\n",
+		posture.Version,
+		posture.OS,
+		posture.OSVersion,
+		posture.KernelVersion,
+		firewall,
+		diskEncryptionString,
+		compliance,
+		failing,
+		len(posture.Results),
+		resultsString,
+		checksString,
+		timeAgo(posture.UpdatedAt),
+	)
+}
+
+// postureCheckValue returns the value collected for a posture check
+func postureCheckValue(check postureCheckOutput) string {
+	switch {
+	case check.Type == peer.PostureCheckFileHash && check.Value == "":
+		return "file can't be read"
+	case check.Type == peer.PostureCheckFileHash:
+		return "sha256 " + check.Value
+	default:
+		return check.Value
+	}
+}
+
 func parsePeers(peers peersStateOutput, rosenpassEnabled, rosenpassPermissive bool) string {
 	var (
 		peersString = ""
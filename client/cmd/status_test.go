@@ -119,6 +119,51 @@ var resp = &proto.StatusResponse{
 				Error:   "timeout",
 			},
 		},
+		Posture: &proto.PostureState{
+			Version:         "0.14.1",
+			OS:              "Ubuntu",
+			OSVersion:       "22.04",
+			KernelVersion:   "6.5.0",
+			FirewallEnabled: true,
+			DiskEncryption: []*proto.DiskEncryptionState{
+				{
+					Path:      "/",
+					Encrypted: true,
+				},
+			},
+			Checks: []*proto.PostureCheckState{
+				{
+					Type:  "process",
+					Path:  "/usr/bin/agent",
+					Value: "not running",
+				},
+				{
+					Type:  "file_hash",
+					Path:  "/etc/agent.conf",
+					Value: "abc",
+				},
+				{
+					Type:    "file_content",
+					Path:    "/etc/agent.conf",
+					Pattern: "enabled=true",
+					Value:   "matched",
+				},
+			},
+			UpdatedAt: timestamppb.New(time.Date(2003, time.Month(3), 3, 3, 3, 3, 0, time.UTC)),
+			State:     "grace",
+			Results: []*proto.PostureCheckResult{
+				{
+					Name:           "agent",
+					State:          "grace",
+					Reasons:        []string{"ProcessCheck failed"},
+					GraceExpiresAt: timestamppb.New(time.Date(2003, time.Month(3), 4, 3, 3, 3, 0, time.UTC)),
+				},
+				{
+					Name:  "config",
+					State: "compliant",
+				},
+			},
+		},
 	},
 	DaemonVersion: "0.14.1",
 }
@@ -230,6 +275,51 @@ var overview = statusOutputOverview{
 	Routes: []string{
 		"10.10.0.0/24",
 	},
+	Posture: postureStateOutput{
+		Version:         "0.14.1",
+		OS:              "Ubuntu",
+		OSVersion:       "22.04",
+		KernelVersion:   "6.5.0",
+		FirewallEnabled: true,
+		DiskEncryption: []diskEncryptionOutput{
+			{
+				Path:      "/",
+				Encrypted: true,
+			},
+		},
+		Checks: []postureCheckOutput{
+			{
+				Type:  "process",
+				Path:  "/usr/bin/agent",
+				Value: "not running",
+			},
+			{
+				Type:  "file_hash",
+				Path:  "/etc/agent.conf",
+				Value: "abc",
+			},
+			{
+				Type:    "file_content",
+				Path:    "/etc/agent.conf",
+				Pattern: "enabled=true",
+				Value:   "matched",
+			},
+		},
+		UpdatedAt: time.Date(2003, 3, 3, 3, 3, 3, 0, time.UTC),
+		State:     "grace",
+		Results: []postureCheckResultOutput{
+			{
+				Name:           "agent",
+				State:          "grace",
+				Reasons:        []string{"ProcessCheck failed"},
+				GraceExpiresAt: time.Date(2003, 3, 4, 3, 3, 3, 0, time.UTC),
+			},
+			{
+				Name:  "config",
+				State: "compliant",
+			},
+		},
+	},
 }
 
 func TestConversionFromFullStatusToOutputOverview(t *testing.T) {
@@ -354,6 +444,7 @@ func TestParsingToJSON(t *testing.T) {
           "publicKey": "Some-Pub-Key",
           "usesKernelInterface": true,
           "fqdn": "some-localhost.awesome-domain.com",
+          "requiresApproval": false,
           "quantumResistance": false,
           "quantumResistancePermissive": false,
           "routes": [
@@ -380,7 +471,58 @@ func TestParsingToJSON(t *testing.T) {
               "enabled": false,
               "error": "timeout"
             }
-          ]
+          ],
+          "posture": {
+            "version": "0.14.1",
+            "os": "Ubuntu",
+            "osVersion": "22.04",
+            "kernelVersion": "6.5.0",
+            "firewallEnabled": true,
+            "diskEncryption": [
+              {
+                "path": "/",
+                "encrypted": true
+              }
+            ],
+            "checks": [
+              {
+                "type": "process",
+                "path": "/usr/bin/agent",
+                "pattern": "",
+                "value": "not running"
+              },
+              {
+                "type": "file_hash",
+                "path": "/etc/agent.conf",
+                "pattern": "",
+                "value": "abc"
+              },
+              {
+                "type": "file_content",
+                "path": "/etc/agent.conf",
+                "pattern": "enabled=true",
+                "value": "matched"
+              }
+            ],
+            "updatedAt": "2003-03-03T03:03:03Z",
+            "state": "grace",
+            "results": [
+              {
+                "name": "agent",
+                "state": "grace",
+                "reasons": [
+                  "ProcessCheck failed"
+                ],
+                "graceExpiresAt": "2003-03-04T03:03:03Z"
+              },
+              {
+                "name": "config",
+                "state": "compliant",
+                "reasons": null,
+                "graceExpiresAt": "0001-01-01T00:00:00Z"
+              }
+            ]
+          }
         }`
 	// @formatter:on
 
@@ -461,6 +603,7 @@ netbirdIp: 192.168.178.100/16
 publicKey: Some-Pub-Key
 usesKernelInterface: true
 fqdn: some-localhost.awesome-domain.com
+requiresApproval: false
 quantumResistance: false
 quantumResistancePermissive: false
 routes:
@@ -479,6 +622,39 @@ dnsServers:
         - example.net
       enabled: false
       error: timeout
+posture:
+    version: 0.14.1
+    os: Ubuntu
+    osVersion: "22.04"
+    kernelVersion: 6.5.0
+    firewallEnabled: true
+    diskEncryption:
+        - path: /
+          encrypted: true
+    checks:
+        - type: process
+          path: /usr/bin/agent
+          pattern: ""
+          value: not running
+        - type: file_hash
+          path: /etc/agent.conf
+          pattern: ""
+          value: abc
+        - type: file_content
+          path: /etc/agent.conf
+          pattern: enabled=true
+          value: matched
+    updatedAt: 2003-03-03T03:03:03Z
+    state: grace
+    results:
+        - name: agent
+          state: grace
+          reasons:
+            - ProcessCheck failed
+          graceExpiresAt: 2003-03-04T03:03:03Z
+        - name: config
+          state: compliant
+          reasons: []
 `
 
 	assert.Equal(t, expectedYAML, yaml)
@@ -490,6 +666,7 @@ func TestParsingToDetail(t *testing.T) {
 	lastHandshake1 := timeAgo(overview.Peers.Details[0].LastWireguardHandshake)
 	lastConnectionUpdate2 := timeAgo(overview.Peers.Details[1].LastStatusUpdate)
 	lastHandshake2 := timeAgo(overview.Peers.Details[1].LastWireguardHandshake)
+	lastPostureUpdate := timeAgo(overview.Posture.UpdatedAt)
 
 	detail := parseToFullDetailSummary(overview)
 
@@ -544,7 +721,23 @@ Interface type: Kernel
 Quantum resistance: false
 Routes: 10.10.0.0/24
 Peers count: 2/2 Connected
-`, lastConnectionUpdate1, lastHandshake1, lastConnectionUpdate2, lastHandshake2, runtime.GOOS, runtime.GOARCH, overview.CliVersion)
+Posture:
+  NetBird version: 0.14.1
+  OS: Ubuntu 22.04
+  Kernel version: 6.5.0
+  Firewall: Enabled
+  Disk encryption:
+    [/] is Encrypted
+  Compliance: grace
+  Checks: 1/2 Failing
+    [agent] is Failing, grace period until 2003-03-04 03:03:03, reason: ProcessCheck failed
+    [config] is Passing
+  Collected values:
+    [process] /usr/bin/agent: not running
+    [file_hash] /etc/agent.conf: sha256 abc
+    [file_content] /etc/agent.conf matching "enabled=true": matched
+  Last update: %s
+`, lastConnectionUpdate1, lastHandshake1, lastConnectionUpdate2, lastHandshake2, runtime.GOOS, runtime.GOARCH, overview.CliVersion, lastPostureUpdate)
 
 	assert.Equal(t, expectedDetail, detail)
 }
@@ -570,6 +763,15 @@ Peers count: 2/2 Connected
 	assert.Equal(t, expectedString, shortVersion)
 }
 
+func TestParsingPendingApproval(t *testing.T) {
+	pendingOverview := overview
+	pendingOverview.RequiresApproval = true
+
+	summary := parseGeneralSummary(pendingOverview, false, false, false)
+
+	assert.Contains(t, summary, "Management: Connected, peer is awaiting approval by an administrator\n")
+}
+
 func TestParsingOfIP(t *testing.T) {
 	InterfaceIP := "192.168.178.123/16"
 
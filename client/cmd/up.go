@@ -147,6 +147,10 @@ func runInForegroundMode(ctx context.Context, cmd *cobra.Command) error {
 		ic.DNSRouteInterval = &dnsRouteInterval
 	}
 
+	if rootCmd.PersistentFlags().Changed(peerTagsFlag) {
+		ic.Tags = peerTags
+	}
+
 	providedSetupKey, err := getSetupKey()
 	if err != nil {
 		return err
@@ -264,6 +268,10 @@ func runInDaemonMode(ctx context.Context, cmd *cobra.Command) error {
 		loginRequest.DnsRouteInterval = durationpb.New(dnsRouteInterval)
 	}
 
+	if rootCmd.PersistentFlags().Changed(peerTagsFlag) {
+		loginRequest.Tags = peerTags
+	}
+
 	var loginErr error
 
 	var loginResp *proto.LoginResponse
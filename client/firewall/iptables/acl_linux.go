@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"strings"
 
 	"github.com/coreos/go-iptables/iptables"
 	"github.com/google/uuid"
@@ -21,6 +22,13 @@ const (
 	// rules chains contains the effective ACL rules
 	chainNameInputRules  = "NETBIRD-ACL-INPUT"
 	chainNameOutputRules = "NETBIRD-ACL-OUTPUT"
+
+	// auditCommentPrefix marks the audit rules, the rest of the comment is the comment of the peer rule
+	auditCommentPrefix = "netbird-audit:"
+	auditLogPrefix     = "netbird-audit: "
+	// auditLogLimit is the rate of the new connections matched by the audit rules which are logged
+	auditLogLimit      = "10/minute"
+	auditLogLimitBurst = "5"
 )
 
 type aclEntries map[string][][]string
@@ -87,15 +95,10 @@ func (m *aclManager) AddPeerFiltering(
 	direction firewall.RuleDirection,
 	action firewall.Action,
 	ipsetName string,
+	comment string,
 ) ([]firewall.Rule, error) {
-	var dPortVal, sPortVal string
-	if dPort != nil && dPort.Values != nil {
-		// TODO: we support only one port per rule in current implementation of ACLs
-		dPortVal = strconv.Itoa(dPort.Values[0])
-	}
-	if sPort != nil && sPort.Values != nil {
-		sPortVal = strconv.Itoa(sPort.Values[0])
-	}
+	dPortVal := portSpec(dPort)
+	sPortVal := portSpec(sPort)
 
 	var chain string
 	if direction == firewall.RuleDirectionOUT {
@@ -105,7 +108,11 @@ func (m *aclManager) AddPeerFiltering(
 	}
 
 	ipsetName = transformIPsetName(ipsetName, sPortVal, dPortVal)
-	specs := filterRuleSpecs(ip, string(protocol), sPortVal, dPortVal, direction, action, ipsetName)
+	specs := filterRuleSpecs(ip, string(protocol), sPortVal, dPortVal, direction, action, ipsetName, comment)
+	var logSpecs []string
+	if action == firewall.ActionAudit {
+		logSpecs = auditLogRuleSpecs(ip, string(protocol), sPortVal, dPortVal, direction, ipsetName)
+	}
 	if ipsetName != "" {
 		if ipList, ipsetExists := m.ipsetStore.ipset(ipsetName); ipsetExists {
 			if err := ipset.Add(ipsetName, ip.String()); err != nil {
@@ -120,6 +127,7 @@ func (m *aclManager) AddPeerFiltering(
 				ip:        ip.String(),
 				chain:     chain,
 				specs:     specs,
+				logSpecs:  logSpecs,
 			}}, nil
 		}
 
@@ -145,13 +153,21 @@ func (m *aclManager) AddPeerFiltering(
 		return nil, fmt.Errorf("rule already exists")
 	}
 
+	// audit rules are appended in order like the drop rules they preview, so they match the traffic the drop rules would.
+	// They don't terminate the chain: the first rule counts the new connections and the second one logs them.
 	if err := m.iptablesClient.Append("filter", chain, specs...); err != nil {
 		return nil, err
 	}
+	if len(logSpecs) > 0 {
+		if err := m.iptablesClient.Append("filter", chain, logSpecs...); err != nil {
+			return nil, err
+		}
+	}
 
 	rule := &Rule{
 		ruleID:    uuid.New().String(),
 		specs:     specs,
+		logSpecs:  logSpecs,
 		ipsetName: ipsetName,
 		ip:        ip.String(),
 		chain:     chain,
@@ -197,6 +213,12 @@ func (m *aclManager) DeletePeerRule(rule firewall.Rule) error {
 		return fmt.Errorf("failed to delete rule: %s, %v: %w", r.chain, r.specs, err)
 	}
 
+	if len(r.logSpecs) > 0 {
+		if err := m.iptablesClient.Delete(tableName, r.chain, r.logSpecs...); err != nil {
+			return fmt.Errorf("failed to delete rule: %s, %v: %w", r.chain, r.logSpecs, err)
+		}
+	}
+
 	m.updateState()
 
 	return nil
@@ -395,10 +417,68 @@ func (m *aclManager) updateState() {
 	}
 }
 
-// filterRuleSpecs returns the specs of a filtering rule
+// AuditCounts returns the packets matched by the audit rules grouped by the comment of the rules
+func (m *aclManager) AuditCounts() (map[string]uint64, error) {
+	counts := make(map[string]uint64)
+	for _, chain := range []string{chainNameInputRules, chainNameOutputRules} {
+		stats, err := m.iptablesClient.Stats(tableName, chain)
+		if err != nil {
+			return nil, fmt.Errorf("list %s chain stats: %w", chain, err)
+		}
+
+		for _, stat := range stats {
+			parsed, err := m.iptablesClient.ParseStat(stat)
+			if err != nil {
+				return nil, fmt.Errorf("parse %s chain stats: %w", chain, err)
+			}
+
+			if comment, ok := auditComment(parsed.Options); ok {
+				counts[comment] += parsed.Packets
+			}
+		}
+	}
+
+	return counts, nil
+}
+
+// auditComment extracts the comment of an audit rule from the options of the listed rule, e.g. /* netbird-audit:id */
+func auditComment(options string) (string, bool) {
+	start := strings.Index(options, "/* "+auditCommentPrefix)
+	if start < 0 {
+		return "", false
+	}
+	comment := options[start+len("/* "+auditCommentPrefix):]
+
+	end := strings.Index(comment, " */")
+	if end < 0 {
+		return "", false
+	}
+	return comment[:end], true
+}
+
+// filterRuleSpecs returns the specs of a filtering rule. The audit rules only count the new connections, without a target.
 func filterRuleSpecs(
-	ip net.IP, protocol string, sPort, dPort string, direction firewall.RuleDirection, action firewall.Action, ipsetName string,
-) (specs []string) {
+	ip net.IP, protocol string, sPort, dPort string, direction firewall.RuleDirection, action firewall.Action, ipsetName string, comment string,
+) []string {
+	specs := matchRuleSpecs(ip, protocol, sPort, dPort, direction, ipsetName)
+	if action == firewall.ActionAudit {
+		return append(specs, "-m", "conntrack", "--ctstate", "NEW", "-m", "comment", "--comment", auditCommentPrefix+comment)
+	}
+	return append(specs, "-j", actionToStr(action))
+}
+
+// auditLogRuleSpecs returns the specs of the rule logging the new connections counted by an audit rule at a limited rate
+func auditLogRuleSpecs(ip net.IP, protocol string, sPort, dPort string, direction firewall.RuleDirection, ipsetName string) []string {
+	specs := matchRuleSpecs(ip, protocol, sPort, dPort, direction, ipsetName)
+	return append(specs,
+		"-m", "conntrack", "--ctstate", "NEW",
+		"-m", "limit", "--limit", auditLogLimit, "--limit-burst", auditLogLimitBurst,
+		"-j", "LOG", "--log-prefix", auditLogPrefix,
+	)
+}
+
+// matchRuleSpecs returns the specs matching the traffic of a filtering rule
+func matchRuleSpecs(ip net.IP, protocol string, sPort, dPort string, direction firewall.RuleDirection, ipsetName string) (specs []string) {
 	matchByIP := true
 	// don't use IP matching if IP is ip 0.0.0.0
 	if ip.String() == "0.0.0.0" {
@@ -431,7 +511,7 @@ func filterRuleSpecs(
 	if dPort != "" {
 		specs = append(specs, "--dport", dPort)
 	}
-	return append(specs, "-j", actionToStr(action))
+	return specs
 }
 
 func actionToStr(action firewall.Action) string {
@@ -441,6 +521,18 @@ func actionToStr(action firewall.Action) string {
 	return "DROP"
 }
 
+// portSpec returns the iptables port argument, a single port or a "start:end" range
+func portSpec(port *firewall.Port) string {
+	if port == nil || len(port.Values) == 0 {
+		return ""
+	}
+	if port.IsRange && len(port.Values) == 2 {
+		return fmt.Sprintf("%d:%d", port.Values[0], port.Values[1])
+	}
+	// TODO: we support only one port per rule in current implementation of ACLs
+	return strconv.Itoa(port.Values[0])
+}
+
 func transformIPsetName(ipsetName string, sPort, dPort string) string {
 	switch {
 	case ipsetName == "":
@@ -108,7 +108,15 @@ func (m *Manager) AddPeerFiltering(
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	return m.aclMgr.AddPeerFiltering(ip, protocol, sPort, dPort, direction, action, ipsetName)
+	return m.aclMgr.AddPeerFiltering(ip, protocol, sPort, dPort, direction, action, ipsetName, comment)
+}
+
+// AuditCounts returns the packets matched by the audit rules grouped by the comment of the rules
+func (m *Manager) AuditCounts() (map[string]uint64, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.aclMgr.AuditCounts()
 }
 
 func (m *Manager) AddRouteFiltering(
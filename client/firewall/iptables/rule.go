@@ -6,8 +6,10 @@ type Rule struct {
 	ipsetName string
 
 	specs []string
-	ip    string
-	chain string
+	// logSpecs are the specs of the rule logging the connections counted by an audit rule
+	logSpecs []string
+	ip       string
+	chain    string
 }
 
 // GetRuleID returns the rule id
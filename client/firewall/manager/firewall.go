@@ -48,6 +48,9 @@ const (
 	ActionAccept Action = iota
 	// ActionDrop is the action to drop a packet
 	ActionDrop
+	// ActionAudit is the action to log and count a packet without changing its verdict,
+	// the packet is handled by the other rules as if the audit rule didn't exist
+	ActionAudit
 )
 
 // Manager is the high level abstraction of a firewall manager
@@ -102,6 +105,13 @@ type Manager interface {
 	Flush() error
 }
 
+// AuditCounter is implemented by the firewall managers supporting peer rules with the ActionAudit action
+type AuditCounter interface {
+	// AuditCounts returns the number of packets matched by the audit rules since they were added,
+	// grouped by the comment of the rules
+	AuditCounts() (map[string]uint64, error)
+}
+
 func GenKey(format string, pair RouterPair) string {
 	return fmt.Sprintf(format, pair.ID, pair.Inverse)
 }
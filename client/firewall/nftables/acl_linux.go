@@ -33,6 +33,11 @@ const (
 	chainNamePrerouting    = "netbird-rt-prerouting"
 
 	allowNetbirdInputRuleID = "allow Netbird incoming traffic"
+
+	auditLogPrefix = "netbird-audit: "
+	// auditLogRate is the number of new connections matched by the audit rules logged per minute
+	auditLogRate  = 10
+	auditLogBurst = 5
 )
 
 const flushError = "flush: %w"
@@ -274,13 +279,14 @@ func (m *AclManager) Flush() error {
 }
 
 func (m *AclManager) addIOFiltering(ip net.IP, proto firewall.Protocol, sPort *firewall.Port, dPort *firewall.Port, direction firewall.RuleDirection, action firewall.Action, ipset *nftables.Set, comment string) (*Rule, error) {
-	ruleId := generatePeerRuleId(ip, sPort, dPort, direction, action, ipset)
+	ruleId := generatePeerRuleId(ip, sPort, dPort, direction, action, ipset, comment)
 	if r, ok := m.rules[ruleId]; ok {
 		return &Rule{
-			r.nftRule,
-			r.nftSet,
-			r.ruleID,
-			ip,
+			nftRule:      r.nftRule,
+			nftSet:       r.nftSet,
+			ruleID:       r.ruleID,
+			ip:           ip,
+			auditComment: r.auditComment,
 		}, nil
 	}
 
@@ -345,35 +351,11 @@ func (m *AclManager) addIOFiltering(ip net.IP, proto firewall.Protocol, sPort *f
 	}
 
 	if sPort != nil && len(sPort.Values) != 0 {
-		expressions = append(expressions,
-			&expr.Payload{
-				DestRegister: 1,
-				Base:         expr.PayloadBaseTransportHeader,
-				Offset:       0,
-				Len:          2,
-			},
-			&expr.Cmp{
-				Op:       expr.CmpOpEq,
-				Register: 1,
-				Data:     encodePort(*sPort),
-			},
-		)
+		expressions = append(expressions, portMatchExpressions(0, *sPort)...)
 	}
 
 	if dPort != nil && len(dPort.Values) != 0 {
-		expressions = append(expressions,
-			&expr.Payload{
-				DestRegister: 1,
-				Base:         expr.PayloadBaseTransportHeader,
-				Offset:       2,
-				Len:          2,
-			},
-			&expr.Cmp{
-				Op:       expr.CmpOpEq,
-				Register: 1,
-				Data:     encodePort(*dPort),
-			},
-		)
+		expressions = append(expressions, portMatchExpressions(2, *dPort)...)
 	}
 
 	switch action {
@@ -381,6 +363,37 @@ func (m *AclManager) addIOFiltering(ip net.IP, proto firewall.Protocol, sPort *f
 		expressions = append(expressions, &expr.Verdict{Kind: expr.VerdictAccept})
 	case firewall.ActionDrop:
 		expressions = append(expressions, &expr.Verdict{Kind: expr.VerdictDrop})
+	case firewall.ActionAudit:
+		// no verdict, the new connections are counted and logged at a limited rate, then continue to the next rules
+		expressions = append(expressions,
+			&expr.Ct{
+				Key:      expr.CtKeySTATE,
+				Register: 1,
+			},
+			&expr.Bitwise{
+				SourceRegister: 1,
+				DestRegister:   1,
+				Len:            4,
+				Mask:           binaryutil.NativeEndian.PutUint32(expr.CtStateBitNEW),
+				Xor:            binaryutil.NativeEndian.PutUint32(0),
+			},
+			&expr.Cmp{
+				Op:       expr.CmpOpNeq,
+				Register: 1,
+				Data:     []byte{0, 0, 0, 0},
+			},
+			&expr.Counter{},
+			&expr.Limit{
+				Type:  expr.LimitTypePkts,
+				Rate:  auditLogRate,
+				Unit:  expr.LimitTimeMinute,
+				Burst: auditLogBurst,
+			},
+			&expr.Log{
+				Key:  1 << unix.NFTA_LOG_PREFIX,
+				Data: []byte(auditLogPrefix),
+			},
+		)
 	}
 
 	userData := []byte(strings.Join([]string{ruleId, comment}, " "))
@@ -391,6 +404,8 @@ func (m *AclManager) addIOFiltering(ip net.IP, proto firewall.Protocol, sPort *f
 	} else {
 		chain = m.chainOutputRules
 	}
+
+	// audit rules are added in order like the drop rules they preview, so they match the traffic the drop rules would
 	nftRule := m.rConn.AddRule(&nftables.Rule{
 		Table:    m.workTable,
 		Chain:    chain,
@@ -404,6 +419,9 @@ func (m *AclManager) addIOFiltering(ip net.IP, proto firewall.Protocol, sPort *f
 		ruleID:  ruleId,
 		ip:      ip,
 	}
+	if action == firewall.ActionAudit {
+		rule.auditComment = comment
+	}
 	m.rules[ruleId] = rule
 	if ipset != nil {
 		m.ipsetStore.AddReferenceToIpset(ipset.Name)
@@ -773,6 +791,44 @@ func (m *AclManager) refreshRuleHandles(chain *nftables.Chain) error {
 	return nil
 }
 
+// AuditCounts returns the packets matched by the audit rules grouped by the comment of the rules
+func (m *AclManager) AuditCounts() (map[string]uint64, error) {
+	counts := make(map[string]uint64)
+	if m.workTable == nil {
+		return counts, nil
+	}
+
+	for _, chain := range []*nftables.Chain{m.chainInputRules, m.chainOutputRules} {
+		if chain == nil {
+			continue
+		}
+
+		list, err := m.rConn.GetRules(m.workTable, chain)
+		if err != nil {
+			return nil, fmt.Errorf("list %s chain rules: %w", chain.Name, err)
+		}
+
+		for _, rule := range list {
+			if len(rule.UserData) == 0 {
+				continue
+			}
+			split := bytes.Split(rule.UserData, []byte(" "))
+			r, ok := m.rules[string(split[0])]
+			if !ok || r.auditComment == "" {
+				continue
+			}
+
+			for _, e := range rule.Exprs {
+				if counter, ok := e.(*expr.Counter); ok {
+					counts[r.auditComment] += counter.Packets
+				}
+			}
+		}
+	}
+
+	return counts, nil
+}
+
 func generatePeerRuleId(
 	ip net.IP,
 	sPort *firewall.Port,
@@ -780,6 +836,7 @@ func generatePeerRuleId(
 	direction firewall.RuleDirection,
 	action firewall.Action,
 	ipset *nftables.Set,
+	comment string,
 ) string {
 	rulesetID := ":" + strconv.Itoa(int(direction)) + ":"
 	if sPort != nil {
@@ -791,15 +848,52 @@ func generatePeerRuleId(
 	}
 	rulesetID += ":"
 	rulesetID += strconv.Itoa(int(action))
+	// audit rules of different policies are counted separately
+	if action == firewall.ActionAudit {
+		rulesetID += ":" + comment
+	}
 	if ipset == nil {
 		return "ip:" + ip.String() + rulesetID
 	}
 	return "set:" + ipset.Name + rulesetID
 }
 
-func encodePort(port firewall.Port) []byte {
+// portMatchExpressions matches the transport header port at the given offset against a single port or a port range
+func portMatchExpressions(offset uint32, port firewall.Port) []expr.Any {
+	expressions := []expr.Any{
+		&expr.Payload{
+			DestRegister: 1,
+			Base:         expr.PayloadBaseTransportHeader,
+			Offset:       offset,
+			Len:          2,
+		},
+	}
+
+	if port.IsRange && len(port.Values) == 2 {
+		return append(expressions,
+			&expr.Cmp{
+				Op:       expr.CmpOpGte,
+				Register: 1,
+				Data:     encodeUint16(port.Values[0]),
+			},
+			&expr.Cmp{
+				Op:       expr.CmpOpLte,
+				Register: 1,
+				Data:     encodeUint16(port.Values[1]),
+			},
+		)
+	}
+
+	return append(expressions, &expr.Cmp{
+		Op:       expr.CmpOpEq,
+		Register: 1,
+		Data:     encodeUint16(port.Values[0]),
+	})
+}
+
+func encodeUint16(value int) []byte {
 	bs := make([]byte, 2)
-	binary.BigEndian.PutUint16(bs, uint16(port.Values[0]))
+	binary.BigEndian.PutUint16(bs, uint16(value))
 	return bs
 }
 
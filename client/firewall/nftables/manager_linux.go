@@ -133,6 +133,14 @@ func (m *Manager) AddPeerFiltering(
 	return m.aclManager.AddPeerFiltering(ip, proto, sPort, dPort, direction, action, ipsetName, comment)
 }
 
+// AuditCounts returns the packets matched by the audit rules grouped by the comment of the rules
+func (m *Manager) AuditCounts() (map[string]uint64, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.aclManager.AuditCounts()
+}
+
 func (m *Manager) AddRouteFiltering(sources []netip.Prefix, destination netip.Prefix, proto firewall.Protocol, sPort *firewall.Port, dPort *firewall.Port, action firewall.Action) (firewall.Rule, error) {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
@@ -12,6 +12,8 @@ type Rule struct {
 	nftSet  *nftables.Set
 	ruleID  string
 	ip      net.IP
+	// comment of the audit rule used to group the matched packets, empty for the other rules
+	auditComment string
 }
 
 // GetRuleID returns the rule id
@@ -2,8 +2,10 @@ package uspfilter
 
 import (
 	"net"
+	"sync/atomic"
 
 	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
 
 	firewall "github.com/netbirdio/netbird/client/firewall/manager"
 )
@@ -18,8 +20,14 @@ type Rule struct {
 	direction  firewall.RuleDirection
 	sPort      uint16
 	dPort      uint16
-	drop       bool
-	comment    string
+	// sPortEnd and dPortEnd are set when the rule matches a port range starting at sPort and dPort
+	sPortEnd uint16
+	dPortEnd uint16
+	drop     bool
+	comment  string
+	// audit rules count the matched packets and don't change the verdict of the packet
+	audit   bool
+	matched *atomic.Uint64
 
 	udpHook func([]byte) bool
 }
@@ -28,3 +36,42 @@ type Rule struct {
 func (r *Rule) GetRuleID() string {
 	return r.id
 }
+
+// matchesPorts returns true if the packet ports match the rule ports, a zero rule port matches any port
+func (r *Rule) matchesPorts(sPort, dPort uint16) bool {
+	if r.sPort == 0 && r.dPort == 0 {
+		return true
+	}
+	if r.sPort != 0 && portInRange(sPort, r.sPort, r.sPortEnd) {
+		return true
+	}
+	return r.dPort != 0 && portInRange(dPort, r.dPort, r.dPortEnd)
+}
+
+// matchesPayload returns true if the decoded transport layer of the packet matches the rule
+func (r *Rule) matchesPayload(payloadLayer gopacket.LayerType, d *decoder) bool {
+	if r.protoLayer == layerTypeAll {
+		return true
+	}
+
+	if payloadLayer != r.protoLayer {
+		return false
+	}
+
+	switch payloadLayer {
+	case layers.LayerTypeTCP:
+		return r.matchesPorts(uint16(d.tcp.SrcPort), uint16(d.tcp.DstPort))
+	case layers.LayerTypeUDP:
+		return r.matchesPorts(uint16(d.udp.SrcPort), uint16(d.udp.DstPort))
+	case layers.LayerTypeICMPv4, layers.LayerTypeICMPv6:
+		return true
+	}
+	return false
+}
+
+func portInRange(port, start, end uint16) bool {
+	if end == 0 {
+		return port == start
+	}
+	return port >= start && port <= end
+}
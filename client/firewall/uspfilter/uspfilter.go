@@ -5,6 +5,7 @@ import (
 	"net"
 	"net/netip"
 	"sync"
+	"sync/atomic"
 
 	"github.com/google/gopacket"
 	"github.com/google/gopacket/layers"
@@ -148,6 +149,10 @@ func (m *Manager) AddPeerFiltering(
 		drop:      action == firewall.ActionDrop,
 		comment:   comment,
 	}
+	if action == firewall.ActionAudit {
+		r.audit = true
+		r.matched = &atomic.Uint64{}
+	}
 	if ipNormalized := ip.To4(); ipNormalized != nil {
 		r.ipLayer = layers.LayerTypeIPv4
 		r.ip = ipNormalized
@@ -157,13 +162,8 @@ func (m *Manager) AddPeerFiltering(
 		r.matchByIP = false
 	}
 
-	if sPort != nil && len(sPort.Values) == 1 {
-		r.sPort = uint16(sPort.Values[0])
-	}
-
-	if dPort != nil && len(dPort.Values) == 1 {
-		r.dPort = uint16(dPort.Values[0])
-	}
+	r.sPort, r.sPortEnd = rulePorts(sPort)
+	r.dPort, r.dPortEnd = rulePorts(dPort)
 
 	switch proto {
 	case firewall.ProtocolTCP:
@@ -307,6 +307,10 @@ func (m *Manager) dropFilter(packetData []byte, rules map[string]RuleSet, isInco
 		}
 	}
 
+	for _, key := range []string{ip.String(), "0.0.0.0", "::"} {
+		auditRules(ip, rules[key], d)
+	}
+
 	filter, ok := validateRule(ip, packetData, rules[ip.String()], d)
 	if ok {
 		return filter
@@ -324,10 +328,25 @@ func (m *Manager) dropFilter(packetData []byte, rules map[string]RuleSet, isInco
 	return true
 }
 
+// auditRules counts and logs the packet for every audit rule it matches
+func auditRules(ip net.IP, rules map[string]Rule, d *decoder) {
+	payloadLayer := d.decoded[1]
+	for _, rule := range rules {
+		if !rule.audit || rule.matchByIP && !ip.Equal(rule.ip) {
+			continue
+		}
+
+		if rule.matchesPayload(payloadLayer, d) {
+			rule.matched.Add(1)
+			log.Debugf("audit rule %s matched packet of peer %s", rule.comment, ip)
+		}
+	}
+}
+
 func validateRule(ip net.IP, packetData []byte, rules map[string]Rule, d *decoder) (bool, bool) {
 	payloadLayer := d.decoded[1]
 	for _, rule := range rules {
-		if rule.matchByIP && !ip.Equal(rule.ip) {
+		if rule.audit || rule.matchByIP && !ip.Equal(rule.ip) {
 			continue
 		}
 
@@ -341,13 +360,7 @@ func validateRule(ip net.IP, packetData []byte, rules map[string]Rule, d *decode
 
 		switch payloadLayer {
 		case layers.LayerTypeTCP:
-			if rule.sPort == 0 && rule.dPort == 0 {
-				return rule.drop, true
-			}
-			if rule.sPort != 0 && rule.sPort == uint16(d.tcp.SrcPort) {
-				return rule.drop, true
-			}
-			if rule.dPort != 0 && rule.dPort == uint16(d.tcp.DstPort) {
+			if rule.matchesPorts(uint16(d.tcp.SrcPort), uint16(d.tcp.DstPort)) {
 				return rule.drop, true
 			}
 		case layers.LayerTypeUDP:
@@ -357,13 +370,7 @@ func validateRule(ip net.IP, packetData []byte, rules map[string]Rule, d *decode
 				return rule.udpHook(packetData), true
 			}
 
-			if rule.sPort == 0 && rule.dPort == 0 {
-				return rule.drop, true
-			}
-			if rule.sPort != 0 && rule.sPort == uint16(d.udp.SrcPort) {
-				return rule.drop, true
-			}
-			if rule.dPort != 0 && rule.dPort == uint16(d.udp.DstPort) {
+			if rule.matchesPorts(uint16(d.udp.SrcPort), uint16(d.udp.DstPort)) {
 				return rule.drop, true
 			}
 		case layers.LayerTypeICMPv4, layers.LayerTypeICMPv6:
@@ -373,6 +380,39 @@ func validateRule(ip net.IP, packetData []byte, rules map[string]Rule, d *decode
 	return false, false
 }
 
+// AuditCounts returns the packets matched by the audit rules grouped by the comment of the rules
+func (m *Manager) AuditCounts() (map[string]uint64, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	counts := make(map[string]uint64)
+	for _, rules := range []map[string]RuleSet{m.incomingRules, m.outgoingRules} {
+		for _, ruleSet := range rules {
+			for _, rule := range ruleSet {
+				if rule.audit {
+					counts[rule.comment] += rule.matched.Load()
+				}
+			}
+		}
+	}
+
+	return counts, nil
+}
+
+// rulePorts returns the single port of the rule or the start and the end of the port range
+func rulePorts(port *firewall.Port) (uint16, uint16) {
+	switch {
+	case port == nil:
+		return 0, 0
+	case port.IsRange && len(port.Values) == 2:
+		return uint16(port.Values[0]), uint16(port.Values[1])
+	case len(port.Values) == 1:
+		return uint16(port.Values[0]), 0
+	default:
+		return 0, 0
+	}
+}
+
 // SetNetwork of the wireguard interface to which filtering applied
 func (m *Manager) SetNetwork(network *net.IPNet) {
 	m.wgNetwork = network
@@ -336,6 +336,76 @@ func TestNotMatchByIP(t *testing.T) {
 	}
 }
 
+func TestPeerFilteringPortRange(t *testing.T) {
+	ifaceMock := &IFaceMock{
+		SetFilterFunc: func(device.PacketFilter) error { return nil },
+	}
+
+	m, err := Create(ifaceMock)
+	require.NoError(t, err)
+
+	port := &fw.Port{IsRange: true, Values: []int{8000, 8100}}
+	rules, err := m.AddPeerFiltering(net.ParseIP("100.10.0.1"), fw.ProtocolTCP, nil, port, fw.RuleDirectionIN, fw.ActionAccept, "", "")
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+
+	rule, ok := rules[0].(*Rule)
+	require.True(t, ok)
+
+	require.True(t, rule.matchesPorts(40000, 8000))
+	require.True(t, rule.matchesPorts(40000, 8050))
+	require.True(t, rule.matchesPorts(40000, 8100))
+	require.False(t, rule.matchesPorts(40000, 7999))
+	require.False(t, rule.matchesPorts(40000, 8101))
+}
+
+func TestPeerFilteringAudit(t *testing.T) {
+	ifaceMock := &IFaceMock{
+		SetFilterFunc: func(device.PacketFilter) error { return nil },
+	}
+
+	m, err := Create(ifaceMock)
+	require.NoError(t, err)
+	m.wgNetwork = &net.IPNet{
+		IP:   net.ParseIP("100.10.0.0"),
+		Mask: net.CIDRMask(16, 32),
+	}
+
+	peerIP := net.ParseIP("100.10.0.1")
+	_, err = m.AddPeerFiltering(peerIP, fw.ProtocolUDP, nil, &fw.Port{Values: []int{53}}, fw.RuleDirectionOUT, fw.ActionAudit, "", "policy1")
+	require.NoError(t, err)
+
+	packet := func(dPort layers.UDPPort) []byte {
+		ipv4 := &layers.IPv4{
+			TTL:      64,
+			Version:  4,
+			SrcIP:    net.ParseIP("100.10.0.100"),
+			DstIP:    peerIP,
+			Protocol: layers.IPProtocolUDP,
+		}
+		udp := &layers.UDP{SrcPort: 51334, DstPort: dPort}
+		require.NoError(t, udp.SetNetworkLayerForChecksum(ipv4))
+
+		buf := gopacket.NewSerializeBuffer()
+		opts := gopacket.SerializeOptions{ComputeChecksums: true, FixLengths: true}
+		require.NoError(t, gopacket.SerializeLayers(buf, opts, ipv4, udp, gopacket.Payload("test")))
+		return buf.Bytes()
+	}
+
+	// audit rules don't accept the traffic, the default policy still drops it
+	require.True(t, m.dropFilter(packet(53), m.outgoingRules, false))
+
+	_, err = m.AddPeerFiltering(peerIP, fw.ProtocolUDP, nil, nil, fw.RuleDirectionOUT, fw.ActionAccept, "", "")
+	require.NoError(t, err)
+
+	require.False(t, m.dropFilter(packet(53), m.outgoingRules, false))
+	require.False(t, m.dropFilter(packet(54), m.outgoingRules, false))
+
+	counts, err := m.AuditCounts()
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{"policy1": 2}, counts)
+}
+
 // TestRemovePacketHook tests the functionality of the RemovePacketHook method
 func TestRemovePacketHook(t *testing.T) {
 	// creating mock iface
@@ -276,9 +276,17 @@ func (d *DefaultManager) protoRuleToFirewallRule(
 		port = &firewall.Port{
 			Values: []int{value},
 		}
+	} else if r.PortInfo != nil {
+		port = convertPortInfo(r.PortInfo)
 	}
 
-	ruleID := d.getPeerRuleID(ip, protocol, int(r.Direction), port, action, "")
+	// audit rules are commented with the policy ID to report the matched packets per policy
+	var comment string
+	if action == firewall.ActionAudit {
+		comment = r.PolicyID
+	}
+
+	ruleID := d.getPeerRuleID(ip, protocol, int(r.Direction), port, action, comment)
 	if rulesPair, ok := d.peerRulesPairs[ruleID]; ok {
 		return ruleID, rulesPair, nil
 	}
@@ -286,9 +294,9 @@ func (d *DefaultManager) protoRuleToFirewallRule(
 	var rules []firewall.Rule
 	switch r.Direction {
 	case mgmProto.RuleDirection_IN:
-		rules, err = d.addInRules(ip, protocol, port, action, ipsetName, "")
+		rules, err = d.addInRules(ip, protocol, port, action, ipsetName, comment)
 	case mgmProto.RuleDirection_OUT:
-		rules, err = d.addOutRules(ip, protocol, port, action, ipsetName, "")
+		rules, err = d.addOutRules(ip, protocol, port, action, ipsetName, comment)
 	default:
 		return "", nil, fmt.Errorf("invalid direction, skipping firewall rule")
 	}
@@ -402,12 +410,12 @@ func (d *DefaultManager) squashAcceptRules(
 	// this function we use to do calculation, can we squash the rules by protocol or not.
 	// We summ amount of Peers IP for given protocol we found in original rules list.
 	// But we zeroed the IP's for protocol if:
-	// 1. Any of the rule has DROP action type.
-	// 2. Any of rule contains Port.
+	// 1. Any of the rule has DROP or AUDIT action type.
+	// 2. Any of rule contains Port or port range.
 	//
 	// We zeroed this to notify squash function that this protocol can't be squashed.
 	addRuleToCalculationMap := func(i int, r *mgmProto.FirewallRule, protocols protoMatch) {
-		drop := r.Action == mgmProto.RuleAction_DROP || r.Port != ""
+		drop := r.Action == mgmProto.RuleAction_DROP || r.Action == mgmProto.RuleAction_AUDIT || r.Port != "" || r.PortInfo != nil
 		if drop {
 			protocols[r.Protocol] = map[string]int{}
 			return
@@ -508,7 +516,11 @@ func (d *DefaultManager) squashAcceptRules(
 
 // getRuleGroupingSelector takes all rule properties except IP address to build selector
 func (d *DefaultManager) getRuleGroupingSelector(rule *mgmProto.FirewallRule) string {
-	return fmt.Sprintf("%v:%v:%v:%s", strconv.Itoa(int(rule.Direction)), rule.Action, rule.Protocol, rule.Port)
+	var portRange string
+	if r := rule.GetPortInfo().GetRange(); r != nil {
+		portRange = fmt.Sprintf("%d-%d", r.GetStart(), r.GetEnd())
+	}
+	return fmt.Sprintf("%v:%v:%v:%s:%s:%s", strconv.Itoa(int(rule.Direction)), rule.Action, rule.Protocol, rule.Port, portRange, rule.PolicyID)
 }
 
 func (d *DefaultManager) rollBack(newRulePairs map[id.RuleID][]firewall.Rule) {
@@ -547,6 +559,8 @@ func convertFirewallAction(action mgmProto.RuleAction) (firewall.Action, error)
 		return firewall.ActionAccept, nil
 	case mgmProto.RuleAction_DROP:
 		return firewall.ActionDrop, nil
+	case mgmProto.RuleAction_AUDIT:
+		return firewall.ActionAudit, nil
 	default:
 		return firewall.ActionDrop, fmt.Errorf("invalid action type: %d", action)
 	}
@@ -0,0 +1,86 @@
+package internal
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/client/firewall/manager"
+	"github.com/netbirdio/netbird/client/system"
+)
+
+// auditReportInterval is how often the packets matched by the audit firewall rules are reported to the management
+const auditReportInterval = time.Minute
+
+// reportAuditCounts periodically sends the packets matched by the audit firewall rules to the management service.
+// The firewall counts the packets since the rules were added, only the packets matched since the last successful
+// report are sent.
+func (e *Engine) reportAuditCounts(counter manager.AuditCounter) {
+	ticker := time.NewTicker(auditReportInterval)
+	defer ticker.Stop()
+
+	reported := make(map[string]uint64)
+	for {
+		select {
+		case <-e.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		counts, err := counter.AuditCounts()
+		if err != nil {
+			log.Warnf("failed to get audit rules counters: %v", err)
+			continue
+		}
+
+		delta := auditCountsDelta(reported, counts)
+		if len(delta) == 0 {
+			reported = counts
+			continue
+		}
+
+		if err := e.mgmClient.SyncMeta(e.getChecksInfo(), delta); err != nil {
+			log.Warnf("failed to report audit rules counters: %v", err)
+			continue
+		}
+		reported = counts
+	}
+}
+
+// getChecksInfo returns the system info collected for the current posture checks.
+// The posture checks are only evaluated again when they change, not on each audit report.
+func (e *Engine) getChecksInfo() *system.Info {
+	e.syncMsgMux.Lock()
+	defer e.syncMsgMux.Unlock()
+
+	if e.checksInfo != nil {
+		return e.checksInfo
+	}
+
+	info, err := system.GetInfoWithChecks(e.ctx, e.checks)
+	if err != nil {
+		log.Warnf("failed to get system info with checks: %v", err)
+		return system.GetInfo(e.ctx)
+	}
+
+	e.checksInfo = info
+	e.updatePostureState(info)
+	return info
+}
+
+// auditCountsDelta returns the packets matched since the reported counts.
+// A count lower than the reported one means that the rules were re-created and their counters reset.
+func auditCountsDelta(reported, counts map[string]uint64) map[string]uint64 {
+	delta := make(map[string]uint64)
+	for comment, packets := range counts {
+		previous := reported[comment]
+		if packets < previous {
+			previous = 0
+		}
+
+		if packets > previous {
+			delta[comment] = packets - previous
+		}
+	}
+	return delta
+}
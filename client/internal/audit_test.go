@@ -0,0 +1,47 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditCountsDelta(t *testing.T) {
+	tests := []struct {
+		name     string
+		reported map[string]uint64
+		counts   map[string]uint64
+		expected map[string]uint64
+	}{
+		{
+			name:     "first report",
+			reported: map[string]uint64{},
+			counts:   map[string]uint64{"policy1": 5},
+			expected: map[string]uint64{"policy1": 5},
+		},
+		{
+			name:     "packets matched since the last report",
+			reported: map[string]uint64{"policy1": 5, "policy2": 1},
+			counts:   map[string]uint64{"policy1": 8, "policy2": 1},
+			expected: map[string]uint64{"policy1": 3},
+		},
+		{
+			name:     "counters reset",
+			reported: map[string]uint64{"policy1": 5},
+			counts:   map[string]uint64{"policy1": 2},
+			expected: map[string]uint64{"policy1": 2},
+		},
+		{
+			name:     "removed rules",
+			reported: map[string]uint64{"policy1": 5},
+			counts:   map[string]uint64{},
+			expected: map[string]uint64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, auditCountsDelta(tt.reported, tt.counts))
+		})
+	}
+}
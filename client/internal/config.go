@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"maps"
 	"net/url"
 	"os"
 	"reflect"
@@ -60,6 +61,7 @@ type ConfigInput struct {
 	DNSRouteInterval    *time.Duration
 	ClientCertPath      string
 	ClientCertKeyPath   string
+	Tags                map[string]string
 }
 
 // Config Configuration type
@@ -111,6 +113,9 @@ type Config struct {
 	//Path to corresponding private key of ClientCertPath
 	ClientCertKeyPath string
 
+	// Tags are key/value labels reported to the management service in the peer system meta
+	Tags map[string]string `json:",omitempty"`
+
 	ClientCertKeyPair *tls.Certificate `json:"-"`
 }
 
@@ -404,6 +409,12 @@ func (config *Config) apply(input ConfigInput) (updated bool, err error) {
 
 	}
 
+	if input.Tags != nil && !maps.Equal(input.Tags, config.Tags) {
+		log.Infof("updating peer tags to %v (old value %v)", input.Tags, config.Tags)
+		config.Tags = input.Tags
+		updated = true
+	}
+
 	if input.ClientCertKeyPath != "" {
 		config.ClientCertKeyPath = input.ClientCertKeyPath
 		updated = true
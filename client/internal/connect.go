@@ -156,6 +156,8 @@ func (c *ConnectClient) run(mobileDependency MobileDependency, probes *ProbeHold
 		state.Set(StatusConnecting)
 
 		engineCtx, cancel := context.WithCancel(c.ctx)
+		// nolint
+		engineCtx = context.WithValue(engineCtx, system.TagsCtxKey, c.config.Tags)
 		defer func() {
 			_, err := state.Status()
 			c.statusRecorder.MarkManagementDisconnected(err)
@@ -192,10 +194,11 @@ func (c *ConnectClient) run(mobileDependency MobileDependency, probes *ProbeHold
 		c.statusRecorder.MarkManagementConnected()
 
 		localPeerState := peer.LocalPeerState{
-			IP:              loginResp.GetPeerConfig().GetAddress(),
-			PubKey:          myPrivateKey.PublicKey().String(),
-			KernelInterface: device.WireGuardModuleIsLoaded(),
-			FQDN:            loginResp.GetPeerConfig().GetFqdn(),
+			IP:               loginResp.GetPeerConfig().GetAddress(),
+			PubKey:           myPrivateKey.PublicKey().String(),
+			KernelInterface:  device.WireGuardModuleIsLoaded(),
+			FQDN:             loginResp.GetPeerConfig().GetFqdn(),
+			RequiresApproval: loginResp.GetPeerConfig().GetRequiresApproval(),
 		}
 		c.statusRecorder.UpdateLocalPeerState(localPeerState)
 
@@ -150,6 +150,8 @@ type Engine struct {
 
 	// networkSerial is the latest CurrentSerial (state ID) of the network sent by the Management service
 	networkSerial uint64
+	// latestNetworkMap is the last applied network map, the network map deltas sent by the Management service apply to it
+	latestNetworkMap *mgmProto.NetworkMap
 
 	networkMonitor *networkmonitor.NetworkMonitor
 
@@ -168,6 +170,9 @@ type Engine struct {
 
 	// checks are the client-applied posture checks that need to be evaluated on the client
 	checks []*mgmProto.Checks
+	// checksInfo is the system info collected for the current posture checks,
+	// reused by the meta syncs which aren't caused by a change of the checks
+	checksInfo *system.Info
 
 	relayManager *relayClient.Manager
 	stateManager *statemanager.Manager
@@ -389,6 +394,10 @@ func (e *Engine) Start() error {
 
 	if e.firewall != nil {
 		e.acl = acl.NewDefaultManager(e.firewall)
+
+		if counter, ok := e.firewall.(manager.AuditCounter); ok {
+			go e.reportAuditCounts(counter)
+		}
 	}
 
 	err = e.dnsServer.Initialize()
@@ -571,6 +580,18 @@ func (e *Engine) handleSync(update *mgmProto.SyncResponse) error {
 			return err
 		}
 	}
+
+	if update.GetNetworkMapDelta() != nil {
+		networkMap, err := mgmProto.ApplyNetworkMapDelta(e.latestNetworkMap, update.GetNetworkMapDelta())
+		if err != nil {
+			// the Management client syncs again on error, which sends the full network map
+			return fmt.Errorf("apply network map delta: %w", err)
+		}
+
+		if err := e.updateNetworkMap(networkMap); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -581,14 +602,18 @@ func (e *Engine) updateChecksIfNew(checks []*mgmProto.Checks) error {
 		return nil
 	}
 	e.checks = checks
+	e.checksInfo = nil
 
 	info, err := system.GetInfoWithChecks(e.ctx, checks)
 	if err != nil {
 		log.Warnf("failed to get system info with checks: %v", err)
 		info = system.GetInfo(e.ctx)
+	} else {
+		e.checksInfo = info
+		e.updatePostureState(info)
 	}
 
-	if err := e.mgmClient.SyncMeta(info); err != nil {
+	if err := e.mgmClient.SyncMeta(info, nil); err != nil {
 		log.Errorf("could not sync meta: error %s", err)
 		return err
 	}
@@ -671,11 +696,22 @@ func (e *Engine) updateConfig(conf *mgmProto.PeerConfig) error {
 		}
 	}
 
+	e.updatePostureResults(conf.GetPostureStatus())
+
+	if conf.GetRequiresApproval() != e.statusRecorder.GetLocalPeerState().RequiresApproval {
+		if conf.GetRequiresApproval() {
+			log.Infof("peer is waiting to be approved by an administrator of the account")
+		} else {
+			log.Infof("peer has been approved by an administrator of the account")
+		}
+	}
+
 	e.statusRecorder.UpdateLocalPeerState(peer.LocalPeerState{
-		IP:              e.config.WgAddr,
-		PubKey:          e.config.WgPrivateKey.PublicKey().String(),
-		KernelInterface: device.WireGuardModuleIsLoaded(),
-		FQDN:            conf.GetFqdn(),
+		IP:               e.config.WgAddr,
+		PubKey:           e.config.WgPrivateKey.PublicKey().String(),
+		KernelInterface:  device.WireGuardModuleIsLoaded(),
+		FQDN:             conf.GetFqdn(),
+		RequiresApproval: conf.GetRequiresApproval(),
 	})
 
 	return nil
@@ -689,6 +725,8 @@ func (e *Engine) receiveManagementEvents() {
 		if err != nil {
 			log.Warnf("failed to get system info with checks: %v", err)
 			info = system.GetInfo(e.ctx)
+		} else {
+			e.updatePostureState(info)
 		}
 
 		// err = e.mgmClient.Sync(info, e.handleSync)
@@ -831,6 +869,7 @@ func (e *Engine) updateNetworkMap(networkMap *mgmProto.NetworkMap) error {
 	}
 
 	e.networkSerial = serial
+	e.latestNetworkMap = networkMap
 
 	// Test received (upstream) servers for availability right away instead of upon usage.
 	// If no server of a server group responds this will disable the respective handler and retry later.
@@ -1505,6 +1544,10 @@ func isChecksEqual(checks []*mgmProto.Checks, oChecks []*mgmProto.Checks) bool {
 	}
 
 	return slices.EqualFunc(checks, oChecks, func(checks, oChecks *mgmProto.Checks) bool {
-		return slices.Equal(checks.Files, oChecks.Files)
+		return slices.Equal(checks.Files, oChecks.Files) &&
+			slices.Equal(checks.FileHashes, oChecks.FileHashes) &&
+			slices.EqualFunc(checks.FileContents, oChecks.FileContents, func(content, oContent *mgmProto.FileContentPattern) bool {
+				return content.GetPath() == oContent.GetPath() && content.GetPattern() == oContent.GetPattern()
+			})
 	})
 }
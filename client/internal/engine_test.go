@@ -496,6 +496,104 @@ func TestEngine_Sync(t *testing.T) {
 	}
 }
 
+func TestEngine_SyncNetworkMapDelta(t *testing.T) {
+	key, err := wgtypes.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// feed updates to Engine via mocked Management client and collect the errors of the handler
+	updates := make(chan *mgmtProto.SyncResponse)
+	defer close(updates)
+	handlerErrors := make(chan error)
+	syncFunc := func(ctx context.Context, info *system.Info, msgHandler func(msg *mgmtProto.SyncResponse) error) error {
+		for msg := range updates {
+			handlerErrors <- msgHandler(msg)
+		}
+		return nil
+	}
+	relayMgr := relayClient.NewManager(ctx, nil, key.PublicKey().String())
+	engine := NewEngine(ctx, cancel, &signal.MockClient{}, &mgmt.MockClient{SyncFunc: syncFunc}, relayMgr, &EngineConfig{
+		WgIfaceName:  "utun106",
+		WgAddr:       "100.64.0.1/24",
+		WgPrivateKey: key,
+		WgPort:       33100,
+	}, MobileDependency{}, peer.NewRecorder("https://mgm"), nil)
+	engine.ctx = ctx
+
+	engine.dnsServer = &dns.MockServer{
+		UpdateDNSServerFunc: func(serial uint64, update nbdns.Config) error { return nil },
+	}
+
+	defer func() {
+		err := engine.Stop()
+		if err != nil {
+			return
+		}
+	}()
+
+	err = engine.Start()
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	peer1 := &mgmtProto.RemotePeerConfig{
+		WgPubKey:   "RRHf3Ma6z6mdLbriAJbqhX7+nM/B71lgw2+91q3LfhU=",
+		AllowedIps: []string{"100.64.0.10/24"},
+	}
+	peer2 := &mgmtProto.RemotePeerConfig{
+		WgPubKey:   "LLHf3Ma6z6mdLbriAJbqhX9+nM/B71lgw2+91q3LlhU=",
+		AllowedIps: []string{"100.64.0.11/24"},
+	}
+	peer3 := &mgmtProto.RemotePeerConfig{
+		WgPubKey:   "GGHf3Ma6z6mdLbriAJbqhX9+nM/B71lgw2+91q3LlhU=",
+		AllowedIps: []string{"100.64.0.12/24"},
+	}
+
+	updates <- &mgmtProto.SyncResponse{
+		NetworkMap: &mgmtProto.NetworkMap{
+			Serial:      10,
+			RemotePeers: []*mgmtProto.RemotePeerConfig{peer1, peer2},
+		},
+	}
+	require.NoError(t, <-handlerErrors)
+	assert.Equal(t, 2, getPeers(engine))
+
+	// delta to the applied network map => apply the changes
+	updates <- &mgmtProto.SyncResponse{
+		NetworkMapDelta: &mgmtProto.NetworkMapDelta{
+			BaseSerial:         10,
+			Serial:             11,
+			AddedRemotePeers:   []*mgmtProto.RemotePeerConfig{peer3},
+			RemovedRemotePeers: []string{peer1.WgPubKey},
+		},
+	}
+	require.NoError(t, <-handlerErrors)
+	assert.Equal(t, 2, getPeers(engine))
+	assert.Equal(t, uint64(11), engine.networkSerial)
+	engine.syncMsgMux.Lock()
+	_, exists := engine.peerConns[peer1.WgPubKey]
+	engine.syncMsgMux.Unlock()
+	assert.False(t, exists)
+
+	// delta to another network map => fail so the management client syncs the full network map again
+	updates <- &mgmtProto.SyncResponse{
+		NetworkMapDelta: &mgmtProto.NetworkMapDelta{
+			BaseSerial:         12,
+			Serial:             13,
+			RemovedRemotePeers: []string{peer2.WgPubKey},
+		},
+	}
+	require.Error(t, <-handlerErrors)
+	assert.Equal(t, 2, getPeers(engine))
+	assert.Equal(t, uint64(11), engine.networkSerial)
+}
+
 func TestEngine_UpdateNetworkMapWithRoutes(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -1090,6 +1188,22 @@ func Test_CheckFilesEqual(t *testing.T) {
 			},
 			expectedBool: false,
 		},
+		{
+			name: "Unequal File Content Patterns Should Return False",
+			inputChecks1: []*mgmtProto.Checks{
+				{
+					FileHashes:   []string{"testfile1"},
+					FileContents: []*mgmtProto.FileContentPattern{{Path: "testfile2", Pattern: "enabled=true"}},
+				},
+			},
+			inputChecks2: []*mgmtProto.Checks{
+				{
+					FileHashes:   []string{"testfile1"},
+					FileContents: []*mgmtProto.FileContentPattern{{Path: "testfile2", Pattern: "enabled=false"}},
+				},
+			},
+			expectedBool: false,
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
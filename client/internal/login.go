@@ -47,6 +47,9 @@ func IsLoginRequired(ctx context.Context, privateKey string, mgmURL *url.URL, ss
 
 // Login or register the client
 func Login(ctx context.Context, config *Config, setupKey string, jwtToken string) error {
+	// nolint
+	ctx = context.WithValue(ctx, system.TagsCtxKey, config.Tags)
+
 	mgmClient, err := getMgmClient(ctx, config.PrivateKey, config.ManagementURL)
 	if err != nil {
 		return err
@@ -72,11 +72,12 @@ func (s *State) GetRoutes() map[string]struct{} {
 
 // LocalPeerState contains the latest state of the local peer
 type LocalPeerState struct {
-	IP              string
-	PubKey          string
-	KernelInterface bool
-	FQDN            string
-	Routes          map[string]struct{}
+	IP               string
+	PubKey           string
+	KernelInterface  bool
+	FQDN             string
+	Routes           map[string]struct{}
+	RequiresApproval bool
 }
 
 // SignalState contains the latest state of a signal connection
@@ -109,6 +110,51 @@ type NSGroupState struct {
 	Error   error
 }
 
+// Posture check types evaluated on the client
+const (
+	PostureCheckProcess     = "process"
+	PostureCheckFileHash    = "file_hash"
+	PostureCheckFileContent = "file_content"
+)
+
+// PostureCheckState is the value collected for a posture check received from the management service.
+// Only the management service knows the expected values, it evaluates the checks and sends the results back.
+type PostureCheckState struct {
+	Type    string
+	Path    string
+	Pattern string
+	Value   string
+}
+
+// PostureCheckResult is the result of a posture check evaluated by the management service
+type PostureCheckResult struct {
+	Name           string
+	State          string
+	Reasons        []string
+	GraceExpiresAt time.Time
+}
+
+// DiskEncryptionState is the encryption state of a mounted volume
+type DiskEncryptionState struct {
+	Path      string
+	Encrypted bool
+}
+
+// PostureState contains the posture checks received from the management service and the locally collected values
+type PostureState struct {
+	Version         string
+	OS              string
+	OSVersion       string
+	KernelVersion   string
+	FirewallEnabled bool
+	DiskEncryption  []DiskEncryptionState
+	Checks          []PostureCheckState
+	UpdatedAt       time.Time
+	// State is the compliance state evaluated by the management service, empty until it is received
+	State   string
+	Results []PostureCheckResult
+}
+
 // FullStatus contains the full state held by the Status instance
 type FullStatus struct {
 	Peers           []State
@@ -118,6 +164,7 @@ type FullStatus struct {
 	RosenpassState  RosenpassState
 	Relays          []relay.ProbeResult
 	NSGroupStates   []NSGroupState
+	PostureState    PostureState
 }
 
 // Status holds a state of peers, signal, management connections and relays
@@ -139,6 +186,7 @@ type Status struct {
 	rosenpassPermissive   bool
 	nsGroupStates         []NSGroupState
 	resolvedDomainsStates map[domain.Domain][]netip.Prefix
+	postureState          PostureState
 
 	// To reduce the number of notification invocation this bool will be true when need to call the notification
 	// Some Peer actions mostly used by in a batch when the network map has been synchronized. In these type of events
@@ -702,6 +750,32 @@ func (d *Status) GetDNSStates() []NSGroupState {
 	return d.nsGroupStates
 }
 
+// UpdatePostureState updates the posture checks and the values collected for them,
+// the results evaluated by the management service are kept
+func (d *Status) UpdatePostureState(postureState PostureState) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	postureState.State = d.postureState.State
+	postureState.Results = d.postureState.Results
+	d.postureState = postureState
+}
+
+// UpdatePostureResults updates the compliance state and the results of the posture checks evaluated by the
+// management service
+func (d *Status) UpdatePostureResults(state string, results []PostureCheckResult) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.postureState.State = state
+	d.postureState.Results = results
+}
+
+// GetPostureState returns the posture checks and the values collected for them
+func (d *Status) GetPostureState() PostureState {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	return d.postureState
+}
+
 func (d *Status) GetResolvedDomainsStates() map[domain.Domain][]netip.Prefix {
 	d.mux.Lock()
 	defer d.mux.Unlock()
@@ -716,6 +790,7 @@ func (d *Status) GetFullStatus() FullStatus {
 		Relays:          d.GetRelayStates(),
 		RosenpassState:  d.GetRosenpassState(),
 		NSGroupStates:   d.GetDNSStates(),
+		PostureState:    d.GetPostureState(),
 	}
 
 	d.mux.Lock()
@@ -152,6 +152,31 @@ func TestCleanLocalPeerState(t *testing.T) {
 	assert.Equal(t, emptyLocalPeerState, status.localPeer, "local peer status should be empty")
 }
 
+func TestUpdatePostureState(t *testing.T) {
+	postureState := PostureState{
+		Version:         "0.30.0",
+		OS:              "Ubuntu",
+		FirewallEnabled: true,
+		Checks: []PostureCheckState{
+			{Type: PostureCheckProcess, Path: "/usr/bin/agent", Value: "not running"},
+		},
+	}
+	status := NewRecorder("https://mgm")
+
+	status.UpdatePostureState(postureState)
+
+	assert.Equal(t, postureState, status.GetPostureState(), "posture state should be equal")
+	assert.Equal(t, postureState, status.GetFullStatus().PostureState, "full status should contain the posture state")
+
+	results := []PostureCheckResult{{Name: "agent", State: "non-compliant", Reasons: []string{"ProcessCheck failed"}}}
+	status.UpdatePostureResults("non-compliant", results)
+	status.UpdatePostureState(postureState)
+
+	postureState.State = "non-compliant"
+	postureState.Results = results
+	assert.Equal(t, postureState, status.GetPostureState(), "results of the management service should be kept")
+}
+
 func TestUpdateSignalState(t *testing.T) {
 	url := "https://signal"
 	var tests = []struct {
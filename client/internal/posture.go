@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/system"
+	mgmProto "github.com/netbirdio/netbird/management/proto"
+)
+
+// updatePostureState records the posture checks received from the management service and the values collected for
+// them, so users can see why their access is restricted in the status output.
+func (e *Engine) updatePostureState(info *system.Info) {
+	e.statusRecorder.UpdatePostureState(toPostureState(info, time.Now()))
+}
+
+// toPostureState converts the system info collected for the posture checks to the status recorder state.
+// The collected values are only informational, the checks are evaluated by the management service.
+func toPostureState(info *system.Info, updatedAt time.Time) peer.PostureState {
+	postureState := peer.PostureState{
+		Version:         info.WiretrusteeVersion,
+		OS:              info.OS,
+		OSVersion:       info.OSVersion,
+		KernelVersion:   info.KernelVersion,
+		FirewallEnabled: info.FirewallEnabled,
+		UpdatedAt:       updatedAt,
+	}
+
+	for _, volume := range info.DiskEncryption {
+		postureState.DiskEncryption = append(postureState.DiskEncryption, peer.DiskEncryptionState{
+			Path:      volume.Path,
+			Encrypted: volume.Encrypted,
+		})
+	}
+
+	for _, file := range info.Files {
+		value := "running"
+		switch {
+		case !file.Exist:
+			value = "not found"
+		case !file.ProcessIsRunning:
+			value = "not running"
+		}
+
+		postureState.Checks = append(postureState.Checks, peer.PostureCheckState{
+			Type:  peer.PostureCheckProcess,
+			Path:  file.Path,
+			Value: value,
+		})
+	}
+
+	for _, fileHash := range info.FileHashes {
+		postureState.Checks = append(postureState.Checks, peer.PostureCheckState{
+			Type:  peer.PostureCheckFileHash,
+			Path:  fileHash.Path,
+			Value: fileHash.SHA256,
+		})
+	}
+
+	for _, fileContent := range info.FileContents {
+		value := "not matched"
+		if fileContent.Matched {
+			value = "matched"
+		}
+
+		postureState.Checks = append(postureState.Checks, peer.PostureCheckState{
+			Type:    peer.PostureCheckFileContent,
+			Path:    fileContent.Path,
+			Pattern: fileContent.Pattern,
+			Value:   value,
+		})
+	}
+
+	return postureState
+}
+
+// updatePostureResults records the posture status evaluated by the management service for this peer
+func (e *Engine) updatePostureResults(postureStatus *mgmProto.PostureStatus) {
+	state, results := toPostureResults(postureStatus)
+	e.statusRecorder.UpdatePostureResults(state, results)
+}
+
+func toPostureResults(postureStatus *mgmProto.PostureStatus) (string, []peer.PostureCheckResult) {
+	results := make([]peer.PostureCheckResult, 0, len(postureStatus.GetChecks()))
+	for _, check := range postureStatus.GetChecks() {
+		result := peer.PostureCheckResult{
+			Name:    check.GetName(),
+			State:   check.GetState(),
+			Reasons: check.GetReasons(),
+		}
+		if check.GetGraceExpiresAt() != nil {
+			result.GraceExpiresAt = check.GetGraceExpiresAt().AsTime()
+		}
+		results = append(results, result)
+	}
+	return postureStatus.GetState(), results
+}
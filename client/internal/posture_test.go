@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/netbirdio/netbird/client/internal/peer"
+	"github.com/netbirdio/netbird/client/system"
+	mgmProto "github.com/netbirdio/netbird/management/proto"
+)
+
+func TestToPostureState(t *testing.T) {
+	updatedAt := time.Now()
+	info := &system.Info{
+		OS:                 "Ubuntu",
+		OSVersion:          "22.04",
+		KernelVersion:      "6.5.0",
+		WiretrusteeVersion: "0.30.0",
+		FirewallEnabled:    true,
+		DiskEncryption:     []system.DiskEncryptionVolume{{Path: "/", Encrypted: true}},
+		Files: []system.File{
+			{Path: "/usr/bin/agent", Exist: true, ProcessIsRunning: true},
+			{Path: "/usr/bin/stopped", Exist: true},
+			{Path: "/usr/bin/missing"},
+		},
+		FileHashes: []system.FileHash{
+			{Path: "/etc/agent.conf", SHA256: "abc"},
+			{Path: "/etc/missing.conf"},
+		},
+		FileContents: []system.FileContentMatch{
+			{Path: "/etc/agent.conf", Pattern: "enabled=true", Matched: true},
+			{Path: "/etc/agent.conf", Pattern: "mode=strict"},
+		},
+	}
+
+	expected := peer.PostureState{
+		Version:         "0.30.0",
+		OS:              "Ubuntu",
+		OSVersion:       "22.04",
+		KernelVersion:   "6.5.0",
+		FirewallEnabled: true,
+		DiskEncryption:  []peer.DiskEncryptionState{{Path: "/", Encrypted: true}},
+		Checks: []peer.PostureCheckState{
+			{Type: peer.PostureCheckProcess, Path: "/usr/bin/agent", Value: "running"},
+			{Type: peer.PostureCheckProcess, Path: "/usr/bin/stopped", Value: "not running"},
+			{Type: peer.PostureCheckProcess, Path: "/usr/bin/missing", Value: "not found"},
+			{Type: peer.PostureCheckFileHash, Path: "/etc/agent.conf", Value: "abc"},
+			{Type: peer.PostureCheckFileHash, Path: "/etc/missing.conf"},
+			{Type: peer.PostureCheckFileContent, Path: "/etc/agent.conf", Pattern: "enabled=true", Value: "matched"},
+			{Type: peer.PostureCheckFileContent, Path: "/etc/agent.conf", Pattern: "mode=strict", Value: "not matched"},
+		},
+		UpdatedAt: updatedAt,
+	}
+
+	assert.Equal(t, expected, toPostureState(info, updatedAt))
+}
+
+func TestToPostureResults(t *testing.T) {
+	state, results := toPostureResults(nil)
+	assert.Empty(t, state, "the state is empty until the management service evaluates the peer")
+	assert.Empty(t, results)
+
+	graceExpiresAt := time.Now().Add(time.Hour).UTC()
+	state, results = toPostureResults(&mgmProto.PostureStatus{
+		State: "grace",
+		Checks: []*mgmProto.PostureCheckStatus{
+			{Name: "version", State: "compliant"},
+			{Name: "agent", State: "grace", Reasons: []string{"FileHashCheck failed"}, GraceExpiresAt: timestamppb.New(graceExpiresAt)},
+		},
+	})
+	assert.Equal(t, "grace", state)
+	assert.Equal(t, []peer.PostureCheckResult{
+		{Name: "version", State: "compliant"},
+		{Name: "agent", State: "grace", Reasons: []string{"FileHashCheck failed"}, GraceExpiresAt: graceExpiresAt},
+	}, results)
+}
@@ -394,6 +394,11 @@ func (s *Server) Login(callerCtx context.Context, msg *proto.LoginRequest) (*pro
 		s.latestConfigInput.DNSRouteInterval = &duration
 	}
 
+	if len(msg.Tags) > 0 {
+		inputConfig.Tags = msg.Tags
+		s.latestConfigInput.Tags = msg.Tags
+	}
+
 	s.mutex.Unlock()
 
 	if msg.OptionalPreSharedKey != nil {
@@ -770,6 +775,7 @@ func toProtoFullStatus(fullStatus peer.FullStatus) *proto.FullStatus {
 	pbFullStatus.LocalPeerState.RosenpassPermissive = fullStatus.RosenpassState.Permissive
 	pbFullStatus.LocalPeerState.RosenpassEnabled = fullStatus.RosenpassState.Enabled
 	pbFullStatus.LocalPeerState.Routes = maps.Keys(fullStatus.LocalPeerState.Routes)
+	pbFullStatus.LocalPeerState.RequiresApproval = fullStatus.LocalPeerState.RequiresApproval
 
 	for _, peerState := range fullStatus.Peers {
 		pbPeerState := &proto.PeerState{
@@ -819,9 +825,56 @@ func toProtoFullStatus(fullStatus peer.FullStatus) *proto.FullStatus {
 		pbFullStatus.DnsServers = append(pbFullStatus.DnsServers, pbDnsState)
 	}
 
+	pbFullStatus.Posture = toProtoPostureState(fullStatus.PostureState)
+
 	return &pbFullStatus
 }
 
+func toProtoPostureState(postureState peer.PostureState) *proto.PostureState {
+	pbPostureState := &proto.PostureState{
+		Version:         postureState.Version,
+		OS:              postureState.OS,
+		OSVersion:       postureState.OSVersion,
+		KernelVersion:   postureState.KernelVersion,
+		FirewallEnabled: postureState.FirewallEnabled,
+		State:           postureState.State,
+	}
+
+	if !postureState.UpdatedAt.IsZero() {
+		pbPostureState.UpdatedAt = timestamppb.New(postureState.UpdatedAt)
+	}
+
+	for _, volume := range postureState.DiskEncryption {
+		pbPostureState.DiskEncryption = append(pbPostureState.DiskEncryption, &proto.DiskEncryptionState{
+			Path:      volume.Path,
+			Encrypted: volume.Encrypted,
+		})
+	}
+
+	for _, check := range postureState.Checks {
+		pbPostureState.Checks = append(pbPostureState.Checks, &proto.PostureCheckState{
+			Type:    check.Type,
+			Path:    check.Path,
+			Pattern: check.Pattern,
+			Value:   check.Value,
+		})
+	}
+
+	for _, result := range postureState.Results {
+		pbResult := &proto.PostureCheckResult{
+			Name:    result.Name,
+			State:   result.State,
+			Reasons: result.Reasons,
+		}
+		if !result.GraceExpiresAt.IsZero() {
+			pbResult.GraceExpiresAt = timestamppb.New(result.GraceExpiresAt)
+		}
+		pbPostureState.Results = append(pbPostureState.Results, pbResult)
+	}
+
+	return pbPostureState
+}
+
 // sendTerminalNotification sends a terminal notification message
 // to inform the user that the NetBird connection session has expired.
 func sendTerminalNotification() error {
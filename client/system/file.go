@@ -0,0 +1,96 @@
+package system
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// maxFileContentSize limits how much of a file is read to match a content pattern
+const maxFileContentSize = 1 << 20
+
+// FileContentPattern is a regular expression to match against the content of a file
+type FileContentPattern struct {
+	Path    string
+	Pattern string
+}
+
+// checkFileHashes computes the SHA-256 hash of the files.
+// The files are streamed through the hash, so their size isn't limited.
+// The hash of a file that can't be read is left empty.
+func checkFileHashes(paths []string) []FileHash {
+	hashes := make([]FileHash, 0, len(paths))
+	for _, path := range paths {
+		hash, err := fileSHA256(path)
+		if err != nil {
+			log.Debugf("failed to compute the hash of %s: %v", path, err)
+		}
+		hashes = append(hashes, FileHash{Path: path, SHA256: hash})
+	}
+	return hashes
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := openRegularFile(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// openRegularFile opens the file if it is a regular file, so devices and named pipes are never read
+func openRegularFile(path string) (*os.File, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("%s is not a regular file", path)
+	}
+
+	return os.Open(path)
+}
+
+// checkFileContents matches the content of the files against the patterns.
+// A file that can't be read or an invalid pattern doesn't match.
+func checkFileContents(patterns []FileContentPattern) []FileContentMatch {
+	matches := make([]FileContentMatch, 0, len(patterns))
+	for _, p := range patterns {
+		matched, err := fileContentMatches(p.Path, p.Pattern)
+		if err != nil {
+			log.Debugf("failed to match the content of %s: %v", p.Path, err)
+		}
+		matches = append(matches, FileContentMatch{Path: p.Path, Pattern: p.Pattern, Matched: matched})
+	}
+	return matches
+}
+
+func fileContentMatches(path, pattern string) (bool, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false, fmt.Errorf("compile pattern: %w", err)
+	}
+
+	f, err := openRegularFile(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	content, err := io.ReadAll(io.LimitReader(f, maxFileContentSize))
+	if err != nil {
+		return false, err
+	}
+	return re.Match(content), nil
+}
@@ -0,0 +1,55 @@
+package system
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_checkFileHashes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "agent")
+	require.NoError(t, os.WriteFile(path, []byte("test"), 0600))
+	missing := filepath.Join(t.TempDir(), "missing")
+
+	// files of several MiB are hashed as a whole
+	largeContent := make([]byte, 4<<20+1)
+	for i := range largeContent {
+		largeContent[i] = byte(i)
+	}
+	large := filepath.Join(t.TempDir(), "large")
+	require.NoError(t, os.WriteFile(large, largeContent, 0600))
+	largeHash := sha256.Sum256(largeContent)
+	dir := t.TempDir()
+
+	hashes := checkFileHashes([]string{path, missing, large, dir})
+	assert.Equal(t, []FileHash{
+		{Path: path, SHA256: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"},
+		{Path: missing},
+		{Path: large, SHA256: hex.EncodeToString(largeHash[:])},
+		{Path: dir},
+	}, hashes)
+}
+
+func Test_checkFileContents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "agent.conf")
+	require.NoError(t, os.WriteFile(path, []byte("[agent]\ntamper_protection = true\n"), 0600))
+	missing := filepath.Join(t.TempDir(), "missing.conf")
+
+	matches := checkFileContents([]FileContentPattern{
+		{Path: path, Pattern: `(?m)^tamper_protection\s*=\s*true$`},
+		{Path: path, Pattern: `(?m)^tamper_protection\s*=\s*false$`},
+		{Path: path, Pattern: `tamper_protection = (true`},
+		{Path: missing, Pattern: `tamper_protection`},
+	})
+	assert.Equal(t, []FileContentMatch{
+		{Path: path, Pattern: `(?m)^tamper_protection\s*=\s*true$`, Matched: true},
+		{Path: path, Pattern: `(?m)^tamper_protection\s*=\s*false$`, Matched: false},
+		{Path: path, Pattern: `tamper_protection = (true`, Matched: false},
+		{Path: missing, Pattern: `tamper_protection`, Matched: false},
+	}, matches)
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"net"
 	"net/netip"
+	"slices"
 	"strings"
 
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/netbirdio/netbird/management/proto"
@@ -24,6 +26,9 @@ const OsNameCtxKey = "OsName"
 // UiVersionCtxKey context key for user UI version
 const UiVersionCtxKey = "user-agent"
 
+// TagsCtxKey context key for the peer tags
+const TagsCtxKey = "tags"
+
 type NetworkAddress struct {
 	NetIP netip.Prefix
 	Mac   string
@@ -40,6 +45,25 @@ type File struct {
 	ProcessIsRunning bool
 }
 
+// FileHash is the SHA-256 hash of a file, empty when the file couldn't be read
+type FileHash struct {
+	Path   string
+	SHA256 string
+}
+
+// FileContentMatch is the result of matching the content of a file against a pattern
+type FileContentMatch struct {
+	Path    string
+	Pattern string
+	Matched bool
+}
+
+// DiskEncryptionVolume is the encryption state of a mounted volume
+type DiskEncryptionVolume struct {
+	Path      string
+	Encrypted bool
+}
+
 // Info is an object that contains machine information
 // Most of the code is taken from https://github.com/matishsiao/goInfo
 type Info struct {
@@ -59,6 +83,11 @@ type Info struct {
 	SystemManufacturer string
 	Environment        Environment
 	Files              []File // for posture checks
+	Tags               map[string]string
+	DiskEncryption     []DiskEncryptionVolume // for posture checks
+	FirewallEnabled    bool                   // for posture checks
+	FileHashes         []FileHash             // for posture checks
+	FileContents       []FileContentMatch     // for posture checks
 }
 
 // extractUserAgent extracts Netbird's agent (client) name and version from the outgoing context
@@ -86,6 +115,15 @@ func extractDeviceName(ctx context.Context, defaultName string) string {
 	return v
 }
 
+// extractTags extracts the peer tags from context
+func extractTags(ctx context.Context) map[string]string {
+	v, ok := ctx.Value(TagsCtxKey).(map[string]string)
+	if !ok {
+		return nil
+	}
+	return v
+}
+
 // GetDesktopUIUserAgent returns the Desktop ui user agent
 func GetDesktopUIUserAgent() string {
 	return "netbird-desktop-ui/" + version.NetbirdVersion()
@@ -144,8 +182,21 @@ func isDuplicated(addresses []NetworkAddress, addr NetworkAddress) bool {
 // GetInfoWithChecks retrieves and parses the system information with applied checks.
 func GetInfoWithChecks(ctx context.Context, checks []*proto.Checks) (*Info, error) {
 	processCheckPaths := make([]string, 0)
+	var hashPaths []string
+	var contentPatterns []FileContentPattern
 	for _, check := range checks {
 		processCheckPaths = append(processCheckPaths, check.GetFiles()...)
+		for _, path := range check.GetFileHashes() {
+			if !slices.Contains(hashPaths, path) {
+				hashPaths = append(hashPaths, path)
+			}
+		}
+		for _, content := range check.GetFileContents() {
+			pattern := FileContentPattern{Path: content.GetPath(), Pattern: content.GetPattern()}
+			if !slices.Contains(contentPatterns, pattern) {
+				contentPatterns = append(contentPatterns, pattern)
+			}
+		}
 	}
 
 	files, err := checkFileAndProcess(processCheckPaths)
@@ -155,6 +206,18 @@ func GetInfoWithChecks(ctx context.Context, checks []*proto.Checks) (*Info, erro
 
 	info := GetInfo(ctx)
 	info.Files = files
+	info.FileHashes = checkFileHashes(hashPaths)
+	info.FileContents = checkFileContents(contentPatterns)
+
+	info.DiskEncryption, err = diskEncryption()
+	if err != nil {
+		log.Warnf("failed to get disk encryption state: %v", err)
+	}
+
+	info.FirewallEnabled, err = firewallEnabled()
+	if err != nil {
+		log.Warnf("failed to get host firewall state: %v", err)
+	}
 
 	return info, nil
 }
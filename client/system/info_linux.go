@@ -89,6 +89,7 @@ func GetInfo(ctx context.Context) *Info {
 		SystemProductName:  prodName,
 		SystemManufacturer: manufacturer,
 		Environment:        env,
+		Tags:               extractTags(ctx),
 	}
 
 	return gio
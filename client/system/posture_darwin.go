@@ -0,0 +1,54 @@
+//go:build !ios
+
+package system
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// firewallStateRegex matches the state of the application firewall, e.g. "Firewall is enabled. (State = 1)"
+var firewallStateRegex = regexp.MustCompile(`\(State = (\d+)\)`)
+
+// diskEncryption returns the FileVault state of the system volume
+func diskEncryption() ([]DiskEncryptionVolume, error) {
+	out, err := exec.Command("/usr/bin/fdesetup", "status").Output()
+	if err != nil {
+		return nil, err
+	}
+
+	return []DiskEncryptionVolume{
+		{
+			Path:      "/",
+			Encrypted: strings.HasPrefix(strings.TrimSpace(string(out)), "FileVault is On"),
+		},
+	}, nil
+}
+
+// firewallEnabled returns the state of the application firewall
+func firewallEnabled() (bool, error) {
+	out, err := exec.Command("/usr/libexec/ApplicationFirewall/socketfilterfw", "--getglobalstate").Output()
+	if err != nil {
+		return false, err
+	}
+
+	return parseFirewallGlobalState(string(out))
+}
+
+// parseFirewallGlobalState parses the output of socketfilterfw --getglobalstate.
+// The state is 0 when the firewall is off, 1 when it is on and 2 when it blocks all the incoming connections.
+func parseFirewallGlobalState(out string) (bool, error) {
+	match := firewallStateRegex.FindStringSubmatch(out)
+	if match == nil {
+		return false, fmt.Errorf("unexpected firewall global state: %q", strings.TrimSpace(out))
+	}
+
+	state, err := strconv.Atoi(match[1])
+	if err != nil {
+		return false, fmt.Errorf("parse firewall global state: %w", err)
+	}
+	return state > 0, nil
+}
@@ -0,0 +1,259 @@
+//go:build !android
+
+package system
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/coreos/go-iptables/iptables"
+	"github.com/google/nftables"
+	"github.com/google/nftables/expr"
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+const (
+	procMountsPath = "/proc/mounts"
+	sysBlockPath   = "/sys/class/block"
+	ufwConfigPath  = "/etc/ufw/ufw.conf"
+
+	// netbirdTableName is the nftables table of the NetBird client firewall, it doesn't protect the host
+	netbirdTableName = "netbird"
+)
+
+// diskEncryption returns the encryption state of the block devices mounted on the system.
+// A volume is encrypted when its device, or one of the devices it is built on, is a LUKS/dm-crypt mapping.
+func diskEncryption() ([]DiskEncryptionVolume, error) {
+	mounts, err := os.Open(procMountsPath)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", procMountsPath, err)
+	}
+	defer mounts.Close()
+
+	return mountsDiskEncryption(mounts, sysBlockPath)
+}
+
+func mountsDiskEncryption(mounts io.Reader, sysBlock string) ([]DiskEncryptionVolume, error) {
+	seen := make(map[string]struct{})
+	var volumes []DiskEncryptionVolume
+
+	scanner := bufio.NewScanner(mounts)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 {
+			continue
+		}
+
+		device, path, fsType := fields[0], unescapeMountPath(fields[1]), fields[2]
+		if !strings.HasPrefix(device, "/dev/") || strings.HasPrefix(device, "/dev/loop") || fsType == "squashfs" {
+			continue
+		}
+
+		if _, ok := seen[path]; ok {
+			continue
+		}
+		seen[path] = struct{}{}
+
+		if resolved, err := filepath.EvalSymlinks(device); err == nil {
+			device = resolved
+		}
+
+		volumes = append(volumes, DiskEncryptionVolume{
+			Path:      path,
+			Encrypted: isCryptDevice(sysBlock, filepath.Base(device)),
+		})
+	}
+
+	return volumes, scanner.Err()
+}
+
+// isCryptDevice checks if the block device is a dm-crypt mapping or is built on top of one, e.g. LVM on LUKS.
+func isCryptDevice(sysBlock, name string) bool {
+	uuid, err := os.ReadFile(filepath.Join(sysBlock, name, "dm", "uuid"))
+	if err == nil && strings.HasPrefix(string(uuid), "CRYPT-") {
+		return true
+	}
+
+	slaves, err := os.ReadDir(filepath.Join(sysBlock, name, "slaves"))
+	if err != nil {
+		return false
+	}
+
+	for _, slave := range slaves {
+		if isCryptDevice(sysBlock, slave.Name()) {
+			return true
+		}
+	}
+	return false
+}
+
+// unescapeMountPath decodes the octal escapes used for white spaces in /proc/mounts
+func unescapeMountPath(path string) string {
+	return strings.NewReplacer(`\040`, " ", `\011`, "\t", `\012`, "\n", `\134`, `\`).Replace(path)
+}
+
+// firewallEnabled checks if ufw is enabled, firewalld is running or the nftables or iptables ruleset filters
+// the incoming traffic of the host
+func firewallEnabled() (bool, error) {
+	if isUfwEnabled() || isFirewalldRunning() {
+		return true, nil
+	}
+
+	nftEnabled, nftErr := nftablesFiltersInput()
+	if nftEnabled {
+		return true, nil
+	}
+
+	iptEnabled, iptErr := iptablesFiltersInput()
+	if iptEnabled {
+		return true, nil
+	}
+
+	if nftErr != nil && iptErr != nil {
+		return false, fmt.Errorf("nftables: %v, iptables: %v", nftErr, iptErr)
+	}
+	return false, nil
+}
+
+func isFirewalldRunning() bool {
+	processes, err := process.Processes()
+	if err != nil {
+		return false
+	}
+
+	for _, p := range processes {
+		if name, _ := p.Name(); name == "firewalld" {
+			return true
+		}
+	}
+	return false
+}
+
+// nftablesFiltersInput checks if a chain of the nftables ruleset filters the incoming traffic of the host
+func nftablesFiltersInput() (bool, error) {
+	conn, err := nftables.New()
+	if err != nil {
+		return false, err
+	}
+
+	chains, err := conn.ListChains()
+	if err != nil {
+		return false, fmt.Errorf("list chains: %w", err)
+	}
+
+	for _, chain := range chains {
+		if !isHostInputChain(chain) {
+			continue
+		}
+
+		rules, err := conn.GetRules(chain.Table, chain)
+		if err != nil {
+			return false, fmt.Errorf("get rules of chain %s: %w", chain.Name, err)
+		}
+
+		if nftChainFiltersInput(chain, rules) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isHostInputChain checks if the chain is a filter chain of the input hook of the IP families,
+// the chains of the NetBird client firewall are skipped
+func isHostInputChain(chain *nftables.Chain) bool {
+	switch chain.Table.Family {
+	case nftables.TableFamilyIPv4, nftables.TableFamilyIPv6, nftables.TableFamilyINet:
+	default:
+		return false
+	}
+
+	return chain.Table.Name != netbirdTableName &&
+		chain.Type == nftables.ChainTypeFilter &&
+		chain.Hooknum != nil && *chain.Hooknum == *nftables.ChainHookInput
+}
+
+// nftChainFiltersInput checks if the input chain drops the traffic by default or has a rule dropping
+// or rejecting the traffic of all the interfaces
+func nftChainFiltersInput(chain *nftables.Chain, rules []*nftables.Rule) bool {
+	if chain.Policy != nil && *chain.Policy == nftables.ChainPolicyDrop {
+		return true
+	}
+
+	return slices.ContainsFunc(rules, func(rule *nftables.Rule) bool {
+		var dropping bool
+		for _, e := range rule.Exprs {
+			switch e := e.(type) {
+			case *expr.Meta:
+				// rules of a single interface, like the ones of the NetBird interface, don't protect the host
+				if e.Key == expr.MetaKeyIIFNAME || e.Key == expr.MetaKeyIIF {
+					return false
+				}
+			case *expr.Verdict:
+				dropping = dropping || e.Kind == expr.VerdictDrop
+			case *expr.Reject:
+				dropping = true
+			case *expr.Target:
+				// rules added with iptables-nft
+				dropping = dropping || e.Name == "REJECT"
+			}
+		}
+		return dropping
+	})
+}
+
+// iptablesFiltersInput checks if the INPUT chain of the iptables filter table filters the incoming traffic
+// of the host
+func iptablesFiltersInput() (bool, error) {
+	ipt, err := iptables.NewWithProtocol(iptables.ProtocolIPv4)
+	if err != nil {
+		return false, err
+	}
+
+	rules, err := ipt.List("filter", "INPUT")
+	if err != nil {
+		return false, fmt.Errorf("list INPUT rules: %w", err)
+	}
+
+	return iptablesRulesFilterInput(rules), nil
+}
+
+// iptablesRulesFilterInput checks if the INPUT chain rules drop the traffic by default or have a rule dropping
+// or rejecting the traffic of all the interfaces
+func iptablesRulesFilterInput(rules []string) bool {
+	for _, rule := range rules {
+		fields := strings.Fields(rule)
+		if len(fields) == 3 && fields[0] == "-P" && fields[2] == "DROP" {
+			return true
+		}
+
+		// rules of a single interface, like the ones of the NetBird interface, don't protect the host
+		if slices.Contains(fields, "-i") {
+			continue
+		}
+
+		target := slices.Index(fields, "-j")
+		if target != -1 && target+1 < len(fields) && (fields[target+1] == "DROP" || fields[target+1] == "REJECT") {
+			return true
+		}
+	}
+	return false
+}
+
+func isUfwEnabled() bool {
+	conf, err := os.ReadFile(ufwConfigPath)
+	if err != nil {
+		return false
+	}
+
+	for _, line := range strings.Split(string(conf), "\n") {
+		if strings.TrimSpace(line) == "ENABLED=yes" {
+			return true
+		}
+	}
+	return false
+}
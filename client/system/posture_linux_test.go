@@ -0,0 +1,81 @@
+//go:build !android
+
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/expr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_mountsDiskEncryption(t *testing.T) {
+	sysBlock := t.TempDir()
+
+	// dm-0 is a LUKS mapping of nvme0n1p3 holding an LVM volume group, dm-1 is a logical volume on top of it
+	writeSysBlock(t, sysBlock, "dm-0", "CRYPT-LUKS2-1234-luks-1234")
+	writeSysBlock(t, sysBlock, "dm-1", "LVM-abcd")
+	require.NoError(t, os.MkdirAll(filepath.Join(sysBlock, "dm-1", "slaves", "dm-0"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(sysBlock, "nvme0n1p1"), 0755))
+
+	mounts := strings.Join([]string{
+		"sysfs /sys sysfs rw,nosuid,nodev,noexec,relatime 0 0",
+		"/dev/dm-1 / ext4 rw,relatime 0 0",
+		"/dev/nvme0n1p1 /boot/efi vfat rw,relatime 0 0",
+		"/dev/dm-0 /mnt/my\\040data xfs rw,relatime 0 0",
+		"/dev/dm-1 / ext4 rw,relatime 0 0",
+		"/dev/loop0 /snap/core/1 squashfs ro,nodev,relatime 0 0",
+	}, "\n")
+
+	volumes, err := mountsDiskEncryption(strings.NewReader(mounts), sysBlock)
+	require.NoError(t, err)
+	assert.Equal(t, []DiskEncryptionVolume{
+		{Path: "/", Encrypted: true},
+		{Path: "/boot/efi", Encrypted: false},
+		{Path: "/mnt/my data", Encrypted: true},
+	}, volumes)
+}
+
+func writeSysBlock(t *testing.T, sysBlock, name, uuid string) {
+	t.Helper()
+	dir := filepath.Join(sysBlock, name, "dm")
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "uuid"), []byte(uuid+"\n"), 0644))
+}
+
+func Test_nftChainFiltersInput(t *testing.T) {
+	drop := nftables.ChainPolicyDrop
+	accept := nftables.ChainPolicyAccept
+
+	netbirdDrop := &nftables.Rule{Exprs: []expr.Any{
+		&expr.Meta{Key: expr.MetaKeyIIFNAME, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte("wt0\x00")},
+		&expr.Verdict{Kind: expr.VerdictDrop},
+	}}
+	sshAccept := &nftables.Rule{Exprs: []expr.Any{&expr.Counter{}, &expr.Verdict{Kind: expr.VerdictAccept}}}
+	reject := &nftables.Rule{Exprs: []expr.Any{&expr.Reject{}}}
+
+	assert.True(t, nftChainFiltersInput(&nftables.Chain{Policy: &drop}, nil), "drop policy filters the traffic")
+	assert.False(t, nftChainFiltersInput(&nftables.Chain{Policy: &accept}, []*nftables.Rule{netbirdDrop, sshAccept}),
+		"rules of a single interface and accept rules don't filter the host traffic")
+	assert.True(t, nftChainFiltersInput(&nftables.Chain{Policy: &accept}, []*nftables.Rule{sshAccept, reject}))
+}
+
+func Test_iptablesRulesFilterInput(t *testing.T) {
+	assert.True(t, iptablesRulesFilterInput([]string{"-P INPUT DROP"}))
+	assert.False(t, iptablesRulesFilterInput([]string{
+		"-P INPUT ACCEPT",
+		"-A INPUT -i wt0 -j NETBIRD-ACL-INPUT",
+		"-A INPUT -i wt0 -j DROP",
+	}), "rules of the NetBird interface don't filter the host traffic")
+	assert.True(t, iptablesRulesFilterInput([]string{
+		"-P INPUT ACCEPT",
+		"-A INPUT -p tcp -m tcp --dport 22 -j ACCEPT",
+		"-A INPUT -j REJECT --reject-with icmp-port-unreachable",
+	}))
+}
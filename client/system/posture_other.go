@@ -0,0 +1,13 @@
+//go:build !(linux && !android) && !(darwin && !ios) && !windows
+
+package system
+
+// diskEncryption is not supported on this platform
+func diskEncryption() ([]DiskEncryptionVolume, error) {
+	return nil, nil
+}
+
+// firewallEnabled is not supported on this platform
+func firewallEnabled() (bool, error) {
+	return false, nil
+}
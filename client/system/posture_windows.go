@@ -0,0 +1,68 @@
+package system
+
+import (
+	"fmt"
+
+	"github.com/yusufpapurcu/wmi"
+	"golang.org/x/sys/windows/registry"
+)
+
+const bitLockerNamespace = `root\CIMV2\Security\MicrosoftVolumeEncryption`
+
+// bitLockerProtectionOn is the Win32_EncryptableVolume protection status of volumes protected by BitLocker
+const bitLockerProtectionOn = 1
+
+type Win32_EncryptableVolume struct {
+	DriveLetter      string
+	ProtectionStatus uint32
+}
+
+// diskEncryption returns the BitLocker protection state of the volumes with a drive letter
+func diskEncryption() ([]DiskEncryptionVolume, error) {
+	var dst []Win32_EncryptableVolume
+	query := wmi.CreateQuery(&dst, "")
+	if err := wmi.QueryNamespace(query, &dst, bitLockerNamespace); err != nil {
+		return nil, err
+	}
+
+	volumes := make([]DiskEncryptionVolume, 0, len(dst))
+	for _, volume := range dst {
+		if volume.DriveLetter == "" {
+			continue
+		}
+		volumes = append(volumes, DiskEncryptionVolume{
+			Path:      volume.DriveLetter,
+			Encrypted: volume.ProtectionStatus == bitLockerProtectionOn,
+		})
+	}
+	return volumes, nil
+}
+
+// firewallEnabled checks if Windows Defender Firewall is enabled for the domain, private and public profiles
+func firewallEnabled() (bool, error) {
+	for _, profile := range []string{"DomainProfile", "StandardProfile", "PublicProfile"} {
+		path := `SYSTEM\CurrentControlSet\Services\SharedAccess\Parameters\FirewallPolicy\` + profile
+		enabled, err := readFirewallProfile(path)
+		if err != nil {
+			return false, fmt.Errorf("read firewall profile %s: %w", profile, err)
+		}
+		if !enabled {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func readFirewallProfile(path string) (bool, error) {
+	k, err := registry.OpenKey(registry.LOCAL_MACHINE, path, registry.QUERY_VALUE)
+	if err != nil {
+		return false, err
+	}
+	defer k.Close()
+
+	enabled, _, err := k.GetIntegerValue("EnableFirewall")
+	if err != nil {
+		return false, err
+	}
+	return enabled == 1, nil
+}
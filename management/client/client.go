@@ -20,5 +20,5 @@ type Client interface {
 	GetPKCEAuthorizationFlow(serverKey wgtypes.Key) (*proto.PKCEAuthorizationFlow, error)
 	GetNetworkMap(sysInfo *system.Info) (*proto.NetworkMap, error)
 	IsHealthy() bool
-	SyncMeta(sysInfo *system.Info) error
+	SyncMeta(sysInfo *system.Info, auditCounts map[string]uint64) error
 }
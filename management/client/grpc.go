@@ -219,7 +219,11 @@ func (c *GrpcClient) GetNetworkMap(sysInfo *system.Info) (*proto.NetworkMap, err
 }
 
 func (c *GrpcClient) connectToStream(ctx context.Context, serverPubKey wgtypes.Key, sysInfo *system.Info) (proto.ManagementService_SyncClient, error) {
-	req := &proto.SyncRequest{Meta: infoToMetaData(sysInfo)}
+	req := &proto.SyncRequest{
+		Meta: infoToMetaData(sysInfo),
+		// the first message of the stream is always a full network map, the following ones can be deltas to it
+		Capabilities: []proto.PeerCapability{proto.PeerCapability_NETWORK_MAP_DELTA},
+	}
 
 	myPrivateKey := c.key
 	myPublicKey := myPrivateKey.PublicKey()
@@ -451,7 +455,8 @@ func (c *GrpcClient) GetPKCEAuthorizationFlow(serverKey wgtypes.Key) (*proto.PKC
 
 // SyncMeta sends updated system metadata to the Management Service.
 // It should be used if there is changes on peer posture check after initial sync.
-func (c *GrpcClient) SyncMeta(sysInfo *system.Info) error {
+// The audit counts are the packets matched by the audit firewall rules per policy since the last report.
+func (c *GrpcClient) SyncMeta(sysInfo *system.Info, auditCounts map[string]uint64) error {
 	if !c.ready() {
 		return errors.New(errMsgNoMgmtConnection)
 	}
@@ -462,7 +467,12 @@ func (c *GrpcClient) SyncMeta(sysInfo *system.Info) error {
 		return err
 	}
 
-	syncMetaReq, err := encryption.EncryptMessage(*serverPubKey, c.key, &proto.SyncMetaRequest{Meta: infoToMetaData(sysInfo)})
+	req := &proto.SyncMetaRequest{Meta: infoToMetaData(sysInfo)}
+	for policyID, packets := range auditCounts {
+		req.AuditCounts = append(req.AuditCounts, &proto.PolicyAuditCount{PolicyID: policyID, Packets: packets})
+	}
+
+	syncMetaReq, err := encryption.EncryptMessage(*serverPubKey, c.key, req)
 	if err != nil {
 		log.Errorf("failed to encrypt message: %s", err)
 		return err
@@ -520,6 +530,31 @@ func infoToMetaData(info *system.Info) *proto.PeerSystemMeta {
 		})
 	}
 
+	diskEncryption := make([]*proto.DiskEncryptionVolume, 0, len(info.DiskEncryption))
+	for _, volume := range info.DiskEncryption {
+		diskEncryption = append(diskEncryption, &proto.DiskEncryptionVolume{
+			Path:      volume.Path,
+			Encrypted: volume.Encrypted,
+		})
+	}
+
+	fileHashes := make([]*proto.FileHash, 0, len(info.FileHashes))
+	for _, file := range info.FileHashes {
+		fileHashes = append(fileHashes, &proto.FileHash{
+			Path:   file.Path,
+			Sha256: file.SHA256,
+		})
+	}
+
+	fileContents := make([]*proto.FileContentMatch, 0, len(info.FileContents))
+	for _, file := range info.FileContents {
+		fileContents = append(fileContents, &proto.FileContentMatch{
+			Path:    file.Path,
+			Pattern: file.Pattern,
+			Matched: file.Matched,
+		})
+	}
+
 	return &proto.PeerSystemMeta{
 		Hostname:           info.Hostname,
 		GoOS:               info.GoOS,
@@ -539,6 +574,11 @@ func infoToMetaData(info *system.Info) *proto.PeerSystemMeta {
 			Cloud:    info.Environment.Cloud,
 			Platform: info.Environment.Platform,
 		},
-		Files: files,
+		Files:           files,
+		Tags:            info.Tags,
+		DiskEncryption:  diskEncryption,
+		FirewallEnabled: info.FirewallEnabled,
+		FileHashes:      fileHashes,
+		FileContents:    fileContents,
 	}
 }
@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/netbirdio/management-integrations/integrations"
+
+	"github.com/netbirdio/netbird/formatter"
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/accountconfig"
+	"github.com/netbirdio/netbird/management/server/telemetry"
+	"github.com/netbirdio/netbird/util"
+)
+
+var (
+	accountConfigAccountID string
+	accountConfigUserID    string
+	accountConfigFile      string
+	accountConfigFormat    string
+	accountConfigDryRun    bool
+
+	accountConfigCmd = &cobra.Command{
+		Use:          "account-config",
+		Short:        "Contains sub-commands to export and apply declarative account configuration documents",
+		Long:         "",
+		SilenceUsage: true,
+	}
+
+	accountConfigExportCmd = &cobra.Command{
+		Use:   "export --account-id ID [--user-id ID] [--file path] [--format yaml|json]",
+		Short: "Export the configuration objects of an account to a YAML or JSON document",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, accountManager, userID, closeFn, err := newAccountConfigManager(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+
+			config, err := accountManager.ExportAccountConfig(ctx, accountConfigAccountID, userID)
+			if err != nil {
+				return fmt.Errorf("failed exporting account configuration: %v", err)
+			}
+
+			data, err := accountconfig.Marshal(config, accountconfig.Format(accountConfigFormat))
+			if err != nil {
+				return err
+			}
+
+			if accountConfigFile == "" || accountConfigFile == "-" {
+				_, err = cmd.OutOrStdout().Write(data)
+				return err
+			}
+
+			return os.WriteFile(accountConfigFile, data, 0600)
+		},
+	}
+
+	accountConfigApplyCmd = &cobra.Command{
+		Use:   "apply --account-id ID --file path [--user-id ID] [--dry-run]",
+		Short: "Apply a YAML or JSON document to the configuration objects of an account",
+		Long: "Apply a YAML or JSON document to the configuration objects of an account" +
+			"\n\n" +
+			"The document is applied in a single transaction and the applied changes are printed as JSON. " +
+			"With --dry-run the changes are only validated and printed. " +
+			"Connected peers of a running management server receive the changes on their next sync, " +
+			"use the /api/config/apply endpoint to update them immediately.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var data []byte
+			var err error
+			if accountConfigFile == "-" {
+				data, err = io.ReadAll(cmd.InOrStdin())
+			} else {
+				data, err = os.ReadFile(accountConfigFile)
+			}
+			if err != nil {
+				return fmt.Errorf("failed reading account configuration %s: %v", accountConfigFile, err)
+			}
+
+			config, err := accountconfig.Parse(data)
+			if err != nil {
+				return err
+			}
+
+			ctx, accountManager, userID, closeFn, err := newAccountConfigManager(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+
+			var changes []*accountconfig.Change
+			if accountConfigDryRun {
+				changes, err = accountManager.PlanAccountConfig(ctx, accountConfigAccountID, userID, config)
+			} else {
+				changes, err = accountManager.ApplyAccountConfig(ctx, accountConfigAccountID, userID, config)
+			}
+			if err != nil {
+				return fmt.Errorf("failed applying account configuration: %v", err)
+			}
+
+			if changes == nil {
+				changes = []*accountconfig.Change{}
+			}
+			output, err := json.MarshalIndent(changes, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(output))
+			return err
+		},
+	}
+)
+
+func init() {
+	accountConfigCmd.PersistentFlags().StringVar(&mgmtDataDir, "datadir", defaultMgmtDataDir, "server data directory location")
+	accountConfigCmd.PersistentFlags().StringVar(&mgmtConfig, "config", defaultMgmtConfig, "Netbird config file location")
+	accountConfigCmd.PersistentFlags().StringVar(&accountConfigAccountID, "account-id", "", "ID of the account")
+	accountConfigCmd.PersistentFlags().StringVar(&accountConfigUserID, "user-id", "", "ID of the user the changes are made by. Defaults to the account owner")
+	accountConfigCmd.MarkPersistentFlagRequired("account-id") //nolint
+
+	accountConfigExportCmd.Flags().StringVar(&accountConfigFile, "file", "-", "file to write the document to, - writes to stdout")
+	accountConfigExportCmd.Flags().StringVar(&accountConfigFormat, "format", string(accountconfig.FormatYAML), "document format, yaml or json")
+
+	accountConfigApplyCmd.Flags().StringVar(&accountConfigFile, "file", "", "file to read the document from, - reads from stdin")
+	accountConfigApplyCmd.Flags().BoolVar(&accountConfigDryRun, "dry-run", false, "only print the changes that would be applied")
+	accountConfigApplyCmd.MarkFlagRequired("file") //nolint
+
+	accountConfigCmd.AddCommand(accountConfigExportCmd)
+	accountConfigCmd.AddCommand(accountConfigApplyCmd)
+}
+
+// newAccountConfigManager builds an account manager on top of the configured store without running background jobs
+func newAccountConfigManager(cmd *cobra.Command) (context.Context, *server.DefaultAccountManager, string, func(), error) {
+	flag.Parse()
+	err := util.InitLog(logLevel, logFile)
+	if err != nil {
+		return nil, nil, "", nil, fmt.Errorf("failed initializing log %v", err)
+	}
+
+	//nolint
+	ctx := context.WithValue(cmd.Context(), formatter.ExecutionContextKey, formatter.SystemSource)
+
+	config, err := loadMgmtConfig(ctx, mgmtConfig)
+	if err != nil {
+		return nil, nil, "", nil, fmt.Errorf("failed reading provided config file: %s: %v", mgmtConfig, err)
+	}
+
+	appMetrics, err := telemetry.NewDefaultAppMetrics(ctx)
+	if err != nil {
+		return nil, nil, "", nil, err
+	}
+
+	store, err := server.NewStore(ctx, config.StoreConfig.Engine, config.Datadir, appMetrics)
+	if err != nil {
+		return nil, nil, "", nil, fmt.Errorf("failed creating Store: %s: %v", config.Datadir, err)
+	}
+
+	eventStore, _, err := initEventStore(ctx, config)
+	if err != nil {
+		store.Close(ctx) //nolint
+		return nil, nil, "", nil, fmt.Errorf("failed to initialize database: %s", err)
+	}
+
+	integratedPeerValidator, err := integrations.NewIntegratedValidator(ctx, eventStore)
+	if err != nil {
+		_ = eventStore.Close(ctx)
+		_ = store.Close(ctx)
+		_ = appMetrics.Close()
+		return nil, nil, "", nil, fmt.Errorf("failed to initialize integrated peer validator: %v", err)
+	}
+
+	accountManager := server.NewAccountConfigManager(ctx, store, eventStore, dnsDomain, integratedPeerValidator, appMetrics)
+
+	closeFn := func() {
+		// events are saved asynchronously, the event store is closed once they are saved
+		accountManager.WaitForPendingEvents()
+		_ = eventStore.Close(ctx)
+		_ = store.Close(ctx)
+		_ = appMetrics.Close()
+	}
+
+	userID := accountConfigUserID
+	if userID == "" {
+		userID, err = getAccountOwnerID(ctx, store, accountConfigAccountID)
+		if err != nil {
+			closeFn()
+			return nil, nil, "", nil, err
+		}
+	}
+
+	log.WithContext(ctx).Debugf("account configuration changes are made by user %s", userID)
+
+	return ctx, accountManager, userID, closeFn, nil
+}
+
+func getAccountOwnerID(ctx context.Context, store server.Store, accountID string) (string, error) {
+	users, err := store.GetAccountUsers(ctx, server.LockingStrengthShare, accountID)
+	if err != nil {
+		return "", fmt.Errorf("failed getting users of account %s: %v", accountID, err)
+	}
+
+	for _, user := range users {
+		if user.Role == server.UserRoleOwner {
+			return user.Id, nil
+		}
+	}
+
+	return "", fmt.Errorf("account %s has no owner, set the --user-id flag", accountID)
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/netbirdio/management-integrations/integrations"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/activity/postgres"
+	"github.com/netbirdio/netbird/management/server/activity/sqlite"
+)
+
+const postgresDsnEnv = "NETBIRD_STORE_ENGINE_POSTGRES_DSN"
+
+// initEventStore creates the activity event store and returns it together with its encryption key.
+// With the Postgres store engine the events are kept in the Postgres database of the main store,
+// so the management server doesn't depend on a local SQLite file.
+func initEventStore(ctx context.Context, config *server.Config) (activity.Store, string, error) {
+	if !isPostgresStoreEngine(config.StoreConfig.Engine) {
+		return integrations.InitEventStore(ctx, config.Datadir, config.DataStoreEncryptionKey)
+	}
+
+	key := config.DataStoreEncryptionKey
+	if key == "" {
+		var err error
+		key, err = sqlite.GenerateKey()
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	store, err := newPostgresEventStore(ctx, key)
+	return store, key, err
+}
+
+func newPostgresEventStore(ctx context.Context, key string) (*postgres.Store, error) {
+	dsn, ok := os.LookupEnv(postgresDsnEnv)
+	if !ok {
+		return nil, fmt.Errorf("%s is not set", postgresDsnEnv)
+	}
+	return postgres.NewPostgresStore(ctx, dsn, key)
+}
+
+// isPostgresStoreEngine returns true if the configured engine, or the engine set by the environment when none is configured, is Postgres
+func isPostgresStoreEngine(engine server.StoreEngine) bool {
+	if engine == "" {
+		engine = server.StoreEngine(strings.ToLower(os.Getenv("NETBIRD_STORE_ENGINE")))
+	}
+	return engine == server.PostgresStoreEngine
+}
@@ -166,7 +166,7 @@ var (
 			if disableSingleAccMode {
 				mgmtSingleAccModeDomain = ""
 			}
-			eventStore, key, err := integrations.InitEventStore(ctx, config.Datadir, config.DataStoreEncryptionKey)
+			eventStore, key, err := initEventStore(ctx, config)
 			if err != nil {
 				return fmt.Errorf("failed to initialize database: %s", err)
 			}
@@ -197,6 +197,10 @@ var (
 				return fmt.Errorf("failed to build default manager: %v", err)
 			}
 
+			if err = accountManager.StartWebhookDispatcher(ctx, config.DataStoreEncryptionKey, config.WebhookAllowPrivateTargets); err != nil {
+				return fmt.Errorf("failed to start webhook dispatcher: %v", err)
+			}
+
 			secretsManager := server.NewTimeBasedAuthSecretsManager(peersUpdateManager, config.TURNConfig, config.Relay)
 
 			trustedPeers := config.ReverseProxy.TrustedPeers
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/netbirdio/netbird/formatter"
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/activity/postgres"
+	"github.com/netbirdio/netbird/util"
+)
+
+var shortEventsPostgres = "Migrate the SQLite activity event store to Postgres. Please make a backup of the events database before running this command."
+
+var eventsPostgresCmd = &cobra.Command{
+	Use:   "events-postgres [--datadir directory] [--config config-file] [--log-file console]",
+	Short: shortEventsPostgres,
+	Long: shortEventsPostgres +
+		"\n\n" +
+		"This command copies the events of {datadir}/events.db to the Postgres database set by the " + postgresDsnEnv + " environment variable. " +
+		"The encryption key of the events is read from the management config file.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		flag.Parse()
+		err := util.InitLog(logLevel, logFile)
+		if err != nil {
+			return fmt.Errorf("failed initializing log %v", err)
+		}
+
+		//nolint
+		ctx := context.WithValue(cmd.Context(), formatter.ExecutionContextKey, formatter.SystemSource)
+
+		config := &server.Config{}
+		if _, err = util.ReadJsonWithEnvSub(mgmtConfig, config); err != nil {
+			return fmt.Errorf("failed reading config %s: %v", mgmtConfig, err)
+		}
+
+		if config.DataStoreEncryptionKey == "" {
+			return fmt.Errorf("the config %s has no DataStoreEncryptionKey, there are no encrypted events to migrate", mgmtConfig)
+		}
+
+		store, err := newPostgresEventStore(ctx, config.DataStoreEncryptionKey)
+		if err != nil {
+			return fmt.Errorf("failed creating Postgres event store: %v", err)
+		}
+		defer store.Close(ctx) //nolint
+
+		if err = postgres.MigrateFromSQLite(ctx, mgmtDataDir, config.DataStoreEncryptionKey, store); err != nil {
+			return err
+		}
+		log.WithContext(ctx).Info("Migration finished successfully")
+
+		return nil
+	},
+}
@@ -38,7 +38,7 @@ var (
 
 	migrationCmd = &cobra.Command{
 		Use:          "sqlite-migration",
-		Short:        "Contains sub-commands to perform JSON file store to SQLite store migration and rollback",
+		Short:        "Contains sub-commands to perform JSON file store to SQLite store and SQLite event store to Postgres migrations",
 		Long:         "",
 		SilenceUsage: true,
 	}
@@ -78,7 +78,11 @@ func init() {
 
 	migrationCmd.AddCommand(upCmd)
 
+	eventsPostgresCmd.Flags().StringVar(&mgmtConfig, "config", defaultMgmtConfig, "Netbird config file location. The activity store encryption key is read from this file")
+	migrationCmd.AddCommand(eventsPostgresCmd)
+
 	rootCmd.AddCommand(migrationCmd)
+	rootCmd.AddCommand(accountConfigCmd)
 }
 
 // SetupCloseHandler handles SIGTERM signal and exits with success
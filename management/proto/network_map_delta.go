@@ -0,0 +1,335 @@
+package proto
+
+import (
+	"fmt"
+
+	pb "google.golang.org/protobuf/proto"
+)
+
+// NewNetworkMapDelta returns the changes to apply to the base network map to get the current one.
+// Applying the changes keeps the order of the current network map elements: a list whose order can't be kept
+// by patching the base list is replaced as a whole.
+// Both network maps are left untouched, the delta shares their elements.
+func NewNetworkMapDelta(base, current *NetworkMap) *NetworkMapDelta {
+	delta := &NetworkMapDelta{
+		BaseSerial: base.GetSerial(),
+		Serial:     current.GetSerial(),
+	}
+
+	if !pb.Equal(base.GetPeerConfig(), current.GetPeerConfig()) {
+		delta.PeerConfig = current.GetPeerConfig()
+	}
+
+	delta.AddedRemotePeers, delta.RemovedRemotePeers = diffOrderedRemotePeers(base.GetRemotePeers(), current.GetRemotePeers())
+	delta.AddedOfflinePeers, delta.RemovedOfflinePeers = diffOrderedRemotePeers(base.GetOfflinePeers(), current.GetOfflinePeers())
+	delta.AddedFirewallRules, delta.RemovedFirewallRules = diffOrderedMessages(base.GetFirewallRules(), current.GetFirewallRules())
+	delta.AddedRoutes, delta.RemovedRoutes = diffOrderedMessages(base.GetRoutes(), current.GetRoutes())
+	delta.AddedRoutesFirewallRules, delta.RemovedRoutesFirewallRules = diffOrderedMessages(base.GetRoutesFirewallRules(), current.GetRoutesFirewallRules())
+
+	baseDNS, currentDNS := base.GetDNSConfig(), current.GetDNSConfig()
+	if baseDNS.GetServiceEnable() != currentDNS.GetServiceEnable() ||
+		!equalMessages(baseDNS.GetNameServerGroups(), currentDNS.GetNameServerGroups()) {
+		delta.DNSConfig = &DNSConfig{
+			ServiceEnable:    currentDNS.GetServiceEnable(),
+			NameServerGroups: currentDNS.GetNameServerGroups(),
+		}
+	}
+
+	baseZones := make(map[string]*CustomZone, len(baseDNS.GetCustomZones()))
+	for _, zone := range baseDNS.GetCustomZones() {
+		baseZones[zone.GetDomain()] = zone
+	}
+
+	for _, zone := range currentDNS.GetCustomZones() {
+		baseZone, ok := baseZones[zone.GetDomain()]
+		delete(baseZones, zone.GetDomain())
+		if !ok {
+			delta.AddedDNSRecords = append(delta.AddedDNSRecords, zone)
+			continue
+		}
+
+		added, removed := diffMessages(baseZone.GetRecords(), zone.GetRecords())
+		if len(added) > 0 {
+			delta.AddedDNSRecords = append(delta.AddedDNSRecords, &CustomZone{Domain: zone.GetDomain(), Records: added})
+		}
+		if len(removed) > 0 {
+			delta.RemovedDNSRecords = append(delta.RemovedDNSRecords, &CustomZone{Domain: zone.GetDomain(), Records: removed})
+		}
+	}
+
+	for _, zone := range baseDNS.GetCustomZones() {
+		if _, removed := baseZones[zone.GetDomain()]; removed {
+			delta.RemovedCustomZones = append(delta.RemovedCustomZones, zone.GetDomain())
+		}
+	}
+
+	// the zones and records are replaced when patching them would change their order
+	if !equalMessages(patchDNSConfig(baseDNS, delta).GetCustomZones(), currentDNS.GetCustomZones()) {
+		delta.AddedDNSRecords = currentDNS.GetCustomZones()
+		delta.RemovedDNSRecords = nil
+		delta.RemovedCustomZones = nil
+		for _, zone := range baseDNS.GetCustomZones() {
+			delta.RemovedCustomZones = append(delta.RemovedCustomZones, zone.GetDomain())
+		}
+	}
+
+	return delta
+}
+
+// ApplyNetworkMapDelta returns the network map resulting from the changes of the delta applied to the base network map.
+// It fails when the delta doesn't apply to the base network map, the full network map has to be synced in this case.
+// The base network map is left untouched, the result shares its elements.
+func ApplyNetworkMapDelta(base *NetworkMap, delta *NetworkMapDelta) (*NetworkMap, error) {
+	if base == nil {
+		return nil, fmt.Errorf("no network map to apply the delta with base serial %d to", delta.GetBaseSerial())
+	}
+
+	if base.GetSerial() != delta.GetBaseSerial() {
+		return nil, fmt.Errorf("network map delta base serial %d doesn't match the network map serial %d", delta.GetBaseSerial(), base.GetSerial())
+	}
+
+	networkMap := &NetworkMap{
+		Serial:              delta.GetSerial(),
+		PeerConfig:          base.GetPeerConfig(),
+		RemotePeers:         patchRemotePeers(base.GetRemotePeers(), delta.GetAddedRemotePeers(), delta.GetRemovedRemotePeers()),
+		OfflinePeers:        patchRemotePeers(base.GetOfflinePeers(), delta.GetAddedOfflinePeers(), delta.GetRemovedOfflinePeers()),
+		FirewallRules:       patchMessages(base.GetFirewallRules(), delta.GetAddedFirewallRules(), delta.GetRemovedFirewallRules()),
+		Routes:              patchMessages(base.GetRoutes(), delta.GetAddedRoutes(), delta.GetRemovedRoutes()),
+		RoutesFirewallRules: patchMessages(base.GetRoutesFirewallRules(), delta.GetAddedRoutesFirewallRules(), delta.GetRemovedRoutesFirewallRules()),
+	}
+
+	if delta.GetPeerConfig() != nil {
+		networkMap.PeerConfig = delta.GetPeerConfig()
+	}
+
+	networkMap.RemotePeersIsEmpty = len(networkMap.RemotePeers) == 0
+	networkMap.FirewallRulesIsEmpty = len(networkMap.FirewallRules) == 0
+	networkMap.RoutesFirewallRulesIsEmpty = len(networkMap.RoutesFirewallRules) == 0
+
+	networkMap.DNSConfig = patchDNSConfig(base.GetDNSConfig(), delta)
+
+	return networkMap, nil
+}
+
+func patchDNSConfig(base *DNSConfig, delta *NetworkMapDelta) *DNSConfig {
+	dnsConfig := &DNSConfig{
+		ServiceEnable:    base.GetServiceEnable(),
+		NameServerGroups: base.GetNameServerGroups(),
+	}
+
+	if delta.GetDNSConfig() != nil {
+		dnsConfig.ServiceEnable = delta.GetDNSConfig().GetServiceEnable()
+		dnsConfig.NameServerGroups = delta.GetDNSConfig().GetNameServerGroups()
+	}
+
+	added := make(map[string][]*SimpleRecord, len(delta.GetAddedDNSRecords()))
+	for _, zone := range delta.GetAddedDNSRecords() {
+		added[zone.GetDomain()] = zone.GetRecords()
+	}
+
+	removed := make(map[string][]*SimpleRecord, len(delta.GetRemovedDNSRecords()))
+	for _, zone := range delta.GetRemovedDNSRecords() {
+		removed[zone.GetDomain()] = zone.GetRecords()
+	}
+
+	removedZones := make(map[string]struct{}, len(delta.GetRemovedCustomZones()))
+	for _, domain := range delta.GetRemovedCustomZones() {
+		removedZones[domain] = struct{}{}
+	}
+
+	for _, zone := range base.GetCustomZones() {
+		domain := zone.GetDomain()
+		if _, ok := removedZones[domain]; ok {
+			continue
+		}
+
+		addedRecords, addedOK := added[domain]
+		removedRecords, removedOK := removed[domain]
+		delete(added, domain)
+
+		if !addedOK && !removedOK {
+			dnsConfig.CustomZones = append(dnsConfig.CustomZones, zone)
+			continue
+		}
+
+		dnsConfig.CustomZones = append(dnsConfig.CustomZones, &CustomZone{
+			Domain:  domain,
+			Records: patchMessages(zone.GetRecords(), addedRecords, removedRecords),
+		})
+	}
+
+	for _, zone := range delta.GetAddedDNSRecords() {
+		if _, ok := added[zone.GetDomain()]; ok {
+			dnsConfig.CustomZones = append(dnsConfig.CustomZones, zone)
+		}
+	}
+
+	return dnsConfig
+}
+
+// diffRemotePeers returns the added or updated peers and the keys of the removed ones
+func diffRemotePeers(base, current []*RemotePeerConfig) ([]*RemotePeerConfig, []string) {
+	basePeers := make(map[string]*RemotePeerConfig, len(base))
+	for _, peer := range base {
+		basePeers[peer.GetWgPubKey()] = peer
+	}
+
+	var added []*RemotePeerConfig
+	for _, peer := range current {
+		basePeer, ok := basePeers[peer.GetWgPubKey()]
+		delete(basePeers, peer.GetWgPubKey())
+		if !ok || !pb.Equal(basePeer, peer) {
+			added = append(added, peer)
+		}
+	}
+
+	var removed []string
+	for _, peer := range base {
+		if _, ok := basePeers[peer.GetWgPubKey()]; ok {
+			removed = append(removed, peer.GetWgPubKey())
+		}
+	}
+
+	return added, removed
+}
+
+// diffOrderedRemotePeers returns the changes of the peers, or all the peers to replace the base ones
+// when patching the base peers with the changes wouldn't give the order of the current ones
+func diffOrderedRemotePeers(base, current []*RemotePeerConfig) ([]*RemotePeerConfig, []string) {
+	added, removed := diffRemotePeers(base, current)
+	if equalMessages(patchRemotePeers(base, added, removed), current) {
+		return added, removed
+	}
+
+	removed = make([]string, 0, len(base))
+	for _, peer := range base {
+		removed = append(removed, peer.GetWgPubKey())
+	}
+	return current, removed
+}
+
+// patchRemotePeers replaces the updated peers in place, drops the removed ones and appends the added ones
+func patchRemotePeers(base, added []*RemotePeerConfig, removed []string) []*RemotePeerConfig {
+	if len(added) == 0 && len(removed) == 0 {
+		return base
+	}
+
+	addedPeers := make(map[string]*RemotePeerConfig, len(added))
+	for _, peer := range added {
+		addedPeers[peer.GetWgPubKey()] = peer
+	}
+
+	removedPeers := make(map[string]struct{}, len(removed))
+	for _, key := range removed {
+		removedPeers[key] = struct{}{}
+	}
+
+	peers := make([]*RemotePeerConfig, 0, len(base)+len(added))
+	for _, peer := range base {
+		key := peer.GetWgPubKey()
+		if _, ok := removedPeers[key]; ok {
+			continue
+		}
+
+		if updated, ok := addedPeers[key]; ok {
+			peer = updated
+			delete(addedPeers, key)
+		}
+		peers = append(peers, peer)
+	}
+
+	for _, peer := range added {
+		if _, ok := addedPeers[peer.GetWgPubKey()]; ok {
+			peers = append(peers, peer)
+		}
+	}
+
+	return peers
+}
+
+// diffMessages returns the messages only found in the current list and the ones only found in the base list.
+// Messages are compared by content and duplicates are counted.
+func diffMessages[T pb.Message](base, current []T) ([]T, []T) {
+	counts := make(map[string]int, len(base))
+	for _, msg := range base {
+		counts[messageKey(msg)]++
+	}
+
+	var added []T
+	for _, msg := range current {
+		key := messageKey(msg)
+		if counts[key] > 0 {
+			counts[key]--
+			continue
+		}
+		added = append(added, msg)
+	}
+
+	var removed []T
+	for _, msg := range base {
+		key := messageKey(msg)
+		if counts[key] > 0 {
+			counts[key]--
+			removed = append(removed, msg)
+		}
+	}
+
+	return added, removed
+}
+
+// diffOrderedMessages returns the changes of the messages, or all the messages to replace the base ones
+// when patching the base list with the changes wouldn't give the order of the current list
+func diffOrderedMessages[T pb.Message](base, current []T) ([]T, []T) {
+	added, removed := diffMessages(base, current)
+	if equalMessages(patchMessages(base, added, removed), current) {
+		return added, removed
+	}
+	return current, base
+}
+
+// patchMessages drops one occurrence of each removed message from the base list and appends the added ones
+func patchMessages[T pb.Message](base, added, removed []T) []T {
+	if len(added) == 0 && len(removed) == 0 {
+		return base
+	}
+
+	counts := make(map[string]int, len(removed))
+	for _, msg := range removed {
+		counts[messageKey(msg)]++
+	}
+
+	messages := make([]T, 0, len(base)+len(added))
+	for _, msg := range base {
+		key := messageKey(msg)
+		if counts[key] > 0 {
+			counts[key]--
+			continue
+		}
+		messages = append(messages, msg)
+	}
+
+	return append(messages, added...)
+}
+
+func equalMessages[T pb.Message](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !pb.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// messageKey returns the deterministic encoding of the message to compare messages by content
+func messageKey(msg pb.Message) string {
+	data, err := pb.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return fmt.Sprint(msg)
+	}
+	return string(data)
+}
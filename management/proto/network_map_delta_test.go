@@ -0,0 +1,221 @@
+package proto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "google.golang.org/protobuf/proto"
+)
+
+func testRemotePeer(key, ip string) *RemotePeerConfig {
+	return &RemotePeerConfig{WgPubKey: key, AllowedIps: []string{ip + "/32"}, Fqdn: key + ".netbird.cloud"}
+}
+
+func testFirewallRule(ip, port string) *FirewallRule {
+	return &FirewallRule{PeerIP: ip, Direction: RuleDirection_IN, Action: RuleAction_ACCEPT, Protocol: RuleProtocol_TCP, Port: port}
+}
+
+func testRecord(name, ip string) *SimpleRecord {
+	return &SimpleRecord{Name: name, Type: 1, Class: "IN", TTL: 300, RData: ip}
+}
+
+func testNetworkMap() *NetworkMap {
+	return &NetworkMap{
+		Serial:     1,
+		PeerConfig: &PeerConfig{Address: "100.64.0.1/16", Fqdn: "local.netbird.cloud"},
+		RemotePeers: []*RemotePeerConfig{
+			testRemotePeer("peerA", "100.64.0.2"),
+			testRemotePeer("peerB", "100.64.0.3"),
+		},
+		OfflinePeers: []*RemotePeerConfig{testRemotePeer("peerC", "100.64.0.4")},
+		FirewallRules: []*FirewallRule{
+			testFirewallRule("100.64.0.2", "22"),
+			testFirewallRule("100.64.0.3", "22"),
+			testFirewallRule("100.64.0.3", "22"),
+		},
+		Routes:                     []*Route{{ID: "route1", NetID: "net1", Network: "10.0.0.0/24", Peer: "peerA"}},
+		RoutesFirewallRulesIsEmpty: true,
+		DNSConfig: &DNSConfig{
+			ServiceEnable: true,
+			CustomZones: []*CustomZone{
+				{Domain: "netbird.cloud.", Records: []*SimpleRecord{testRecord("peerA.netbird.cloud.", "100.64.0.2"), testRecord("peerB.netbird.cloud.", "100.64.0.3")}},
+				{Domain: "old.zone."},
+			},
+		},
+	}
+}
+
+func TestNetworkMapDelta(t *testing.T) {
+	tt := []struct {
+		name   string
+		update func(networkMap *NetworkMap)
+		check  func(t *testing.T, delta *NetworkMapDelta)
+	}{
+		{
+			name:   "unchanged network map",
+			update: func(networkMap *NetworkMap) {},
+			check: func(t *testing.T, delta *NetworkMapDelta) {
+				assert.True(t, pb.Equal(&NetworkMapDelta{BaseSerial: 1, Serial: 2}, delta), delta.String())
+			},
+		},
+		{
+			name: "peers added, updated and removed",
+			update: func(networkMap *NetworkMap) {
+				networkMap.RemotePeers = []*RemotePeerConfig{
+					testRemotePeer("peerA", "100.64.0.12"),
+					testRemotePeer("peerC", "100.64.0.4"),
+				}
+				networkMap.OfflinePeers = nil
+			},
+			check: func(t *testing.T, delta *NetworkMapDelta) {
+				assert.Len(t, delta.GetAddedRemotePeers(), 2)
+				assert.Equal(t, []string{"peerB"}, delta.GetRemovedRemotePeers())
+				assert.Equal(t, []string{"peerC"}, delta.GetRemovedOfflinePeers())
+			},
+		},
+		{
+			name: "all peers removed",
+			update: func(networkMap *NetworkMap) {
+				networkMap.RemotePeers = nil
+				networkMap.RemotePeersIsEmpty = true
+			},
+		},
+		{
+			name: "duplicated firewall rule removed",
+			update: func(networkMap *NetworkMap) {
+				networkMap.FirewallRules = networkMap.FirewallRules[:2]
+				networkMap.FirewallRules = append(networkMap.FirewallRules, testFirewallRule("100.64.0.2", "443"))
+			},
+			check: func(t *testing.T, delta *NetworkMapDelta) {
+				assert.Len(t, delta.GetAddedFirewallRules(), 1)
+				assert.Len(t, delta.GetRemovedFirewallRules(), 1)
+			},
+		},
+		{
+			name: "firewall rule inserted before the existing ones",
+			update: func(networkMap *NetworkMap) {
+				networkMap.FirewallRules = append([]*FirewallRule{testFirewallRule("100.64.0.4", "80")}, networkMap.FirewallRules...)
+			},
+			check: func(t *testing.T, delta *NetworkMapDelta) {
+				assert.Len(t, delta.GetAddedFirewallRules(), 4, "the rules should be replaced to keep their order")
+				assert.Len(t, delta.GetRemovedFirewallRules(), 3)
+			},
+		},
+		{
+			name: "firewall rules reordered",
+			update: func(networkMap *NetworkMap) {
+				rules := networkMap.FirewallRules
+				networkMap.FirewallRules = []*FirewallRule{rules[1], rules[2], rules[0]}
+			},
+			check: func(t *testing.T, delta *NetworkMapDelta) {
+				assert.Len(t, delta.GetAddedFirewallRules(), 3)
+				assert.Len(t, delta.GetRemovedFirewallRules(), 3)
+			},
+		},
+		{
+			name: "firewall rule appended",
+			update: func(networkMap *NetworkMap) {
+				networkMap.FirewallRules = append(networkMap.FirewallRules, testFirewallRule("100.64.0.4", "80"))
+			},
+			check: func(t *testing.T, delta *NetworkMapDelta) {
+				assert.Len(t, delta.GetAddedFirewallRules(), 1, "appended rules keep the order of a patched list")
+				assert.Empty(t, delta.GetRemovedFirewallRules())
+			},
+		},
+		{
+			name: "route inserted before the existing one",
+			update: func(networkMap *NetworkMap) {
+				networkMap.Routes = append([]*Route{{ID: "route0", NetID: "net0", Network: "10.1.0.0/24", Peer: "peerB"}}, networkMap.Routes...)
+			},
+			check: func(t *testing.T, delta *NetworkMapDelta) {
+				assert.Len(t, delta.GetAddedRoutes(), 2)
+				assert.Len(t, delta.GetRemovedRoutes(), 1)
+			},
+		},
+		{
+			name: "remote peer inserted before the existing ones",
+			update: func(networkMap *NetworkMap) {
+				networkMap.RemotePeers = append([]*RemotePeerConfig{testRemotePeer("peerD", "100.64.0.5")}, networkMap.RemotePeers...)
+			},
+			check: func(t *testing.T, delta *NetworkMapDelta) {
+				assert.Len(t, delta.GetAddedRemotePeers(), 3)
+				assert.Equal(t, []string{"peerA", "peerB"}, delta.GetRemovedRemotePeers())
+			},
+		},
+		{
+			name: "routes and peer config changed",
+			update: func(networkMap *NetworkMap) {
+				networkMap.PeerConfig = &PeerConfig{Address: "100.64.0.1/16", Fqdn: "renamed.netbird.cloud"}
+				networkMap.Routes = []*Route{{ID: "route1", NetID: "net1", Network: "10.0.0.0/24", Peer: "peerB"}}
+				networkMap.RoutesFirewallRules = []*RouteFirewallRule{{SourceRanges: []string{"100.64.0.0/16"}, Destination: "10.0.0.0/24"}}
+				networkMap.RoutesFirewallRulesIsEmpty = false
+			},
+			check: func(t *testing.T, delta *NetworkMapDelta) {
+				assert.NotNil(t, delta.GetPeerConfig())
+				assert.Len(t, delta.GetAddedRoutes(), 1)
+				assert.Len(t, delta.GetRemovedRoutes(), 1)
+			},
+		},
+		{
+			name: "dns records and zones changed",
+			update: func(networkMap *NetworkMap) {
+				networkMap.DNSConfig = &DNSConfig{
+					ServiceEnable:    true,
+					NameServerGroups: []*NameServerGroup{{Primary: true, NameServers: []*NameServer{{IP: "8.8.8.8", NSType: 1, Port: 53}}}},
+					CustomZones: []*CustomZone{
+						{Domain: "netbird.cloud.", Records: []*SimpleRecord{testRecord("peerA.netbird.cloud.", "100.64.0.2"), testRecord("peerD.netbird.cloud.", "100.64.0.5")}},
+						{Domain: "new.zone.", Records: []*SimpleRecord{testRecord("host.new.zone.", "100.64.0.6")}},
+					},
+				}
+			},
+			check: func(t *testing.T, delta *NetworkMapDelta) {
+				assert.NotNil(t, delta.GetDNSConfig())
+				assert.Empty(t, delta.GetDNSConfig().GetCustomZones())
+				assert.Len(t, delta.GetAddedDNSRecords(), 2)
+				assert.Len(t, delta.GetRemovedDNSRecords(), 1)
+				assert.Equal(t, []string{"old.zone."}, delta.GetRemovedCustomZones())
+			},
+		},
+		{
+			name: "dns zone inserted before the existing ones",
+			update: func(networkMap *NetworkMap) {
+				zones := networkMap.DNSConfig.CustomZones
+				networkMap.DNSConfig.CustomZones = append([]*CustomZone{{Domain: "new.zone.", Records: []*SimpleRecord{testRecord("host.new.zone.", "100.64.0.6")}}}, zones...)
+			},
+			check: func(t *testing.T, delta *NetworkMapDelta) {
+				assert.Len(t, delta.GetAddedDNSRecords(), 3, "the zones should be replaced to keep their order")
+				assert.Equal(t, []string{"netbird.cloud.", "old.zone."}, delta.GetRemovedCustomZones())
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			base := testNetworkMap()
+			current := testNetworkMap()
+			current.Serial = 2
+			tc.update(current)
+
+			delta := NewNetworkMapDelta(base, current)
+			if tc.check != nil {
+				tc.check(t, delta)
+			}
+
+			baseCopy := pb.Clone(base).(*NetworkMap)
+
+			applied, err := ApplyNetworkMapDelta(base, delta)
+			require.NoError(t, err)
+			assert.True(t, pb.Equal(current, applied), "applied delta should give the current network map\nexpected: %s\nactual: %s", current, applied)
+			assert.True(t, pb.Equal(baseCopy, base), "base network map shouldn't be modified")
+		})
+	}
+}
+
+func TestApplyNetworkMapDelta_SerialMismatch(t *testing.T) {
+	_, err := ApplyNetworkMapDelta(nil, &NetworkMapDelta{BaseSerial: 1, Serial: 2})
+	assert.Error(t, err)
+
+	_, err = ApplyNetworkMapDelta(testNetworkMap(), &NetworkMapDelta{BaseSerial: 3, Serial: 4})
+	assert.Error(t, err)
+}
@@ -0,0 +1,492 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// AccessRequestStatus is the state of an access request
+type AccessRequestStatus string
+
+const (
+	// AccessRequestStatusPending indicates that the request waits for an admin decision
+	AccessRequestStatusPending AccessRequestStatus = "pending"
+	// AccessRequestStatusApproved indicates that the access was granted and is active until the request expires
+	AccessRequestStatusApproved AccessRequestStatus = "approved"
+	// AccessRequestStatusDenied indicates that an admin denied the request
+	AccessRequestStatusDenied AccessRequestStatus = "denied"
+	// AccessRequestStatusExpired indicates that the granted access was revoked after the requested duration
+	AccessRequestStatusExpired AccessRequestStatus = "expired"
+)
+
+const (
+	// AccessRequestMinDuration is the minimum duration of access that can be requested
+	AccessRequestMinDuration = time.Minute
+	// AccessRequestMaxDuration is the maximum duration of access that can be requested
+	AccessRequestMaxDuration = 7 * 24 * time.Hour
+)
+
+// AccessRequest is a request of a user to get temporary access from the user's peers to the peers of the given groups
+type AccessRequest struct {
+	// ID of the access request
+	ID string `gorm:"primaryKey"`
+
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `json:"-" gorm:"index"`
+
+	// UserID is the ID of the user requesting access
+	UserID string
+
+	// Groups are the destination group IDs the user requests access to
+	Groups []string `gorm:"serializer:json"`
+
+	// Reason is a justification of the request provided by the user
+	Reason string
+
+	// Duration of the access once approved
+	Duration time.Duration
+
+	// Status of the request
+	Status AccessRequestStatus
+
+	// CreatedAt is the time the request was created
+	CreatedAt time.Time
+
+	// ReviewedBy is the ID of the user who approved or denied the request
+	ReviewedBy string
+
+	// ReviewedAt is the time the request was approved or denied
+	ReviewedAt time.Time
+
+	// ExpiresAt is the time the granted access is revoked. Set on approval.
+	ExpiresAt time.Time
+
+	// PolicyID is the ID of the policy created on approval
+	PolicyID string
+
+	// SourceGroupID is the ID of the dynamic group matching the user's peers created on approval
+	SourceGroupID string
+}
+
+// Copy returns a copy of the access request
+func (r *AccessRequest) Copy() *AccessRequest {
+	c := *r
+	c.Groups = make([]string, len(r.Groups))
+	copy(c.Groups, r.Groups)
+	return &c
+}
+
+// EventMeta returns activity event meta related to the access request
+func (r *AccessRequest) EventMeta() map[string]any {
+	return map[string]any{"user_id": r.UserID, "groups": r.Groups, "duration": r.Duration.String(), "reason": r.Reason}
+}
+
+// CreateAccessRequest creates a pending request of the user to access the peers of the given groups for the given duration
+func (am *DefaultAccountManager) CreateAccessRequest(ctx context.Context, accountID, userID string, groups []string, duration time.Duration, reason string) (*AccessRequest, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if user.IsServiceUser {
+		return nil, status.Errorf(status.PermissionDenied, "service users can't request access")
+	}
+
+	if len(groups) == 0 {
+		return nil, status.Errorf(status.InvalidArgument, "at least one group should be requested")
+	}
+
+	if duration < AccessRequestMinDuration || duration > AccessRequestMaxDuration {
+		return nil, status.Errorf(status.InvalidArgument, "access duration should be between %s and %s", AccessRequestMinDuration, AccessRequestMaxDuration)
+	}
+
+	request := &AccessRequest{
+		ID:        xid.New().String(),
+		AccountID: accountID,
+		UserID:    userID,
+		Groups:    groups,
+		Reason:    reason,
+		Duration:  duration,
+		Status:    AccessRequestStatusPending,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		existingGroups, err := transaction.GetGroupsByIDs(ctx, LockingStrengthShare, accountID, groups)
+		if err != nil {
+			return err
+		}
+
+		for _, groupID := range groups {
+			if _, ok := existingGroups[groupID]; !ok {
+				return status.NewGroupNotFoundError(groupID)
+			}
+		}
+
+		return transaction.SaveAccessRequest(ctx, LockingStrengthUpdate, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, request.ID, accountID, activity.AccessRequested, request.EventMeta())
+
+	return request, nil
+}
+
+// GetAccessRequest returns the access request. Regular users can only see their own requests.
+func (am *DefaultAccountManager) GetAccessRequest(ctx context.Context, accountID, requestID, userID string) (*AccessRequest, error) {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	request, err := am.Store.GetAccessRequestByID(ctx, LockingStrengthShare, accountID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.HasAdminPower() && request.UserID != userID {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	return request, nil
+}
+
+// ListAccessRequests returns the access requests of the account. Regular users can only see their own requests.
+func (am *DefaultAccountManager) ListAccessRequests(ctx context.Context, accountID, userID string) ([]*AccessRequest, error) {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	requests, err := am.Store.GetAccountAccessRequests(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.HasAdminPower() {
+		return requests, nil
+	}
+
+	userRequests := make([]*AccessRequest, 0)
+	for _, request := range requests {
+		if request.UserID == userID {
+			userRequests = append(userRequests, request)
+		}
+	}
+
+	return userRequests, nil
+}
+
+// ApproveAccessRequest grants the requested access by creating a policy from a dynamic group of the requester's peers
+// to the requested groups. The policy is removed automatically once the request expires.
+func (am *DefaultAccountManager) ApproveAccessRequest(ctx context.Context, accountID, requestID, userID string) (*AccessRequest, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	if err := am.validateAccessRequestReviewer(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+
+	var request *AccessRequest
+	var policy *Policy
+	var eventsToStore []func()
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		var err error
+		request, err = getPendingAccessRequest(ctx, transaction, accountID, requestID, userID)
+		if err != nil {
+			return err
+		}
+
+		groups, err := transaction.GetGroupsByIDs(ctx, LockingStrengthShare, accountID, request.Groups)
+		if err != nil {
+			return err
+		}
+
+		// the requested groups could have been deleted while the request was pending
+		for _, groupID := range request.Groups {
+			if _, ok := groups[groupID]; !ok {
+				return status.NewGroupNotFoundError(groupID)
+			}
+		}
+
+		now := time.Now().UTC()
+		request.Status = AccessRequestStatusApproved
+		request.ReviewedBy = userID
+		request.ReviewedAt = now
+		request.ExpiresAt = now.Add(request.Duration)
+
+		// the source group is dynamic so the peers the user adds after the approval are covered as well
+		sourceGroup := &nbgroup.Group{
+			ID:        xid.New().String(),
+			AccountID: accountID,
+			Name:      fmt.Sprintf("Access request %s", request.ID),
+			Issued:    nbgroup.GroupIssuedAPI,
+			Rule:      fmt.Sprintf("%s=\"%s\"", nbgroup.RuleAttributeUserID, request.UserID),
+		}
+		if err = validateGroupRule(ctx, transaction, accountID, sourceGroup); err != nil {
+			return err
+		}
+		eventsToStore = am.prepareGroupEvents(ctx, transaction, accountID, userID, sourceGroup)
+
+		policy = newAccessRequestPolicy(request, sourceGroup.ID, request.Groups)
+		request.SourceGroupID = sourceGroup.ID
+		request.PolicyID = policy.ID
+
+		if err = transaction.SaveGroup(ctx, LockingStrengthUpdate, sourceGroup); err != nil {
+			return err
+		}
+
+		if err = transaction.CreatePolicy(ctx, LockingStrengthUpdate, policy); err != nil {
+			return err
+		}
+
+		if err = transaction.IncrementNetworkSerial(ctx, LockingStrengthUpdate, accountID); err != nil {
+			return err
+		}
+
+		return transaction.SaveAccessRequest(ctx, LockingStrengthUpdate, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, request.ID, accountID, activity.AccessRequestApproved, request.EventMeta())
+	for _, storeEvent := range eventsToStore {
+		storeEvent()
+	}
+	am.StoreEvent(ctx, userID, policy.ID, accountID, activity.PolicyAdded, policy.EventMeta())
+
+	am.updateAccountPeers(ctx, accountID)
+	am.checkAndScheduleAccessRequestExpiration(ctx, accountID)
+
+	return request, nil
+}
+
+// DenyAccessRequest rejects a pending access request
+func (am *DefaultAccountManager) DenyAccessRequest(ctx context.Context, accountID, requestID, userID string) (*AccessRequest, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	if err := am.validateAccessRequestReviewer(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+
+	var request *AccessRequest
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		var err error
+		request, err = getPendingAccessRequest(ctx, transaction, accountID, requestID, userID)
+		if err != nil {
+			return err
+		}
+
+		request.Status = AccessRequestStatusDenied
+		request.ReviewedBy = userID
+		request.ReviewedAt = time.Now().UTC()
+
+		return transaction.SaveAccessRequest(ctx, LockingStrengthUpdate, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, request.ID, accountID, activity.AccessRequestDenied, request.EventMeta())
+
+	return request, nil
+}
+
+// validateAccessRequestReviewer checks that the user is allowed to approve or deny access requests
+func (am *DefaultAccountManager) validateAccessRequestReviewer(ctx context.Context, accountID, userID string) error {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.AccountID != accountID {
+		return status.NewUserNotPartOfAccountError()
+	}
+
+	if !user.HasAdminPower() {
+		return status.NewAdminPermissionError()
+	}
+
+	return nil
+}
+
+// getPendingAccessRequest returns the access request if it is still pending and wasn't created by the reviewer
+func getPendingAccessRequest(ctx context.Context, transaction Store, accountID, requestID, reviewerID string) (*AccessRequest, error) {
+	request, err := transaction.GetAccessRequestByID(ctx, LockingStrengthUpdate, accountID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Status != AccessRequestStatusPending {
+		return nil, status.Errorf(status.PreconditionFailed, "access request %s is already %s", requestID, request.Status)
+	}
+
+	if request.UserID == reviewerID {
+		return nil, status.Errorf(status.PermissionDenied, "users can't review their own access requests")
+	}
+
+	return request, nil
+}
+
+// newAccessRequestPolicy creates a policy granting access from the source group to the destination groups until the
+// request expires. The schedule keeps the policy inactive after expiration even if it wasn't removed yet.
+func newAccessRequestPolicy(request *AccessRequest, sourceGroupID string, destinations []string) *Policy {
+	policyID := xid.New().String()
+	expiresAt := request.ExpiresAt
+	return &Policy{
+		ID:          policyID,
+		AccountID:   request.AccountID,
+		Name:        fmt.Sprintf("Access request %s", request.ID),
+		Description: fmt.Sprintf("Temporary access granted by request %s", request.ID),
+		Enabled:     true,
+		Schedule:    &PolicySchedule{EndDate: &expiresAt},
+		Rules: []*PolicyRule{
+			{
+				ID:            policyID,
+				PolicyID:      policyID,
+				Name:          fmt.Sprintf("Access request %s", request.ID),
+				Enabled:       true,
+				Action:        PolicyTrafficActionAccept,
+				Protocol:      PolicyRuleProtocolALL,
+				Bidirectional: true,
+				Sources:       []string{sourceGroupID},
+				Destinations:  destinations,
+			},
+		},
+	}
+}
+
+// getNextAccessRequestExpiration returns the duration until the next approved request expires.
+// If there is no approved request this function returns false and a duration of 0.
+func getNextAccessRequestExpiration(requests []*AccessRequest) (time.Duration, bool) {
+	var nextExpiry *time.Time
+	for _, request := range requests {
+		if request.Status != AccessRequestStatusApproved {
+			continue
+		}
+		if nextExpiry == nil || request.ExpiresAt.Before(*nextExpiry) {
+			expiresAt := request.ExpiresAt
+			nextExpiry = &expiresAt
+		}
+	}
+
+	if nextExpiry == nil {
+		return 0, false
+	}
+
+	// if expiration is below 1s return 1s duration
+	// this avoids issues with ticker that can't be set to < 0
+	return max(time.Until(*nextExpiry), time.Second), true
+}
+
+// accessRequestExpirationJob revokes the access of expired requests and returns the duration until the next expiration
+func (am *DefaultAccountManager) accessRequestExpirationJob(ctx context.Context, accountID string) func() (time.Duration, bool) {
+	return func() (time.Duration, bool) {
+		unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+		defer unlock()
+
+		var expired []*AccessRequest
+		var requests []*AccessRequest
+		err := am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+			var err error
+			requests, err = transaction.GetAccountAccessRequests(ctx, LockingStrengthUpdate, accountID)
+			if err != nil {
+				return err
+			}
+
+			now := time.Now().UTC()
+			for _, request := range requests {
+				if request.Status != AccessRequestStatusApproved || request.ExpiresAt.After(now) {
+					continue
+				}
+
+				if err = revokeAccessRequest(ctx, transaction, request); err != nil {
+					return err
+				}
+				expired = append(expired, request)
+			}
+
+			if len(expired) == 0 {
+				return nil
+			}
+
+			return transaction.IncrementNetworkSerial(ctx, LockingStrengthUpdate, accountID)
+		})
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed to expire access requests of account %s: %v", accountID, err)
+			return getNextAccessRequestExpiration(requests)
+		}
+
+		log.WithContext(ctx).Debugf("discovered %d access requests to expire for account %s", len(expired), accountID)
+
+		for _, request := range expired {
+			am.StoreEvent(ctx, activity.SystemInitiator, request.ID, accountID, activity.AccessRequestExpired, request.EventMeta())
+		}
+
+		if len(expired) > 0 {
+			am.updateAccountPeers(ctx, accountID)
+		}
+
+		return getNextAccessRequestExpiration(requests)
+	}
+}
+
+// revokeAccessRequest removes the policy and the source group created on approval and marks the request expired
+func revokeAccessRequest(ctx context.Context, transaction Store, request *AccessRequest) error {
+	if err := transaction.DeletePolicy(ctx, LockingStrengthUpdate, request.AccountID, request.PolicyID); err != nil {
+		if s, ok := status.FromError(err); !ok || s.Type() != status.NotFound {
+			return err
+		}
+	}
+
+	if err := transaction.DeleteGroup(ctx, LockingStrengthUpdate, request.AccountID, request.SourceGroupID); err != nil {
+		if s, ok := status.FromError(err); !ok || s.Type() != status.NotFound {
+			return err
+		}
+	}
+
+	request.Status = AccessRequestStatusExpired
+	return transaction.SaveAccessRequest(ctx, LockingStrengthUpdate, request)
+}
+
+// checkAndScheduleAccessRequestExpiration schedules the revocation of the next expiring access request of the account
+func (am *DefaultAccountManager) checkAndScheduleAccessRequestExpiration(ctx context.Context, accountID string) {
+	am.accessRequestExpiry.Cancel(ctx, []string{accountID})
+
+	requests, err := am.Store.GetAccountAccessRequests(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed getting access requests of account %s: %v", accountID, err)
+		return
+	}
+
+	if nextRun, ok := getNextAccessRequestExpiration(requests); ok {
+		go am.accessRequestExpiry.Schedule(ctx, nextRun, accountID, am.accessRequestExpirationJob(ctx, accountID))
+	}
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+func initTestAccessRequestAccount(t *testing.T, am *DefaultAccountManager) *Account {
+	t.Helper()
+
+	account := newAccountWithId(context.Background(), "testingAccount", groupAdminUserID, "example.com")
+	account.Users[adminUserID] = &User{Id: adminUserID, AccountID: account.Id, Role: UserRoleAdmin}
+	account.Users[regularUserID] = &User{Id: regularUserID, AccountID: account.Id, Role: UserRoleUser}
+	account.Peers["userPeer"] = &nbpeer.Peer{ID: "userPeer", AccountID: account.Id, UserID: regularUserID, Key: "userPeerKey", Status: &nbpeer.PeerStatus{}}
+	account.Peers["serverPeer"] = &nbpeer.Peer{ID: "serverPeer", AccountID: account.Id, Key: "serverPeerKey", Status: &nbpeer.PeerStatus{}}
+	account.Groups["servers"] = &nbgroup.Group{ID: "servers", AccountID: account.Id, Name: "Servers", Peers: []string{"serverPeer"}}
+
+	require.NoError(t, am.Store.SaveAccount(context.Background(), account))
+
+	return account
+}
+
+func TestDefaultAccountManager_AccessRequest(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+
+	account := initTestAccessRequestAccount(t, am)
+	ctx := context.Background()
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err = am.CreateAccessRequest(ctx, account.Id, regularUserID, []string{"servers"}, time.Second, "too short")
+		assert.Error(t, err)
+
+		_, err = am.CreateAccessRequest(ctx, account.Id, regularUserID, []string{"servers"}, 8*24*time.Hour, "too long")
+		assert.Error(t, err)
+
+		_, err = am.CreateAccessRequest(ctx, account.Id, regularUserID, []string{"unknown"}, time.Hour, "unknown group")
+		assert.Error(t, err)
+
+		_, err = am.CreateAccessRequest(ctx, account.Id, regularUserID, nil, time.Hour, "no groups")
+		assert.Error(t, err)
+	})
+
+	t.Run("approve and expire", func(t *testing.T) {
+		request, err := am.CreateAccessRequest(ctx, account.Id, regularUserID, []string{"servers"}, time.Hour, "incident")
+		require.NoError(t, err)
+		assert.Equal(t, AccessRequestStatusPending, request.Status)
+
+		requests, err := am.ListAccessRequests(ctx, account.Id, regularUserID)
+		require.NoError(t, err)
+		assert.Len(t, requests, 1)
+
+		// regular users can't approve requests
+		_, err = am.ApproveAccessRequest(ctx, account.Id, request.ID, regularUserID)
+		assert.Error(t, err)
+
+		approved, err := am.ApproveAccessRequest(ctx, account.Id, request.ID, adminUserID)
+		require.NoError(t, err)
+		assert.Equal(t, AccessRequestStatusApproved, approved.Status)
+		assert.Equal(t, adminUserID, approved.ReviewedBy)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), approved.ExpiresAt, time.Minute)
+
+		// already approved requests can't be reviewed again
+		_, err = am.DenyAccessRequest(ctx, account.Id, request.ID, adminUserID)
+		assert.Error(t, err)
+
+		policy, err := am.Store.GetPolicyByID(ctx, LockingStrengthShare, account.Id, approved.PolicyID)
+		require.NoError(t, err)
+		require.Len(t, policy.Rules, 1)
+		assert.Equal(t, []string{"servers"}, policy.Rules[0].Destinations)
+
+		sourceGroup, err := am.Store.GetGroupByID(ctx, LockingStrengthShare, account.Id, approved.SourceGroupID)
+		require.NoError(t, err)
+		assert.True(t, sourceGroup.IsDynamic(), "source group should match the peers the user adds later")
+		assert.Equal(t, []string{"userPeer"}, sourceGroup.Peers)
+
+		newPeer := &nbpeer.Peer{ID: "newUserPeer", AccountID: account.Id, UserID: regularUserID, Key: "newUserPeerKey", Status: &nbpeer.PeerStatus{}}
+		require.NoError(t, am.Store.AddPeerToAccount(ctx, newPeer))
+		_, _, err = am.syncPeerDynamicGroups(ctx, account.Id, newPeer)
+		require.NoError(t, err)
+
+		sourceGroup, err = am.Store.GetGroupByID(ctx, LockingStrengthShare, account.Id, approved.SourceGroupID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"userPeer", "newUserPeer"}, sourceGroup.Peers)
+
+		approved.ExpiresAt = time.Now().Add(-time.Minute)
+		require.NoError(t, am.Store.SaveAccessRequest(ctx, LockingStrengthUpdate, approved))
+
+		_, ok := am.accessRequestExpirationJob(ctx, account.Id)()
+		assert.False(t, ok, "no more approved requests should be left")
+
+		expired, err := am.GetAccessRequest(ctx, account.Id, request.ID, regularUserID)
+		require.NoError(t, err)
+		assert.Equal(t, AccessRequestStatusExpired, expired.Status)
+
+		_, err = am.Store.GetPolicyByID(ctx, LockingStrengthShare, account.Id, approved.PolicyID)
+		assert.Error(t, err, "policy should be removed on expiration")
+
+		_, err = am.Store.GetGroupByID(ctx, LockingStrengthShare, account.Id, approved.SourceGroupID)
+		assert.Error(t, err, "source group should be removed on expiration")
+	})
+
+	t.Run("requested group deleted before approval", func(t *testing.T) {
+		group := &nbgroup.Group{ID: "temporary", AccountID: account.Id, Name: "Temporary", Issued: nbgroup.GroupIssuedAPI}
+		require.NoError(t, am.Store.SaveGroup(ctx, LockingStrengthUpdate, group))
+
+		request, err := am.CreateAccessRequest(ctx, account.Id, regularUserID, []string{"servers", "temporary"}, time.Hour, "deleted group")
+		require.NoError(t, err)
+
+		require.NoError(t, am.Store.DeleteGroup(ctx, LockingStrengthUpdate, account.Id, "temporary"))
+
+		_, err = am.ApproveAccessRequest(ctx, account.Id, request.ID, adminUserID)
+		assert.Error(t, err, "approval should fail when a requested group doesn't exist anymore")
+
+		_, err = am.DenyAccessRequest(ctx, account.Id, request.ID, adminUserID)
+		require.NoError(t, err)
+	})
+
+	t.Run("deny", func(t *testing.T) {
+		request, err := am.CreateAccessRequest(ctx, account.Id, regularUserID, []string{"servers"}, time.Hour, "maintenance")
+		require.NoError(t, err)
+
+		denied, err := am.DenyAccessRequest(ctx, account.Id, request.ID, adminUserID)
+		require.NoError(t, err)
+		assert.Equal(t, AccessRequestStatusDenied, denied.Status)
+		assert.Empty(t, denied.PolicyID)
+	})
+
+	t.Run("self review is not allowed", func(t *testing.T) {
+		request, err := am.CreateAccessRequest(ctx, account.Id, adminUserID, []string{"servers"}, time.Hour, "self")
+		require.NoError(t, err)
+
+		_, err = am.ApproveAccessRequest(ctx, account.Id, request.ID, adminUserID)
+		assert.Error(t, err)
+
+		// regular users only see their own requests
+		_, err = am.GetAccessRequest(ctx, account.Id, request.ID, regularUserID)
+		assert.Error(t, err)
+
+		requests, err := am.ListAccessRequests(ctx, account.Id, adminUserID)
+		require.NoError(t, err)
+		assert.Len(t, requests, 4)
+	})
+}
@@ -10,9 +10,12 @@ import (
 	"math/rand"
 	"net"
 	"net/netip"
+	"os"
 	"reflect"
 	"regexp"
+	"runtime"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -30,6 +33,7 @@ import (
 	nbdns "github.com/netbirdio/netbird/dns"
 	"github.com/netbirdio/netbird/management/domain"
 	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/accountconfig"
 	"github.com/netbirdio/netbird/management/server/activity"
 	"github.com/netbirdio/netbird/management/server/geolocation"
 	nbgroup "github.com/netbirdio/netbird/management/server/group"
@@ -37,10 +41,15 @@ import (
 	"github.com/netbirdio/netbird/management/server/integrated_validator"
 	"github.com/netbirdio/netbird/management/server/integration_reference"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/organization"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/rbac"
+	"github.com/netbirdio/netbird/management/server/scim"
+	nbservice "github.com/netbirdio/netbird/management/server/service"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/management/server/telemetry"
+	"github.com/netbirdio/netbird/management/server/webhook"
 	"github.com/netbirdio/netbird/route"
 )
 
@@ -70,7 +79,10 @@ type AccountManager interface {
 	GetAccount(ctx context.Context, accountID string) (*Account, error)
 	CreateSetupKey(ctx context.Context, accountID string, keyName string, keyType SetupKeyType, expiresIn time.Duration,
 		autoGroups []string, usageLimit int, userID string, ephemeral bool) (*SetupKey, error)
+	CreateSetupKeyWithOptions(ctx context.Context, accountID string, keyName string, keyType SetupKeyType, expiresIn time.Duration,
+		autoGroups []string, usageLimit int, userID string, ephemeral bool, opts SetupKeyOptions) (*SetupKey, error)
 	SaveSetupKey(ctx context.Context, accountID string, key *SetupKey, userID string) (*SetupKey, error)
+	SaveSetupKeyWithOptions(ctx context.Context, accountID string, key *SetupKey, userID string, opts SaveSetupKeyOptions) (*SetupKey, error)
 	CreateUser(ctx context.Context, accountID, initiatorUserID string, key *UserInfo) (*UserInfo, error)
 	DeleteUser(ctx context.Context, accountID, initiatorUserID string, targetUserID string) error
 	DeleteRegularUsers(ctx context.Context, accountID, initiatorUserID string, targetUserIDs []string) error
@@ -118,6 +130,7 @@ type AccountManager interface {
 	ListPolicies(ctx context.Context, accountID, userID string) ([]*Policy, error)
 	GetRoute(ctx context.Context, accountID string, routeID route.ID, userID string) (*route.Route, error)
 	CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool) (*route.Route, error)
+	CreateRouteWithOptions(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool, opts CreateRouteOptions) (*route.Route, error)
 	SaveRoute(ctx context.Context, accountID, userID string, route *route.Route) error
 	DeleteRoute(ctx context.Context, accountID string, routeID route.ID, userID string) error
 	ListRoutes(ctx context.Context, accountID, userID string) ([]*route.Route, error)
@@ -128,7 +141,7 @@ type AccountManager interface {
 	ListNameServerGroups(ctx context.Context, accountID string, userID string) ([]*nbdns.NameServerGroup, error)
 	GetDNSDomain() string
 	StoreEvent(ctx context.Context, initiatorID, targetID, accountID string, activityID activity.ActivityDescriber, meta map[string]any)
-	GetEvents(ctx context.Context, accountID, userID string) ([]*activity.Event, error)
+	GetEvents(ctx context.Context, accountID, userID string, filter activity.Filter) ([]*activity.Event, error)
 	GetDNSSettings(ctx context.Context, accountID string, userID string) (*DNSSettings, error)
 	SaveDNSSettings(ctx context.Context, accountID string, userID string, dnsSettingsToSave *DNSSettings) error
 	GetPeer(ctx context.Context, accountID, peerID, userID string) (*nbpeer.Peer, error)
@@ -142,6 +155,32 @@ type AccountManager interface {
 	SavePostureChecks(ctx context.Context, accountID, userID string, postureChecks *posture.Checks) (*posture.Checks, error)
 	DeletePostureChecks(ctx context.Context, accountID, postureChecksID, userID string) error
 	ListPostureChecks(ctx context.Context, accountID, userID string) ([]*posture.Checks, error)
+	GetService(ctx context.Context, accountID, serviceID, userID string) (*nbservice.Service, error)
+	SaveService(ctx context.Context, accountID, userID string, service *nbservice.Service) (*nbservice.Service, error)
+	DeleteService(ctx context.Context, accountID, serviceID, userID string) error
+	ListServices(ctx context.Context, accountID, userID string) ([]*nbservice.Service, error)
+	GetServicePolicies(ctx context.Context, accountID, serviceID, userID string) ([]*Policy, error)
+	GetPolicyAuditCounts(ctx context.Context, accountID, policyID, userID string) ([]*PolicyAuditCount, error)
+	SyncPeerAuditCounts(ctx context.Context, peerPubKey string, counts map[string]uint64) error
+	GetPeerPostureStatus(ctx context.Context, accountID, peerID, userID string) (*nbpeer.PostureStatus, error)
+	GetPendingPeers(ctx context.Context, accountID, userID string) ([]*nbpeer.Peer, error)
+	ApprovePeers(ctx context.Context, accountID, userID string, peerIDs []string) error
+	RejectPeers(ctx context.Context, accountID, userID string, peerIDs []string) error
+	GetOrganizations(ctx context.Context, userID string) ([]*organization.Organization, error)
+	GetOrganization(ctx context.Context, organizationID, userID string) (*organization.Organization, error)
+	CreateOrganization(ctx context.Context, accountID, userID, name string) (*organization.Organization, error)
+	UpdateOrganization(ctx context.Context, organizationID, userID, name string) (*organization.Organization, error)
+	DeleteOrganization(ctx context.Context, organizationID, userID string) error
+	GetOrganizationAccounts(ctx context.Context, organizationID, userID string) ([]*organization.AccountSummary, error)
+	CreateOrganizationAccount(ctx context.Context, organizationID, userID, domain string) (*organization.AccountSummary, error)
+	RemoveOrganizationAccount(ctx context.Context, organizationID, accountID, userID string) error
+	GetOrganizationAdmins(ctx context.Context, organizationID, userID string) ([]*organization.Admin, error)
+	AddOrganizationAdmin(ctx context.Context, organizationID, userID, adminUserID string) (*organization.Admin, error)
+	RemoveOrganizationAdmin(ctx context.Context, organizationID, userID, adminUserID string) error
+	GetOrganizationAccountClaims(ctx context.Context, userID, accountID string) (jwtclaims.AuthorizationClaims, error)
+	ApplyPeerBulkOperations(ctx context.Context, accountID, userID string, operations []*PeerBulkOperation) (*BulkResult, error)
+	ApplyGroupBulkOperations(ctx context.Context, accountID, userID string, operations []*GroupBulkOperation) (*BulkResult, error)
+	ApplyUserBulkOperations(ctx context.Context, accountID, userID string, operations []*UserBulkOperation) (*BulkResult, error)
 	GetIdpManager() idp.Manager
 	UpdateIntegratedValidatorGroups(ctx context.Context, accountID string, userID string, groups []string) error
 	GroupValidation(ctx context.Context, accountId string, groups []string) (bool, error)
@@ -153,6 +192,36 @@ type AccountManager interface {
 	GetAccountIDForPeerKey(ctx context.Context, peerKey string) (string, error)
 	GetAccountSettings(ctx context.Context, accountID string, userID string) (*Settings, error)
 	DeleteSetupKey(ctx context.Context, accountID, userID, keyID string) error
+	CreateAccessRequest(ctx context.Context, accountID, userID string, groups []string, duration time.Duration, reason string) (*AccessRequest, error)
+	GetAccessRequest(ctx context.Context, accountID, requestID, userID string) (*AccessRequest, error)
+	ListAccessRequests(ctx context.Context, accountID, userID string) ([]*AccessRequest, error)
+	ApproveAccessRequest(ctx context.Context, accountID, requestID, userID string) (*AccessRequest, error)
+	DenyAccessRequest(ctx context.Context, accountID, requestID, userID string) (*AccessRequest, error)
+	GetWebhookEndpoint(ctx context.Context, accountID, endpointID, userID string) (*webhook.Endpoint, error)
+	ListWebhookEndpoints(ctx context.Context, accountID, userID string) ([]*webhook.Endpoint, error)
+	SaveWebhookEndpoint(ctx context.Context, accountID, userID string, endpoint *webhook.Endpoint) (*webhook.Endpoint, error)
+	DeleteWebhookEndpoint(ctx context.Context, accountID, endpointID, userID string) error
+	ExportAccountConfig(ctx context.Context, accountID, userID string) (*accountconfig.Config, error)
+	PlanAccountConfig(ctx context.Context, accountID, userID string, config *accountconfig.Config) ([]*accountconfig.Change, error)
+	ApplyAccountConfig(ctx context.Context, accountID, userID string, config *accountconfig.Config) ([]*accountconfig.Change, error)
+	ExplainPeerConnection(ctx context.Context, accountID, peerID, userID string, req *PolicyExplainRequest) (*PolicyExplanation, error)
+	GetCustomRole(ctx context.Context, accountID, roleID, userID string) (*rbac.Role, error)
+	ListCustomRoles(ctx context.Context, accountID, userID string) ([]*rbac.Role, error)
+	SaveCustomRole(ctx context.Context, accountID, userID string, role *rbac.Role) (*rbac.Role, error)
+	DeleteCustomRole(ctx context.Context, accountID, roleID, userID string) error
+	GetUserCustomRole(ctx context.Context, user *User) (*rbac.Role, error)
+	CreateSCIMToken(ctx context.Context, accountID, userID string) (*scim.TokenGenerated, error)
+	GetSCIMToken(ctx context.Context, accountID, userID string) (*scim.Token, error)
+	DeleteSCIMToken(ctx context.Context, accountID, userID string) error
+	GetAccountIDFromSCIMToken(ctx context.Context, token string) (string, string, error)
+	ListSCIMUsers(ctx context.Context, accountID string) ([]*scim.User, error)
+	GetSCIMUser(ctx context.Context, accountID, userID string) (*scim.User, error)
+	SaveSCIMUser(ctx context.Context, accountID, initiatorUserID string, user *scim.User) (*scim.User, error)
+	DeleteSCIMUser(ctx context.Context, accountID, initiatorUserID, userID string) error
+	ListSCIMGroups(ctx context.Context, accountID string) ([]*scim.Group, error)
+	GetSCIMGroup(ctx context.Context, accountID, groupID string) (*scim.Group, error)
+	SaveSCIMGroup(ctx context.Context, accountID, initiatorUserID string, group *scim.Group) (*scim.Group, error)
+	DeleteSCIMGroup(ctx context.Context, accountID, initiatorUserID, groupID string) error
 }
 
 type DefaultAccountManager struct {
@@ -171,6 +240,12 @@ type DefaultAccountManager struct {
 
 	requestBuffer *AccountRequestBuffer
 
+	// peersUpdateBuffer coalesces the account peers updates requested within its buffer interval
+	peersUpdateBuffer *AccountPeersUpdateBuffer
+
+	// networkMapWorkers is the number of network maps computed concurrently when updating the account peers
+	networkMapWorkers int
+
 	// singleAccountMode indicates whether the instance has a single account.
 	// If true, then every new user will end up under the same account.
 	// This value will be set to false if management service has more than one account.
@@ -183,6 +258,26 @@ type DefaultAccountManager struct {
 
 	peerInactivityExpiry Scheduler
 
+	// policyScheduleTransitions pushes network maps to peers when scheduled policies become active or inactive
+	policyScheduleTransitions Scheduler
+
+	// accessRequestExpiry revokes the access granted by approved access requests once they expire
+	accessRequestExpiry Scheduler
+
+	// postureGraceExpiry re-evaluates the peers posture once their posture checks grace periods expire
+	postureGraceExpiry Scheduler
+
+	// eventSinks receive the activity events from the outbox of the event store
+	eventSinks   []activity.Sink
+	eventSinksMu sync.RWMutex
+	// eventOutboxNotify wakes up the event outbox relay when an event was stored
+	eventOutboxNotify chan struct{}
+	// pendingEvents tracks the activity events being saved to the event store
+	pendingEvents sync.WaitGroup
+
+	// webhooks delivers the activity events to the webhook endpoints of the accounts, nil until started
+	webhooks *webhookDispatcher
+
 	// userDeleteFromIDPEnabled allows to delete user from IDP when user is deleted from account
 	userDeleteFromIDPEnabled bool
 
@@ -258,6 +353,7 @@ type Account struct {
 	Domain                 string `gorm:"index"`
 	DomainCategory         string
 	IsDomainPrimaryAccount bool
+	OrganizationID         string                            `gorm:"index"`
 	SetupKeys              map[string]*SetupKey              `gorm:"-"`
 	SetupKeysG             []SetupKey                        `json:"-" gorm:"foreignKey:AccountID;references:id"`
 	Network                *Network                          `gorm:"embedded;embeddedPrefix:network_"`
@@ -274,8 +370,12 @@ type Account struct {
 	NameServerGroupsG      []nbdns.NameServerGroup           `json:"-" gorm:"foreignKey:AccountID;references:id"`
 	DNSSettings            DNSSettings                       `gorm:"embedded;embeddedPrefix:dns_settings_"`
 	PostureChecks          []*posture.Checks                 `gorm:"foreignKey:AccountID;references:id"`
+	Services               []*nbservice.Service              `gorm:"foreignKey:AccountID;references:id"`
 	// Settings is a dictionary of Account settings
 	Settings *Settings `gorm:"embedded;embeddedPrefix:settings_"`
+
+	// policyIndexCache caches the compiled policies of accounts loaded from the store
+	policyIndexCache *policyIndexCache
 }
 
 // Subclass used in gorm to only load settings and not whole account
@@ -302,6 +402,7 @@ type UserInfo struct {
 	Email                string                                     `json:"email"`
 	Name                 string                                     `json:"name"`
 	Role                 string                                     `json:"role"`
+	CustomRoleID         string                                     `json:"custom_role_id"`
 	AutoGroups           []string                                   `json:"auto_groups"`
 	Status               string                                     `json:"-"`
 	IsServiceUser        bool                                       `json:"is_service_user"`
@@ -413,6 +514,20 @@ func (a *Account) getRoutingPeerRoutes(ctx context.Context, peerID string) (enab
 				break
 			}
 		}
+		if r.PeerTagSelector != "" {
+			selector, err := nbpeer.ParseTagSelector(r.PeerTagSelector)
+			if err != nil {
+				log.WithContext(ctx).Errorf("route %s has invalid peer tag selector under account %s: %v", r.ID, a.Id, err)
+				continue
+			}
+			if selector.Matches(peer.GetTags()) {
+				newPeerRoute := r.Copy()
+				newPeerRoute.Peer = peerID
+				newPeerRoute.PeerTagSelector = ""
+				newPeerRoute.ID = route.ID(string(r.ID) + ":" + peerID)
+				takeRoute(newPeerRoute, peerID)
+			}
+		}
 		if r.Peer == peerID {
 			takeRoute(r.Copy(), peerID)
 		}
@@ -693,6 +808,7 @@ func (a *Account) UpdateSettings(update *Settings) *Account {
 // UpdatePeer saves new or replaces existing peer
 func (a *Account) UpdatePeer(update *nbpeer.Peer) {
 	a.Peers[update.ID] = update
+	a.invalidatePolicyIndex()
 }
 
 // DeletePeer deletes peer from the account cleaning up all the references
@@ -879,6 +995,11 @@ func (a *Account) Copy() *Account {
 		postureChecks = append(postureChecks, postureCheck.Copy())
 	}
 
+	services := []*nbservice.Service{}
+	for _, service := range a.Services {
+		services = append(services, service.Copy())
+	}
+
 	return &Account{
 		Id:                     a.Id,
 		CreatedBy:              a.CreatedBy,
@@ -886,6 +1007,7 @@ func (a *Account) Copy() *Account {
 		Domain:                 a.Domain,
 		DomainCategory:         a.DomainCategory,
 		IsDomainPrimaryAccount: a.IsDomainPrimaryAccount,
+		OrganizationID:         a.OrganizationID,
 		SetupKeys:              setupKeys,
 		Network:                a.Network.Copy(),
 		Peers:                  peers,
@@ -896,6 +1018,7 @@ func (a *Account) Copy() *Account {
 		NameServerGroups:       nsGroups,
 		DNSSettings:            dnsSettings,
 		PostureChecks:          postureChecks,
+		Services:               services,
 		Settings:               settings,
 	}
 }
@@ -1000,6 +1123,7 @@ func (a *Account) UserGroupsAddToPeers(userID string, groups ...string) map[stri
 		groupUpdates[gid] = difference(group.Peers, oldPeers)
 	}
 
+	a.invalidatePolicyIndex()
 	return groupUpdates
 }
 
@@ -1029,6 +1153,7 @@ func (a *Account) UserGroupsRemoveFromPeers(userID string, groups ...string) map
 		groupUpdates[gid] = difference(oldPeers, group.Peers)
 	}
 
+	a.invalidatePolicyIndex()
 	return groupUpdates
 }
 
@@ -1047,22 +1172,29 @@ func BuildManager(
 	metrics telemetry.AppMetrics,
 ) (*DefaultAccountManager, error) {
 	am := &DefaultAccountManager{
-		Store:                    store,
-		geo:                      geo,
-		peersUpdateManager:       peersUpdateManager,
-		idpManager:               idpManager,
-		ctx:                      context.Background(),
-		cacheMux:                 sync.Mutex{},
-		cacheLoading:             map[string]chan struct{}{},
-		dnsDomain:                dnsDomain,
-		eventStore:               eventStore,
-		peerLoginExpiry:          NewDefaultScheduler(),
-		peerInactivityExpiry:     NewDefaultScheduler(),
-		userDeleteFromIDPEnabled: userDeleteFromIDPEnabled,
-		integratedPeerValidator:  integratedPeerValidator,
-		metrics:                  metrics,
-		requestBuffer:            NewAccountRequestBuffer(ctx, store),
-	}
+		Store:                     store,
+		geo:                       geo,
+		peersUpdateManager:        peersUpdateManager,
+		idpManager:                idpManager,
+		ctx:                       context.Background(),
+		cacheMux:                  sync.Mutex{},
+		cacheLoading:              map[string]chan struct{}{},
+		dnsDomain:                 dnsDomain,
+		eventStore:                eventStore,
+		eventOutboxNotify:         make(chan struct{}, 1),
+		peerLoginExpiry:           NewDefaultScheduler(),
+		peerInactivityExpiry:      NewDefaultScheduler(),
+		policyScheduleTransitions: NewDefaultScheduler(),
+		accessRequestExpiry:       NewDefaultScheduler(),
+		postureGraceExpiry:        NewDefaultScheduler(),
+		userDeleteFromIDPEnabled:  userDeleteFromIDPEnabled,
+		integratedPeerValidator:   integratedPeerValidator,
+		metrics:                   metrics,
+		requestBuffer:             NewAccountRequestBuffer(ctx, store),
+		networkMapWorkers:         getNetworkMapWorkers(ctx),
+	}
+	am.peersUpdateBuffer = NewAccountPeersUpdateBuffer(ctx, am.sendAccountPeersUpdate, metrics)
+
 	allAccounts := store.GetAllAccounts(ctx)
 	// enable single account mode only if configured by user and number of existing accounts is not grater than 1
 	am.singleAccountMode = singleAccountModeDomain != "" && len(allAccounts) <= 1
@@ -1096,6 +1228,10 @@ func BuildManager(
 				return nil, err
 			}
 		}
+
+		am.schedulePolicyScheduleTransition(ctx, account)
+		am.checkAndScheduleAccessRequestExpiration(ctx, account.Id)
+		am.schedulePostureGraceExpiration(ctx, account)
 	}
 
 	goCacheClient := gocache.New(CacheExpirationMax, 30*time.Minute)
@@ -1125,6 +1261,19 @@ func BuildManager(
 	return am, nil
 }
 
+// getNetworkMapWorkers returns the number of network map workers set with NB_NETWORK_MAP_WORKERS,
+// it defaults to the number of CPUs
+func getNetworkMapWorkers(ctx context.Context) int {
+	workers, err := strconv.Atoi(os.Getenv("NB_NETWORK_MAP_WORKERS"))
+	if err != nil || workers < 1 {
+		workers = runtime.NumCPU()
+	}
+
+	log.WithContext(ctx).Infof("set network map workers to %d", workers)
+
+	return workers
+}
+
 func (am *DefaultAccountManager) GetExternalCacheManager() ExternalCacheManager {
 	return am.externalCacheManager
 }
@@ -1164,11 +1313,18 @@ func (am *DefaultAccountManager) UpdateAccountSettings(ctx context.Context, acco
 		return nil, status.Errorf(status.PermissionDenied, "user is not allowed to update account")
 	}
 
+	newSettings.Extra = mergeExtraSettings(account.Settings.Extra, newSettings.Extra)
+
 	err = am.integratedPeerValidator.ValidateExtraSettings(ctx, newSettings.Extra, account.Settings.Extra, account.Peers, userID, accountID)
 	if err != nil {
 		return nil, err
 	}
 
+	err = validatePeerApprovalRules(ctx, am.Store, accountID, newSettings.Extra)
+	if err != nil {
+		return nil, err
+	}
+
 	oldSettings := account.Settings
 	if oldSettings.PeerLoginExpirationEnabled != newSettings.PeerLoginExpirationEnabled {
 		event := activity.AccountPeerLoginExpirationEnabled
@@ -1196,6 +1352,8 @@ func (am *DefaultAccountManager) UpdateAccountSettings(ctx context.Context, acco
 		return nil, fmt.Errorf("groups propagation failed: %w", err)
 	}
 
+	approvedPeers := am.handlePeerApprovalSettings(ctx, account, oldSettings, newSettings, userID, accountID)
+
 	updatedAccount := account.UpdateSettings(newSettings)
 
 	err = am.Store.SaveAccount(ctx, account)
@@ -1203,9 +1361,34 @@ func (am *DefaultAccountManager) UpdateAccountSettings(ctx context.Context, acco
 		return nil, err
 	}
 
+	if len(approvedPeers) > 0 {
+		for _, peer := range approvedPeers {
+			am.StoreEvent(ctx, userID, peer.ID, accountID, activity.PeerApproved, peer.EventMeta(am.GetDNSDomain()))
+		}
+		am.updateAccountPeers(ctx, accountID)
+	}
+
 	return updatedAccount, nil
 }
 
+// handlePeerApprovalSettings stores the peer approval setting changes and approves the pending peers when
+// the peer approval is disabled. It returns the approved peers.
+func (am *DefaultAccountManager) handlePeerApprovalSettings(ctx context.Context, account *Account, oldSettings, newSettings *Settings, userID, accountID string) []*nbpeer.Peer {
+	oldEnabled := oldSettings.Extra != nil && oldSettings.Extra.PeerApprovalEnabled
+	newEnabled := newSettings.Extra != nil && newSettings.Extra.PeerApprovalEnabled
+	if oldEnabled == newEnabled {
+		return nil
+	}
+
+	if newEnabled {
+		am.StoreEvent(ctx, userID, accountID, accountID, activity.AccountPeerApprovalEnabled, nil)
+		return nil
+	}
+
+	am.StoreEvent(ctx, userID, accountID, accountID, activity.AccountPeerApprovalDisabled, nil)
+	return approveAllPendingPeers(account)
+}
+
 func (am *DefaultAccountManager) handleGroupsPropagationSettings(ctx context.Context, oldSettings, newSettings *Settings, userID, accountID string) error {
 	if oldSettings.GroupsPropagationEnabled != newSettings.GroupsPropagationEnabled {
 		if newSettings.GroupsPropagationEnabled {
@@ -1429,6 +1612,10 @@ func (am *DefaultAccountManager) DeleteAccount(ctx context.Context, accountID, u
 	}
 	// cancel peer login expiry job
 	am.peerLoginExpiry.Cancel(ctx, []string{account.Id})
+	// cancel policy schedule transition, access request and posture grace expiry jobs
+	am.policyScheduleTransitions.Cancel(ctx, []string{account.Id})
+	am.accessRequestExpiry.Cancel(ctx, []string{account.Id})
+	am.postureGraceExpiry.Cancel(ctx, []string{account.Id})
 
 	log.WithContext(ctx).Debugf("account %s deleted", accountID)
 	return nil
@@ -2043,12 +2230,15 @@ func (am *DefaultAccountManager) syncJWTGroups(ctx context.Context, accountID st
 		return nil
 	}
 
-	if settings.JWTGroupsClaimName == "" {
+	if _, ok := am.idpManager.(idp.UserGroupsManager); settings.JWTGroupsClaimName == "" && !ok {
 		log.WithContext(ctx).Debugf("JWT groups are enabled but no claim name is set")
 		return nil
 	}
 
-	jwtGroupsNames := extractJWTGroups(ctx, settings.JWTGroupsClaimName, claims)
+	jwtGroupsNames, err := am.getUserJWTGroups(ctx, settings.JWTGroupsClaimName, claims)
+	if err != nil {
+		return err
+	}
 
 	unlockAccount := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer func() {
@@ -2431,7 +2621,10 @@ func (am *DefaultAccountManager) CheckUserAccessByJWTGroups(ctx context.Context,
 	// filtering access based on the allowed groups.
 	if settings != nil && settings.JWTGroupsEnabled {
 		if allowedGroups := settings.JWTAllowGroups; len(allowedGroups) > 0 {
-			userJWTGroups := extractJWTGroups(ctx, settings.JWTGroupsClaimName, claims)
+			userJWTGroups, err := am.getUserJWTGroups(ctx, settings.JWTGroupsClaimName, claims)
+			if err != nil {
+				return err
+			}
 
 			if !userHasAllowedGroup(allowedGroups, userJWTGroups) {
 				return fmt.Errorf("user does not belong to any of the allowed JWT groups")
@@ -2619,6 +2812,27 @@ func extractJWTGroups(ctx context.Context, claimName string, claims jwtclaims.Au
 	return userJWTGroups
 }
 
+// getUserJWTGroups returns the groups of the JWT groups claim. If the token doesn't carry them,
+// the groups are looked up in the IdP when it supports it.
+func (am *DefaultAccountManager) getUserJWTGroups(ctx context.Context, claimName string, claims jwtclaims.AuthorizationClaims) ([]string, error) {
+	jwtGroups := extractJWTGroups(ctx, claimName, claims)
+	if len(jwtGroups) > 0 {
+		return jwtGroups, nil
+	}
+
+	groupsManager, ok := am.idpManager.(idp.UserGroupsManager)
+	if !ok {
+		return jwtGroups, nil
+	}
+
+	idpGroups, err := groupsManager.GetUserGroups(ctx, claims.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting user groups from the IdP: %w", err)
+	}
+
+	return idpGroups, nil
+}
+
 // userHasAllowedGroup checks if a user belongs to any of the allowed groups.
 func userHasAllowedGroup(allowedGroups []string, userGroups []string) bool {
 	for _, userGroup := range userGroups {
@@ -1,19 +1,106 @@
 package account
 
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+)
+
 type ExtraSettings struct {
 	// PeerApprovalEnabled enables or disables the need for peers bo be approved by an administrator
 	PeerApprovalEnabled bool
 
+	// PeerApprovalRules automatically approve the new peers matching one of them when the peer approval is enabled
+	PeerApprovalRules []PeerApprovalRule `gorm:"serializer:json"`
+
 	// IntegratedValidatorGroups list of group IDs to be used with integrated approval configurations
 	IntegratedValidatorGroups []string `gorm:"serializer:json"`
 }
 
+// PeerApprovalRule matches the new peers that don't need to be approved by an administrator
+type PeerApprovalRule struct {
+	// SetupKeyID matches the peers enrolled with the setup key. Any peer matches if empty
+	SetupKeyID string
+
+	// SourceRanges matches the peers connecting from one of the ranges. Any address matches if empty
+	SourceRanges []string
+}
+
 // Copy copies the ExtraSettings struct
 func (e *ExtraSettings) Copy() *ExtraSettings {
 	var cpGroup []string
 
+	var cpRules []PeerApprovalRule
+	for _, rule := range e.PeerApprovalRules {
+		cpRules = append(cpRules, rule.Copy())
+	}
+
 	return &ExtraSettings{
 		PeerApprovalEnabled:       e.PeerApprovalEnabled,
+		PeerApprovalRules:         cpRules,
 		IntegratedValidatorGroups: append(cpGroup, e.IntegratedValidatorGroups...),
 	}
 }
+
+// PeerRequiresApproval returns true if the peer approval is enabled and the new peer doesn't match any approval rule
+func (e *ExtraSettings) PeerRequiresApproval(setupKeyID string, connectionIP net.IP) bool {
+	if e == nil || !e.PeerApprovalEnabled {
+		return false
+	}
+
+	for _, rule := range e.PeerApprovalRules {
+		if rule.Matches(setupKeyID, connectionIP) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy copies the PeerApprovalRule struct
+func (r PeerApprovalRule) Copy() PeerApprovalRule {
+	return PeerApprovalRule{
+		SetupKeyID:   r.SetupKeyID,
+		SourceRanges: slices.Clone(r.SourceRanges),
+	}
+}
+
+// Validate checks that the rule matches a restricted set of peers and that its source ranges are valid
+func (r PeerApprovalRule) Validate() error {
+	if r.SetupKeyID == "" && len(r.SourceRanges) == 0 {
+		return errors.New("peer approval rule should have a setup key or source ranges")
+	}
+
+	for _, sourceRange := range r.SourceRanges {
+		if _, err := netip.ParsePrefix(sourceRange); err != nil {
+			return fmt.Errorf("invalid peer approval rule source range %s", sourceRange)
+		}
+	}
+	return nil
+}
+
+// Matches returns true if the peer enrolled with the setup key and connecting from the IP matches the rule
+func (r PeerApprovalRule) Matches(setupKeyID string, connectionIP net.IP) bool {
+	if r.SetupKeyID != "" && r.SetupKeyID != setupKeyID {
+		return false
+	}
+
+	if len(r.SourceRanges) == 0 {
+		return true
+	}
+
+	addr, ok := netip.AddrFromSlice(connectionIP)
+	if !ok {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, sourceRange := range r.SourceRanges {
+		prefix, err := netip.ParsePrefix(sourceRange)
+		if err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
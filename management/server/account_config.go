@@ -0,0 +1,1157 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/rs/xid"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/domain"
+	"github.com/netbirdio/netbird/management/server/accountconfig"
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	"github.com/netbirdio/netbird/management/server/integrated_validator"
+	"github.com/netbirdio/netbird/management/server/posture"
+	nbservice "github.com/netbirdio/netbird/management/server/service"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/telemetry"
+	"github.com/netbirdio/netbird/route"
+)
+
+// errAccountConfigDryRun rolls back the transaction of a planned account configuration
+var errAccountConfigDryRun = errors.New("account configuration dry run")
+
+// NewAccountConfigManager returns an account manager for the one-shot account configuration commands.
+// Unlike BuildManager it doesn't run background jobs: the expiration and policy schedule jobs are left to the
+// management server and no peers are connected to be updated, they get the changes on their next sync.
+func NewAccountConfigManager(ctx context.Context, store Store, eventStore activity.Store, dnsDomain string,
+	integratedPeerValidator integrated_validator.IntegratedValidator, metrics telemetry.AppMetrics) *DefaultAccountManager {
+	return &DefaultAccountManager{
+		Store:                     store,
+		ctx:                       ctx,
+		dnsDomain:                 dnsDomain,
+		eventStore:                eventStore,
+		eventOutboxNotify:         make(chan struct{}, 1),
+		peersUpdateManager:        NewPeersUpdateManager(metrics),
+		peerLoginExpiry:           noopScheduler{},
+		peerInactivityExpiry:      noopScheduler{},
+		policyScheduleTransitions: noopScheduler{},
+		accessRequestExpiry:       noopScheduler{},
+		postureGraceExpiry:        noopScheduler{},
+		integratedPeerValidator:   integratedPeerValidator,
+		metrics:                   metrics,
+		peersUpdateBuffer: &AccountPeersUpdateBuffer{
+			ctx:     ctx,
+			updates: make(map[string]*accountPeersUpdate),
+			update:  func(context.Context, string) {},
+		},
+	}
+}
+
+// ExportAccountConfig returns the configuration objects of the account as a declarative document
+func (am *DefaultAccountManager) ExportAccountConfig(ctx context.Context, accountID, userID string) (*accountconfig.Config, error) {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if !user.HasAdminPower() {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	return exportAccountConfig(ctx, am.Store, accountID)
+}
+
+// PlanAccountConfig validates the document against the account and returns the changes that applying it would make.
+// The changes are validated by applying them in a transaction that is rolled back.
+func (am *DefaultAccountManager) PlanAccountConfig(ctx context.Context, accountID, userID string, config *accountconfig.Config) ([]*accountconfig.Change, error) {
+	return am.applyAccountConfig(ctx, accountID, userID, config, true)
+}
+
+// ApplyAccountConfig brings the account configuration to the state described by the document in a single transaction
+// and returns the applied changes. Applying the same document again makes no changes.
+func (am *DefaultAccountManager) ApplyAccountConfig(ctx context.Context, accountID, userID string, config *accountconfig.Config) ([]*accountconfig.Change, error) {
+	return am.applyAccountConfig(ctx, accountID, userID, config, false)
+}
+
+func (am *DefaultAccountManager) applyAccountConfig(ctx context.Context, accountID, userID string, config *accountconfig.Config, dryRun bool) ([]*accountconfig.Change, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if !user.HasAdminPower() {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	if err = config.Validate(); err != nil {
+		return nil, status.Errorf(status.InvalidArgument, "%s", err.Error())
+	}
+
+	var changes []*accountconfig.Change
+	var applier *accountConfigApplier
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		current, err := exportAccountConfig(ctx, transaction, accountID)
+		if err != nil {
+			return err
+		}
+
+		changes = accountconfig.Diff(current, config)
+		if len(changes) == 0 {
+			return nil
+		}
+
+		applier, err = am.newAccountConfigApplier(ctx, transaction, accountID, userID, config)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range changes {
+			if err = applier.apply(change); err != nil {
+				return wrapAccountConfigChangeError(change, err)
+			}
+		}
+
+		if err = transaction.IncrementNetworkSerial(ctx, LockingStrengthUpdate, accountID); err != nil {
+			return err
+		}
+
+		if dryRun {
+			return errAccountConfigDryRun
+		}
+		return nil
+	})
+	if dryRun && errors.Is(err, errAccountConfigDryRun) {
+		return changes, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun || len(changes) == 0 {
+		return changes, nil
+	}
+
+	for _, storeEvent := range applier.events {
+		storeEvent()
+	}
+
+	am.updateAccountPeers(ctx, accountID)
+
+	if slices.ContainsFunc(changes, func(c *accountconfig.Change) bool { return c.Kind == accountconfig.KindPolicy }) {
+		am.checkAndSchedulePolicyScheduleTransition(ctx, accountID)
+	}
+
+	return changes, nil
+}
+
+// wrapAccountConfigChangeError adds the failed change to the error message keeping the error type
+func wrapAccountConfigChangeError(change *accountconfig.Change, err error) error {
+	errorType := status.InvalidArgument
+	message := err.Error()
+	if s, ok := status.FromError(err); ok {
+		errorType = s.Type()
+		message = s.Message
+	}
+	return status.Errorf(errorType, "failed to %s %s %s: %s", change.Action, change.Kind, change.Name, message)
+}
+
+// exportAccountConfig builds the declarative document of the account configuration
+func exportAccountConfig(ctx context.Context, transaction Store, accountID string) (*accountconfig.Config, error) {
+	groups, err := transaction.GetAccountGroups(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	postureChecks, err := transaction.GetAccountPostureChecks(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	services, err := transaction.GetAccountServices(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := transaction.GetAccountPolicies(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	routes, err := transaction.GetAccountRoutes(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	nsGroups, err := transaction.GetAccountNameServerGroups(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsSettings, err := transaction.GetAccountDNSSettings(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessRequests, err := getAccessRequestObjects(ctx, transaction, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupNames := make(map[string]string, len(groups))
+	config := &accountconfig.Config{
+		Version:          accountconfig.Version,
+		Groups:           make([]*accountconfig.Group, 0),
+		PostureChecks:    make([]*accountconfig.PostureCheck, 0, len(postureChecks)),
+		Services:         make([]*accountconfig.Service, 0, len(services)),
+		Policies:         make([]*accountconfig.Policy, 0, len(policies)),
+		Routes:           make([]*accountconfig.Route, 0, len(routes)),
+		NameServerGroups: make([]*accountconfig.NameServerGroup, 0, len(nsGroups)),
+	}
+
+	for _, group := range groups {
+		groupNames[group.ID] = group.Name
+		if !accessRequests.isManagedGroup(group) {
+			continue
+		}
+		configGroup := &accountconfig.Group{Name: group.Name, Rule: group.Rule}
+		if !group.IsDynamic() {
+			configGroup.Peers = slices.Clone(group.Peers)
+		}
+		config.Groups = append(config.Groups, configGroup)
+	}
+
+	postureCheckNames := make(map[string]string, len(postureChecks))
+	for _, checks := range postureChecks {
+		postureCheckNames[checks.ID] = checks.Name
+		config.PostureChecks = append(config.PostureChecks, accountconfig.NewPostureCheck(checks))
+	}
+
+	serviceNames := make(map[string]string, len(services))
+	for _, service := range services {
+		serviceNames[service.ID] = service.Name
+		config.Services = append(config.Services, accountconfig.NewService(service))
+	}
+
+	for _, policy := range policies {
+		if !accessRequests.isManagedPolicy(policy) {
+			continue
+		}
+		config.Policies = append(config.Policies, toAccountConfigPolicy(policy, groupNames, postureCheckNames, serviceNames))
+	}
+
+	for _, r := range routes {
+		configRoute, err := toAccountConfigRoute(r, groupNames)
+		if err != nil {
+			return nil, err
+		}
+		config.Routes = append(config.Routes, configRoute)
+	}
+
+	for _, nsGroup := range nsGroups {
+		config.NameServerGroups = append(config.NameServerGroups, toAccountConfigNameServerGroup(nsGroup, groupNames))
+	}
+
+	config.DNSSettings = &accountconfig.DNSSettings{
+		DisabledManagementGroups: namesOf(dnsSettings.DisabledManagementGroups, groupNames),
+	}
+
+	sort.Slice(config.Groups, func(i, j int) bool { return config.Groups[i].Name < config.Groups[j].Name })
+	sort.Slice(config.PostureChecks, func(i, j int) bool { return config.PostureChecks[i].Name < config.PostureChecks[j].Name })
+	sort.Slice(config.Services, func(i, j int) bool { return config.Services[i].Name < config.Services[j].Name })
+	sort.Slice(config.Policies, func(i, j int) bool { return config.Policies[i].Name < config.Policies[j].Name })
+	sort.Slice(config.Routes, func(i, j int) bool { return config.Routes[i].ID < config.Routes[j].ID })
+	sort.Slice(config.NameServerGroups, func(i, j int) bool { return config.NameServerGroups[i].Name < config.NameServerGroups[j].Name })
+
+	if err = checkUniqueNames(config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// checkUniqueNames returns an error if the account has objects of the same kind and name. Objects are identified
+// by name in the document, so they can't be told apart.
+func checkUniqueNames(config *accountconfig.Config) error {
+	if name, ok := findDuplicateName(config.Groups, func(g *accountconfig.Group) string { return g.Name }); ok {
+		return newDuplicateNameError("group", name)
+	}
+
+	if name, ok := findDuplicateName(config.PostureChecks, func(p *accountconfig.PostureCheck) string { return p.Name }); ok {
+		return newDuplicateNameError("posture check", name)
+	}
+
+	if name, ok := findDuplicateName(config.Services, func(s *accountconfig.Service) string { return s.Name }); ok {
+		return newDuplicateNameError("service", name)
+	}
+
+	if name, ok := findDuplicateName(config.Policies, func(p *accountconfig.Policy) string { return p.Name }); ok {
+		return newDuplicateNameError("policy", name)
+	}
+
+	if name, ok := findDuplicateName(config.NameServerGroups, func(n *accountconfig.NameServerGroup) string { return n.Name }); ok {
+		return newDuplicateNameError("nameserver group", name)
+	}
+
+	return nil
+}
+
+// findDuplicateName returns the first name shared by two objects of the sorted list
+func findDuplicateName[T any](objects []*T, name func(*T) string) (string, bool) {
+	for i := 1; i < len(objects); i++ {
+		if name(objects[i]) == name(objects[i-1]) {
+			return name(objects[i]), true
+		}
+	}
+	return "", false
+}
+
+func newDuplicateNameError(kind, name string) error {
+	return status.Errorf(status.PreconditionFailed, "more than one %s is named %s, rename them to use the account configuration", kind, name)
+}
+
+// accessRequestObjects holds the IDs of the groups and policies created on access request approval.
+// They are managed by the access requests and left out of the account configuration document.
+type accessRequestObjects struct {
+	groups   map[string]struct{}
+	policies map[string]struct{}
+}
+
+func getAccessRequestObjects(ctx context.Context, transaction Store, accountID string) (*accessRequestObjects, error) {
+	requests, err := transaction.GetAccountAccessRequests(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := &accessRequestObjects{
+		groups:   make(map[string]struct{}),
+		policies: make(map[string]struct{}),
+	}
+	for _, request := range requests {
+		if request.SourceGroupID != "" {
+			objects.groups[request.SourceGroupID] = struct{}{}
+		}
+		if request.PolicyID != "" {
+			objects.policies[request.PolicyID] = struct{}{}
+		}
+	}
+
+	return objects, nil
+}
+
+// isManagedGroup returns true if the group is managed by the account configuration document.
+// The All group, the groups issued by the IdP or integrations and the access request groups are only referenced.
+func (o *accessRequestObjects) isManagedGroup(group *nbgroup.Group) bool {
+	_, ok := o.groups[group.ID]
+	return !ok && group.Issued == nbgroup.GroupIssuedAPI && !group.IsGroupAll()
+}
+
+// isManagedPolicy returns true if the policy is managed by the account configuration document
+func (o *accessRequestObjects) isManagedPolicy(policy *Policy) bool {
+	_, ok := o.policies[policy.ID]
+	return !ok
+}
+
+// namesOf maps the IDs to names, keeping the IDs that have no name
+func namesOf(ids []string, names map[string]string) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if name, ok := names[id]; ok {
+			result = append(result, name)
+		} else {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func toAccountConfigPolicy(policy *Policy, groupNames, postureCheckNames, serviceNames map[string]string) *accountconfig.Policy {
+	configPolicy := &accountconfig.Policy{
+		Name:                policy.Name,
+		Description:         policy.Description,
+		Enabled:             policy.Enabled,
+		Rules:               make([]*accountconfig.PolicyRule, 0, len(policy.Rules)),
+		SourcePostureChecks: namesOf(policy.SourcePostureChecks, postureCheckNames),
+	}
+
+	for _, rule := range policy.Rules {
+		configRule := &accountconfig.PolicyRule{
+			Name:          rule.Name,
+			Description:   rule.Description,
+			Enabled:       rule.Enabled,
+			Action:        string(rule.Action),
+			Bidirectional: rule.Bidirectional,
+			Protocol:      string(rule.Protocol),
+			Ports:         slices.Clone(rule.Ports),
+			Sources:       namesOf(rule.Sources, groupNames),
+			Destinations:  namesOf(rule.Destinations, groupNames),
+
+			SourceTagSelector:      rule.SourceTagSelector,
+			DestinationTagSelector: rule.DestinationTagSelector,
+		}
+		for _, portRange := range rule.PortRanges {
+			configRule.PortRanges = append(configRule.PortRanges, accountconfig.PortRange{Start: portRange.Start, End: portRange.End})
+		}
+		if len(rule.Services) > 0 {
+			configRule.Services = namesOf(rule.Services, serviceNames)
+		}
+		configPolicy.Rules = append(configPolicy.Rules, configRule)
+	}
+
+	if schedule := policy.Schedule; schedule != nil {
+		configPolicy.Schedule = &accountconfig.PolicySchedule{
+			Timezone:  schedule.Timezone,
+			StartDate: schedule.StartDate,
+			EndDate:   schedule.EndDate,
+		}
+		for _, day := range schedule.Days {
+			configPolicy.Schedule.Days = append(configPolicy.Schedule.Days, strings.ToLower(day.String()))
+		}
+		for _, timeRange := range schedule.TimeRanges {
+			configPolicy.Schedule.TimeRanges = append(configPolicy.Schedule.TimeRanges, accountconfig.TimeRange{Start: timeRange.Start, End: timeRange.End})
+		}
+	}
+
+	return configPolicy
+}
+
+func toAccountConfigRoute(r *route.Route, groupNames map[string]string) (*accountconfig.Route, error) {
+	configRoute := &accountconfig.Route{
+		ID:                  string(r.ID),
+		NetworkID:           string(r.NetID),
+		Description:         r.Description,
+		Enabled:             r.Enabled,
+		Peer:                r.Peer,
+		PeerGroups:          namesOf(r.PeerGroups, groupNames),
+		PeerTagSelector:     r.PeerTagSelector,
+		Metric:              r.Metric,
+		Masquerade:          r.Masquerade,
+		KeepRoute:           r.KeepRoute,
+		Groups:              namesOf(r.Groups, groupNames),
+		AccessControlGroups: namesOf(r.AccessControlGroups, groupNames),
+	}
+
+	if len(r.Domains) > 0 {
+		domains, err := r.Domains.ToStringList()
+		if err != nil {
+			return nil, err
+		}
+		configRoute.Domains = domains
+	} else {
+		configRoute.Network = r.Network.String()
+	}
+
+	return configRoute, nil
+}
+
+func toAccountConfigNameServerGroup(nsGroup *nbdns.NameServerGroup, groupNames map[string]string) *accountconfig.NameServerGroup {
+	configGroup := &accountconfig.NameServerGroup{
+		Name:                 nsGroup.Name,
+		Description:          nsGroup.Description,
+		Enabled:              nsGroup.Enabled,
+		NameServers:          make([]accountconfig.NameServer, 0, len(nsGroup.NameServers)),
+		Primary:              nsGroup.Primary,
+		Domains:              slices.Clone(nsGroup.Domains),
+		SearchDomainsEnabled: nsGroup.SearchDomainsEnabled,
+		Groups:               namesOf(nsGroup.Groups, groupNames),
+	}
+
+	for _, ns := range nsGroup.NameServers {
+		configGroup.NameServers = append(configGroup.NameServers, accountconfig.NameServer{
+			IP:     ns.IP.String(),
+			NSType: ns.NSType.String(),
+			Port:   ns.Port,
+		})
+	}
+
+	return configGroup
+}
+
+// accountConfigApplier applies the changes of an account configuration document within a transaction
+type accountConfigApplier struct {
+	am          *DefaultAccountManager
+	ctx         context.Context
+	transaction Store
+	accountID   string
+	userID      string
+	desired     *accountconfig.Config
+
+	// groupIDs maps the names of all account groups to their IDs, preferring the API managed groups
+	groupIDs      map[string]string
+	groups        map[string]*nbgroup.Group
+	postureChecks map[string]*posture.Checks
+	services      map[string]*nbservice.Service
+	policies      map[string]*Policy
+	routes        map[string]*route.Route
+	nsGroups      map[string]*nbdns.NameServerGroup
+
+	// savedRoutes are the document routes that are already saved
+	savedRoutes map[*accountconfig.Route]struct{}
+
+	events []func()
+}
+
+func (am *DefaultAccountManager) newAccountConfigApplier(ctx context.Context, transaction Store, accountID, userID string, desired *accountconfig.Config) (*accountConfigApplier, error) {
+	a := &accountConfigApplier{
+		am:            am,
+		ctx:           ctx,
+		transaction:   transaction,
+		accountID:     accountID,
+		userID:        userID,
+		desired:       desired,
+		groupIDs:      make(map[string]string),
+		groups:        make(map[string]*nbgroup.Group),
+		postureChecks: make(map[string]*posture.Checks),
+		services:      make(map[string]*nbservice.Service),
+		policies:      make(map[string]*Policy),
+		routes:        make(map[string]*route.Route),
+		nsGroups:      make(map[string]*nbdns.NameServerGroup),
+		savedRoutes:   make(map[*accountconfig.Route]struct{}),
+	}
+
+	accessRequests, err := getAccessRequestObjects(ctx, transaction, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := transaction.GetAccountGroups(ctx, LockingStrengthUpdate, accountID)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if accessRequests.isManagedGroup(group) {
+			a.groups[group.Name] = group
+			a.groupIDs[group.Name] = group.ID
+		} else if _, ok := a.groupIDs[group.Name]; !ok {
+			a.groupIDs[group.Name] = group.ID
+		}
+	}
+	// managed groups take precedence over the IdP groups of the same name
+	for name, group := range a.groups {
+		a.groupIDs[name] = group.ID
+	}
+
+	postureChecks, err := transaction.GetAccountPostureChecks(ctx, LockingStrengthUpdate, accountID)
+	if err != nil {
+		return nil, err
+	}
+	for _, checks := range postureChecks {
+		a.postureChecks[checks.Name] = checks
+	}
+
+	services, err := transaction.GetAccountServices(ctx, LockingStrengthUpdate, accountID)
+	if err != nil {
+		return nil, err
+	}
+	for _, service := range services {
+		a.services[service.Name] = service
+	}
+
+	policies, err := transaction.GetAccountPolicies(ctx, LockingStrengthUpdate, accountID)
+	if err != nil {
+		return nil, err
+	}
+	for _, policy := range policies {
+		if accessRequests.isManagedPolicy(policy) {
+			a.policies[policy.Name] = policy
+		}
+	}
+
+	routes, err := transaction.GetAccountRoutes(ctx, LockingStrengthUpdate, accountID)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range routes {
+		a.routes[string(r.ID)] = r
+	}
+
+	nsGroups, err := transaction.GetAccountNameServerGroups(ctx, LockingStrengthUpdate, accountID)
+	if err != nil {
+		return nil, err
+	}
+	for _, nsGroup := range nsGroups {
+		a.nsGroups[nsGroup.Name] = nsGroup
+	}
+
+	return a, nil
+}
+
+func (a *accountConfigApplier) apply(change *accountconfig.Change) error {
+	switch change.Kind {
+	case accountconfig.KindGroup:
+		if change.Action == accountconfig.ActionDelete {
+			return a.deleteGroup(change.Name)
+		}
+		return a.saveGroup(change.Name)
+	case accountconfig.KindPostureCheck:
+		if change.Action == accountconfig.ActionDelete {
+			return a.deletePostureCheck(change.Name)
+		}
+		return a.savePostureCheck(change.Name)
+	case accountconfig.KindService:
+		if change.Action == accountconfig.ActionDelete {
+			return a.deleteService(change.Name)
+		}
+		return a.saveService(change.Name)
+	case accountconfig.KindPolicy:
+		if change.Action == accountconfig.ActionDelete {
+			return a.deletePolicy(change.Name)
+		}
+		return a.savePolicy(change.Name)
+	case accountconfig.KindRoute:
+		if change.Action == accountconfig.ActionDelete {
+			return a.deleteRoute(change.Name)
+		}
+		return a.saveRoute(change)
+	case accountconfig.KindNameServerGroup:
+		if change.Action == accountconfig.ActionDelete {
+			return a.deleteNameServerGroup(change.Name)
+		}
+		return a.saveNameServerGroup(change.Name)
+	case accountconfig.KindDNSSettings:
+		return a.saveDNSSettings()
+	default:
+		return status.Errorf(status.InvalidArgument, "unknown configuration object kind %s", change.Kind)
+	}
+}
+
+// resolveGroups maps the group names to the IDs of the account groups
+func (a *accountConfigApplier) resolveGroups(names []string) ([]string, error) {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		id, ok := a.groupIDs[name]
+		if !ok {
+			return nil, status.Errorf(status.InvalidArgument, "group %s not found", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (a *accountConfigApplier) saveGroup(name string) error {
+	desired := findByName(a.desired.Groups, func(g *accountconfig.Group) string { return g.Name }, name)
+
+	group := &nbgroup.Group{Name: name, Issued: nbgroup.GroupIssuedAPI, Peers: []string{}}
+	if existing, ok := a.groups[name]; ok {
+		group = existing.Copy()
+	}
+
+	if desired.Peers != nil {
+		group.Peers = slices.Clone(desired.Peers)
+	}
+	group.Rule = desired.Rule
+
+	if err := validateNewGroup(a.ctx, a.transaction, a.accountID, group); err != nil {
+		return err
+	}
+	group.AccountID = a.accountID
+
+	a.events = append(a.events, a.am.prepareGroupEvents(a.ctx, a.transaction, a.accountID, a.userID, group)...)
+
+	if err := a.transaction.SaveGroup(a.ctx, LockingStrengthUpdate, group); err != nil {
+		return err
+	}
+
+	a.groups[name] = group
+	a.groupIDs[name] = group.ID
+	return nil
+}
+
+func (a *accountConfigApplier) deleteGroup(name string) error {
+	group := a.groups[name]
+
+	if err := validateDeleteGroup(a.ctx, a.transaction, group, a.userID); err != nil {
+		return err
+	}
+
+	if err := a.transaction.DeleteGroup(a.ctx, LockingStrengthUpdate, a.accountID, group.ID); err != nil {
+		return err
+	}
+
+	a.addEvent(group.ID, activity.GroupDeleted, group.EventMeta())
+	return nil
+}
+
+func (a *accountConfigApplier) savePostureCheck(name string) error {
+	desired := findByName(a.desired.PostureChecks, func(p *accountconfig.PostureCheck) string { return p.Name }, name)
+
+	action := activity.PostureCheckCreated
+	var id string
+	if existing, ok := a.postureChecks[name]; ok {
+		id = existing.ID
+		action = activity.PostureCheckUpdated
+	}
+
+	checks, err := desired.ToPostureChecks(id)
+	if err != nil {
+		return err
+	}
+
+	if err = validatePostureChecks(a.ctx, a.transaction, a.accountID, checks); err != nil {
+		return err
+	}
+	checks.AccountID = a.accountID
+
+	if err = a.transaction.SavePostureChecks(a.ctx, LockingStrengthUpdate, checks); err != nil {
+		return err
+	}
+
+	a.postureChecks[name] = checks
+	a.addEvent(checks.ID, action, checks.EventMeta())
+	return nil
+}
+
+func (a *accountConfigApplier) deletePostureCheck(name string) error {
+	checks := a.postureChecks[name]
+
+	if err := isPostureCheckLinkedToPolicy(a.ctx, a.transaction, checks.ID, a.accountID); err != nil {
+		return err
+	}
+
+	if err := a.transaction.DeletePostureChecks(a.ctx, LockingStrengthUpdate, a.accountID, checks.ID); err != nil {
+		return err
+	}
+
+	a.addEvent(checks.ID, activity.PostureCheckDeleted, checks.EventMeta())
+	return nil
+}
+
+func (a *accountConfigApplier) saveService(name string) error {
+	desired := findByName(a.desired.Services, func(s *accountconfig.Service) string { return s.Name }, name)
+
+	action := activity.ServiceCreated
+	var id string
+	if existing, ok := a.services[name]; ok {
+		id = existing.ID
+		action = activity.ServiceUpdated
+	}
+
+	service := desired.ToService(id)
+	if err := validateService(a.ctx, a.transaction, a.accountID, service); err != nil {
+		return err
+	}
+	service.AccountID = a.accountID
+
+	if err := a.transaction.SaveService(a.ctx, LockingStrengthUpdate, service); err != nil {
+		return err
+	}
+
+	a.services[name] = service
+	a.addEvent(service.ID, action, service.EventMeta())
+	return nil
+}
+
+func (a *accountConfigApplier) deleteService(name string) error {
+	service := a.services[name]
+
+	policies, err := getServicePolicies(a.ctx, a.transaction, a.accountID, service.ID)
+	if err != nil {
+		return err
+	}
+	if len(policies) > 0 {
+		return status.Errorf(status.PreconditionFailed, "service has been linked to policy: %s", policies[0].Name)
+	}
+
+	if err = a.transaction.DeleteService(a.ctx, LockingStrengthUpdate, a.accountID, service.ID); err != nil {
+		return err
+	}
+
+	delete(a.services, name)
+	a.addEvent(service.ID, activity.ServiceDeleted, service.EventMeta())
+	return nil
+}
+
+func (a *accountConfigApplier) savePolicy(name string) error {
+	desired := findByName(a.desired.Policies, func(p *accountconfig.Policy) string { return p.Name }, name)
+
+	policy := &Policy{
+		AccountID:   a.accountID,
+		Name:        desired.Name,
+		Description: desired.Description,
+		Enabled:     desired.Enabled,
+	}
+
+	existing, isUpdate := a.policies[name]
+	if isUpdate {
+		policy.ID = existing.ID
+	}
+
+	for _, checksName := range desired.SourcePostureChecks {
+		checks, ok := a.postureChecks[checksName]
+		if !ok {
+			return status.Errorf(status.InvalidArgument, "posture check %s not found", checksName)
+		}
+		policy.SourcePostureChecks = append(policy.SourcePostureChecks, checks.ID)
+	}
+
+	for _, desiredRule := range desired.Rules {
+		rule, err := a.toPolicyRule(desiredRule, existing)
+		if err != nil {
+			return err
+		}
+		policy.Rules = append(policy.Rules, rule)
+	}
+
+	if desired.Schedule != nil {
+		schedule, err := toPolicySchedule(desired.Schedule)
+		if err != nil {
+			return err
+		}
+		policy.Schedule = schedule
+	}
+
+	if err := validatePolicy(a.ctx, a.transaction, a.accountID, policy); err != nil {
+		return err
+	}
+	for _, rule := range policy.Rules {
+		rule.PolicyID = policy.ID
+	}
+
+	action := activity.PolicyAdded
+	saveFunc := a.transaction.CreatePolicy
+	if isUpdate {
+		action = activity.PolicyUpdated
+		saveFunc = a.transaction.SavePolicy
+
+		// the document is authoritative for the rules of the policy, so the rules missing from it are deleted
+		ruleIDs := make([]string, 0, len(policy.Rules))
+		for _, rule := range policy.Rules {
+			ruleIDs = append(ruleIDs, rule.ID)
+		}
+		if err := a.transaction.DeletePolicyRules(a.ctx, LockingStrengthUpdate, policy.ID, ruleIDs); err != nil {
+			return err
+		}
+	}
+
+	if err := saveFunc(a.ctx, LockingStrengthUpdate, policy); err != nil {
+		return err
+	}
+
+	a.policies[name] = policy
+	a.addEvent(policy.ID, action, policy.EventMeta())
+	return nil
+}
+
+// toPolicyRule converts the document rule, reusing the ID of the existing policy rule of the same name
+func (a *accountConfigApplier) toPolicyRule(desired *accountconfig.PolicyRule, existing *Policy) (*PolicyRule, error) {
+	rule := &PolicyRule{
+		ID:            xid.New().String(),
+		Name:          desired.Name,
+		Description:   desired.Description,
+		Enabled:       desired.Enabled,
+		Action:        PolicyTrafficActionType(desired.Action),
+		Bidirectional: desired.Bidirectional,
+		Protocol:      PolicyRuleProtocolType(desired.Protocol),
+		Ports:         slices.Clone(desired.Ports),
+
+		SourceTagSelector:      desired.SourceTagSelector,
+		DestinationTagSelector: desired.DestinationTagSelector,
+	}
+
+	if existing != nil {
+		if i := slices.IndexFunc(existing.Rules, func(r *PolicyRule) bool { return r.Name == desired.Name }); i >= 0 {
+			rule.ID = existing.Rules[i].ID
+		}
+	}
+
+	switch rule.Action {
+	case PolicyTrafficActionAccept, PolicyTrafficActionDrop, PolicyTrafficActionAudit:
+	default:
+		return nil, status.Errorf(status.InvalidArgument, "unknown action %q of rule %s", desired.Action, desired.Name)
+	}
+
+	switch rule.Protocol {
+	case PolicyRuleProtocolALL, PolicyRuleProtocolTCP, PolicyRuleProtocolUDP, PolicyRuleProtocolICMP:
+	default:
+		return nil, status.Errorf(status.InvalidArgument, "unknown protocol %q of rule %s", desired.Protocol, desired.Name)
+	}
+
+	for _, portRange := range desired.PortRanges {
+		rule.PortRanges = append(rule.PortRanges, RulePortRange{Start: portRange.Start, End: portRange.End})
+	}
+
+	for _, serviceName := range desired.Services {
+		service, ok := a.services[serviceName]
+		if !ok {
+			return nil, status.Errorf(status.InvalidArgument, "service %s not found", serviceName)
+		}
+		rule.Services = append(rule.Services, service.ID)
+	}
+
+	if err := validateTagSelector(rule.SourceTagSelector); err != nil {
+		return nil, err
+	}
+
+	if err := validateTagSelector(rule.DestinationTagSelector); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if rule.Sources, err = a.resolveGroups(desired.Sources); err != nil {
+		return nil, err
+	}
+
+	if rule.Destinations, err = a.resolveGroups(desired.Destinations); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func toPolicySchedule(desired *accountconfig.PolicySchedule) (*PolicySchedule, error) {
+	var timeRanges []PolicyScheduleTimeRange
+	for _, timeRange := range desired.TimeRanges {
+		timeRanges = append(timeRanges, PolicyScheduleTimeRange{Start: timeRange.Start, End: timeRange.End})
+	}
+
+	return NewPolicySchedule(desired.Timezone, desired.StartDate, desired.EndDate, desired.Days, timeRanges)
+}
+
+func (a *accountConfigApplier) deletePolicy(name string) error {
+	policy := a.policies[name]
+
+	if err := a.transaction.DeletePolicy(a.ctx, LockingStrengthUpdate, a.accountID, policy.ID); err != nil {
+		return err
+	}
+
+	delete(a.policies, name)
+	a.addEvent(policy.ID, activity.PolicyRemoved, policy.EventMeta())
+	return nil
+}
+
+func (a *accountConfigApplier) saveRoute(change *accountconfig.Change) error {
+	var desired *accountconfig.Route
+	for _, r := range a.desired.Routes {
+		if _, saved := a.savedRoutes[r]; saved {
+			continue
+		}
+		if (change.Action == accountconfig.ActionUpdate && r.ID == change.Name) ||
+			(change.Action == accountconfig.ActionCreate && r.NetworkID == change.Name && a.routes[r.ID] == nil) {
+			desired = r
+			break
+		}
+	}
+	if desired == nil {
+		return status.Errorf(status.InvalidArgument, "route %s not found in the document", change.Name)
+	}
+
+	newRoute, err := a.toRoute(desired)
+	if err != nil {
+		return err
+	}
+
+	if err = a.validateRoute(newRoute); err != nil {
+		return err
+	}
+
+	if err = a.transaction.SaveRoute(a.ctx, LockingStrengthUpdate, newRoute); err != nil {
+		return err
+	}
+
+	action := activity.RouteCreated
+	if change.Action == accountconfig.ActionUpdate {
+		action = activity.RouteUpdated
+	}
+
+	a.routes[string(newRoute.ID)] = newRoute
+	// routes are matched by network ID on create, so a following create of the same network ID picks the next route
+	a.savedRoutes[desired] = struct{}{}
+	a.addEvent(string(newRoute.ID), action, newRoute.EventMeta())
+	return nil
+}
+
+func (a *accountConfigApplier) toRoute(desired *accountconfig.Route) (*route.Route, error) {
+	newRoute := &route.Route{
+		ID:          route.ID(desired.ID),
+		AccountID:   a.accountID,
+		NetID:       route.NetID(desired.NetworkID),
+		Description: desired.Description,
+		Enabled:     desired.Enabled,
+		Peer:        desired.Peer,
+		Metric:      desired.Metric,
+		Masquerade:  desired.Masquerade,
+		KeepRoute:   desired.KeepRoute,
+
+		PeerTagSelector: desired.PeerTagSelector,
+	}
+
+	if desired.ID == "" {
+		newRoute.ID = route.ID(xid.New().String())
+	} else if _, ok := a.routes[desired.ID]; !ok {
+		return nil, status.NewRouteNotFoundError(desired.ID)
+	}
+
+	var err error
+	switch {
+	case len(desired.Domains) > 0 && desired.Network != "":
+		return nil, status.Errorf(status.InvalidArgument, "domains and network should not be provided at the same time")
+	case len(desired.Domains) > 0:
+		newRoute.Domains, err = domain.FromStringList(desired.Domains)
+		if err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid domains: %v", err)
+		}
+		newRoute.NetworkType = route.DomainNetwork
+		newRoute.Network = getPlaceholderIP()
+	default:
+		newRoute.NetworkType, newRoute.Network, err = route.ParseNetwork(desired.Network)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if newRoute.PeerGroups, err = a.resolveGroups(desired.PeerGroups); err != nil {
+		return nil, err
+	}
+
+	if newRoute.Groups, err = a.resolveGroups(desired.Groups); err != nil {
+		return nil, err
+	}
+
+	if newRoute.AccessControlGroups, err = a.resolveGroups(desired.AccessControlGroups); err != nil {
+		return nil, err
+	}
+
+	return newRoute, nil
+}
+
+func (a *accountConfigApplier) validateRoute(newRoute *route.Route) error {
+	account, err := a.transaction.GetAccount(a.ctx, a.accountID)
+	if err != nil {
+		return err
+	}
+
+	return a.am.validateRoute(account, newRoute)
+}
+
+func (a *accountConfigApplier) deleteRoute(id string) error {
+	r := a.routes[id]
+
+	if err := a.transaction.DeleteRoute(a.ctx, LockingStrengthUpdate, a.accountID, id); err != nil {
+		return err
+	}
+
+	delete(a.routes, id)
+	a.addEvent(id, activity.RouteRemoved, r.EventMeta())
+	return nil
+}
+
+func (a *accountConfigApplier) saveNameServerGroup(name string) error {
+	desired := findByName(a.desired.NameServerGroups, func(n *accountconfig.NameServerGroup) string { return n.Name }, name)
+
+	nsGroup := &nbdns.NameServerGroup{
+		ID:                   xid.New().String(),
+		AccountID:            a.accountID,
+		Name:                 desired.Name,
+		Description:          desired.Description,
+		Enabled:              desired.Enabled,
+		Primary:              desired.Primary,
+		Domains:              slices.Clone(desired.Domains),
+		SearchDomainsEnabled: desired.SearchDomainsEnabled,
+	}
+
+	action := activity.NameserverGroupCreated
+	if existing, ok := a.nsGroups[name]; ok {
+		nsGroup.ID = existing.ID
+		action = activity.NameserverGroupUpdated
+	}
+
+	for _, ns := range desired.NameServers {
+		ip, err := netip.ParseAddr(ns.IP)
+		if err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid nameserver IP %s", ns.IP)
+		}
+
+		nsType := nbdns.ToNameServerType(ns.NSType)
+		if nsType == nbdns.InvalidNameServerType {
+			return status.Errorf(status.InvalidArgument, "invalid nameserver type %s", ns.NSType)
+		}
+
+		nsGroup.NameServers = append(nsGroup.NameServers, nbdns.NameServer{IP: ip, NSType: nsType, Port: ns.Port})
+	}
+
+	var err error
+	if nsGroup.Groups, err = a.resolveGroups(desired.Groups); err != nil {
+		return err
+	}
+
+	if err = validateNameServerGroup(a.ctx, a.transaction, a.accountID, nsGroup); err != nil {
+		return err
+	}
+
+	if err = a.transaction.SaveNameServerGroup(a.ctx, LockingStrengthUpdate, nsGroup); err != nil {
+		return err
+	}
+
+	a.nsGroups[name] = nsGroup
+	a.addEvent(nsGroup.ID, action, nsGroup.EventMeta())
+	return nil
+}
+
+func (a *accountConfigApplier) deleteNameServerGroup(name string) error {
+	nsGroup := a.nsGroups[name]
+
+	if err := a.transaction.DeleteNameServerGroup(a.ctx, LockingStrengthUpdate, a.accountID, nsGroup.ID); err != nil {
+		return err
+	}
+
+	delete(a.nsGroups, name)
+	a.addEvent(nsGroup.ID, activity.NameserverGroupDeleted, nsGroup.EventMeta())
+	return nil
+}
+
+func (a *accountConfigApplier) saveDNSSettings() error {
+	groupIDs, err := a.resolveGroups(a.desired.DNSSettings.DisabledManagementGroups)
+	if err != nil {
+		return err
+	}
+
+	settings := &DNSSettings{DisabledManagementGroups: groupIDs}
+	if err = validateDNSSettings(a.ctx, a.transaction, a.accountID, settings); err != nil {
+		return err
+	}
+
+	oldSettings, err := a.transaction.GetAccountDNSSettings(a.ctx, LockingStrengthUpdate, a.accountID)
+	if err != nil {
+		return err
+	}
+
+	addedGroups := difference(settings.DisabledManagementGroups, oldSettings.DisabledManagementGroups)
+	removedGroups := difference(oldSettings.DisabledManagementGroups, settings.DisabledManagementGroups)
+	a.events = append(a.events, a.am.prepareDNSSettingsEvents(a.ctx, a.transaction, a.accountID, a.userID, addedGroups, removedGroups)...)
+
+	return a.transaction.SaveDNSSettings(a.ctx, LockingStrengthUpdate, a.accountID, settings)
+}
+
+func (a *accountConfigApplier) addEvent(targetID string, action activity.Activity, meta map[string]any) {
+	ctx, userID, accountID := a.ctx, a.userID, a.accountID
+	a.events = append(a.events, func() {
+		a.am.StoreEvent(ctx, userID, targetID, accountID, action, meta)
+	})
+}
+
+// findByName returns the object of the given name. The name is known to exist as it comes from the diff.
+func findByName[T any](objects []*T, name func(*T) string, wanted string) *T {
+	for _, object := range objects {
+		if name(object) == wanted {
+			return object
+		}
+	}
+	return nil
+}
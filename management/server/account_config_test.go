@@ -0,0 +1,342 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/accountconfig"
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+func testAccountConfig() *accountconfig.Config {
+	return &accountconfig.Config{
+		Version: accountconfig.Version,
+		Groups: []*accountconfig.Group{
+			{Name: "devs", Peers: []string{}},
+			{Name: "servers", Peers: []string{}},
+		},
+		PostureChecks: []*accountconfig.PostureCheck{
+			{Name: "min version", Checks: api.Checks{NbVersionCheck: &api.NBVersionCheck{MinVersion: "0.26.0"}}},
+		},
+		Policies: []*accountconfig.Policy{
+			{
+				Name:    "devs to servers",
+				Enabled: true,
+				Rules: []*accountconfig.PolicyRule{
+					{
+						Name:          "ssh",
+						Enabled:       true,
+						Action:        "accept",
+						Bidirectional: true,
+						Protocol:      "tcp",
+						Ports:         []string{"22"},
+						Sources:       []string{"devs"},
+						Destinations:  []string{"servers"},
+					},
+				},
+				SourcePostureChecks: []string{"min version"},
+				Schedule: &accountconfig.PolicySchedule{
+					Days:       []string{"monday", "friday"},
+					TimeRanges: []accountconfig.TimeRange{{Start: "08:00", End: "18:00"}},
+				},
+			},
+		},
+		NameServerGroups: []*accountconfig.NameServerGroup{
+			{
+				Name:        "google",
+				Enabled:     true,
+				NameServers: []accountconfig.NameServer{{IP: "8.8.8.8", NSType: "udp", Port: 53}},
+				Primary:     true,
+				Groups:      []string{"devs"},
+			},
+		},
+		DNSSettings: &accountconfig.DNSSettings{DisabledManagementGroups: []string{"servers"}},
+	}
+}
+
+func TestDefaultAccountManager_ApplyAccountConfig(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+
+	account, err := initTestPostureChecksAccount(am)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	config := testAccountConfig()
+
+	t.Run("plan doesn't change the account", func(t *testing.T) {
+		changes, err := am.PlanAccountConfig(ctx, account.Id, adminUserID, config)
+		require.NoError(t, err)
+		assert.Len(t, changes, 7)
+
+		_, err = am.Store.GetGroupByName(ctx, LockingStrengthShare, account.Id, "devs")
+		assert.Error(t, err, "planned group should not be created")
+	})
+
+	t.Run("apply creates the objects", func(t *testing.T) {
+		changes, err := am.ApplyAccountConfig(ctx, account.Id, adminUserID, config)
+		require.NoError(t, err)
+		assert.Equal(t, []*accountconfig.Change{
+			{Kind: accountconfig.KindGroup, Action: accountconfig.ActionCreate, Name: "devs"},
+			{Kind: accountconfig.KindGroup, Action: accountconfig.ActionCreate, Name: "servers"},
+			{Kind: accountconfig.KindPostureCheck, Action: accountconfig.ActionCreate, Name: "min version"},
+			{Kind: accountconfig.KindPolicy, Action: accountconfig.ActionCreate, Name: "devs to servers"},
+			{Kind: accountconfig.KindNameServerGroup, Action: accountconfig.ActionCreate, Name: "google"},
+			{Kind: accountconfig.KindDNSSettings, Action: accountconfig.ActionUpdate, Name: "dns_settings"},
+			{Kind: accountconfig.KindPolicy, Action: accountconfig.ActionDelete, Name: DefaultRuleName},
+		}, changes)
+
+		devs, err := am.Store.GetGroupByName(ctx, LockingStrengthShare, account.Id, "devs")
+		require.NoError(t, err)
+
+		policies, err := am.Store.GetAccountPolicies(ctx, LockingStrengthShare, account.Id)
+		require.NoError(t, err)
+		require.Len(t, policies, 1, "the default policy missing from the document should be deleted")
+		policy := policies[0]
+		require.Len(t, policy.Rules, 1)
+		assert.Equal(t, []string{devs.ID}, policy.Rules[0].Sources)
+		assert.Equal(t, []time.Weekday{time.Monday, time.Friday}, policy.Schedule.Days)
+
+		assert.Eventually(t, func() bool {
+			events, err := am.eventStore.Get(ctx, account.Id, 0, 100, false)
+			return err == nil && len(events) >= 7
+		}, time.Second, 10*time.Millisecond, "every change should be recorded as an activity event")
+	})
+
+	t.Run("apply is idempotent", func(t *testing.T) {
+		changes, err := am.ApplyAccountConfig(ctx, account.Id, adminUserID, config)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("export returns the applied document", func(t *testing.T) {
+		exported, err := am.ExportAccountConfig(ctx, account.Id, adminUserID)
+		require.NoError(t, err)
+		assert.Equal(t, config.Groups, exported.Groups)
+		assert.Equal(t, config.DNSSettings, exported.DNSSettings)
+		assert.Equal(t, config.Policies, exported.Policies)
+
+		_, err = am.ExportAccountConfig(ctx, account.Id, regularUserID)
+		assert.Error(t, err, "regular user should not export the configuration")
+	})
+
+	t.Run("invalid document is rolled back", func(t *testing.T) {
+		invalid := testAccountConfig()
+		invalid.Groups = append(invalid.Groups, &accountconfig.Group{Name: "new", Peers: []string{}})
+		invalid.Policies[0].Rules[0].Destinations = []string{"unknown"}
+
+		_, err := am.ApplyAccountConfig(ctx, account.Id, adminUserID, invalid)
+		require.Error(t, err)
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.InvalidArgument, sErr.Type())
+
+		_, err = am.Store.GetGroupByName(ctx, LockingStrengthShare, account.Id, "new")
+		assert.Error(t, err, "group of a failed apply should not be created")
+	})
+
+	t.Run("route of another account is rejected", func(t *testing.T) {
+		withRoute := testAccountConfig()
+		withRoute.Routes = []*accountconfig.Route{
+			{ID: "unknown", NetworkID: "office", Network: "10.0.0.0/24", Metric: 9999, Groups: []string{"devs"}, PeerGroups: []string{"servers"}},
+		}
+
+		_, err := am.ApplyAccountConfig(ctx, account.Id, adminUserID, withRoute)
+		require.Error(t, err)
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.NotFound, sErr.Type())
+	})
+
+	t.Run("apply deletes the missing objects", func(t *testing.T) {
+		config.Policies = []*accountconfig.Policy{}
+		config.PostureChecks = []*accountconfig.PostureCheck{}
+		config.NameServerGroups = []*accountconfig.NameServerGroup{}
+		config.DNSSettings.DisabledManagementGroups = []string{}
+		config.Groups = config.Groups[:1]
+
+		changes, err := am.ApplyAccountConfig(ctx, account.Id, adminUserID, config)
+		require.NoError(t, err)
+		assert.Len(t, changes, 5)
+
+		policies, err := am.Store.GetAccountPolicies(ctx, LockingStrengthShare, account.Id)
+		require.NoError(t, err)
+		assert.Empty(t, policies)
+
+		_, err = am.Store.GetGroupByName(ctx, LockingStrengthShare, account.Id, "servers")
+		assert.Error(t, err)
+
+		assert.Eventually(t, func() bool {
+			events, err := am.eventStore.Get(ctx, account.Id, 0, 100, false)
+			if err != nil {
+				return false
+			}
+			for _, event := range events {
+				if event.Activity == activity.GroupDeleted {
+					return true
+				}
+			}
+			return false
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("regular user can't apply", func(t *testing.T) {
+		_, err := am.ApplyAccountConfig(ctx, account.Id, regularUserID, config)
+		assert.Error(t, err)
+	})
+}
+
+func TestDefaultAccountManager_ApplyAccountConfigPostureCheckGracePeriod(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+
+	account, err := initTestPostureChecksAccount(am)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = am.SavePostureChecks(ctx, account.Id, adminUserID, &posture.Checks{
+		Name:        "min version",
+		GracePeriod: 5 * time.Minute,
+		Checks: posture.ChecksDefinition{
+			NBVersionCheck: &posture.NBVersionCheck{MinVersion: "0.26.0"},
+		},
+	})
+	require.NoError(t, err)
+
+	exported, err := am.ExportAccountConfig(ctx, account.Id, adminUserID)
+	require.NoError(t, err)
+	require.Len(t, exported.PostureChecks, 1)
+	assert.Equal(t, 300, exported.PostureChecks[0].GracePeriod)
+
+	changes, err := am.ApplyAccountConfig(ctx, account.Id, adminUserID, exported)
+	require.NoError(t, err)
+	assert.Empty(t, changes, "re-applying the exported document should not change the account")
+
+	checks, err := am.Store.GetAccountPostureChecks(ctx, LockingStrengthShare, account.Id)
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.Equal(t, 5*time.Minute, checks[0].GracePeriod)
+
+	exported.PostureChecks[0].GracePeriod = 60
+	changes, err = am.PlanAccountConfig(ctx, account.Id, adminUserID, exported)
+	require.NoError(t, err)
+	assert.Equal(t, []*accountconfig.Change{
+		{Kind: accountconfig.KindPostureCheck, Action: accountconfig.ActionUpdate, Name: "min version"},
+	}, changes)
+
+	_, err = am.ApplyAccountConfig(ctx, account.Id, adminUserID, exported)
+	require.NoError(t, err)
+
+	checks, err = am.Store.GetAccountPostureChecks(ctx, LockingStrengthShare, account.Id)
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.Equal(t, time.Minute, checks[0].GracePeriod)
+}
+
+func TestDefaultAccountManager_ApplyAccountConfigPolicies(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+
+	account, err := initTestPostureChecksAccount(am)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	config := testAccountConfig()
+	config.Policies[0].Rules = append(config.Policies[0].Rules, &accountconfig.PolicyRule{
+		Name:         "http",
+		Enabled:      true,
+		Action:       "accept",
+		Protocol:     "tcp",
+		Ports:        []string{"80"},
+		Sources:      []string{"devs"},
+		Destinations: []string{"servers"},
+	})
+
+	// the account config manager saves the events before it is closed
+	configManager := NewAccountConfigManager(ctx, am.Store, am.eventStore, "", am.integratedPeerValidator, nil)
+	_, err = configManager.ApplyAccountConfig(ctx, account.Id, adminUserID, config)
+	require.NoError(t, err)
+	configManager.WaitForPendingEvents()
+
+	events, err := am.eventStore.Get(ctx, account.Id, 0, 100, false)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, len(events), 7, "events should be saved once the pending events are waited for")
+
+	t.Run("rules missing from the document are deleted", func(t *testing.T) {
+		config.Policies[0].Rules = config.Policies[0].Rules[:1]
+		_, err := am.ApplyAccountConfig(ctx, account.Id, adminUserID, config)
+		require.NoError(t, err)
+
+		policy, err := am.Store.GetAccountPolicies(ctx, LockingStrengthShare, account.Id)
+		require.NoError(t, err)
+		require.Len(t, policy, 1)
+		require.Len(t, policy[0].Rules, 1)
+		assert.Equal(t, "ssh", policy[0].Rules[0].Name)
+	})
+
+	devs, err := am.Store.GetGroupByName(ctx, LockingStrengthShare, account.Id, "devs")
+	require.NoError(t, err)
+
+	t.Run("access request policies are left out", func(t *testing.T) {
+		request := &AccessRequest{
+			ID:            "request",
+			AccountID:     account.Id,
+			UserID:        regularUserID,
+			Groups:        []string{devs.ID},
+			Status:        AccessRequestStatusApproved,
+			PolicyID:      "request-policy",
+			SourceGroupID: "request-group",
+		}
+		require.NoError(t, am.Store.SaveAccessRequest(ctx, LockingStrengthUpdate, request))
+		require.NoError(t, am.Store.SaveGroup(ctx, LockingStrengthUpdate, &nbgroup.Group{
+			ID: "request-group", AccountID: account.Id, Name: "Access request request", Issued: nbgroup.GroupIssuedAPI,
+		}))
+		require.NoError(t, am.Store.CreatePolicy(ctx, LockingStrengthUpdate, newTestAccessRequestPolicy(request, devs.ID)))
+
+		exported, err := am.ExportAccountConfig(ctx, account.Id, adminUserID)
+		require.NoError(t, err)
+		assert.Equal(t, config.Groups, exported.Groups)
+		assert.Equal(t, config.Policies, exported.Policies)
+
+		changes, err := am.ApplyAccountConfig(ctx, account.Id, adminUserID, config)
+		require.NoError(t, err)
+		assert.Empty(t, changes, "access request objects should not be deleted")
+	})
+
+	t.Run("duplicate names are rejected", func(t *testing.T) {
+		policies, err := am.Store.GetAccountPolicies(ctx, LockingStrengthShare, account.Id)
+		require.NoError(t, err)
+		duplicate := policies[0].Copy()
+		duplicate.ID = "duplicate"
+		duplicate.Rules = nil
+		require.NoError(t, am.Store.CreatePolicy(ctx, LockingStrengthUpdate, duplicate))
+
+		_, err = am.ApplyAccountConfig(ctx, account.Id, adminUserID, config)
+		require.Error(t, err)
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.PreconditionFailed, sErr.Type())
+
+		_, err = am.ExportAccountConfig(ctx, account.Id, adminUserID)
+		assert.Error(t, err)
+	})
+}
+
+func newTestAccessRequestPolicy(request *AccessRequest, destination string) *Policy {
+	request.ExpiresAt = time.Now().Add(time.Hour)
+	policy := newAccessRequestPolicy(request, request.SourceGroupID, []string{destination})
+	policy.ID = request.PolicyID
+	for _, rule := range policy.Rules {
+		rule.ID = request.PolicyID
+		rule.PolicyID = request.PolicyID
+	}
+	return policy
+}
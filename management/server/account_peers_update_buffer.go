@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/telemetry"
+)
+
+// accountPeersUpdate is the state of the peers update of an account
+type accountPeersUpdate struct {
+	// computing is set while the network maps of the account peers are computed and sent
+	computing bool
+	// pending is set when an update is requested while computing, it is sent after the next buffer interval
+	pending bool
+}
+
+// AccountPeersUpdateBuffer coalesces the peers update requests of an account received within the buffer interval,
+// so the network maps of the account peers are computed once for a burst of changes.
+// A single update is computed at a time for an account.
+type AccountPeersUpdateBuffer struct {
+	ctx            context.Context
+	updates        map[string]*accountPeersUpdate
+	mu             sync.Mutex
+	bufferInterval time.Duration
+	update         func(ctx context.Context, accountID string)
+	metrics        telemetry.AppMetrics
+}
+
+// NewAccountPeersUpdateBuffer creates a buffer calling update once per buffer interval and account.
+// The buffer interval is set with NB_PEERS_UPDATE_BUFFER_INTERVAL, a zero interval disables the buffering.
+func NewAccountPeersUpdateBuffer(ctx context.Context, update func(ctx context.Context, accountID string), metrics telemetry.AppMetrics) *AccountPeersUpdateBuffer {
+	bufferIntervalStr := os.Getenv("NB_PEERS_UPDATE_BUFFER_INTERVAL")
+	bufferInterval, err := time.ParseDuration(bufferIntervalStr)
+	if err != nil {
+		if bufferIntervalStr != "" {
+			log.WithContext(ctx).Warnf("failed to parse peers update buffer interval: %s", err)
+		}
+		bufferInterval = 100 * time.Millisecond
+	}
+
+	log.WithContext(ctx).Infof("set peers update buffer interval to %s", bufferInterval)
+
+	return &AccountPeersUpdateBuffer{
+		ctx:            ctx,
+		updates:        make(map[string]*accountPeersUpdate),
+		bufferInterval: bufferInterval,
+		update:         update,
+		metrics:        metrics,
+	}
+}
+
+// UpdateAccountPeers requests an update of the account peers. The update is sent after the buffer interval
+// together with the other requests received in the meantime.
+func (b *AccountPeersUpdateBuffer) UpdateAccountPeers(ctx context.Context, accountID string) {
+	if b.bufferInterval <= 0 {
+		b.computeUpdate(ctx, accountID)
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	update, ok := b.updates[accountID]
+	switch {
+	case !ok:
+		b.updates[accountID] = &accountPeersUpdate{}
+		// the request context is canceled once the request is handled, the update outlives it
+		go b.processUpdates(context.WithoutCancel(ctx), accountID)
+	case update.computing && !update.pending:
+		update.pending = true
+	default:
+		log.WithContext(ctx).Tracef("coalesced peers update of account %s", accountID)
+		if b.metrics != nil {
+			b.metrics.AccountManagerMetrics().CountUpdateAccountPeersCoalesced()
+		}
+	}
+}
+
+// processUpdates sends the updates of the account after each buffer interval until no update is pending
+func (b *AccountPeersUpdateBuffer) processUpdates(ctx context.Context, accountID string) {
+	for {
+		select {
+		case <-time.After(b.bufferInterval):
+		case <-b.ctx.Done():
+			return
+		}
+
+		b.mu.Lock()
+		b.updates[accountID].computing = true
+		b.mu.Unlock()
+
+		b.computeUpdate(ctx, accountID)
+
+		b.mu.Lock()
+		update := b.updates[accountID]
+		if !update.pending {
+			delete(b.updates, accountID)
+			b.mu.Unlock()
+			return
+		}
+		update.computing = false
+		update.pending = false
+		b.mu.Unlock()
+	}
+}
+
+func (b *AccountPeersUpdateBuffer) computeUpdate(ctx context.Context, accountID string) {
+	b.update(ctx, accountID)
+	if b.metrics != nil {
+		b.metrics.AccountManagerMetrics().CountUpdateAccountPeersComputed()
+	}
+}
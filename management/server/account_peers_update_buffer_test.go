@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountPeersUpdateBuffer_CoalescesUpdates(t *testing.T) {
+	t.Setenv("NB_PEERS_UPDATE_BUFFER_INTERVAL", "50ms")
+
+	var calls atomic.Int32
+	buffer := NewAccountPeersUpdateBuffer(context.Background(), func(ctx context.Context, accountID string) {
+		calls.Add(1)
+	}, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			buffer.UpdateAccountPeers(context.Background(), "account")
+		}()
+	}
+	wg.Wait()
+
+	assert.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(1), calls.Load(), "a burst of requests should be sent once")
+}
+
+func TestAccountPeersUpdateBuffer_PendingWhileComputing(t *testing.T) {
+	t.Setenv("NB_PEERS_UPDATE_BUFFER_INTERVAL", "20ms")
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var calls atomic.Int32
+	buffer := NewAccountPeersUpdateBuffer(context.Background(), func(ctx context.Context, accountID string) {
+		if calls.Add(1) == 1 {
+			close(started)
+			<-release
+		}
+	}, nil)
+
+	buffer.UpdateAccountPeers(context.Background(), "account")
+	<-started
+
+	for i := 0; i < 10; i++ {
+		buffer.UpdateAccountPeers(context.Background(), "account")
+	}
+	close(release)
+
+	assert.Eventually(t, func() bool { return calls.Load() == 2 }, time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(2), calls.Load(), "requests received while computing should be sent once after it")
+}
+
+func TestAccountPeersUpdateBuffer_Disabled(t *testing.T) {
+	t.Setenv("NB_PEERS_UPDATE_BUFFER_INTERVAL", "0")
+
+	var calls int
+	buffer := NewAccountPeersUpdateBuffer(context.Background(), func(ctx context.Context, accountID string) {
+		calls++
+	}, nil)
+
+	buffer.UpdateAccountPeers(context.Background(), "account")
+	buffer.UpdateAccountPeers(context.Background(), "account")
+
+	assert.Equal(t, 2, calls, "updates should be sent right away without buffer interval")
+}
@@ -21,15 +21,18 @@ import (
 	"github.com/netbirdio/netbird/management/server/account"
 	"github.com/netbirdio/netbird/management/server/activity"
 	"github.com/netbirdio/netbird/management/server/group"
+	"github.com/netbirdio/netbird/management/server/idp"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/posture"
+	nbservice "github.com/netbirdio/netbird/management/server/service"
 	"github.com/netbirdio/netbird/management/server/telemetry"
 	"github.com/netbirdio/netbird/route"
 )
 
 type MocIntegratedValidator struct {
 	ValidatePeerFunc func(_ context.Context, update *nbpeer.Peer, peer *nbpeer.Peer, userID string, accountID string, dnsDomain string, peersGroup []string, extraSettings *account.ExtraSettings) (*nbpeer.Peer, bool, error)
+	PeerDeletedFunc  func(_ context.Context, accountID, peerID string) error
 }
 
 func (a MocIntegratedValidator) ValidateExtraSettings(_ context.Context, newExtraSettings *account.ExtraSettings, oldExtraSettings *account.ExtraSettings, peers map[string]*nbpeer.Peer, userID string, accountID string) error {
@@ -58,7 +61,10 @@ func (MocIntegratedValidator) IsNotValidPeer(_ context.Context, accountID string
 	return false, false, nil
 }
 
-func (MocIntegratedValidator) PeerDeleted(_ context.Context, _, _ string) error {
+func (a MocIntegratedValidator) PeerDeleted(_ context.Context, accountID, peerID string) error {
+	if a.PeerDeletedFunc != nil {
+		return a.PeerDeletedFunc(context.Background(), accountID, peerID)
+	}
 	return nil
 }
 
@@ -1534,7 +1540,7 @@ func getEvent(t *testing.T, accountID string, manager AccountManager, eventType
 		case <-time.After(time.Second):
 			t.Fatal("no PeerAddedWithSetupKey event was generated")
 		default:
-			events, err := manager.GetEvents(context.Background(), accountID, userID)
+			events, err := manager.GetEvents(context.Background(), accountID, userID, activity.Filter{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -1700,6 +1706,7 @@ func TestAccount_Copy(t *testing.T) {
 		Domain:                 "test.com",
 		DomainCategory:         "public",
 		IsDomainPrimaryAccount: true,
+		OrganizationID:         "organization1",
 		SetupKeys: map[string]*SetupKey{
 			"setup1": {
 				Id:         "setup1",
@@ -1773,6 +1780,13 @@ func TestAccount_Copy(t *testing.T) {
 				ID: "posture Checks1",
 			},
 		},
+		Services: []*nbservice.Service{
+			{
+				ID:         "service1",
+				Ports:      []string{"5432"},
+				PortRanges: []nbservice.PortRange{{Start: 8000, End: 8080}},
+			},
+		},
 		Settings: &Settings{},
 	}
 	err := hasNilField(account)
@@ -1802,6 +1816,10 @@ func hasNilField(x interface{}) error {
 		if json, ok := rv.Type().Field(i).Tag.Lookup("json"); ok && json == "-" {
 			continue
 		}
+		// skip unexported fields, they aren't copied
+		if !rv.Type().Field(i).IsExported() {
+			continue
+		}
 		if f := rv.Field(i); f.IsValid() {
 			k := f.Kind()
 			switch k {
@@ -2792,6 +2810,36 @@ func TestAccount_SetJWTGroups(t *testing.T) {
 		assert.Len(t, user.AutoGroups, 1, "only non-JWT groups should remain")
 		assert.Contains(t, user.AutoGroups, "group1", " group1 should still be present")
 	})
+
+	t.Run("groups looked up in the IdP", func(t *testing.T) {
+		manager.idpManager = &testUserGroupsIDP{groups: map[string][]string{"user1": {"directory-group"}}}
+		defer func() { manager.idpManager = nil }()
+
+		claims := jwtclaims.AuthorizationClaims{
+			UserId: "user1",
+			Raw:    jwt.MapClaims{},
+		}
+		err = manager.syncJWTGroups(context.Background(), "accountID", claims)
+		assert.NoError(t, err, "unable to sync jwt groups")
+
+		directoryGroup, err := manager.Store.GetGroupByName(context.Background(), LockingStrengthShare, "accountID", "directory-group")
+		require.NoError(t, err, "group of the IdP should be created")
+		assert.Equal(t, group.GroupIssuedJWT, directoryGroup.Issued)
+
+		user, err := manager.Store.GetUserByUserID(context.Background(), LockingStrengthShare, "user1")
+		assert.NoError(t, err, "unable to get user")
+		assert.Contains(t, user.AutoGroups, directoryGroup.ID, "group of the IdP should be added to the user")
+	})
+}
+
+// testUserGroupsIDP is an IdP manager looking up the user groups
+type testUserGroupsIDP struct {
+	idp.MockIDP
+	groups map[string][]string
+}
+
+func (m *testUserGroupsIDP) GetUserGroups(_ context.Context, userID string) ([]string, error) {
+	return m.groups[userID], nil
 }
 
 func TestAccount_UserGroupsAddToPeers(t *testing.T) {
@@ -2916,6 +2964,9 @@ func waitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
 func setupNetworkMapTest(t *testing.T) (*DefaultAccountManager, *Account, *nbpeer.Peer, *nbpeer.Peer, *nbpeer.Peer) {
 	t.Helper()
 
+	// the tests expect the update of each change to be sent right away
+	t.Setenv("NB_PEERS_UPDATE_BUFFER_INTERVAL", "0")
+
 	manager, err := createManager(t)
 	if err != nil {
 		t.Fatal(err)
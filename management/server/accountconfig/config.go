@@ -0,0 +1,398 @@
+package accountconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/posture"
+	nbservice "github.com/netbirdio/netbird/management/server/service"
+)
+
+// Version is the current version of the account configuration document
+const Version = "v1"
+
+// Format is the encoding of the account configuration document
+type Format string
+
+const (
+	// FormatYAML encodes the document as YAML
+	FormatYAML Format = "yaml"
+	// FormatJSON encodes the document as JSON
+	FormatJSON Format = "json"
+)
+
+// Config is a versioned document describing the configuration objects of an account.
+// Objects reference each other by name, so a document can be applied to any account.
+//
+// A section that is omitted from the document is left untouched on apply. A section that is present
+// is authoritative: objects of the account that are missing from it are deleted.
+type Config struct {
+	// Version of the document format
+	Version string `json:"version"`
+
+	// Groups are the API managed groups of the account
+	Groups []*Group `json:"groups"`
+
+	// PostureChecks of the account
+	PostureChecks []*PostureCheck `json:"posture_checks"`
+
+	// Services are the named protocols and ports referenced by the policy rules
+	Services []*Service `json:"services"`
+
+	// Policies of the account
+	Policies []*Policy `json:"policies"`
+
+	// Routes of the account
+	Routes []*Route `json:"routes"`
+
+	// NameServerGroups of the account
+	NameServerGroups []*NameServerGroup `json:"nameserver_groups"`
+
+	// DNSSettings of the account
+	DNSSettings *DNSSettings `json:"dns_settings"`
+}
+
+// Group is an API managed group. Groups issued by the IdP or integrations can be referenced by name but aren't managed.
+type Group struct {
+	Name string `json:"name"`
+
+	// Peers are the IDs of the group peers. Nil leaves the peers of an existing group untouched.
+	// Ignored for dynamic groups.
+	Peers []string `json:"peers,omitempty"`
+
+	// Rule makes the group dynamic, its peers are computed from the peer attributes
+	Rule string `json:"rule,omitempty"`
+}
+
+// PostureCheck is a named set of posture checks
+type PostureCheck struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+
+	// GracePeriod is the period in seconds peers failing the checks keep the access
+	GracePeriod int `json:"grace_period,omitempty"`
+
+	Checks api.Checks `json:"checks"`
+}
+
+// NewPostureCheck returns the document representation of the posture checks
+func NewPostureCheck(checks *posture.Checks) *PostureCheck {
+	return &PostureCheck{
+		Name:        checks.Name,
+		Description: checks.Description,
+		GracePeriod: int(checks.GracePeriod.Seconds()),
+		Checks:      checks.ToAPIResponse().Checks,
+	}
+}
+
+// ToPostureChecks returns the posture checks described by the document with the given ID
+func (p *PostureCheck) ToPostureChecks(id string) (*posture.Checks, error) {
+	checks := p.Checks
+	gracePeriod := p.GracePeriod
+	return posture.NewChecksFromAPIPostureCheckUpdate(api.PostureCheckUpdate{
+		Name:        p.Name,
+		Description: p.Description,
+		GracePeriod: &gracePeriod,
+		Checks:      &checks,
+	}, id)
+}
+
+// Service is a named protocol with a set of ports and port ranges
+type Service struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Protocol    string      `json:"protocol"`
+	Ports       []string    `json:"ports,omitempty"`
+	PortRanges  []PortRange `json:"port_ranges,omitempty"`
+}
+
+// NewService returns the document representation of the service
+func NewService(service *nbservice.Service) *Service {
+	configService := &Service{
+		Name:        service.Name,
+		Description: service.Description,
+		Protocol:    string(service.Protocol),
+		Ports:       slices.Clone(service.Ports),
+	}
+	for _, portRange := range service.PortRanges {
+		configService.PortRanges = append(configService.PortRanges, PortRange{Start: portRange.Start, End: portRange.End})
+	}
+	return configService
+}
+
+// ToService returns the service described by the document with the given ID
+func (s *Service) ToService(id string) *nbservice.Service {
+	service := &nbservice.Service{
+		ID:          id,
+		Name:        s.Name,
+		Description: s.Description,
+		Protocol:    nbservice.Protocol(s.Protocol),
+		Ports:       slices.Clone(s.Ports),
+	}
+	for _, portRange := range s.PortRanges {
+		service.PortRanges = append(service.PortRanges, nbservice.PortRange{Start: portRange.Start, End: portRange.End})
+	}
+	return service
+}
+
+// Policy is an access control policy
+type Policy struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Enabled     bool   `json:"enabled"`
+
+	Rules []*PolicyRule `json:"rules"`
+
+	// SourcePostureChecks are the names of the posture checks applied to the source peers
+	SourcePostureChecks []string `json:"source_posture_checks,omitempty"`
+
+	Schedule *PolicySchedule `json:"schedule,omitempty"`
+}
+
+// PolicyRule is a rule of a policy
+type PolicyRule struct {
+	Name          string `json:"name"`
+	Description   string `json:"description,omitempty"`
+	Enabled       bool   `json:"enabled"`
+	Action        string `json:"action"`
+	Bidirectional bool   `json:"bidirectional"`
+	Protocol      string `json:"protocol"`
+
+	Ports      []string    `json:"ports,omitempty"`
+	PortRanges []PortRange `json:"port_ranges,omitempty"`
+
+	// Services are the names of the services, they replace the protocol and ports of the rule
+	Services []string `json:"services,omitempty"`
+
+	// Sources are the names of the source groups
+	Sources []string `json:"sources"`
+
+	// Destinations are the names of the destination groups
+	Destinations []string `json:"destinations"`
+
+	SourceTagSelector      string `json:"source_tag_selector,omitempty"`
+	DestinationTagSelector string `json:"destination_tag_selector,omitempty"`
+}
+
+// PortRange is an inclusive range of ports
+type PortRange struct {
+	Start uint16 `json:"start"`
+	End   uint16 `json:"end"`
+}
+
+// PolicySchedule defines the time windows when a policy is applied
+type PolicySchedule struct {
+	// Days are lowercase names of the week days. Empty means every day.
+	Days []string `json:"days,omitempty"`
+
+	TimeRanges []TimeRange `json:"time_ranges,omitempty"`
+	Timezone   string      `json:"timezone,omitempty"`
+	StartDate  *time.Time  `json:"start_date,omitempty"`
+	EndDate    *time.Time  `json:"end_date,omitempty"`
+}
+
+// TimeRange is a daily time range in HH:MM format
+type TimeRange struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// Route is a network route. Routes have no unique name, so they are identified by ID.
+// A route without an ID is created on apply.
+type Route struct {
+	ID          string `json:"id,omitempty"`
+	NetworkID   string `json:"network_id"`
+	Description string `json:"description,omitempty"`
+	Enabled     bool   `json:"enabled"`
+
+	// Network is the routed range. Mutually exclusive with Domains.
+	Network string   `json:"network,omitempty"`
+	Domains []string `json:"domains,omitempty"`
+
+	// Peer is the ID of the routing peer. Mutually exclusive with PeerGroups.
+	Peer string `json:"peer,omitempty"`
+
+	// PeerGroups are the names of the routing peer groups
+	PeerGroups []string `json:"peer_groups,omitempty"`
+
+	// PeerTagSelector selects the routing peers by their tags. Mutually exclusive with Peer and PeerGroups.
+	PeerTagSelector string `json:"peer_tag_selector,omitempty"`
+
+	Metric     int  `json:"metric"`
+	Masquerade bool `json:"masquerade"`
+	KeepRoute  bool `json:"keep_route"`
+
+	// Groups are the names of the distribution groups
+	Groups []string `json:"groups"`
+
+	// AccessControlGroups are the names of the access control groups
+	AccessControlGroups []string `json:"access_control_groups,omitempty"`
+}
+
+// NameServerGroup is a group of nameservers distributed to peers
+type NameServerGroup struct {
+	Name                 string       `json:"name"`
+	Description          string       `json:"description,omitempty"`
+	Enabled              bool         `json:"enabled"`
+	NameServers          []NameServer `json:"nameservers"`
+	Primary              bool         `json:"primary"`
+	Domains              []string     `json:"domains,omitempty"`
+	SearchDomainsEnabled bool         `json:"search_domains_enabled"`
+
+	// Groups are the names of the distribution groups
+	Groups []string `json:"groups"`
+}
+
+// NameServer is a nameserver address
+type NameServer struct {
+	IP     string `json:"ip"`
+	NSType string `json:"ns_type"`
+	Port   int    `json:"port"`
+}
+
+// DNSSettings are the DNS settings of the account
+type DNSSettings struct {
+	// DisabledManagementGroups are the names of the groups whose DNS management is disabled
+	DisabledManagementGroups []string `json:"disabled_management_groups"`
+}
+
+// Parse decodes a YAML or JSON document. Unknown fields are rejected to catch typos.
+func Parse(data []byte) (*Config, error) {
+	// YAML is a superset of JSON, so both formats are decoded by the YAML parser
+	// and the result is re-encoded to JSON to apply the JSON field names.
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse document: %w", err)
+	}
+
+	if raw == nil {
+		return nil, errors.New("document is empty")
+	}
+
+	jsonData, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("parse document: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.DisallowUnknownFields()
+
+	var config Config
+	if err = decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("parse document: %w", err)
+	}
+
+	if err = config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// Marshal encodes the document in the given format
+func Marshal(config *Config, format Format) ([]byte, error) {
+	jsonData, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case FormatJSON:
+		return jsonData, nil
+	case FormatYAML:
+		// decoding the JSON into a node keeps the field order of the structs
+		var node yaml.Node
+		if err = yaml.Unmarshal(jsonData, &node); err != nil {
+			return nil, err
+		}
+		resetStyle(&node)
+
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err = encoder.Encode(&node); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), encoder.Close()
+	default:
+		return nil, fmt.Errorf("unsupported format %s", format)
+	}
+}
+
+// resetStyle drops the JSON flow style of the node tree, so it is encoded in the YAML block style
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
+// Validate checks the document version and that the objects are uniquely identified
+func (c *Config) Validate() error {
+	if c.Version != Version {
+		return fmt.Errorf("unsupported document version %q, expected %q", c.Version, Version)
+	}
+
+	if err := checkUnique("group", c.Groups, func(g *Group) string { return g.Name }); err != nil {
+		return err
+	}
+
+	if err := checkUnique("posture check", c.PostureChecks, func(p *PostureCheck) string { return p.Name }); err != nil {
+		return err
+	}
+
+	if err := checkUnique("service", c.Services, func(s *Service) string { return s.Name }); err != nil {
+		return err
+	}
+
+	if err := checkUnique("policy", c.Policies, func(p *Policy) string { return p.Name }); err != nil {
+		return err
+	}
+
+	if err := checkUnique("nameserver group", c.NameServerGroups, func(n *NameServerGroup) string { return n.Name }); err != nil {
+		return err
+	}
+
+	routeIDs := make(map[string]struct{}, len(c.Routes))
+	for _, r := range c.Routes {
+		if r == nil {
+			return errors.New("route can't be empty")
+		}
+		if r.ID == "" {
+			continue
+		}
+		if _, ok := routeIDs[r.ID]; ok {
+			return fmt.Errorf("duplicate route ID %s", r.ID)
+		}
+		routeIDs[r.ID] = struct{}{}
+	}
+
+	return nil
+}
+
+func checkUnique[T any](kind string, objects []*T, name func(*T) string) error {
+	names := make(map[string]struct{}, len(objects))
+	for _, object := range objects {
+		if object == nil {
+			return fmt.Errorf("%s can't be empty", kind)
+		}
+
+		n := name(object)
+		if n == "" {
+			return fmt.Errorf("%s name is required", kind)
+		}
+
+		if _, ok := names[n]; ok {
+			return fmt.Errorf("duplicate %s name %s", kind, n)
+		}
+		names[n] = struct{}{}
+	}
+	return nil
+}
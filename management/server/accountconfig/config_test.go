@@ -0,0 +1,129 @@
+package accountconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/http/api"
+)
+
+const testDocument = `
+version: v1
+groups:
+  - name: devs
+    peers: [peer-1, peer-2]
+  - name: servers
+policies:
+  - name: devs to servers
+    enabled: true
+    rules:
+      - name: devs to servers
+        enabled: true
+        action: accept
+        bidirectional: true
+        protocol: tcp
+        ports: ["22"]
+        sources: [devs]
+        destinations: [servers]
+`
+
+func TestParse(t *testing.T) {
+	config, err := Parse([]byte(testDocument))
+	require.NoError(t, err)
+
+	require.Len(t, config.Groups, 2)
+	assert.Equal(t, []string{"peer-1", "peer-2"}, config.Groups[0].Peers)
+	assert.Nil(t, config.Groups[1].Peers, "omitted peers should stay unmanaged")
+	require.Len(t, config.Policies, 1)
+	assert.Equal(t, []string{"22"}, config.Policies[0].Rules[0].Ports)
+	assert.Nil(t, config.Routes, "omitted section should stay unmanaged")
+	assert.Nil(t, config.DNSSettings)
+
+	data, err := Marshal(config, FormatJSON)
+	require.NoError(t, err)
+	fromJSON, err := Parse(data)
+	require.NoError(t, err)
+	assert.Equal(t, config.Policies, fromJSON.Policies)
+
+	data, err = Marshal(config, FormatYAML)
+	require.NoError(t, err)
+	fromYAML, err := Parse(data)
+	require.NoError(t, err)
+	assert.Equal(t, config.Groups, fromYAML.Groups)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	testCases := []struct {
+		name     string
+		document string
+	}{
+		{name: "empty document", document: ""},
+		{name: "unsupported version", document: "version: v2\n"},
+		{name: "unknown field", document: "version: v1\ngroups:\n  - name: devs\n    peer: [peer-1]\n"},
+		{name: "duplicate group", document: "version: v1\ngroups:\n  - name: devs\n  - name: devs\n"},
+		{name: "group without name", document: "version: v1\ngroups:\n  - peers: [peer-1]\n"},
+		{name: "duplicate route ID", document: "version: v1\nroutes:\n  - id: r1\n    network_id: a\n  - id: r1\n    network_id: b\n"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Parse([]byte(testCase.document))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	current := &Config{
+		Version: Version,
+		Groups: []*Group{
+			{Name: "devs", Peers: []string{"peer-2", "peer-1"}},
+			{Name: "old", Peers: []string{}},
+		},
+		PostureChecks: []*PostureCheck{
+			{Name: "min version", GracePeriod: 300, Checks: api.Checks{NbVersionCheck: &api.NBVersionCheck{MinVersion: "0.26.0"}}},
+		},
+		Policies: []*Policy{
+			{Name: "devs to servers", Enabled: true, Rules: []*PolicyRule{{Name: "rule", Protocol: "all", Sources: []string{"devs"}}}},
+		},
+		Routes: []*Route{
+			{ID: "route-1", NetworkID: "office", Network: "10.0.0.0/24", Metric: 9999, Groups: []string{"devs"}},
+			{ID: "route-2", NetworkID: "lab", Network: "10.1.0.0/24", Metric: 9999, Groups: []string{"devs"}},
+		},
+		DNSSettings: &DNSSettings{DisabledManagementGroups: []string{}},
+	}
+
+	desired := &Config{
+		Version: Version,
+		Groups: []*Group{
+			{Name: "devs", Peers: []string{"peer-1", "peer-2"}},
+			{Name: "servers"},
+		},
+		PostureChecks: []*PostureCheck{
+			{Name: "min version", Checks: api.Checks{NbVersionCheck: &api.NBVersionCheck{MinVersion: "0.26.0"}}},
+		},
+		Policies: []*Policy{
+			{Name: "devs to servers", Enabled: false, Rules: []*PolicyRule{{Name: "rule", Protocol: "all", Sources: []string{"devs"}}}},
+		},
+		Routes: []*Route{
+			{ID: "route-1", NetworkID: "office", Network: "10.0.0.0/24", Metric: 9999, Groups: []string{"devs"}},
+			{NetworkID: "datacenter", Network: "10.2.0.0/24", Metric: 9999, Groups: []string{"servers"}},
+		},
+		DNSSettings: &DNSSettings{},
+	}
+
+	changes := Diff(current, desired)
+	assert.Equal(t, []*Change{
+		{Kind: KindGroup, Action: ActionCreate, Name: "servers"},
+		{Kind: KindPostureCheck, Action: ActionUpdate, Name: "min version"},
+		{Kind: KindPolicy, Action: ActionUpdate, Name: "devs to servers"},
+		{Kind: KindRoute, Action: ActionCreate, Name: "datacenter"},
+		{Kind: KindRoute, Action: ActionDelete, Name: "route-2"},
+		{Kind: KindGroup, Action: ActionDelete, Name: "old"},
+	}, changes)
+
+	assert.Empty(t, Diff(current, current), "diff of the same configuration should be empty")
+	assert.Empty(t, Diff(current, &Config{Version: Version}), "omitted sections should not produce changes")
+}
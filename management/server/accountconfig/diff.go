@@ -0,0 +1,222 @@
+package accountconfig
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+)
+
+// Kind is the type of the configuration object a change applies to
+type Kind string
+
+const (
+	KindGroup           Kind = "group"
+	KindPostureCheck    Kind = "posture_check"
+	KindService         Kind = "service"
+	KindPolicy          Kind = "policy"
+	KindRoute           Kind = "route"
+	KindNameServerGroup Kind = "nameserver_group"
+	KindDNSSettings     Kind = "dns_settings"
+)
+
+// Action is the operation a change performs
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Change is a single operation needed to bring the account configuration to the desired state
+type Change struct {
+	Kind   Kind   `json:"kind"`
+	Action Action `json:"action"`
+	// Name identifies the object. Routes are identified by ID, with the network ID used for new routes.
+	Name string `json:"name"`
+}
+
+// Diff returns the changes needed to turn the current configuration into the desired one.
+// Creates and updates are ordered so that referenced objects come first, deletes come last in reverse order.
+func Diff(current, desired *Config) []*Change {
+	var changes []*Change
+	var deletes []*Change
+
+	if desired.Groups != nil {
+		c, d := diffNamed(KindGroup, current.Groups, desired.Groups, func(g *Group) string { return g.Name }, groupEqual)
+		changes = append(changes, c...)
+		deletes = append(deletes, d...)
+	}
+
+	if desired.PostureChecks != nil {
+		c, d := diffNamed(KindPostureCheck, current.PostureChecks, desired.PostureChecks, func(p *PostureCheck) string { return p.Name }, jsonEqual[PostureCheck])
+		changes = append(changes, c...)
+		deletes = append(deletes, d...)
+	}
+
+	if desired.Services != nil {
+		c, d := diffNamed(KindService, current.Services, desired.Services, func(s *Service) string { return s.Name }, jsonEqual[Service])
+		changes = append(changes, c...)
+		deletes = append(deletes, d...)
+	}
+
+	if desired.Policies != nil {
+		c, d := diffNamed(KindPolicy, current.Policies, desired.Policies, func(p *Policy) string { return p.Name }, jsonEqual[Policy])
+		changes = append(changes, c...)
+		deletes = append(deletes, d...)
+	}
+
+	if desired.Routes != nil {
+		c, d := diffRoutes(current.Routes, desired.Routes)
+		changes = append(changes, c...)
+		deletes = append(deletes, d...)
+	}
+
+	if desired.NameServerGroups != nil {
+		c, d := diffNamed(KindNameServerGroup, current.NameServerGroups, desired.NameServerGroups, func(n *NameServerGroup) string { return n.Name }, jsonEqual[NameServerGroup])
+		changes = append(changes, c...)
+		deletes = append(deletes, d...)
+	}
+
+	if desired.DNSSettings != nil && !jsonEqual(current.DNSSettings, desired.DNSSettings) {
+		changes = append(changes, &Change{Kind: KindDNSSettings, Action: ActionUpdate, Name: string(KindDNSSettings)})
+	}
+
+	slices.Reverse(deletes)
+	return append(changes, deletes...)
+}
+
+// diffNamed compares objects identified by name and returns the create and update changes and the delete changes
+func diffNamed[T any](kind Kind, current, desired []*T, name func(*T) string, equal func(a, b *T) bool) ([]*Change, []*Change) {
+	existing := make(map[string]*T, len(current))
+	for _, object := range current {
+		existing[name(object)] = object
+	}
+
+	var changes []*Change
+	wanted := make(map[string]struct{}, len(desired))
+	for _, object := range desired {
+		n := name(object)
+		wanted[n] = struct{}{}
+
+		old, ok := existing[n]
+		switch {
+		case !ok:
+			changes = append(changes, &Change{Kind: kind, Action: ActionCreate, Name: n})
+		case !equal(old, object):
+			changes = append(changes, &Change{Kind: kind, Action: ActionUpdate, Name: n})
+		}
+	}
+
+	var deletes []*Change
+	for _, object := range current {
+		if _, ok := wanted[name(object)]; !ok {
+			deletes = append(deletes, &Change{Kind: kind, Action: ActionDelete, Name: name(object)})
+		}
+	}
+
+	return changes, deletes
+}
+
+func diffRoutes(current, desired []*Route) ([]*Change, []*Change) {
+	existing := make(map[string]*Route, len(current))
+	for _, r := range current {
+		existing[r.ID] = r
+	}
+
+	var changes []*Change
+	wanted := make(map[string]struct{}, len(desired))
+	for _, r := range desired {
+		old, ok := existing[r.ID]
+		switch {
+		case r.ID == "" || !ok:
+			changes = append(changes, &Change{Kind: KindRoute, Action: ActionCreate, Name: r.NetworkID})
+		case !jsonEqual(old, r):
+			changes = append(changes, &Change{Kind: KindRoute, Action: ActionUpdate, Name: r.ID})
+		}
+		wanted[r.ID] = struct{}{}
+	}
+
+	var deletes []*Change
+	for _, r := range current {
+		if _, ok := wanted[r.ID]; !ok {
+			deletes = append(deletes, &Change{Kind: KindRoute, Action: ActionDelete, Name: r.ID})
+		}
+	}
+
+	return changes, deletes
+}
+
+// groupEqual compares groups, ignoring the peers if they are not managed by the desired group
+func groupEqual(current, desired *Group) bool {
+	if current.Rule != desired.Rule {
+		return false
+	}
+
+	if desired.Peers == nil || desired.Rule != "" {
+		return current.Name == desired.Name
+	}
+
+	currentPeers := slices.Clone(current.Peers)
+	desiredPeers := slices.Clone(desired.Peers)
+	slices.Sort(currentPeers)
+	slices.Sort(desiredPeers)
+	return slices.Equal(currentPeers, desiredPeers)
+}
+
+// jsonEqual compares the document representation of the objects, so nil and empty lists are equal
+func jsonEqual[T any](a, b *T) bool {
+	aValue, aErr := normalize(a)
+	bValue, bErr := normalize(b)
+	return aErr == nil && bErr == nil && reflect.DeepEqual(aValue, bValue)
+}
+
+// normalize returns the generic JSON representation of the object without nulls, empty lists and empty objects
+func normalize(object any) (any, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	var value any
+	if err = json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	return prune(value), nil
+}
+
+func prune(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			item = prune(item)
+			if isEmpty(item) {
+				delete(v, key)
+				continue
+			}
+			v[key] = item
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = prune(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]any:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
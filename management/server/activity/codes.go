@@ -151,6 +151,71 @@ const (
 
 	UserGroupPropagationEnabled  Activity = 69
 	UserGroupPropagationDisabled Activity = 70
+
+	// AccessRequested indicates that a user requested temporary access to a group of peers
+	AccessRequested Activity = 71
+	// AccessRequestApproved indicates that an admin approved an access request
+	AccessRequestApproved Activity = 72
+	// AccessRequestDenied indicates that an admin denied an access request
+	AccessRequestDenied Activity = 73
+	// AccessRequestExpired indicates that the access granted by an access request was revoked
+	AccessRequestExpired Activity = 74
+	// WebhookEndpointCreated indicates that a user created a webhook endpoint
+	WebhookEndpointCreated Activity = 75
+	// WebhookEndpointUpdated indicates that a user updated a webhook endpoint
+	WebhookEndpointUpdated Activity = 76
+	// WebhookEndpointDeleted indicates that a user deleted a webhook endpoint
+	WebhookEndpointDeleted Activity = 77
+	// CustomRoleCreated indicates that a user created a custom role
+	CustomRoleCreated Activity = 78
+	// CustomRoleUpdated indicates that a user updated a custom role
+	CustomRoleUpdated Activity = 79
+	// CustomRoleDeleted indicates that a user deleted a custom role
+	CustomRoleDeleted Activity = 80
+	// UserCustomRoleUpdated indicates that a user changed the custom role of a user
+	UserCustomRoleUpdated Activity = 81
+	// SCIMTokenCreated indicates that a user created the SCIM provisioning token
+	SCIMTokenCreated Activity = 82
+	// SCIMTokenDeleted indicates that a user deleted the SCIM provisioning token
+	SCIMTokenDeleted Activity = 83
+	// UserProvisioned indicates that a user was provisioned over SCIM
+	UserProvisioned Activity = 84
+	// PeerTagsUpdated indicates that a user updated the tags of a peer
+	PeerTagsUpdated Activity = 85
+
+	// ServiceCreated indicates that a user created a service
+	ServiceCreated Activity = 86
+	// ServiceUpdated indicates that a user updated a service
+	ServiceUpdated Activity = 87
+	// ServiceDeleted indicates that a user deleted a service
+	ServiceDeleted Activity = 88
+
+	// PeerPostureCompliant indicates that a peer passes the posture checks again
+	PeerPostureCompliant Activity = 89
+	// PeerPostureGracePeriodStarted indicates that a peer fails posture checks and entered their grace period
+	PeerPostureGracePeriodStarted Activity = 90
+	// PeerPostureNonCompliant indicates that a peer fails posture checks and lost the access they grant
+	PeerPostureNonCompliant Activity = 91
+	// SetupKeyRestrictionViolated indicates that a peer was rejected because it doesn't match the setup key restrictions
+	SetupKeyRestrictionViolated Activity = 92
+	// OrganizationCreated indicates that a user created an organization
+	OrganizationCreated Activity = 93
+	// OrganizationUpdated indicates that a user updated an organization
+	OrganizationUpdated Activity = 94
+	// OrganizationDeleted indicates that a user deleted an organization
+	OrganizationDeleted Activity = 95
+	// OrganizationAccountAdded indicates that a user added an account to an organization
+	OrganizationAccountAdded Activity = 96
+	// OrganizationAccountRemoved indicates that a user removed an account from an organization
+	OrganizationAccountRemoved Activity = 97
+	// OrganizationAdminAdded indicates that a user added an admin to an organization
+	OrganizationAdminAdded Activity = 98
+	// OrganizationAdminRemoved indicates that a user removed an admin from an organization
+	OrganizationAdminRemoved Activity = 99
+	// OrganizationAdminAccessGranted indicates that an organization admin accessed the account for the first time
+	OrganizationAdminAccessGranted Activity = 100
+	// PeerRejected indicates that a user rejected a peer pending approval
+	PeerRejected Activity = 101
 )
 
 var activityMap = map[Activity]Code{
@@ -228,6 +293,45 @@ var activityMap = map[Activity]Code{
 
 	UserGroupPropagationEnabled:  {"User group propagation enabled", "account.setting.group.propagation.enable"},
 	UserGroupPropagationDisabled: {"User group propagation disabled", "account.setting.group.propagation.disable"},
+
+	AccessRequested:       {"Access requested", "access.request.create"},
+	AccessRequestApproved: {"Access request approved", "access.request.approve"},
+	AccessRequestDenied:   {"Access request denied", "access.request.deny"},
+	AccessRequestExpired:  {"Access request expired", "access.request.expire"},
+
+	WebhookEndpointCreated: {"Webhook endpoint created", "webhook.endpoint.add"},
+	WebhookEndpointUpdated: {"Webhook endpoint updated", "webhook.endpoint.update"},
+	WebhookEndpointDeleted: {"Webhook endpoint deleted", "webhook.endpoint.delete"},
+
+	CustomRoleCreated:     {"Custom role created", "role.add"},
+	CustomRoleUpdated:     {"Custom role updated", "role.update"},
+	CustomRoleDeleted:     {"Custom role deleted", "role.delete"},
+	UserCustomRoleUpdated: {"User custom role updated", "user.custom.role.update"},
+
+	SCIMTokenCreated: {"SCIM token created", "scim.token.create"},
+	SCIMTokenDeleted: {"SCIM token deleted", "scim.token.delete"},
+	UserProvisioned:  {"User provisioned", "user.provision"},
+
+	PeerTagsUpdated: {"Peer tags updated", "peer.tags.update"},
+
+	ServiceCreated: {"Service created", "service.add"},
+	ServiceUpdated: {"Service updated", "service.update"},
+	ServiceDeleted: {"Service deleted", "service.delete"},
+
+	PeerPostureCompliant:          {"Peer posture compliant", "peer.posture.compliant"},
+	PeerPostureGracePeriodStarted: {"Peer posture grace period started", "peer.posture.grace"},
+	PeerPostureNonCompliant:       {"Peer posture non-compliant", "peer.posture.noncompliant"},
+	SetupKeyRestrictionViolated:   {"Setup key restriction violated", "setupkey.restriction.violated"},
+
+	OrganizationCreated:            {"Organization created", "organization.add"},
+	OrganizationUpdated:            {"Organization updated", "organization.update"},
+	OrganizationDeleted:            {"Organization deleted", "organization.delete"},
+	OrganizationAccountAdded:       {"Account added to organization", "organization.account.add"},
+	OrganizationAccountRemoved:     {"Account removed from organization", "organization.account.delete"},
+	OrganizationAdminAdded:         {"Organization admin added", "organization.admin.add"},
+	OrganizationAdminRemoved:       {"Organization admin removed", "organization.admin.delete"},
+	OrganizationAdminAccessGranted: {"Organization admin access granted", "organization.admin.access.grant"},
+	PeerRejected:                   {"Peer rejected", "peer.reject"},
 }
 
 // StringCode returns a string code of the activity
@@ -246,6 +350,16 @@ func (a Activity) Message() string {
 	return "UNKNOWN_ACTIVITY"
 }
 
+// ActivityFromStringCode returns the activity with the given string code
+func ActivityFromStringCode(code string) (Activity, bool) {
+	for a, c := range activityMap {
+		if c.Code == code {
+			return a, true
+		}
+	}
+	return 0, false
+}
+
 // RegisterActivityMap adds new codes to the activity map
 func RegisterActivityMap(codes map[Activity]Code) {
 	maps.Copy(activityMap, codes)
@@ -0,0 +1,95 @@
+package activity
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Filter narrows down and pages the events returned by Store.GetFiltered
+type Filter struct {
+	// Activities limits the events to the given activities. Empty means all activities.
+	Activities []Activity
+	// InitiatorID limits the events to the ones initiated by the given ID
+	InitiatorID string
+	// TargetID limits the events to the ones that affected the given ID
+	TargetID string
+	// StartTime limits the events to the ones that occurred at or after the given time. Zero means no limit.
+	StartTime time.Time
+	// EndTime limits the events to the ones that occurred before the given time. Zero means no limit.
+	EndTime time.Time
+	// Search limits the events to the ones with meta containing the given text, case-insensitive.
+	// Events initiated by or targeting a deleted user whose email or name contain the text match as well.
+	Search string
+	// SearchUserIDs are the IDs of the existing users whose email or name contain the search text.
+	// Events initiated by or targeting these users match the search as well.
+	SearchUserIDs []string
+
+	// Cursor is the ID of the last event of the previous page. Zero returns the first page.
+	Cursor uint64
+	// Limit is the maximum number of events to return
+	Limit int
+	// Descending returns the newest events first
+	Descending bool
+}
+
+// Match returns true if the event satisfies the filter conditions. Pagination fields are not taken into account.
+func (f *Filter) Match(event *Event) bool {
+	if len(f.Activities) > 0 {
+		a, ok := event.Activity.(Activity)
+		if !ok || !slices.Contains(f.Activities, a) {
+			return false
+		}
+	}
+
+	if f.InitiatorID != "" && event.InitiatorID != f.InitiatorID {
+		return false
+	}
+
+	if f.TargetID != "" && event.TargetID != f.TargetID {
+		return false
+	}
+
+	if !f.StartTime.IsZero() && event.Timestamp.Before(f.StartTime) {
+		return false
+	}
+
+	if !f.EndTime.IsZero() && !event.Timestamp.Before(f.EndTime) {
+		return false
+	}
+
+	if f.Search != "" && !f.matchSearch(event) {
+		return false
+	}
+
+	return true
+}
+
+// matchSearch returns true if the event meta, the initiator name or email contain the search text
+// or if the event was initiated by or targets one of the search users
+func (f *Filter) matchSearch(event *Event) bool {
+	if slices.Contains(f.SearchUserIDs, event.InitiatorID) || slices.Contains(f.SearchUserIDs, event.TargetID) {
+		return true
+	}
+
+	search := strings.ToLower(f.Search)
+	if strings.Contains(strings.ToLower(event.InitiatorName), search) || strings.Contains(strings.ToLower(event.InitiatorEmail), search) {
+		return true
+	}
+
+	meta, err := json.Marshal(event.Meta)
+	return err == nil && strings.Contains(strings.ToLower(string(meta)), search)
+}
+
+// AfterCursor returns true if the event comes after the filter cursor in the requested order
+func (f *Filter) AfterCursor(event *Event) bool {
+	if f.Cursor == 0 {
+		return true
+	}
+
+	if f.Descending {
+		return event.ID < f.Cursor
+	}
+	return event.ID > f.Cursor
+}
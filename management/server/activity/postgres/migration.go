@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity/sqlite"
+)
+
+const (
+	selectSQLiteEventsQuery       = `SELECT id, activity, timestamp, initiator_id, account_id, meta, target_id FROM events ORDER BY id`
+	selectSQLiteDeletedUsersQuery = `SELECT id, email, name, enc_algo FROM deleted_users`
+
+	insertMigratedEventQuery = `INSERT INTO events(id, activity, timestamp, initiator_id, account_id, meta, target_id) VALUES($1, $2, $3, $4, $5, $6, $7)`
+	resetEventsSequenceQuery = `SELECT setval(pg_get_serial_sequence('events', 'id'), COALESCE(MAX(id), 0) + 1, false) FROM events`
+)
+
+// MigrateFromSQLite copies the events and the deleted users of the SQLite event store located in the data directory
+// to the Postgres store. Event IDs are kept, so the deleted users remain encrypted with the same key.
+// The migration is refused if the Postgres store already holds events.
+func MigrateFromSQLite(ctx context.Context, dataDir string, encryptionKey string, store *Store) error {
+	// bring the SQLite schema and the encryption of deleted users up to date before copying
+	sqliteStore, err := sqlite.NewSQLiteStore(ctx, dataDir, encryptionKey)
+	if err != nil {
+		return fmt.Errorf("open SQLite event store: %w", err)
+	}
+	if err = sqliteStore.Close(ctx); err != nil {
+		return fmt.Errorf("close SQLite event store: %w", err)
+	}
+
+	src, err := sql.Open("sqlite3", filepath.Join(dataDir, sqlite.EventSinkDB))
+	if err != nil {
+		return fmt.Errorf("open SQLite event store: %w", err)
+	}
+	defer src.Close() //nolint
+
+	var count int64
+	if err = store.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM events`).Scan(&count); err != nil {
+		return fmt.Errorf("count Postgres events: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("the Postgres event store already contains %d events", count)
+	}
+
+	tx, err := store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	events, err := copyEvents(ctx, src, tx)
+	if err != nil {
+		return fmt.Errorf("copy events: %w", err)
+	}
+
+	users, err := copyDeletedUsers(ctx, src, tx)
+	if err != nil {
+		return fmt.Errorf("copy deleted users: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, resetEventsSequenceQuery); err != nil {
+		return fmt.Errorf("reset events sequence: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	log.WithContext(ctx).Infof("migrated %d events and %d deleted users from SQLite to Postgres", events, users)
+
+	return nil
+}
+
+func copyEvents(ctx context.Context, src *sql.DB, tx *sql.Tx) (int, error) {
+	rows, err := src.QueryContext(ctx, selectSQLiteEventsQuery)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close() //nolint
+
+	stmt, err := tx.PrepareContext(ctx, insertMigratedEventQuery)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close() //nolint
+
+	var count int
+	for rows.Next() {
+		var id, operation int64
+		var timestamp time.Time
+		var initiator, account, meta, target sql.NullString
+		if err = rows.Scan(&id, &operation, &timestamp, &initiator, &account, &meta, &target); err != nil {
+			return 0, err
+		}
+
+		if _, err = stmt.ExecContext(ctx, id, operation, timestamp.UTC(), initiator.String, account.String, meta.String, target.String); err != nil {
+			return 0, err
+		}
+		count++
+	}
+
+	return count, rows.Err()
+}
+
+func copyDeletedUsers(ctx context.Context, src *sql.DB, tx *sql.Tx) (int, error) {
+	rows, err := src.QueryContext(ctx, selectSQLiteDeletedUsersQuery)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close() //nolint
+
+	stmt, err := tx.PrepareContext(ctx, insertDeleteUserQuery)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close() //nolint
+
+	var count int
+	for rows.Next() {
+		var id, email string
+		var name, encAlgo sql.NullString
+		if err = rows.Scan(&id, &email, &name, &encAlgo); err != nil {
+			return 0, err
+		}
+
+		if _, err = stmt.ExecContext(ctx, id, email, name, encAlgo.String); err != nil {
+			return 0, err
+		}
+		count++
+	}
+
+	return count, rows.Err()
+}
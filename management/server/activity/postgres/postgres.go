@@ -0,0 +1,435 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/activity/sqlite"
+)
+
+const (
+	createTableQuery = `CREATE TABLE IF NOT EXISTS events (
+		id BIGSERIAL PRIMARY KEY,
+		activity INTEGER,
+		timestamp TIMESTAMPTZ,
+		initiator_id TEXT,
+		account_id TEXT,
+		meta TEXT,
+		target_id TEXT);`
+
+	createAccountIndexQuery = `CREATE INDEX IF NOT EXISTS idx_events_account_id ON events (account_id, id);`
+
+	createTableDeletedUsersQuery = `CREATE TABLE IF NOT EXISTS deleted_users (id TEXT NOT NULL, email TEXT NOT NULL, name TEXT, enc_algo TEXT NOT NULL);`
+
+	createTableOutboxQuery = `CREATE TABLE IF NOT EXISTS event_outbox (event_id BIGINT PRIMARY KEY);`
+
+	selectQuery = `SELECT events.id, activity, timestamp, initiator_id, i.name as "initiator_name", i.email as "initiator_email", target_id, t.name as "target_name", t.email as "target_email", account_id, meta
+		FROM events
+		LEFT JOIN (
+		    SELECT id, MAX(name) as name, MAX(email) as email
+		    FROM deleted_users
+		    GROUP BY id
+		) i ON events.initiator_id = i.id
+		LEFT JOIN (
+		    SELECT id, MAX(name) as name, MAX(email) as email
+		    FROM deleted_users
+		    GROUP BY id
+		) t ON events.target_id = t.id
+		WHERE `
+
+	insertQuery = `INSERT INTO events(activity, timestamp, initiator_id, target_id, account_id, meta) VALUES($1, $2, $3, $4, $5, $6) RETURNING id`
+
+	insertDeleteUserQuery = `INSERT INTO deleted_users(id, email, name, enc_algo) VALUES($1, $2, $3, $4)`
+
+	selectDeletedUsersQuery = `SELECT id, email, name FROM deleted_users`
+
+	insertOutboxQuery = `INSERT INTO event_outbox(event_id) VALUES($1)`
+
+	deleteOutboxQuery = `DELETE FROM event_outbox WHERE event_id = ANY($1)`
+
+	fallbackName  = "unknown"
+	fallbackEmail = "unknown@unknown.com"
+
+	gcmEncAlgo = "GCM"
+)
+
+// Store is the implementation of the activity.Store interface backed by Postgres.
+// The emails and names of deleted users are encrypted the same way as in the SQLite store.
+type Store struct {
+	db           *sql.DB
+	fieldEncrypt *sqlite.FieldEncrypt
+}
+
+// NewPostgresStore creates a new Store connected to the database of the given DSN and creates the event tables if not exist
+func NewPostgresStore(ctx context.Context, dsn string, encryptionKey string) (*Store, error) {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	crypt, err := sqlite.NewFieldEncrypt(encryptionKey)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	if err = migrate(ctx, db); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("events database migration: %w", err)
+	}
+
+	return &Store{
+		db:           db,
+		fieldEncrypt: crypt,
+	}, nil
+}
+
+func migrate(ctx context.Context, db *sql.DB) error {
+	for _, query := range []string{createTableQuery, createAccountIndexQuery, createTableDeletedUsersQuery, createTableOutboxQuery} {
+		if _, err := db.ExecContext(ctx, query); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get returns "limit" number of events from index ordered descending or ascending by a timestamp
+func (store *Store) Get(ctx context.Context, accountID string, offset, limit int, descending bool) ([]*activity.Event, error) {
+	order := "ASC"
+	if descending {
+		order = "DESC"
+	}
+
+	query := selectQuery + "account_id = $1 ORDER BY timestamp " + order + " LIMIT $2 OFFSET $3"
+	return store.query(ctx, query, accountID, limit, offset)
+}
+
+// GetFiltered returns up to filter.Limit events matching the filter that come after filter.Cursor, ordered by ID
+func (store *Store) GetFiltered(ctx context.Context, accountID string, filter activity.Filter) ([]*activity.Event, error) {
+	args := []any{accountID}
+	conditions := []string{"account_id = $1"}
+
+	// addCondition appends a condition with a single placeholder for the given argument
+	addCondition := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if len(filter.Activities) > 0 {
+		activities := make([]int32, 0, len(filter.Activities))
+		for _, a := range filter.Activities {
+			activities = append(activities, int32(a))
+		}
+		addCondition("activity = ANY($%d)", activities)
+	}
+
+	if filter.InitiatorID != "" {
+		addCondition("initiator_id = $%d", filter.InitiatorID)
+	}
+
+	if filter.TargetID != "" {
+		addCondition("target_id = $%d", filter.TargetID)
+	}
+
+	if !filter.StartTime.IsZero() {
+		addCondition("timestamp >= $%d", filter.StartTime.UTC())
+	}
+
+	if !filter.EndTime.IsZero() {
+		addCondition("timestamp < $%d", filter.EndTime.UTC())
+	}
+
+	if filter.Search != "" {
+		userIDs, err := store.searchDeletedUsers(ctx, filter.Search)
+		if err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, filter.SearchUserIDs...)
+
+		args = append(args, "%"+escapeLike(filter.Search)+"%")
+		searchCondition := fmt.Sprintf(`meta ILIKE $%d ESCAPE '\'`, len(args))
+		if len(userIDs) > 0 {
+			args = append(args, userIDs)
+			searchCondition += fmt.Sprintf(" OR initiator_id = ANY($%d) OR target_id = ANY($%d)", len(args), len(args))
+		}
+		conditions = append(conditions, "("+searchCondition+")")
+	}
+
+	order := "ASC"
+	if filter.Descending {
+		order = "DESC"
+	}
+
+	if filter.Cursor != 0 {
+		if filter.Descending {
+			addCondition("events.id < $%d", int64(filter.Cursor))
+		} else {
+			addCondition("events.id > $%d", int64(filter.Cursor))
+		}
+	}
+
+	query := selectQuery + strings.Join(conditions, " AND ") + " ORDER BY events.id " + order
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	return store.query(ctx, query, args...)
+}
+
+func (store *Store) query(ctx context.Context, query string, args ...any) ([]*activity.Event, error) {
+	result, err := store.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer result.Close() //nolint
+	return store.processResult(ctx, result)
+}
+
+func (store *Store) processResult(ctx context.Context, result *sql.Rows) ([]*activity.Event, error) {
+	events := make([]*activity.Event, 0)
+	var cryptErr error
+	for result.Next() {
+		var id int64
+		var operation activity.Activity
+		var timestamp time.Time
+		var initiator string
+		var initiatorName *string
+		var initiatorEmail *string
+		var target string
+		var targetUserName *string
+		var targetEmail *string
+		var account string
+		var jsonMeta string
+		err := result.Scan(&id, &operation, &timestamp, &initiator, &initiatorName, &initiatorEmail, &target, &targetUserName, &targetEmail, &account, &jsonMeta)
+		if err != nil {
+			return nil, err
+		}
+
+		meta := make(map[string]any)
+		if jsonMeta != "" {
+			err = json.Unmarshal([]byte(jsonMeta), &meta)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if targetUserName != nil {
+			meta["username"], err = store.decrypt(*targetUserName, fallbackName)
+			if err != nil {
+				cryptErr = fmt.Errorf("failed to decrypt username for target id: %s", target)
+			}
+		}
+
+		if targetEmail != nil {
+			meta["email"], err = store.decrypt(*targetEmail, fallbackEmail)
+			if err != nil {
+				cryptErr = fmt.Errorf("failed to decrypt email address for target id: %s", target)
+			}
+		}
+
+		event := &activity.Event{
+			Timestamp:   timestamp.UTC(),
+			Activity:    operation,
+			ID:          uint64(id),
+			InitiatorID: initiator,
+			TargetID:    target,
+			AccountID:   account,
+			Meta:        meta,
+		}
+
+		if initiatorName != nil {
+			event.InitiatorName, err = store.decrypt(*initiatorName, fallbackName)
+			if err != nil {
+				cryptErr = fmt.Errorf("failed to decrypt username of initiator: %s", initiator)
+			}
+		}
+
+		if initiatorEmail != nil {
+			event.InitiatorEmail, err = store.decrypt(*initiatorEmail, fallbackEmail)
+			if err != nil {
+				cryptErr = fmt.Errorf("failed to decrypt email address of initiator: %s", initiator)
+			}
+		}
+
+		events = append(events, event)
+	}
+
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+
+	if cryptErr != nil {
+		log.WithContext(ctx).Warnf("%s", cryptErr)
+	}
+
+	return events, nil
+}
+
+// decrypt returns the decrypted value or the fallback if the value can't be decrypted
+func (store *Store) decrypt(value, fallback string) (string, error) {
+	decrypted, err := store.fieldEncrypt.Decrypt(value)
+	if err != nil {
+		return fallback, err
+	}
+	return decrypted, nil
+}
+
+// Save an event in the Postgres events table and encrypt the "email" and "name" elements of the meta map.
+// The event is added to the outbox in the same transaction.
+func (store *Store) Save(ctx context.Context, event *activity.Event) (*activity.Event, error) {
+	tx, err := store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	meta, err := store.saveDeletedUserEmailAndNameInEncrypted(ctx, tx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMeta string
+	if meta != nil {
+		metaBytes, err := json.Marshal(meta)
+		if err != nil {
+			return nil, err
+		}
+		jsonMeta = string(metaBytes)
+	}
+
+	var id int64
+	err = tx.QueryRowContext(ctx, insertQuery, event.Activity, event.Timestamp, event.InitiatorID, event.TargetID, event.AccountID, jsonMeta).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = tx.ExecContext(ctx, insertOutboxQuery, id); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	eventCopy := event.Copy()
+	eventCopy.ID = uint64(id)
+	return eventCopy, nil
+}
+
+// GetOutbox returns up to limit events of the outbox ordered by ID
+func (store *Store) GetOutbox(ctx context.Context, limit int) ([]*activity.Event, error) {
+	query := selectQuery + "events.id IN (SELECT event_id FROM event_outbox) ORDER BY events.id ASC LIMIT $1"
+	return store.query(ctx, query, limit)
+}
+
+// DeleteFromOutbox removes the events with the given IDs from the outbox
+func (store *Store) DeleteFromOutbox(ctx context.Context, eventIDs []uint64) error {
+	if len(eventIDs) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(eventIDs))
+	for _, id := range eventIDs {
+		ids = append(ids, int64(id))
+	}
+
+	_, err := store.db.ExecContext(ctx, deleteOutboxQuery, ids)
+	return err
+}
+
+// saveDeletedUserEmailAndNameInEncrypted if the meta contains email and name then store it in encrypted way and
+// return the meta without these items
+func (store *Store) saveDeletedUserEmailAndNameInEncrypted(ctx context.Context, tx *sql.Tx, event *activity.Event) (map[string]any, error) {
+	email, ok := event.Meta["email"]
+	if !ok {
+		return event.Meta, nil
+	}
+
+	name, ok := event.Meta["name"]
+	if !ok {
+		return event.Meta, nil
+	}
+
+	encryptedEmail, err := store.fieldEncrypt.Encrypt(fmt.Sprintf("%s", email))
+	if err != nil {
+		return nil, err
+	}
+	encryptedName, err := store.fieldEncrypt.Encrypt(fmt.Sprintf("%s", name))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, insertDeleteUserQuery, event.TargetID, encryptedEmail, encryptedName, gcmEncAlgo)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(event.Meta) == 2 {
+		return nil, nil // nolint
+	}
+
+	meta := make(map[string]any, len(event.Meta)-2)
+	for k, v := range event.Meta {
+		if k != "email" && k != "name" {
+			meta[k] = v
+		}
+	}
+	return meta, nil
+}
+
+// Close the Store
+func (store *Store) Close(_ context.Context) error {
+	if store.db != nil {
+		return store.db.Close()
+	}
+	return nil
+}
+
+// searchDeletedUsers returns the IDs of the deleted users whose email or name contain the text, case-insensitive.
+// The emails and names are stored encrypted, so they are decrypted to be matched.
+func (store *Store) searchDeletedUsers(ctx context.Context, text string) ([]string, error) {
+	rows, err := store.db.QueryContext(ctx, selectDeletedUsersQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint
+
+	text = strings.ToLower(text)
+	var ids []string
+	for rows.Next() {
+		var id, encryptedEmail string
+		var encryptedName sql.NullString
+		if err = rows.Scan(&id, &encryptedEmail, &encryptedName); err != nil {
+			return nil, err
+		}
+
+		email, _ := store.decrypt(encryptedEmail, "")
+		var name string
+		if encryptedName.Valid {
+			name, _ = store.decrypt(encryptedName.String, "")
+		}
+
+		if strings.Contains(strings.ToLower(email), text) || strings.Contains(strings.ToLower(name), text) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, rows.Err()
+}
+
+// escapeLike escapes the LIKE wildcards in the given text
+func escapeLike(text string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(text)
+}
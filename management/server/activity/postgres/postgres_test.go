@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/activity/sqlite"
+	"github.com/netbirdio/netbird/management/server/testutil"
+)
+
+func newTestStore(t *testing.T, key string) *Store {
+	t.Helper()
+
+	if os.Getenv("NETBIRD_STORE_ENGINE") != "postgres" || runtime.GOOS != "linux" {
+		t.Skip("skipping Postgres event store test, set NETBIRD_STORE_ENGINE=postgres to run it")
+	}
+
+	cleanUp, err := testutil.CreatePGDB()
+	require.NoError(t, err)
+	t.Cleanup(cleanUp)
+
+	store, err := NewPostgresStore(context.Background(), os.Getenv("NETBIRD_STORE_ENGINE_POSTGRES_DSN"), key)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = store.Close(context.Background())
+	})
+
+	return store
+}
+
+func TestStore_SaveAndGet(t *testing.T) {
+	key, _ := sqlite.GenerateKey()
+	store := newTestStore(t, key)
+	ctx := context.Background()
+
+	accountID := "account_1"
+	start := time.Now().UTC().Add(-time.Hour)
+	for i := 0; i < 10; i++ {
+		_, err := store.Save(ctx, &activity.Event{
+			Timestamp:   start.Add(time.Duration(i) * time.Minute),
+			Activity:    activity.PeerAddedByUser,
+			InitiatorID: "user_" + fmt.Sprint(i%2),
+			TargetID:    "peer_" + fmt.Sprint(i),
+			AccountID:   accountID,
+			Meta:        map[string]any{"fqdn": fmt.Sprintf("peer-%d.netbird.cloud", i)},
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := store.Save(ctx, &activity.Event{
+		Timestamp:   start.Add(time.Hour),
+		Activity:    activity.UserDeleted,
+		InitiatorID: "user_0",
+		TargetID:    "user_2",
+		AccountID:   accountID,
+		Meta:        map[string]any{"email": "deleted@netbird.io", "name": "Deleted User", "is_service_user": false},
+	})
+	require.NoError(t, err)
+
+	result, err := store.Get(ctx, accountID, 0, 5, true)
+	require.NoError(t, err)
+	require.Len(t, result, 5)
+	assert.Equal(t, activity.UserDeleted, result[0].Activity)
+	assert.Equal(t, "deleted@netbird.io", result[0].Meta["email"], "deleted user email should be decrypted")
+	assert.Equal(t, "Deleted User", result[0].Meta["username"])
+	assert.Equal(t, false, result[0].Meta["is_service_user"])
+
+	result, err = store.GetFiltered(ctx, accountID, activity.Filter{
+		Activities:  []activity.Activity{activity.PeerAddedByUser},
+		InitiatorID: "user_0",
+		Search:      "NETBIRD.cloud",
+		Limit:       2,
+	})
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "peer_0", result[0].TargetID)
+	assert.Equal(t, "peer_2", result[1].TargetID)
+
+	result, err = store.GetFiltered(ctx, accountID, activity.Filter{InitiatorID: "user_0", Activities: []activity.Activity{activity.PeerAddedByUser}, Cursor: result[1].ID})
+	require.NoError(t, err)
+	assert.Len(t, result, 3)
+
+	outbox, err := store.GetOutbox(ctx, 20)
+	require.NoError(t, err)
+	require.Len(t, outbox, 11, "saved events should be added to the outbox")
+
+	require.NoError(t, store.DeleteFromOutbox(ctx, []uint64{outbox[0].ID, outbox[1].ID}))
+	outbox, err = store.GetOutbox(ctx, 20)
+	require.NoError(t, err)
+	assert.Len(t, outbox, 9)
+}
+
+func TestMigrateFromSQLite(t *testing.T) {
+	key, _ := sqlite.GenerateKey()
+	store := newTestStore(t, key)
+	ctx := context.Background()
+
+	dataDir := t.TempDir()
+	sqliteStore, err := sqlite.NewSQLiteStore(ctx, dataDir, key)
+	require.NoError(t, err)
+
+	accountID := "account_1"
+	for i := 0; i < 3; i++ {
+		_, err = sqliteStore.Save(ctx, &activity.Event{
+			Timestamp:   time.Now().UTC(),
+			Activity:    activity.PeerAddedByUser,
+			InitiatorID: "user_1",
+			TargetID:    "peer_" + fmt.Sprint(i),
+			AccountID:   accountID,
+		})
+		require.NoError(t, err)
+	}
+	_, err = sqliteStore.Save(ctx, &activity.Event{
+		Timestamp:   time.Now().UTC(),
+		Activity:    activity.UserDeleted,
+		InitiatorID: "user_1",
+		TargetID:    "user_2",
+		AccountID:   accountID,
+		Meta:        map[string]any{"email": "deleted@netbird.io", "name": "Deleted User"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, sqliteStore.Close(ctx))
+
+	require.NoError(t, MigrateFromSQLite(ctx, dataDir, key, store))
+
+	result, err := store.Get(ctx, accountID, 0, 10, false)
+	require.NoError(t, err)
+	require.Len(t, result, 4)
+	assert.Equal(t, uint64(1), result[0].ID)
+	assert.Equal(t, "deleted@netbird.io", result[3].Meta["email"])
+
+	saved, err := store.Save(ctx, &activity.Event{Timestamp: time.Now().UTC(), Activity: activity.PeerRenamed, AccountID: accountID})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), saved.ID, "new events should continue after the migrated IDs")
+
+	assert.Error(t, MigrateFromSQLite(ctx, dataDir, key, store), "migration into a non-empty store should be refused")
+}
@@ -0,0 +1,10 @@
+package activity
+
+import "context"
+
+// Sink receives activity events once they are stored, e.g. to forward them to external systems.
+// Events are passed from the outbox of the event store at least once, so Deliver has to be idempotent.
+// When Deliver fails, the event is kept in the outbox and passed again later. Deliver should return quickly.
+type Sink interface {
+	Deliver(ctx context.Context, event *Event) error
+}
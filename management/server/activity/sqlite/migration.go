@@ -17,6 +17,10 @@ func migrate(ctx context.Context, crypt *FieldEncrypt, db *sql.DB) error {
 		return err
 	}
 
+	if _, err := db.Exec(createTableOutboxQuery); err != nil {
+		return err
+	}
+
 	if err := updateDeletedUsersTable(ctx, db); err != nil {
 		return fmt.Errorf("failed to update deleted_users table: %v", err)
 	}
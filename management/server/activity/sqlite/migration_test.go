@@ -16,7 +16,7 @@ import (
 func setupDatabase(t *testing.T) *sql.DB {
 	t.Helper()
 
-	dbFile := filepath.Join(t.TempDir(), eventSinkDB)
+	dbFile := filepath.Join(t.TempDir(), EventSinkDB)
 	db, err := sql.Open("sqlite3", dbFile)
 	require.NoError(t, err, "Failed to open database")
 
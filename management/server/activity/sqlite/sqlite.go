@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -15,8 +16,8 @@ import (
 )
 
 const (
-	// eventSinkDB is the default name of the events database
-	eventSinkDB      = "events.db"
+	// EventSinkDB is the default name of the events database
+	EventSinkDB      = "events.db"
 	createTableQuery = "CREATE TABLE IF NOT EXISTS events " +
 		"(id INTEGER PRIMARY KEY AUTOINCREMENT, " +
 		"activity INTEGER, " +
@@ -28,6 +29,8 @@ const (
 
 	creatTableDeletedUsersQuery = `CREATE TABLE IF NOT EXISTS deleted_users (id TEXT NOT NULL, email TEXT NOT NULL, name TEXT, enc_algo TEXT NOT NULL);`
 
+	createTableOutboxQuery = `CREATE TABLE IF NOT EXISTS event_outbox (event_id INTEGER PRIMARY KEY);`
+
 	selectDescQuery = `SELECT events.id, activity, timestamp, initiator_id, i.name as "initiator_name", i.email as "initiator_email", target_id, t.name as "target_name", t.email as "target_email", account_id, meta
 		FROM events 
 		LEFT JOIN (
@@ -58,6 +61,20 @@ const (
 		WHERE account_id = ? 
 		ORDER BY timestamp ASC LIMIT ? OFFSET ?;`
 
+	selectFilteredQuery = `SELECT events.id, activity, timestamp, initiator_id, i.name as "initiator_name", i.email as "initiator_email", target_id, t.name as "target_name", t.email as "target_email", account_id, meta
+		FROM events 
+		LEFT JOIN (
+		    SELECT id, MAX(name) as name, MAX(email) as email 
+		    FROM deleted_users
+		    GROUP BY id
+		) i ON events.initiator_id = i.id 
+		LEFT JOIN (
+		    SELECT id, MAX(name) as name, MAX(email) as email 
+		    FROM deleted_users
+		    GROUP BY id
+		) t ON events.target_id = t.id
+		WHERE `
+
 	insertQuery = "INSERT INTO events(activity, timestamp, initiator_id, target_id, account_id, meta) " +
 		"VALUES(?, ?, ?, ?, ?, ?)"
 
@@ -71,6 +88,10 @@ const (
 
 	insertDeleteUserQuery = `INSERT INTO deleted_users(id, email, name, enc_algo) VALUES(?, ?, ?, ?)`
 
+	selectDeletedUsersQuery = `SELECT id, email, name FROM deleted_users`
+
+	insertOutboxQuery = `INSERT INTO event_outbox(event_id) VALUES(?)`
+
 	fallbackName  = "unknown"
 	fallbackEmail = "unknown@unknown.com"
 
@@ -90,7 +111,7 @@ type Store struct {
 
 // NewSQLiteStore creates a new Store with an event table if not exists.
 func NewSQLiteStore(ctx context.Context, dataDir string, encryptionKey string) (*Store, error) {
-	dbFile := filepath.Join(dataDir, eventSinkDB)
+	dbFile := filepath.Join(dataDir, EventSinkDB)
 	db, err := sql.Open("sqlite3", dbFile)
 	if err != nil {
 		return nil, err
@@ -214,10 +235,141 @@ func (store *Store) Get(ctx context.Context, accountID string, offset, limit int
 	return store.processResult(ctx, result)
 }
 
-// Save an event in the SQLite events table end encrypt the "email" element in meta map
-func (store *Store) Save(_ context.Context, event *activity.Event) (*activity.Event, error) {
+// GetFiltered returns up to filter.Limit events matching the filter that come after filter.Cursor, ordered by ID
+func (store *Store) GetFiltered(ctx context.Context, accountID string, filter activity.Filter) ([]*activity.Event, error) {
+	conditions := []string{"account_id = ?"}
+	args := []any{accountID}
+
+	if len(filter.Activities) > 0 {
+		placeholders := make([]string, 0, len(filter.Activities))
+		for _, a := range filter.Activities {
+			placeholders = append(placeholders, "?")
+			args = append(args, a)
+		}
+		conditions = append(conditions, fmt.Sprintf("activity IN (%s)", strings.Join(placeholders, ", ")))
+	}
+
+	if filter.InitiatorID != "" {
+		conditions = append(conditions, "initiator_id = ?")
+		args = append(args, filter.InitiatorID)
+	}
+
+	if filter.TargetID != "" {
+		conditions = append(conditions, "target_id = ?")
+		args = append(args, filter.TargetID)
+	}
+
+	if !filter.StartTime.IsZero() {
+		conditions = append(conditions, "timestamp >= ?")
+		args = append(args, filter.StartTime.UTC())
+	}
+
+	if !filter.EndTime.IsZero() {
+		conditions = append(conditions, "timestamp < ?")
+		args = append(args, filter.EndTime.UTC())
+	}
+
+	if filter.Search != "" {
+		userIDs, err := store.searchDeletedUsers(ctx, filter.Search)
+		if err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, filter.SearchUserIDs...)
+
+		searchConditions := []string{`meta LIKE ? ESCAPE '\'`}
+		args = append(args, "%"+escapeLike(filter.Search)+"%")
+		if len(userIDs) > 0 {
+			placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(userIDs)), ", ")
+			searchConditions = append(searchConditions,
+				fmt.Sprintf("initiator_id IN (%s)", placeholders), fmt.Sprintf("target_id IN (%s)", placeholders))
+			for i := 0; i < 2; i++ {
+				for _, id := range userIDs {
+					args = append(args, id)
+				}
+			}
+		}
+		conditions = append(conditions, "("+strings.Join(searchConditions, " OR ")+")")
+	}
+
+	order := "ASC"
+	if filter.Descending {
+		order = "DESC"
+	}
+
+	if filter.Cursor != 0 {
+		if filter.Descending {
+			conditions = append(conditions, "events.id < ?")
+		} else {
+			conditions = append(conditions, "events.id > ?")
+		}
+		args = append(args, filter.Cursor)
+	}
+
+	query := selectFilteredQuery + strings.Join(conditions, " AND ") + " ORDER BY events.id " + order
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
+
+	result, err := store.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer result.Close() //nolint
+	return store.processResult(ctx, result)
+}
+
+// searchDeletedUsers returns the IDs of the deleted users whose email or name contain the text, case-insensitive.
+// The emails and names are stored encrypted, so they are decrypted to be matched.
+func (store *Store) searchDeletedUsers(ctx context.Context, text string) ([]string, error) {
+	rows, err := store.db.QueryContext(ctx, selectDeletedUsersQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint
+
+	text = strings.ToLower(text)
+	var ids []string
+	for rows.Next() {
+		var id, encryptedEmail string
+		var encryptedName sql.NullString
+		if err = rows.Scan(&id, &encryptedEmail, &encryptedName); err != nil {
+			return nil, err
+		}
+
+		email, _ := store.fieldEncrypt.Decrypt(encryptedEmail)
+		var name string
+		if encryptedName.Valid {
+			name, _ = store.fieldEncrypt.Decrypt(encryptedName.String)
+		}
+
+		if strings.Contains(strings.ToLower(email), text) || strings.Contains(strings.ToLower(name), text) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, rows.Err()
+}
+
+// escapeLike escapes the LIKE wildcards in the given text
+func escapeLike(text string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(text)
+}
+
+// Save an event in the SQLite events table end encrypt the "email" element in meta map.
+// The event is added to the outbox in the same transaction.
+func (store *Store) Save(ctx context.Context, event *activity.Event) (*activity.Event, error) {
+	tx, err := store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
 	var jsonMeta string
-	meta, err := store.saveDeletedUserEmailAndNameInEncrypted(event)
+	meta, err := store.saveDeletedUserEmailAndNameInEncrypted(ctx, tx, event)
 	if err != nil {
 		return nil, err
 	}
@@ -230,7 +382,7 @@ func (store *Store) Save(_ context.Context, event *activity.Event) (*activity.Ev
 		jsonMeta = string(metaBytes)
 	}
 
-	result, err := store.insertStatement.Exec(event.Activity, event.Timestamp, event.InitiatorID, event.TargetID, event.AccountID, jsonMeta)
+	result, err := tx.StmtContext(ctx, store.insertStatement).Exec(event.Activity, event.Timestamp, event.InitiatorID, event.TargetID, event.AccountID, jsonMeta)
 	if err != nil {
 		return nil, err
 	}
@@ -240,14 +392,52 @@ func (store *Store) Save(_ context.Context, event *activity.Event) (*activity.Ev
 		return nil, err
 	}
 
+	if _, err = tx.ExecContext(ctx, insertOutboxQuery, id); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
 	eventCopy := event.Copy()
 	eventCopy.ID = uint64(id)
 	return eventCopy, nil
 }
 
+// GetOutbox returns up to limit events of the outbox ordered by ID
+func (store *Store) GetOutbox(ctx context.Context, limit int) ([]*activity.Event, error) {
+	query := selectFilteredQuery + "events.id IN (SELECT event_id FROM event_outbox) ORDER BY events.id ASC LIMIT ?"
+	result, err := store.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer result.Close() //nolint
+	return store.processResult(ctx, result)
+}
+
+// DeleteFromOutbox removes the events with the given IDs from the outbox
+func (store *Store) DeleteFromOutbox(ctx context.Context, eventIDs []uint64) error {
+	if len(eventIDs) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, 0, len(eventIDs))
+	args := make([]any, 0, len(eventIDs))
+	for _, id := range eventIDs {
+		placeholders = append(placeholders, "?")
+		args = append(args, int64(id))
+	}
+
+	query := fmt.Sprintf("DELETE FROM event_outbox WHERE event_id IN (%s)", strings.Join(placeholders, ", "))
+	_, err := store.db.ExecContext(ctx, query, args...)
+	return err
+}
+
 // saveDeletedUserEmailAndNameInEncrypted if the meta contains email and name then store it in encrypted way and delete
 // this item from meta map
-func (store *Store) saveDeletedUserEmailAndNameInEncrypted(event *activity.Event) (map[string]any, error) {
+func (store *Store) saveDeletedUserEmailAndNameInEncrypted(ctx context.Context, tx *sql.Tx, event *activity.Event) (map[string]any, error) {
 	email, ok := event.Meta["email"]
 	if !ok {
 		return event.Meta, nil
@@ -267,7 +457,7 @@ func (store *Store) saveDeletedUserEmailAndNameInEncrypted(event *activity.Event
 		return nil, err
 	}
 
-	_, err = store.deleteUserStmt.Exec(event.TargetID, encryptedEmail, encryptedName, gcmEncAlgo)
+	_, err = tx.StmtContext(ctx, store.deleteUserStmt).Exec(event.TargetID, encryptedEmail, encryptedName, gcmEncAlgo)
 	if err != nil {
 		return nil, err
 	}
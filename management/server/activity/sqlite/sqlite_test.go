@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/netbirdio/netbird/management/server/activity"
 )
@@ -55,3 +56,156 @@ func TestNewSQLiteStore(t *testing.T) {
 	assert.Len(t, result, 5)
 	assert.True(t, result[0].Timestamp.After(result[len(result)-1].Timestamp))
 }
+
+func TestStore_GetFiltered(t *testing.T) {
+	key, _ := GenerateKey()
+	store, err := NewSQLiteStore(context.Background(), t.TempDir(), key)
+	require.NoError(t, err)
+	defer store.Close(context.Background()) //nolint
+
+	accountID := "account_1"
+	start := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 10; i++ {
+		typ := activity.PeerAddedByUser
+		if i%2 == 1 {
+			typ = activity.PeerRenamed
+		}
+		_, err = store.Save(context.Background(), &activity.Event{
+			Timestamp:   start.Add(time.Duration(i) * time.Hour),
+			Activity:    typ,
+			InitiatorID: "user_" + fmt.Sprint(i%3),
+			TargetID:    "peer_" + fmt.Sprint(i),
+			AccountID:   accountID,
+			Meta:        map[string]any{"name": "Peer_" + fmt.Sprint(i) + "_100%"},
+		})
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name        string
+		filter      activity.Filter
+		expectedIDs []uint64
+	}{
+		{
+			name:        "activity",
+			filter:      activity.Filter{Activities: []activity.Activity{activity.PeerRenamed}},
+			expectedIDs: []uint64{2, 4, 6, 8, 10},
+		},
+		{
+			name:        "initiator and target",
+			filter:      activity.Filter{InitiatorID: "user_0", TargetID: "peer_3"},
+			expectedIDs: []uint64{4},
+		},
+		{
+			name:        "time range",
+			filter:      activity.Filter{StartTime: start.Add(2 * time.Hour), EndTime: start.Add(4 * time.Hour)},
+			expectedIDs: []uint64{3, 4},
+		},
+		{
+			name:        "case-insensitive meta search with wildcards escaped",
+			filter:      activity.Filter{Search: "peer_1_100%"},
+			expectedIDs: []uint64{2},
+		},
+		{
+			name:        "first page descending",
+			filter:      activity.Filter{Limit: 3, Descending: true},
+			expectedIDs: []uint64{10, 9, 8},
+		},
+		{
+			name:        "next page descending",
+			filter:      activity.Filter{Limit: 3, Descending: true, Cursor: 8},
+			expectedIDs: []uint64{7, 6, 5},
+		},
+		{
+			name:        "next page ascending",
+			filter:      activity.Filter{Limit: 2, Cursor: 8},
+			expectedIDs: []uint64{9, 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := store.GetFiltered(context.Background(), accountID, tt.filter)
+			require.NoError(t, err)
+
+			ids := make([]uint64, 0, len(events))
+			for _, event := range events {
+				ids = append(ids, event.ID)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
+
+func TestStore_GetFilteredSearchUsers(t *testing.T) {
+	key, _ := GenerateKey()
+	store, err := NewSQLiteStore(context.Background(), t.TempDir(), key)
+	require.NoError(t, err)
+	defer store.Close(context.Background()) //nolint
+
+	ctx := context.Background()
+	accountID := "account_1"
+	events := []*activity.Event{
+		{Activity: activity.PeerAddedByUser, InitiatorID: "deleted_user", TargetID: "peer_1"},
+		{Activity: activity.PeerAddedByUser, InitiatorID: "existing_user", TargetID: "peer_2"},
+		{Activity: activity.UserDeleted, InitiatorID: "existing_user", TargetID: "deleted_user",
+			Meta: map[string]any{"email": "Deleted@Example.com", "name": "Deleted User"}},
+	}
+	for _, event := range events {
+		event.Timestamp = time.Now().UTC()
+		event.AccountID = accountID
+		_, err = store.Save(ctx, event)
+		require.NoError(t, err)
+	}
+
+	result, err := store.GetFiltered(ctx, accountID, activity.Filter{Search: "deleted@example"})
+	require.NoError(t, err)
+	require.Len(t, result, 2, "events of deleted users should be found by their encrypted email")
+	assert.Equal(t, "peer_1", result[0].TargetID)
+	assert.Equal(t, "deleted_user", result[1].TargetID)
+
+	result, err = store.GetFiltered(ctx, accountID, activity.Filter{Search: "Deleted User"})
+	require.NoError(t, err)
+	assert.Len(t, result, 2, "events of deleted users should be found by their encrypted name")
+
+	result, err = store.GetFiltered(ctx, accountID, activity.Filter{Search: "existing@example.com", SearchUserIDs: []string{"existing_user"}})
+	require.NoError(t, err)
+	assert.Len(t, result, 2, "events of the users matching the search should be found")
+}
+
+func TestStore_Outbox(t *testing.T) {
+	key, _ := GenerateKey()
+	store, err := NewSQLiteStore(context.Background(), t.TempDir(), key)
+	require.NoError(t, err)
+	defer store.Close(context.Background()) //nolint
+
+	ctx := context.Background()
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		event, err := store.Save(ctx, &activity.Event{
+			Timestamp: time.Now().UTC(),
+			Activity:  activity.PeerAddedByUser,
+			TargetID:  "peer_" + fmt.Sprint(i),
+			AccountID: "account_1",
+		})
+		require.NoError(t, err)
+		ids = append(ids, event.ID)
+	}
+
+	outbox, err := store.GetOutbox(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, outbox, 2)
+	assert.Equal(t, ids[0], outbox[0].ID, "outbox should be ordered by event ID")
+	assert.Equal(t, "peer_1", outbox[1].TargetID)
+
+	require.NoError(t, store.DeleteFromOutbox(ctx, []uint64{ids[0], ids[1]}))
+
+	outbox, err = store.GetOutbox(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, outbox, 1)
+	assert.Equal(t, ids[2], outbox[0].ID)
+
+	events, err := store.Get(ctx, "account_1", 0, 10, false)
+	require.NoError(t, err)
+	assert.Len(t, events, 3, "events should be kept when removed from the outbox")
+}
@@ -2,6 +2,7 @@ package activity
 
 import (
 	"context"
+	"sort"
 	"sync"
 )
 
@@ -11,6 +12,13 @@ type Store interface {
 	Save(ctx context.Context, event *Event) (*Event, error)
 	// Get returns "limit" number of events from the "offset" index ordered descending or ascending by a timestamp
 	Get(ctx context.Context, accountID string, offset, limit int, descending bool) ([]*Event, error)
+	// GetFiltered returns up to filter.Limit events matching the filter that come after filter.Cursor, ordered by ID
+	GetFiltered(ctx context.Context, accountID string, filter Filter) ([]*Event, error)
+	// GetOutbox returns up to limit events of the outbox ordered by ID. Every event is added to the outbox in the
+	// transaction saving it, so sinks receive all the events even if management stops right after saving them.
+	GetOutbox(ctx context.Context, limit int) ([]*Event, error)
+	// DeleteFromOutbox removes the events with the given IDs from the outbox once they were passed to the sinks
+	DeleteFromOutbox(ctx context.Context, eventIDs []uint64) error
 	// Close the sink flushing events if necessary
 	Close(ctx context.Context) error
 }
@@ -20,6 +28,7 @@ type InMemoryEventStore struct {
 	mu     sync.Mutex
 	nextID uint64
 	events []*Event
+	outbox map[uint64]*Event
 }
 
 // Save sets the Event.ID to 1
@@ -29,13 +38,17 @@ func (store *InMemoryEventStore) Save(_ context.Context, event *Event) (*Event,
 	if store.events == nil {
 		store.events = make([]*Event, 0)
 	}
+	if store.outbox == nil {
+		store.outbox = make(map[uint64]*Event)
+	}
 	event.ID = store.nextID
 	store.nextID++
 	store.events = append(store.events, event)
+	store.outbox[event.ID] = event
 	return event, nil
 }
 
-// Get returns a list of ALL events that belong to the given accountID without taking offset, limit and order into consideration
+// Get returns "limit" number of events from the "offset" index ordered descending or ascending by a timestamp
 func (store *InMemoryEventStore) Get(_ context.Context, accountID string, offset, limit int, descending bool) ([]*Event, error) {
 	store.mu.Lock()
 	defer store.mu.Unlock()
@@ -45,7 +58,74 @@ func (store *InMemoryEventStore) Get(_ context.Context, accountID string, offset
 			events = append(events, event)
 		}
 	}
-	return events, nil
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if descending {
+			return events[i].Timestamp.After(events[j].Timestamp)
+		}
+		return events[i].Timestamp.Before(events[j].Timestamp)
+	})
+
+	return paginate(events, offset, limit), nil
+}
+
+// GetFiltered returns up to filter.Limit events matching the filter that come after filter.Cursor, ordered by ID
+func (store *InMemoryEventStore) GetFiltered(_ context.Context, accountID string, filter Filter) ([]*Event, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	events := make([]*Event, 0)
+	for _, event := range store.events {
+		if event.AccountID == accountID && filter.AfterCursor(event) && filter.Match(event) {
+			events = append(events, event)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if filter.Descending {
+			return events[i].ID > events[j].ID
+		}
+		return events[i].ID < events[j].ID
+	})
+
+	return paginate(events, 0, filter.Limit), nil
+}
+
+// GetOutbox returns up to limit events of the outbox ordered by ID
+func (store *InMemoryEventStore) GetOutbox(_ context.Context, limit int) ([]*Event, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	events := make([]*Event, 0, len(store.outbox))
+	for _, event := range store.outbox {
+		events = append(events, event)
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ID < events[j].ID
+	})
+
+	return paginate(events, 0, limit), nil
+}
+
+// DeleteFromOutbox removes the events with the given IDs from the outbox
+func (store *InMemoryEventStore) DeleteFromOutbox(_ context.Context, eventIDs []uint64) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	for _, id := range eventIDs {
+		delete(store.outbox, id)
+	}
+	return nil
+}
+
+func paginate(events []*Event, offset, limit int) []*Event {
+	if offset >= len(events) {
+		return []*Event{}
+	}
+	events = events[offset:]
+
+	if limit > 0 && limit < len(events) {
+		events = events[:limit]
+	}
+	return events
 }
 
 // Close cleans up the event list
@@ -53,5 +133,6 @@ func (store *InMemoryEventStore) Close(_ context.Context) error {
 	store.mu.Lock()
 	defer store.mu.Unlock()
 	store.events = make([]*Event, 0)
+	store.outbox = nil
 	return nil
 }
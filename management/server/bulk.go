@@ -0,0 +1,795 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/rbac"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// maxBulkOperations limits the number of operations of a single bulk request
+const maxBulkOperations = 1000
+
+// errBulkOperationsFailed rolls back the transaction of a bulk request with failed operations
+var errBulkOperationsFailed = errors.New("bulk operations failed")
+
+// BulkAction is the action of a single operation of a bulk request
+type BulkAction string
+
+const (
+	BulkActionCreate BulkAction = "create"
+	BulkActionUpdate BulkAction = "update"
+	BulkActionDelete BulkAction = "delete"
+)
+
+// bulkActionOperations maps the bulk actions to the operations of the custom role permissions
+var bulkActionOperations = map[BulkAction]rbac.Operation{
+	BulkActionCreate: rbac.OperationCreate,
+	BulkActionUpdate: rbac.OperationUpdate,
+	BulkActionDelete: rbac.OperationDelete,
+}
+
+// BulkOperationStatus is the outcome of a single operation of a bulk request
+type BulkOperationStatus string
+
+const (
+	// BulkOperationApplied means that the operation has been applied
+	BulkOperationApplied BulkOperationStatus = "applied"
+	// BulkOperationFailed means that the operation failed, which rolls back the whole request
+	BulkOperationFailed BulkOperationStatus = "failed"
+	// BulkOperationSkipped means that the operation succeeded but was rolled back because other operations failed
+	BulkOperationSkipped BulkOperationStatus = "skipped"
+)
+
+// PeerBulkOperation is an operation on a peer of a bulk request. Peers can only be updated and deleted.
+type PeerBulkOperation struct {
+	Action BulkAction
+	// Peer holds the ID of the peer and the updated fields
+	Peer *nbpeer.Peer
+}
+
+// GroupBulkOperation is an operation on a group of a bulk request
+type GroupBulkOperation struct {
+	Action BulkAction
+	// Group holds the ID of the updated or deleted group and the fields of the created or updated group
+	Group *nbgroup.Group
+}
+
+// UserBulkOperation is an operation on a user of a bulk request. Only service users can be created,
+// regular users have to be invited.
+type UserBulkOperation struct {
+	Action BulkAction
+	// User holds the ID of the updated or deleted user and the fields of the created or updated user
+	User *User
+	// CustomRoleID is the custom role of the updated user. Nil keeps the current custom role.
+	CustomRoleID *string
+}
+
+// BulkOperationResult is the outcome of a single operation of a bulk request
+type BulkOperationResult struct {
+	Action BulkAction
+	// ID of the resource, set by the operation for created resources
+	ID     string
+	Status BulkOperationStatus
+	Error  error
+}
+
+// BulkResult holds the outcome of the operations of a bulk request in the order of the request.
+// Operations are applied in a single transaction, either all of them are applied or none.
+type BulkResult struct {
+	Applied bool
+	Results []*BulkOperationResult
+}
+
+func newBulkResult(size int) *BulkResult {
+	return &BulkResult{Results: make([]*BulkOperationResult, 0, size)}
+}
+
+// add records the outcome of the next operation
+func (r *BulkResult) add(action BulkAction, id string, err error) {
+	result := &BulkOperationResult{Action: action, ID: id, Status: BulkOperationApplied}
+	if err != nil {
+		result.Status = BulkOperationFailed
+		result.Error = err
+	}
+	r.Results = append(r.Results, result)
+}
+
+func (r *BulkResult) failed() bool {
+	for _, result := range r.Results {
+		if result.Status == BulkOperationFailed {
+			return true
+		}
+	}
+	return false
+}
+
+// rollback marks the succeeded operations as skipped once the transaction has been rolled back
+func (r *BulkResult) rollback() {
+	r.Applied = false
+	for _, result := range r.Results {
+		if result.Status == BulkOperationApplied {
+			result.Status = BulkOperationSkipped
+		}
+	}
+}
+
+// bulkInitiator is the user performing a bulk request. The custom role of the user is resolved before the
+// transaction starts, so the permissions can be checked without accessing the store.
+type bulkInitiator struct {
+	*User
+	customRole *rbac.Role
+}
+
+// checkPermission returns an error if a regular user isn't allowed to perform the action on the resource
+func (i *bulkInitiator) checkPermission(resource rbac.Resource, action BulkAction) error {
+	if i.IsRegularUser() && !i.customRole.Allows(resource, bulkActionOperations[action]) {
+		return status.NewAdminPermissionError()
+	}
+	return nil
+}
+
+// getBulkInitiator validates the bulk request and returns the user performing it
+func (am *DefaultAccountManager) getBulkInitiator(ctx context.Context, accountID, userID string, operations int) (*bulkInitiator, error) {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if operations == 0 {
+		return nil, status.Errorf(status.InvalidArgument, "the list of operations should not be empty")
+	}
+
+	if operations > maxBulkOperations {
+		return nil, status.Errorf(status.InvalidArgument, "a bulk request can contain at most %d operations", maxBulkOperations)
+	}
+
+	customRole, err := am.GetUserCustomRole(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bulkInitiator{User: user, customRole: customRole}, nil
+}
+
+// ApplyPeerBulkOperations updates and deletes peers of the account in a single transaction.
+// The integrated validator is notified of the updated and deleted peers and the account peers are updated once
+// the transaction is committed, so the rolled back operations have no side effects.
+func (am *DefaultAccountManager) ApplyPeerBulkOperations(ctx context.Context, accountID, userID string, operations []*PeerBulkOperation) (*BulkResult, error) {
+	initiator, err := am.getBulkInitiator(ctx, accountID, userID, len(operations))
+	if err != nil {
+		return nil, err
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	var result *BulkResult
+	var applier *peersBulkApplier
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		account, err := transaction.GetAccount(ctx, accountID)
+		if err != nil {
+			return err
+		}
+
+		result = newBulkResult(len(operations))
+		applier = &peersBulkApplier{am: am, ctx: ctx, transaction: transaction, account: account, initiator: initiator}
+
+		for _, operation := range operations {
+			result.add(operation.Action, operation.peerID(), applier.apply(operation))
+		}
+
+		if result.failed() {
+			return errBulkOperationsFailed
+		}
+
+		if err = transaction.SaveAccount(ctx, account); err != nil {
+			return err
+		}
+
+		return applier.syncDynamicGroups()
+	})
+	if errors.Is(err, errBulkOperationsFailed) {
+		result.rollback()
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result.Applied = true
+
+	for _, validation := range applier.validations {
+		_, requiresPeerUpdates, err := am.integratedPeerValidator.ValidatePeer(ctx, validation.update, validation.peer, userID, accountID, am.GetDNSDomain(), validation.peerGroups, applier.account.Settings.Extra)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed to validate the update of peer %s: %v", validation.peer.ID, err)
+			continue
+		}
+		applier.updateAccountPeers = applier.updateAccountPeers || requiresPeerUpdates
+	}
+
+	for _, peer := range applier.deletedPeers {
+		am.notifyPeerDeleted(ctx, accountID, peer.ID)
+		am.disconnectDeletedPeer(ctx, applier.account.Network.CurrentSerial(), peer.ID)
+	}
+
+	for _, storeEvent := range applier.events {
+		storeEvent()
+	}
+
+	for _, update := range applier.updates {
+		am.schedulePeerExpirations(ctx, applier.account, update)
+	}
+
+	if applier.updateAccountPeers {
+		am.updateAccountPeers(ctx, accountID)
+	}
+
+	return result, nil
+}
+
+// notifyPeerDeleted notifies the integrated validator of a peer deleted by a committed bulk request
+func (am *DefaultAccountManager) notifyPeerDeleted(ctx context.Context, accountID, peerID string) {
+	if err := am.integratedPeerValidator.PeerDeleted(ctx, accountID, peerID); err != nil {
+		log.WithContext(ctx).Errorf("failed to notify the integrated validator of the deleted peer %s: %v", peerID, err)
+	}
+}
+
+func (o *PeerBulkOperation) peerID() string {
+	if o.Peer == nil {
+		return ""
+	}
+	return o.Peer.ID
+}
+
+// peersBulkApplier applies the operations of a peers bulk request to the account
+type peersBulkApplier struct {
+	am          *DefaultAccountManager
+	ctx         context.Context
+	transaction Store
+	account     *Account
+	initiator   *bulkInitiator
+
+	updates            []*peerUpdateResult
+	validations        []*peerValidation
+	deletedPeers       []*nbpeer.Peer
+	events             []func()
+	updateAccountPeers bool
+}
+
+// peerValidation is a peer update passed to the integrated validator once the bulk request is committed
+type peerValidation struct {
+	update *nbpeer.Peer
+	// peer is the peer before the update
+	peer       *nbpeer.Peer
+	peerGroups []string
+}
+
+func (a *peersBulkApplier) apply(operation *PeerBulkOperation) error {
+	if operation.Action != BulkActionUpdate && operation.Action != BulkActionDelete {
+		return status.Errorf(status.InvalidArgument, "unsupported action %q, peers can only be updated or deleted", operation.Action)
+	}
+
+	if operation.peerID() == "" {
+		return status.Errorf(status.InvalidArgument, "peer ID is missing")
+	}
+
+	if err := a.initiator.checkPermission(rbac.ResourcePeers, operation.Action); err != nil {
+		return err
+	}
+
+	if operation.Action == BulkActionDelete {
+		return a.deletePeer(operation.Peer.ID)
+	}
+
+	peer := a.account.GetPeer(operation.Peer.ID)
+	if peer == nil {
+		return status.Errorf(status.NotFound, "peer %s not found", operation.Peer.ID)
+	}
+
+	validation := &peerValidation{
+		update:     operation.Peer,
+		peer:       peer.Copy(),
+		peerGroups: a.account.GetPeerGroupsList(peer.ID),
+	}
+
+	update, err := a.am.applyValidatedPeerUpdate(a.ctx, a.account, a.initiator.Id, operation.Peer)
+	if err != nil {
+		return err
+	}
+
+	a.updates = append(a.updates, update)
+	a.validations = append(a.validations, validation)
+	a.events = append(a.events, update.events...)
+	a.updateAccountPeers = a.updateAccountPeers || update.affectsAccountPeers()
+
+	return nil
+}
+
+func (a *peersBulkApplier) deletePeer(peerID string) error {
+	peer := a.account.GetPeer(peerID)
+	if peer == nil {
+		return status.Errorf(status.NotFound, "peer %s not found", peerID)
+	}
+
+	var peerGroupIDs []string
+	for _, group := range a.account.Groups {
+		if slices.Contains(group.Peers, peerID) {
+			peerGroupIDs = append(peerGroupIDs, group.ID)
+		}
+	}
+
+	inActiveGroup, err := areGroupChangesAffectPeers(a.ctx, a.transaction, a.account.Id, peerGroupIDs)
+	if err != nil {
+		return err
+	}
+
+	a.account.DeletePeer(peer.ID)
+	a.deletedPeers = append(a.deletedPeers, peer)
+	a.updateAccountPeers = a.updateAccountPeers || inActiveGroup
+
+	meta := peer.EventMeta(a.am.GetDNSDomain())
+	a.events = append(a.events, func() {
+		a.am.StoreEvent(a.ctx, a.initiator.Id, peer.ID, a.account.Id, activity.PeerRemovedByUser, meta)
+	})
+
+	return nil
+}
+
+// syncDynamicGroups updates the membership of the peers with updated tags in the dynamic groups of the account
+func (a *peersBulkApplier) syncDynamicGroups() error {
+	for _, update := range a.updates {
+		if !update.tagsUpdated || a.account.GetPeer(update.peer.ID) == nil {
+			continue
+		}
+
+		_, events, err := a.am.updatePeerDynamicGroups(a.ctx, a.transaction, a.account.Id, update.peer)
+		if err != nil {
+			return err
+		}
+		a.events = append(a.events, events...)
+	}
+
+	return nil
+}
+
+// ApplyGroupBulkOperations creates, updates and deletes groups of the account in a single transaction.
+// The account peers are updated once all operations have been applied.
+func (am *DefaultAccountManager) ApplyGroupBulkOperations(ctx context.Context, accountID, userID string, operations []*GroupBulkOperation) (*BulkResult, error) {
+	initiator, err := am.getBulkInitiator(ctx, accountID, userID, len(operations))
+	if err != nil {
+		return nil, err
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	var result *BulkResult
+	var applier *groupsBulkApplier
+	var updateAccountPeers bool
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		result = newBulkResult(len(operations))
+		applier = &groupsBulkApplier{am: am, ctx: ctx, transaction: transaction, accountID: accountID, initiator: initiator}
+
+		for _, operation := range operations {
+			groupID, err := applier.apply(operation)
+			result.add(operation.Action, groupID, err)
+		}
+
+		if result.failed() {
+			return errBulkOperationsFailed
+		}
+
+		var err error
+		updateAccountPeers, err = areGroupChangesAffectPeers(ctx, transaction, accountID, applier.savedGroupIDs)
+		if err != nil {
+			return err
+		}
+
+		return transaction.IncrementNetworkSerial(ctx, LockingStrengthUpdate, accountID)
+	})
+	if errors.Is(err, errBulkOperationsFailed) {
+		result.rollback()
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result.Applied = true
+
+	for _, storeEvent := range applier.events {
+		storeEvent()
+	}
+
+	if updateAccountPeers {
+		am.updateAccountPeers(ctx, accountID)
+	}
+
+	return result, nil
+}
+
+// groupsBulkApplier applies the operations of a groups bulk request in the transaction
+type groupsBulkApplier struct {
+	am          *DefaultAccountManager
+	ctx         context.Context
+	transaction Store
+	accountID   string
+	initiator   *bulkInitiator
+
+	savedGroupIDs []string
+	events        []func()
+}
+
+// apply applies the operation and returns the ID of the group
+func (a *groupsBulkApplier) apply(operation *GroupBulkOperation) (string, error) {
+	if _, ok := bulkActionOperations[operation.Action]; !ok {
+		return "", status.Errorf(status.InvalidArgument, "unsupported action %q", operation.Action)
+	}
+
+	if operation.Group == nil {
+		return "", status.Errorf(status.InvalidArgument, "group is missing")
+	}
+
+	if err := a.initiator.checkPermission(rbac.ResourceGroups, operation.Action); err != nil {
+		return operation.Group.ID, err
+	}
+
+	group := operation.Group
+	switch operation.Action {
+	case BulkActionCreate:
+		group.ID = ""
+		group.Issued = nbgroup.GroupIssuedAPI
+	case BulkActionUpdate:
+		existingGroup, err := a.transaction.GetGroupByID(a.ctx, LockingStrengthUpdate, a.accountID, group.ID)
+		if err != nil {
+			return group.ID, err
+		}
+
+		if existingGroup.IsGroupAll() {
+			return group.ID, status.Errorf(status.InvalidArgument, "updating group ALL is not allowed")
+		}
+
+		group.Issued = existingGroup.Issued
+		group.IntegrationReference = existingGroup.IntegrationReference
+	case BulkActionDelete:
+		return group.ID, a.deleteGroup(group.ID)
+	}
+
+	if group.Name == "" {
+		return group.ID, status.Errorf(status.InvalidArgument, "group name shouldn't be empty")
+	}
+
+	if group.Peers == nil {
+		group.Peers = make([]string, 0)
+	}
+
+	if err := validateNewGroup(a.ctx, a.transaction, a.accountID, group); err != nil {
+		return group.ID, err
+	}
+
+	group.AccountID = a.accountID
+	a.events = append(a.events, a.am.prepareGroupEvents(a.ctx, a.transaction, a.accountID, a.initiator.Id, group)...)
+
+	if err := a.transaction.SaveGroups(a.ctx, LockingStrengthUpdate, []*nbgroup.Group{group}); err != nil {
+		return group.ID, err
+	}
+
+	a.savedGroupIDs = append(a.savedGroupIDs, group.ID)
+
+	return group.ID, nil
+}
+
+func (a *groupsBulkApplier) deleteGroup(groupID string) error {
+	group, err := a.transaction.GetGroupByID(a.ctx, LockingStrengthUpdate, a.accountID, groupID)
+	if err != nil {
+		return err
+	}
+
+	if err = validateDeleteGroup(a.ctx, a.transaction, group, a.initiator.Id); err != nil {
+		return err
+	}
+
+	if err = a.transaction.DeleteGroups(a.ctx, LockingStrengthUpdate, a.accountID, []string{groupID}); err != nil {
+		return err
+	}
+
+	a.events = append(a.events, func() {
+		a.am.StoreEvent(a.ctx, a.initiator.Id, group.ID, a.accountID, activity.GroupDeleted, group.EventMeta())
+	})
+
+	return nil
+}
+
+// ApplyUserBulkOperations creates service users and updates and deletes users of the account in a single transaction.
+// The account peers are updated once all operations have been applied.
+func (am *DefaultAccountManager) ApplyUserBulkOperations(ctx context.Context, accountID, userID string, operations []*UserBulkOperation) (*BulkResult, error) {
+	initiator, err := am.getBulkInitiator(ctx, accountID, userID, len(operations))
+	if err != nil {
+		return nil, err
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	// the names and emails of the deleted users are resolved before the transaction starts as they may come from the IdP
+	var deletedUserInfos map[string]*UserInfo
+	for _, operation := range operations {
+		if operation.Action == BulkActionDelete {
+			deletedUserInfos, err = am.getUserInfosByID(ctx, accountID, userID)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	var result *BulkResult
+	var applier *usersBulkApplier
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		account, err := transaction.GetAccount(ctx, accountID)
+		if err != nil {
+			return err
+		}
+
+		result = newBulkResult(len(operations))
+		applier = &usersBulkApplier{
+			am:               am,
+			ctx:              ctx,
+			transaction:      transaction,
+			account:          account,
+			initiator:        initiator,
+			deletedUserInfos: deletedUserInfos,
+		}
+
+		for _, operation := range operations {
+			targetUserID, err := applier.apply(operation)
+			result.add(operation.Action, targetUserID, err)
+		}
+
+		if result.failed() {
+			return errBulkOperationsFailed
+		}
+
+		if account.Settings.GroupsPropagationEnabled && areUsersLinkedToPeers(account, applier.updatedUserIDs) {
+			applier.updateAccountPeers = true
+		}
+
+		account.Network.IncSerial()
+		return transaction.SaveAccount(ctx, account)
+	})
+	if errors.Is(err, errBulkOperationsFailed) {
+		result.rollback()
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result.Applied = true
+
+	for _, regularUserID := range applier.deletedRegularUserIDs {
+		if err = am.deleteExistingUserFromIDP(ctx, regularUserID, accountID); err != nil {
+			log.WithContext(ctx).Errorf("failed to delete user %s from IdP: %v", regularUserID, err)
+		}
+	}
+
+	for _, peer := range applier.deletedPeers {
+		am.notifyPeerDeleted(ctx, accountID, peer.ID)
+		am.disconnectDeletedPeer(ctx, applier.account.Network.CurrentSerial(), peer.ID)
+	}
+
+	if len(applier.expiredPeerIDs) > 0 {
+		// this will trigger peer disconnect from the management service
+		am.peersUpdateManager.CloseChannels(ctx, applier.expiredPeerIDs)
+	}
+
+	for _, storeEvent := range applier.events {
+		storeEvent()
+	}
+
+	if applier.updateAccountPeers {
+		am.updateAccountPeers(ctx, accountID)
+	}
+
+	return result, nil
+}
+
+// getUserInfosByID returns the users of the account with the data from the IdP by their IDs
+func (am *DefaultAccountManager) getUserInfosByID(ctx context.Context, accountID, userID string) (map[string]*UserInfo, error) {
+	userInfos, err := am.GetUsersFromAccount(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	userInfosByID := make(map[string]*UserInfo, len(userInfos))
+	for _, userInfo := range userInfos {
+		userInfosByID[userInfo.ID] = userInfo
+	}
+
+	return userInfosByID, nil
+}
+
+func (o *UserBulkOperation) userID() string {
+	if o.User == nil {
+		return ""
+	}
+	return o.User.Id
+}
+
+// usersBulkApplier applies the operations of a users bulk request to the account
+type usersBulkApplier struct {
+	am               *DefaultAccountManager
+	ctx              context.Context
+	transaction      Store
+	account          *Account
+	initiator        *bulkInitiator
+	deletedUserInfos map[string]*UserInfo
+
+	updatedUserIDs        []string
+	deletedRegularUserIDs []string
+	deletedPeers          []*nbpeer.Peer
+	expiredPeerIDs        []string
+	events                []func()
+	updateAccountPeers    bool
+}
+
+// apply applies the operation and returns the ID of the user
+func (a *usersBulkApplier) apply(operation *UserBulkOperation) (string, error) {
+	if operation.User == nil {
+		return "", status.Errorf(status.InvalidArgument, "user is missing")
+	}
+
+	switch operation.Action {
+	case BulkActionCreate:
+		return a.createServiceUser(operation.User)
+	case BulkActionUpdate:
+		return operation.User.Id, a.updateUser(operation)
+	case BulkActionDelete:
+		return operation.User.Id, a.deleteUser(operation.User.Id)
+	default:
+		return operation.userID(), status.Errorf(status.InvalidArgument, "unsupported action %q", operation.Action)
+	}
+}
+
+func (a *usersBulkApplier) createServiceUser(user *User) (string, error) {
+	if !user.IsServiceUser {
+		return "", status.Errorf(status.InvalidArgument, "only service users can be created in bulk, regular users have to be invited")
+	}
+
+	switch user.Role {
+	case UserRoleOwner:
+		return "", status.Errorf(status.InvalidArgument, "can't create a service user with owner role")
+	case UserRoleUnknown, "":
+		return "", status.Errorf(status.InvalidArgument, "invalid user role")
+	}
+
+	if !a.initiator.HasAdminPower() && !customRoleCanManageUserWithRole(a.initiator.customRole, user.Role, rbac.OperationCreate) {
+		return "", status.Errorf(status.PermissionDenied, "only users with admin power can create service users")
+	}
+
+	newUser := NewUser(uuid.New().String(), user.Role, true, false, user.ServiceUserName, user.AutoGroups, UserIssuedAPI)
+	a.account.Users[newUser.Id] = newUser
+
+	a.events = append(a.events, func() {
+		meta := map[string]any{"name": newUser.ServiceUserName}
+		a.am.StoreEvent(a.ctx, a.initiator.Id, newUser.Id, a.account.Id, activity.ServiceUserCreated, meta)
+	})
+
+	return newUser.Id, nil
+}
+
+func (a *usersBulkApplier) updateUser(operation *UserBulkOperation) error {
+	update := operation.User
+
+	if !a.initiator.HasAdminPower() && !a.initiator.customRole.Allows(rbac.ResourceUsers, rbac.OperationUpdate) {
+		return status.Errorf(status.PermissionDenied, "only users with admin power are authorized to perform user update operations")
+	}
+
+	oldUser := a.account.Users[update.Id]
+	if oldUser == nil {
+		return status.Errorf(status.NotFound, "user to update doesn't exist: %s", update.Id)
+	}
+
+	update.Issued = oldUser.Issued
+	update.IntegrationReference = oldUser.IntegrationReference
+	update.CustomRoleID = oldUser.CustomRoleID
+	if operation.CustomRoleID != nil {
+		update.CustomRoleID = *operation.CustomRoleID
+	}
+
+	_, blockedPeers, events, err := a.am.applyUserUpdate(a.ctx, a.transaction, a.account, a.initiator.User, update, false)
+	if err != nil {
+		return err
+	}
+
+	a.updatedUserIDs = append(a.updatedUserIDs, update.Id)
+	a.events = append(a.events, events...)
+
+	for _, peer := range blockedPeers {
+		if peer.Status.LoginExpired {
+			continue
+		}
+
+		peer.MarkLoginExpired(true)
+		a.account.UpdatePeer(peer)
+		a.expiredPeerIDs = append(a.expiredPeerIDs, peer.ID)
+		a.updateAccountPeers = true
+
+		meta := peer.EventMeta(a.am.GetDNSDomain())
+		a.events = append(a.events, func() {
+			a.am.StoreEvent(a.ctx, peer.UserID, peer.ID, a.account.Id, activity.PeerLoginExpired, meta)
+		})
+	}
+
+	return nil
+}
+
+func (a *usersBulkApplier) deleteUser(targetUserID string) error {
+	if a.initiator.Id == targetUserID {
+		return status.Errorf(status.InvalidArgument, "self deletion is not allowed")
+	}
+
+	canDeleteUsers := a.initiator.HasAdminPower() || a.initiator.customRole.Allows(rbac.ResourceUsers, rbac.OperationDelete)
+	targetUser := a.account.Users[targetUserID]
+	if err := validateUserDeletion(a.initiator.User, canDeleteUsers, targetUser); err != nil {
+		return err
+	}
+
+	if targetUser.IsServiceUser {
+		delete(a.account.Users, targetUserID)
+
+		meta := map[string]any{"name": targetUser.ServiceUserName, "created_at": targetUser.CreatedAt}
+		a.events = append(a.events, func() {
+			a.am.StoreEvent(a.ctx, a.initiator.Id, targetUserID, a.account.Id, activity.ServiceUserDeleted, meta)
+		})
+
+		return nil
+	}
+
+	userInfo, ok := a.deletedUserInfos[targetUserID]
+	if !ok {
+		return status.Errorf(status.Internal, "user info not found for user: %s", targetUserID)
+	}
+
+	peers, err := a.account.FindUserPeers(targetUserID)
+	if err != nil {
+		return status.Errorf(status.Internal, "failed to find user peers")
+	}
+
+	for _, peer := range peers {
+		a.account.DeletePeer(peer.ID)
+		a.deletedPeers = append(a.deletedPeers, peer)
+		a.updateAccountPeers = true
+
+		meta := peer.EventMeta(a.am.GetDNSDomain())
+		a.events = append(a.events, func() {
+			a.am.StoreEvent(a.ctx, a.initiator.Id, peer.ID, a.account.Id, activity.PeerRemovedByUser, meta)
+		})
+	}
+
+	delete(a.account.Users, targetUserID)
+	a.deletedRegularUserIDs = append(a.deletedRegularUserIDs, targetUserID)
+
+	meta := map[string]any{"name": userInfo.Name, "email": userInfo.Email, "created_at": targetUser.CreatedAt}
+	a.events = append(a.events, func() {
+		a.am.StoreEvent(a.ctx, a.initiator.Id, targetUserID, a.account.Id, activity.UserDeleted, meta)
+	})
+
+	return nil
+}
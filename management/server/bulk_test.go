@@ -0,0 +1,286 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbAccount "github.com/netbirdio/netbird/management/server/account"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/rbac"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+func setupBulkTestAccount(t *testing.T) (*DefaultAccountManager, *Account) {
+	t.Helper()
+
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	account := newAccountWithId(context.Background(), "bulk_account", userID, "netbird.io")
+	for i := 0; i < 3; i++ {
+		peerID := fmt.Sprintf("peer%d", i)
+		account.Peers[peerID] = &nbpeer.Peer{
+			ID:        peerID,
+			AccountID: account.Id,
+			Key:       fmt.Sprintf("key%d", i),
+			Name:      peerID,
+			DNSLabel:  peerID,
+			UserID:    userID,
+			Status:    &nbpeer.PeerStatus{},
+			Meta:      nbpeer.PeerSystemMeta{Hostname: peerID},
+		}
+	}
+
+	account.Groups["group1"] = &nbgroup.Group{ID: "group1", AccountID: account.Id, Name: "group1", Issued: nbgroup.GroupIssuedAPI, Peers: []string{"peer0"}}
+
+	regularUser := NewRegularUser("regular_user")
+	regularUser.AccountID = account.Id
+	account.Users[regularUser.Id] = regularUser
+
+	serviceUser := NewUser("service_user", UserRoleUser, true, false, "service", []string{}, UserIssuedAPI)
+	serviceUser.AccountID = account.Id
+	account.Users[serviceUser.Id] = serviceUser
+
+	require.NoError(t, manager.Store.SaveAccount(context.Background(), account))
+
+	return manager, account
+}
+
+func assertBulkStatuses(t *testing.T, result *BulkResult, expected ...BulkOperationStatus) {
+	t.Helper()
+
+	require.Len(t, result.Results, len(expected))
+	for i, operationResult := range result.Results {
+		assert.Equal(t, expected[i], operationResult.Status, "operation %d: %v", i, operationResult.Error)
+	}
+}
+
+func TestDefaultAccountManager_ApplyPeerBulkOperations(t *testing.T) {
+	manager, account := setupBulkTestAccount(t)
+	ctx := context.Background()
+
+	var validatedPeers, deletedPeers []string
+	manager.integratedPeerValidator = MocIntegratedValidator{
+		ValidatePeerFunc: func(_ context.Context, update *nbpeer.Peer, peer *nbpeer.Peer, _ string, _ string, _ string, _ []string, _ *nbAccount.ExtraSettings) (*nbpeer.Peer, bool, error) {
+			validatedPeers = append(validatedPeers, peer.ID)
+			return update, false, nil
+		},
+		PeerDeletedFunc: func(_ context.Context, _, peerID string) error {
+			deletedPeers = append(deletedPeers, peerID)
+			return nil
+		},
+	}
+
+	t.Run("failed operation rolls back the request", func(t *testing.T) {
+		result, err := manager.ApplyPeerBulkOperations(ctx, account.Id, userID, []*PeerBulkOperation{
+			{Action: BulkActionUpdate, Peer: &nbpeer.Peer{ID: "peer0", Name: "renamed", SSHEnabled: true}},
+			{Action: BulkActionDelete, Peer: &nbpeer.Peer{ID: "unknown"}},
+			{Action: BulkActionCreate, Peer: &nbpeer.Peer{ID: "peer2"}},
+		})
+		require.NoError(t, err)
+		assert.False(t, result.Applied)
+		assertBulkStatuses(t, result, BulkOperationSkipped, BulkOperationFailed, BulkOperationFailed)
+
+		peer, err := manager.Store.GetPeerByID(ctx, LockingStrengthShare, account.Id, "peer0")
+		require.NoError(t, err)
+		assert.Equal(t, "peer0", peer.Name)
+		assert.False(t, peer.SSHEnabled)
+
+		assert.Empty(t, validatedPeers, "rolled back updates shouldn't be passed to the integrated validator")
+		assert.Empty(t, deletedPeers, "rolled back deletions shouldn't be passed to the integrated validator")
+	})
+
+	t.Run("operations are applied", func(t *testing.T) {
+		result, err := manager.ApplyPeerBulkOperations(ctx, account.Id, userID, []*PeerBulkOperation{
+			{Action: BulkActionUpdate, Peer: &nbpeer.Peer{ID: "peer0", Name: "renamed", SSHEnabled: true}},
+			{Action: BulkActionDelete, Peer: &nbpeer.Peer{ID: "peer1"}},
+		})
+		require.NoError(t, err)
+		assert.True(t, result.Applied)
+		assertBulkStatuses(t, result, BulkOperationApplied, BulkOperationApplied)
+
+		peer, err := manager.Store.GetPeerByID(ctx, LockingStrengthShare, account.Id, "peer0")
+		require.NoError(t, err)
+		assert.Equal(t, "renamed", peer.Name)
+		assert.True(t, peer.SSHEnabled)
+
+		_, err = manager.Store.GetPeerByID(ctx, LockingStrengthShare, account.Id, "peer1")
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.NotFound, sErr.Type())
+
+		assert.Equal(t, []string{"peer0"}, validatedPeers)
+		assert.Equal(t, []string{"peer1"}, deletedPeers)
+	})
+
+	t.Run("regular users need permissions", func(t *testing.T) {
+		result, err := manager.ApplyPeerBulkOperations(ctx, account.Id, "regular_user", []*PeerBulkOperation{
+			{Action: BulkActionUpdate, Peer: &nbpeer.Peer{ID: "peer2", Name: "peer2"}},
+		})
+		require.NoError(t, err)
+		assert.False(t, result.Applied)
+		assertBulkStatuses(t, result, BulkOperationFailed)
+
+		sErr, ok := status.FromError(result.Results[0].Error)
+		require.True(t, ok)
+		assert.Equal(t, status.PermissionDenied, sErr.Type())
+	})
+
+	t.Run("request limits are validated", func(t *testing.T) {
+		_, err := manager.ApplyPeerBulkOperations(ctx, account.Id, userID, nil)
+		assert.Error(t, err)
+
+		operations := make([]*PeerBulkOperation, maxBulkOperations+1)
+		for i := range operations {
+			operations[i] = &PeerBulkOperation{Action: BulkActionDelete, Peer: &nbpeer.Peer{ID: "peer2"}}
+		}
+		_, err = manager.ApplyPeerBulkOperations(ctx, account.Id, userID, operations)
+		assert.Error(t, err)
+	})
+}
+
+func TestDefaultAccountManager_ApplyGroupBulkOperations(t *testing.T) {
+	manager, account := setupBulkTestAccount(t)
+	ctx := context.Background()
+
+	t.Run("failed operation rolls back the request", func(t *testing.T) {
+		result, err := manager.ApplyGroupBulkOperations(ctx, account.Id, userID, []*GroupBulkOperation{
+			{Action: BulkActionCreate, Group: &nbgroup.Group{Name: "created"}},
+			{Action: BulkActionUpdate, Group: &nbgroup.Group{ID: "group1", Name: "group1", Peers: []string{"unknown"}}},
+		})
+		require.NoError(t, err)
+		assert.False(t, result.Applied)
+		assertBulkStatuses(t, result, BulkOperationSkipped, BulkOperationFailed)
+
+		groups, err := manager.Store.GetAccountGroups(ctx, LockingStrengthShare, account.Id)
+		require.NoError(t, err)
+		assert.Len(t, groups, 2)
+	})
+
+	t.Run("operations are applied", func(t *testing.T) {
+		result, err := manager.ApplyGroupBulkOperations(ctx, account.Id, userID, []*GroupBulkOperation{
+			{Action: BulkActionCreate, Group: &nbgroup.Group{Name: "created", Peers: []string{"peer1"}}},
+			{Action: BulkActionUpdate, Group: &nbgroup.Group{ID: "group1", Name: "updated", Peers: []string{"peer0", "peer2"}}},
+		})
+		require.NoError(t, err)
+		assert.True(t, result.Applied)
+		assertBulkStatuses(t, result, BulkOperationApplied, BulkOperationApplied)
+		require.NotEmpty(t, result.Results[0].ID)
+
+		created, err := manager.Store.GetGroupByID(ctx, LockingStrengthShare, account.Id, result.Results[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, "created", created.Name)
+		assert.Equal(t, nbgroup.GroupIssuedAPI, created.Issued)
+
+		updated, err := manager.Store.GetGroupByID(ctx, LockingStrengthShare, account.Id, "group1")
+		require.NoError(t, err)
+		assert.Equal(t, "updated", updated.Name)
+		assert.ElementsMatch(t, []string{"peer0", "peer2"}, updated.Peers)
+
+		result, err = manager.ApplyGroupBulkOperations(ctx, account.Id, userID, []*GroupBulkOperation{
+			{Action: BulkActionDelete, Group: &nbgroup.Group{ID: created.ID}},
+		})
+		require.NoError(t, err)
+		assert.True(t, result.Applied)
+
+		_, err = manager.Store.GetGroupByID(ctx, LockingStrengthShare, account.Id, created.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("group all can't be updated", func(t *testing.T) {
+		groupAll, err := manager.Store.GetGroupByName(ctx, LockingStrengthShare, account.Id, "All")
+		require.NoError(t, err)
+
+		result, err := manager.ApplyGroupBulkOperations(ctx, account.Id, userID, []*GroupBulkOperation{
+			{Action: BulkActionUpdate, Group: &nbgroup.Group{ID: groupAll.ID, Name: "renamed"}},
+		})
+		require.NoError(t, err)
+		assertBulkStatuses(t, result, BulkOperationFailed)
+	})
+
+	t.Run("custom role grants the permitted actions only", func(t *testing.T) {
+		role := &rbac.Role{
+			ID:        "group_creator",
+			AccountID: account.Id,
+			Name:      "group creator",
+			Permissions: []rbac.Permission{
+				{Resource: rbac.ResourceGroups, Operations: []rbac.Operation{rbac.OperationCreate}},
+			},
+		}
+		require.NoError(t, manager.Store.SaveCustomRole(ctx, LockingStrengthUpdate, role))
+
+		user, err := manager.Store.GetUserByUserID(ctx, LockingStrengthShare, "regular_user")
+		require.NoError(t, err)
+		user.CustomRoleID = role.ID
+		require.NoError(t, manager.Store.SaveUser(ctx, LockingStrengthUpdate, user))
+
+		result, err := manager.ApplyGroupBulkOperations(ctx, account.Id, user.Id, []*GroupBulkOperation{
+			{Action: BulkActionCreate, Group: &nbgroup.Group{Name: "by custom role"}},
+			{Action: BulkActionDelete, Group: &nbgroup.Group{ID: "group1"}},
+		})
+		require.NoError(t, err)
+		assertBulkStatuses(t, result, BulkOperationSkipped, BulkOperationFailed)
+
+		result, err = manager.ApplyGroupBulkOperations(ctx, account.Id, user.Id, []*GroupBulkOperation{
+			{Action: BulkActionCreate, Group: &nbgroup.Group{Name: "by custom role"}},
+		})
+		require.NoError(t, err)
+		assert.True(t, result.Applied)
+	})
+}
+
+func TestDefaultAccountManager_ApplyUserBulkOperations(t *testing.T) {
+	manager, account := setupBulkTestAccount(t)
+	ctx := context.Background()
+
+	t.Run("failed operation rolls back the request", func(t *testing.T) {
+		result, err := manager.ApplyUserBulkOperations(ctx, account.Id, userID, []*UserBulkOperation{
+			{Action: BulkActionCreate, User: &User{IsServiceUser: true, ServiceUserName: "new", Role: UserRoleUser}},
+			{Action: BulkActionDelete, User: &User{Id: userID}},
+		})
+		require.NoError(t, err)
+		assert.False(t, result.Applied)
+		assertBulkStatuses(t, result, BulkOperationSkipped, BulkOperationFailed)
+
+		users, err := manager.Store.GetAccountUsers(ctx, LockingStrengthShare, account.Id)
+		require.NoError(t, err)
+		assert.Len(t, users, 3)
+	})
+
+	t.Run("regular users can't be created", func(t *testing.T) {
+		result, err := manager.ApplyUserBulkOperations(ctx, account.Id, userID, []*UserBulkOperation{
+			{Action: BulkActionCreate, User: &User{Role: UserRoleUser}},
+		})
+		require.NoError(t, err)
+		assertBulkStatuses(t, result, BulkOperationFailed)
+	})
+
+	t.Run("operations are applied", func(t *testing.T) {
+		result, err := manager.ApplyUserBulkOperations(ctx, account.Id, userID, []*UserBulkOperation{
+			{Action: BulkActionCreate, User: &User{IsServiceUser: true, ServiceUserName: "new", Role: UserRoleUser, AutoGroups: []string{}}},
+			{Action: BulkActionUpdate, User: &User{Id: "regular_user", Role: UserRoleAdmin, AutoGroups: []string{"group1"}}},
+			{Action: BulkActionDelete, User: &User{Id: "service_user"}},
+		})
+		require.NoError(t, err)
+		assert.True(t, result.Applied)
+		assertBulkStatuses(t, result, BulkOperationApplied, BulkOperationApplied, BulkOperationApplied)
+
+		created, err := manager.Store.GetUserByUserID(ctx, LockingStrengthShare, result.Results[0].ID)
+		require.NoError(t, err)
+		assert.True(t, created.IsServiceUser)
+		assert.Equal(t, "new", created.ServiceUserName)
+
+		updated, err := manager.Store.GetUserByUserID(ctx, LockingStrengthShare, "regular_user")
+		require.NoError(t, err)
+		assert.Equal(t, UserRoleAdmin, updated.Role)
+		assert.Equal(t, []string{"group1"}, updated.AutoGroups)
+
+		_, err = manager.Store.GetUserByUserID(ctx, LockingStrengthShare, "service_user")
+		assert.Error(t, err)
+	})
+}
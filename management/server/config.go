@@ -40,6 +40,9 @@ type Config struct {
 	Datadir                string
 	DataStoreEncryptionKey string
 
+	// WebhookAllowPrivateTargets allows webhook endpoints on loopback, link-local and private addresses
+	WebhookAllowPrivateTargets bool
+
 	HttpConfig *HttpServerConfig
 
 	IdpManagerConfig *idp.Config
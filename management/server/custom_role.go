@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/rbac"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// GetCustomRole returns a custom role of the account
+func (am *DefaultAccountManager) GetCustomRole(ctx context.Context, accountID, roleID, userID string) (*rbac.Role, error) {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if !user.HasAdminPower() && user.CustomRoleID != roleID {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	return am.Store.GetCustomRoleByID(ctx, LockingStrengthShare, accountID, roleID)
+}
+
+// ListCustomRoles returns the custom roles of the account
+func (am *DefaultAccountManager) ListCustomRoles(ctx context.Context, accountID, userID string) ([]*rbac.Role, error) {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if !user.HasAdminPower() {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	return am.Store.GetAccountCustomRoles(ctx, LockingStrengthShare, accountID)
+}
+
+// SaveCustomRole creates a custom role or updates an existing one if the ID is set
+func (am *DefaultAccountManager) SaveCustomRole(ctx context.Context, accountID, userID string, role *rbac.Role) (*rbac.Role, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if !user.HasAdminPower() {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	if err = role.Validate(); err != nil {
+		return nil, status.Errorf(status.InvalidArgument, "%s", err.Error())
+	}
+
+	action := activity.CustomRoleCreated
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		if role.ID != "" {
+			if _, err := transaction.GetCustomRoleByID(ctx, LockingStrengthUpdate, accountID, role.ID); err != nil {
+				return err
+			}
+			action = activity.CustomRoleUpdated
+		} else {
+			role.ID = xid.New().String()
+		}
+
+		role.AccountID = accountID
+		return transaction.SaveCustomRole(ctx, LockingStrengthUpdate, role)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, role.ID, accountID, action, role.EventMeta())
+
+	return role, nil
+}
+
+// DeleteCustomRole deletes a custom role that isn't assigned to any user of the account
+func (am *DefaultAccountManager) DeleteCustomRole(ctx context.Context, accountID, roleID, userID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.AccountID != accountID {
+		return status.NewUserNotPartOfAccountError()
+	}
+
+	if !user.HasAdminPower() {
+		return status.NewAdminPermissionError()
+	}
+
+	var role *rbac.Role
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		role, err = transaction.GetCustomRoleByID(ctx, LockingStrengthUpdate, accountID, roleID)
+		if err != nil {
+			return err
+		}
+
+		users, err := transaction.GetAccountUsers(ctx, LockingStrengthShare, accountID)
+		if err != nil {
+			return err
+		}
+
+		for _, accountUser := range users {
+			if accountUser.CustomRoleID == roleID {
+				return status.Errorf(status.PreconditionFailed, "custom role %s is assigned to user %s", role.Name, accountUser.Id)
+			}
+		}
+
+		return transaction.DeleteCustomRole(ctx, LockingStrengthUpdate, accountID, roleID)
+	})
+	if err != nil {
+		return err
+	}
+
+	am.StoreEvent(ctx, userID, role.ID, accountID, activity.CustomRoleDeleted, role.EventMeta())
+
+	return nil
+}
+
+// GetUserCustomRole returns the custom role assigned to the user or nil if the user has none
+func (am *DefaultAccountManager) GetUserCustomRole(ctx context.Context, user *User) (*rbac.Role, error) {
+	if user.CustomRoleID == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	return am.Store.GetCustomRoleByID(ctx, LockingStrengthShare, user.AccountID, user.CustomRoleID)
+}
+
+// hasPermission returns true if the custom role assigned to the user grants the operation on the resource.
+// Permissions of the built-in user roles are checked by the callers.
+func (am *DefaultAccountManager) hasPermission(ctx context.Context, user *User, resource rbac.Resource, operation rbac.Operation) bool {
+	role, err := am.GetUserCustomRole(ctx, user)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get custom role %s of user %s: %v", user.CustomRoleID, user.Id, err)
+		return false
+	}
+
+	return role.Allows(resource, operation)
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/rbac"
+)
+
+func TestDefaultAccountManager_SaveCustomRole(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+
+	account, err := initTestPostureChecksAccount(am)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	role := &rbac.Role{
+		Name:        "network operator",
+		Permissions: []rbac.Permission{{Resource: rbac.ResourceRoutes, Operations: []rbac.Operation{rbac.OperationRead}}},
+	}
+
+	_, err = am.SaveCustomRole(ctx, account.Id, regularUserID, role)
+	assert.Error(t, err, "regular users can't manage custom roles")
+
+	_, err = am.SaveCustomRole(ctx, account.Id, adminUserID, &rbac.Role{
+		Name:        "invalid",
+		Permissions: []rbac.Permission{{Resource: "unknown", Operations: []rbac.Operation{rbac.OperationRead}}},
+	})
+	assert.Error(t, err, "unknown resources should be rejected")
+
+	_, err = am.SaveCustomRole(ctx, account.Id, adminUserID, &rbac.Role{ID: "missing", Name: "missing"})
+	assert.Error(t, err, "updating a missing role should fail")
+
+	saved, err := am.SaveCustomRole(ctx, account.Id, adminUserID, role)
+	require.NoError(t, err)
+	assert.NotEmpty(t, saved.ID)
+
+	saved.Description = "manages network routes"
+	_, err = am.SaveCustomRole(ctx, account.Id, adminUserID, saved)
+	require.NoError(t, err)
+
+	roles, err := am.ListCustomRoles(ctx, account.Id, adminUserID)
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	assert.Equal(t, "manages network routes", roles[0].Description)
+
+	_, err = am.ListCustomRoles(ctx, account.Id, regularUserID)
+	assert.Error(t, err, "regular users can't list custom roles")
+
+	_, err = am.GetCustomRole(ctx, account.Id, saved.ID, regularUserID)
+	assert.Error(t, err, "regular users can only get their own custom role")
+}
+
+func TestDefaultAccountManager_CustomRolePermissions(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+
+	account, err := initTestPostureChecksAccount(am)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	role, err := am.SaveCustomRole(ctx, account.Id, adminUserID, &rbac.Role{
+		Name: "operator",
+		Permissions: []rbac.Permission{
+			{Resource: rbac.ResourceRoutes, Operations: []rbac.Operation{rbac.OperationRead}},
+			{Resource: rbac.ResourcePolicies, Operations: []rbac.Operation{rbac.OperationRead}},
+			{Resource: rbac.ResourceUsers, Operations: []rbac.Operation{rbac.OperationRead, rbac.OperationUpdate}},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = am.ListRoutes(ctx, account.Id, regularUserID)
+	assert.Error(t, err, "regular users without a custom role can't list routes")
+
+	regularUser := account.Users[regularUserID].Copy()
+	regularUser.CustomRoleID = "missing"
+	_, err = am.SaveUser(ctx, account.Id, adminUserID, regularUser)
+	assert.Error(t, err, "assigning a missing custom role should fail")
+
+	regularUser.CustomRoleID = role.ID
+	_, err = am.SaveUser(ctx, account.Id, adminUserID, regularUser)
+	require.NoError(t, err)
+
+	_, err = am.ListRoutes(ctx, account.Id, regularUserID)
+	assert.NoError(t, err, "custom role grants reading routes")
+
+	_, err = am.ListPolicies(ctx, account.Id, regularUserID)
+	assert.NoError(t, err, "custom role grants reading policies")
+
+	_, err = am.ListSetupKeys(ctx, account.Id, regularUserID)
+	assert.Error(t, err, "custom role doesn't grant reading setup keys")
+
+	err = am.DeletePolicy(ctx, account.Id, account.Policies[0].ID, regularUserID)
+	assert.Error(t, err, "custom role doesn't grant deleting policies")
+
+	users, err := am.GetUsersFromAccount(ctx, account.Id, regularUserID)
+	require.NoError(t, err)
+	assert.Len(t, users, len(account.Users), "custom role grants reading all users")
+
+	t.Run("custom role can't be used to escalate privileges", func(t *testing.T) {
+		update := regularUser.Copy()
+		update.Role = UserRoleAdmin
+		_, err = am.SaveUser(ctx, account.Id, regularUserID, update)
+		assert.Error(t, err, "users without admin power can't change roles")
+
+		update = regularUser.Copy()
+		update.CustomRoleID = ""
+		_, err = am.SaveUser(ctx, account.Id, regularUserID, update)
+		assert.Error(t, err, "users without admin power can't change custom roles")
+
+		admin := account.Users[adminUserID].Copy()
+		admin.Blocked = true
+		_, err = am.SaveUser(ctx, account.Id, regularUserID, admin)
+		assert.Error(t, err, "users without admin power can't update admins")
+	})
+
+	err = am.DeleteCustomRole(ctx, account.Id, role.ID, adminUserID)
+	assert.Error(t, err, "assigned custom role can't be deleted")
+
+	regularUser.CustomRoleID = ""
+	_, err = am.SaveUser(ctx, account.Id, adminUserID, regularUser)
+	require.NoError(t, err)
+
+	_, err = am.ListRoutes(ctx, account.Id, regularUserID)
+	assert.Error(t, err, "removed custom role shouldn't grant permissions")
+
+	err = am.DeleteCustomRole(ctx, account.Id, role.ID, adminUserID)
+	require.NoError(t, err)
+
+	_, err = am.GetCustomRole(ctx, account.Id, role.ID, adminUserID)
+	assert.Error(t, err)
+}
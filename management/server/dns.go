@@ -13,6 +13,7 @@ import (
 	"github.com/netbirdio/netbird/management/proto"
 	"github.com/netbirdio/netbird/management/server/activity"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/rbac"
 	"github.com/netbirdio/netbird/management/server/status"
 )
 
@@ -90,7 +91,7 @@ func (am *DefaultAccountManager) GetDNSSettings(ctx context.Context, accountID s
 		return nil, status.NewUserNotPartOfAccountError()
 	}
 
-	if user.IsRegularUser() {
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourceDNS, rbac.OperationRead) {
 		return nil, status.NewAdminPermissionError()
 	}
 
@@ -112,7 +113,7 @@ func (am *DefaultAccountManager) SaveDNSSettings(ctx context.Context, accountID
 		return status.NewUserNotPartOfAccountError()
 	}
 
-	if !user.HasAdminPower() {
+	if !user.HasAdminPower() && !am.hasPermission(ctx, user, rbac.ResourceDNS, rbac.OperationUpdate) {
 		return status.NewAdminPermissionError()
 	}
 
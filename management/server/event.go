@@ -2,17 +2,30 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/rbac"
 	"github.com/netbirdio/netbird/management/server/status"
 )
 
-// GetEvents returns a list of activity events of an account
-func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userID string) ([]*activity.Event, error) {
+const (
+	// maxEventsLimit is the maximum number of events returned by a single GetEvents call
+	maxEventsLimit = 10000
+	// eventOutboxRelayInterval is the interval the event outbox is checked for events not passed to the sinks yet
+	eventOutboxRelayInterval = 10 * time.Second
+	// eventOutboxBatchSize is the maximum number of events read from the event outbox at once
+	eventOutboxBatchSize = 100
+)
+
+// GetEvents returns a list of activity events of an account matching the filter.
+// A zero or too large filter limit is replaced by maxEventsLimit.
+func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userID string, filter activity.Filter) ([]*activity.Event, error) {
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
@@ -26,11 +39,22 @@ func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userI
 		return nil, err
 	}
 
-	if !(user.HasAdminPower() || user.IsServiceUser) {
+	if !(user.HasAdminPower() || user.IsServiceUser) && !am.hasPermission(ctx, user, rbac.ResourceEvents, rbac.OperationRead) {
 		return nil, status.Errorf(status.PermissionDenied, "only users with admin power can view events")
 	}
 
-	events, err := am.eventStore.Get(ctx, accountID, 0, 10000, true)
+	if filter.Limit <= 0 || filter.Limit > maxEventsLimit {
+		filter.Limit = maxEventsLimit
+	}
+
+	if filter.Search != "" {
+		filter.SearchUserIDs, err = am.searchAccountUsers(ctx, accountID, userID, filter.Search)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	events, err := am.eventStore.GetFiltered(ctx, accountID, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -55,9 +79,31 @@ func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userI
 	return filtered, nil
 }
 
+// searchAccountUsers returns the IDs of the account users whose email or name contain the text, case-insensitive.
+// Events only reference users by ID, so the search text is matched against the user details of the IdP.
+func (am *DefaultAccountManager) searchAccountUsers(ctx context.Context, accountID, userID, text string) ([]string, error) {
+	users, err := am.GetUsersFromAccount(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	text = strings.ToLower(text)
+	var ids []string
+	for _, user := range users {
+		if strings.Contains(strings.ToLower(user.Email), text) || strings.Contains(strings.ToLower(user.Name), text) {
+			ids = append(ids, user.ID)
+		}
+	}
+
+	return ids, nil
+}
+
 func (am *DefaultAccountManager) StoreEvent(ctx context.Context, initiatorID, targetID, accountID string, activityID activity.ActivityDescriber, meta map[string]any) {
 
+	am.pendingEvents.Add(1)
 	go func() {
+		defer am.pendingEvents.Done()
+
 		_, err := am.eventStore.Save(ctx, &activity.Event{
 			Timestamp:   time.Now().UTC(),
 			Activity:    activityID,
@@ -69,7 +115,93 @@ func (am *DefaultAccountManager) StoreEvent(ctx context.Context, initiatorID, ta
 		if err != nil {
 			// todo add metric
 			log.WithContext(ctx).Errorf("received an error while storing an activity event, error: %s", err)
+			return
 		}
+
+		am.triggerEventOutboxRelay()
 	}()
 
 }
+
+// WaitForPendingEvents blocks until the activity events passed to StoreEvent are saved to the event store
+func (am *DefaultAccountManager) WaitForPendingEvents() {
+	am.pendingEvents.Wait()
+}
+
+// AddEventSink registers a sink that receives every activity event after it was stored
+func (am *DefaultAccountManager) AddEventSink(sink activity.Sink) {
+	am.eventSinksMu.Lock()
+	defer am.eventSinksMu.Unlock()
+	am.eventSinks = append(am.eventSinks, sink)
+}
+
+// triggerEventOutboxRelay wakes up the event outbox relay without blocking if a relay run is already pending
+func (am *DefaultAccountManager) triggerEventOutboxRelay() {
+	select {
+	case am.eventOutboxNotify <- struct{}{}:
+	default:
+	}
+}
+
+// relayEventOutbox passes the events of the outbox of the event store to the sinks until the context is done.
+// Events stored while no relay was running, e.g. before a restart, are passed on the first run.
+func (am *DefaultAccountManager) relayEventOutbox(ctx context.Context) {
+	ticker := time.NewTicker(eventOutboxRelayInterval)
+	defer ticker.Stop()
+
+	for {
+		am.deliverEventOutbox(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-am.eventOutboxNotify:
+		}
+	}
+}
+
+// deliverEventOutbox passes the events of the outbox to the sinks in order and removes them from the outbox.
+// An event the sinks failed to accept stays in the outbox together with the following events for the next run.
+func (am *DefaultAccountManager) deliverEventOutbox(ctx context.Context) {
+	for {
+		events, err := am.eventStore.GetOutbox(ctx, eventOutboxBatchSize)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed to get the event outbox: %v", err)
+			return
+		}
+
+		delivered := make([]uint64, 0, len(events))
+		for _, event := range events {
+			if err = am.deliverEventToSinks(ctx, event); err != nil {
+				log.WithContext(ctx).Errorf("failed to deliver activity event %d to the sinks: %v", event.ID, err)
+				break
+			}
+			delivered = append(delivered, event.ID)
+		}
+
+		if err := am.eventStore.DeleteFromOutbox(ctx, delivered); err != nil {
+			log.WithContext(ctx).Errorf("failed to remove delivered events from the event outbox: %v", err)
+			return
+		}
+
+		if len(delivered) < eventOutboxBatchSize {
+			return
+		}
+	}
+}
+
+func (am *DefaultAccountManager) deliverEventToSinks(ctx context.Context, event *activity.Event) error {
+	am.eventSinksMu.RLock()
+	sinks := am.eventSinks
+	am.eventSinksMu.RUnlock()
+
+	var errs []error
+	for _, sink := range sinks {
+		if err := sink.Deliver(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -2,10 +2,13 @@ package server
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/netbirdio/netbird/management/server/activity"
 )
@@ -36,7 +39,7 @@ func TestDefaultAccountManager_GetEvents(t *testing.T) {
 	accountID := "accountID"
 
 	t.Run("get empty events list", func(t *testing.T) {
-		events, err := manager.GetEvents(context.Background(), accountID, userID)
+		events, err := manager.GetEvents(context.Background(), accountID, userID, activity.Filter{})
 		if err != nil {
 			return
 		}
@@ -46,7 +49,7 @@ func TestDefaultAccountManager_GetEvents(t *testing.T) {
 
 	t.Run("get events", func(t *testing.T) {
 		generateAndStoreEvents(t, manager, activity.PeerAddedByUser, userID, "peer", accountID, 10)
-		events, err := manager.GetEvents(context.Background(), accountID, userID)
+		events, err := manager.GetEvents(context.Background(), accountID, userID, activity.Filter{})
 		if err != nil {
 			return
 		}
@@ -57,7 +60,7 @@ func TestDefaultAccountManager_GetEvents(t *testing.T) {
 
 	t.Run("get events without duplicates", func(t *testing.T) {
 		generateAndStoreEvents(t, manager, activity.UserJoined, userID, "", accountID, 10)
-		events, err := manager.GetEvents(context.Background(), accountID, userID)
+		events, err := manager.GetEvents(context.Background(), accountID, userID, activity.Filter{})
 		if err != nil {
 			return
 		}
@@ -65,3 +68,43 @@ func TestDefaultAccountManager_GetEvents(t *testing.T) {
 		_ = manager.eventStore.Close(context.Background()) //nolint
 	})
 }
+
+type recordingEventSink struct {
+	mu     sync.Mutex
+	events []uint64
+	fail   bool
+}
+
+func (s *recordingEventSink) Deliver(_ context.Context, event *activity.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fail {
+		return errors.New("sink unavailable")
+	}
+	s.events = append(s.events, event.ID)
+	return nil
+}
+
+func TestDefaultAccountManager_DeliverEventOutbox(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	sink := &recordingEventSink{fail: true}
+	manager.AddEventSink(sink)
+
+	generateAndStoreEvents(t, manager, activity.PeerAddedByUser, userID, "peer", "accountID", 3)
+
+	manager.deliverEventOutbox(ctx)
+	outbox, err := manager.eventStore.GetOutbox(ctx, 10)
+	require.NoError(t, err)
+	assert.Len(t, outbox, 3, "events should stay in the outbox when the sinks fail")
+
+	sink.fail = false
+	manager.deliverEventOutbox(ctx)
+	assert.Equal(t, []uint64{0, 1, 2}, sink.events, "events should be delivered in order")
+
+	outbox, err = manager.eventStore.GetOutbox(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, outbox, "delivered events should be removed from the outbox")
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strconv"
 
 	"github.com/rs/xid"
 	log "github.com/sirupsen/logrus"
@@ -14,6 +15,8 @@ import (
 
 	"github.com/netbirdio/netbird/management/server/activity"
 	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/rbac"
 	"github.com/netbirdio/netbird/management/server/status"
 )
 
@@ -37,7 +40,7 @@ func (am *DefaultAccountManager) CheckGroupPermissions(ctx context.Context, acco
 		return status.NewUserNotPartOfAccountError()
 	}
 
-	if user.IsRegularUser() {
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourceGroups, rbac.OperationRead) {
 		return status.NewAdminPermissionError()
 	}
 
@@ -85,7 +88,7 @@ func (am *DefaultAccountManager) SaveGroups(ctx context.Context, accountID, user
 		return status.NewUserNotPartOfAccountError()
 	}
 
-	if user.IsRegularUser() {
+	if user.IsRegularUser() && !am.canSaveGroups(ctx, user, groups) {
 		return status.NewAdminPermissionError()
 	}
 
@@ -134,6 +137,24 @@ func (am *DefaultAccountManager) SaveGroups(ctx context.Context, accountID, user
 	return nil
 }
 
+// canSaveGroups checks whether the custom role of the user allows creating new and updating existing groups.
+func (am *DefaultAccountManager) canSaveGroups(ctx context.Context, user *User, groups []*nbgroup.Group) bool {
+	for _, group := range groups {
+		operation := rbac.OperationCreate
+		if group.ID != "" {
+			if _, err := am.Store.GetGroupByID(ctx, LockingStrengthShare, user.AccountID, group.ID); err == nil {
+				operation = rbac.OperationUpdate
+			}
+		}
+
+		if !am.hasPermission(ctx, user, rbac.ResourceGroups, operation) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // prepareGroupEvents prepares a list of event functions to be stored.
 func (am *DefaultAccountManager) prepareGroupEvents(ctx context.Context, transaction Store, accountID, userID string, newGroup *nbgroup.Group) []func() {
 	var eventsToStore []func()
@@ -232,7 +253,7 @@ func (am *DefaultAccountManager) DeleteGroups(ctx context.Context, accountID, us
 		return status.NewUserNotPartOfAccountError()
 	}
 
-	if user.IsRegularUser() {
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourceGroups, rbac.OperationDelete) {
 		return status.NewAdminPermissionError()
 	}
 
@@ -289,6 +310,10 @@ func (am *DefaultAccountManager) GroupAddPeer(ctx context.Context, accountID, gr
 			return err
 		}
 
+		if group.IsDynamic() {
+			return status.Errorf(status.InvalidArgument, "peers can't be added to dynamic group %s", group.Name)
+		}
+
 		if updated := group.AddPeer(peerID); !updated {
 			return nil
 		}
@@ -330,6 +355,10 @@ func (am *DefaultAccountManager) GroupDeletePeer(ctx context.Context, accountID,
 			return err
 		}
 
+		if group.IsDynamic() {
+			return status.Errorf(status.InvalidArgument, "peers can't be removed from dynamic group %s", group.Name)
+		}
+
 		if updated := group.RemovePeer(peerID); !updated {
 			return nil
 		}
@@ -379,6 +408,10 @@ func validateNewGroup(ctx context.Context, transaction Store, accountID string,
 		newGroup.ID = xid.New().String()
 	}
 
+	if newGroup.IsDynamic() {
+		return validateGroupRule(ctx, transaction, accountID, newGroup)
+	}
+
 	for _, peerID := range newGroup.Peers {
 		_, err := transaction.GetPeerByID(ctx, LockingStrengthShare, accountID, peerID)
 		if err != nil {
@@ -590,3 +623,242 @@ func anyGroupHasPeers(ctx context.Context, transaction Store, accountID string,
 
 	return false, nil
 }
+
+// getPeerRuleAttributes returns the attributes of the peer and its user that dynamic group rules are evaluated against.
+func getPeerRuleAttributes(peer *nbpeer.Peer, user *User) map[string]string {
+	attributes := map[string]string{
+		nbgroup.RuleAttributeHostname:      peer.Meta.Hostname,
+		nbgroup.RuleAttributeOS:            peer.Meta.GoOS,
+		nbgroup.RuleAttributeOSName:        peer.Meta.OS,
+		nbgroup.RuleAttributeOSVersion:     peer.Meta.OSVersion,
+		nbgroup.RuleAttributeKernelVersion: peer.Meta.KernelVersion,
+		nbgroup.RuleAttributePlatform:      peer.Meta.Platform,
+		nbgroup.RuleAttributeVersion:       peer.Meta.WtVersion,
+		nbgroup.RuleAttributeUIVersion:     peer.Meta.UIVersion,
+		nbgroup.RuleAttributeCountry:       peer.Location.CountryCode,
+		nbgroup.RuleAttributeCity:          peer.Location.CityName,
+	}
+
+	if user != nil {
+		attributes[nbgroup.RuleAttributeUserID] = user.Id
+		attributes[nbgroup.RuleAttributeUserRole] = string(user.Role)
+		attributes[nbgroup.RuleAttributeServiceUser] = strconv.FormatBool(user.IsServiceUser)
+	}
+
+	for key, value := range peer.GetTags() {
+		attributes[nbgroup.RuleAttributeTagPrefix+key] = value
+	}
+
+	return attributes
+}
+
+// validateGroupRule checks the rule of a dynamic group and sets the group peers to the account peers matching it.
+// The membership events are prepared by the caller with prepareGroupEvents before the group is saved.
+func validateGroupRule(ctx context.Context, transaction Store, accountID string, group *nbgroup.Group) error {
+	if group.Issued != nbgroup.GroupIssuedAPI || group.IsGroupAll() {
+		return status.Errorf(status.InvalidArgument, "only API issued groups can be dynamic")
+	}
+
+	rule, err := nbgroup.ParseRule(group.Rule)
+	if err != nil {
+		return status.Errorf(status.InvalidArgument, "%s", err)
+	}
+
+	peers, err := transaction.GetAccountPeers(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return err
+	}
+
+	users, err := transaction.GetAccountUsers(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return err
+	}
+
+	usersMap := make(map[string]*User, len(users))
+	for _, user := range users {
+		usersMap[user.Id] = user
+	}
+
+	group.Peers = make([]string, 0)
+	for _, peer := range peers {
+		if rule.Matches(getPeerRuleAttributes(peer, usersMap[peer.UserID])) {
+			group.Peers = append(group.Peers, peer.ID)
+		}
+	}
+
+	return nil
+}
+
+// updatePeerDynamicGroups evaluates the rules of the dynamic groups of the account against the peer
+// and adds or removes the peer from the groups accordingly.
+// It returns the updated groups and the membership events to store once the transaction is committed.
+func (am *DefaultAccountManager) updatePeerDynamicGroups(ctx context.Context, transaction Store, accountID string, peer *nbpeer.Peer) ([]*nbgroup.Group, []func(), error) {
+	groups, err := transaction.GetAccountGroups(ctx, LockingStrengthUpdate, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user *User
+	var attributes map[string]string
+	var updatedGroups []*nbgroup.Group
+	var eventsToStore []func()
+
+	for _, group := range groups {
+		if !group.IsDynamic() {
+			continue
+		}
+
+		rule, err := nbgroup.ParseRule(group.Rule)
+		if err != nil {
+			log.WithContext(ctx).Errorf("group %s has an invalid rule under account %s: %v", group.ID, accountID, err)
+			continue
+		}
+
+		if attributes == nil {
+			if peer.UserID != "" {
+				user, err = transaction.GetUserByUserID(ctx, LockingStrengthShare, peer.UserID)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			attributes = getPeerRuleAttributes(peer, user)
+		}
+
+		action, updated := updateDynamicGroupPeer(group, peer.ID, rule.Matches(attributes))
+		if !updated {
+			continue
+		}
+
+		updatedGroups = append(updatedGroups, group)
+		eventsToStore = append(eventsToStore, am.dynamicGroupPeerEvent(ctx, accountID, group, peer, action))
+	}
+
+	if len(updatedGroups) == 0 {
+		return nil, nil, nil
+	}
+
+	if err = transaction.SaveGroups(ctx, LockingStrengthUpdate, updatedGroups); err != nil {
+		return nil, nil, err
+	}
+
+	if err = transaction.IncrementNetworkSerial(ctx, LockingStrengthUpdate, accountID); err != nil {
+		return nil, nil, err
+	}
+
+	return updatedGroups, eventsToStore, nil
+}
+
+// updateUsersPeersDynamicGroups evaluates the rules of the dynamic groups of the account against the peers of the users
+// and adds or removes the peers from the groups accordingly. It is used when the user attributes matched by the rules
+// change, the groups are updated in place and have to be saved with the account.
+// It returns the IDs of the updated groups and the membership events to store once the account is saved.
+func (am *DefaultAccountManager) updateUsersPeersDynamicGroups(ctx context.Context, account *Account, userIDs []string) ([]string, []func()) {
+	var peers []*nbpeer.Peer
+	attributes := make(map[string]map[string]string)
+	for _, userID := range userIDs {
+		user, ok := account.Users[userID]
+		if !ok {
+			continue
+		}
+
+		userPeers, err := account.FindUserPeers(userID)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed to find peers of user %s under account %s: %v", userID, account.Id, err)
+			continue
+		}
+
+		for _, peer := range userPeers {
+			peers = append(peers, peer)
+			attributes[peer.ID] = getPeerRuleAttributes(peer, user)
+		}
+	}
+
+	var updatedGroupIDs []string
+	var eventsToStore []func()
+
+	for _, group := range account.Groups {
+		if !group.IsDynamic() {
+			continue
+		}
+
+		rule, err := nbgroup.ParseRule(group.Rule)
+		if err != nil {
+			log.WithContext(ctx).Errorf("group %s has an invalid rule under account %s: %v", group.ID, account.Id, err)
+			continue
+		}
+
+		groupUpdated := false
+		for _, peer := range peers {
+			action, updated := updateDynamicGroupPeer(group, peer.ID, rule.Matches(attributes[peer.ID]))
+			if !updated {
+				continue
+			}
+
+			groupUpdated = true
+			eventsToStore = append(eventsToStore, am.dynamicGroupPeerEvent(ctx, account.Id, group, peer, action))
+		}
+
+		if groupUpdated {
+			updatedGroupIDs = append(updatedGroupIDs, group.ID)
+		}
+	}
+
+	return updatedGroupIDs, eventsToStore
+}
+
+// updateDynamicGroupPeer adds the peer to the dynamic group when it matches the group rule and removes it otherwise.
+// It returns the membership activity and whether the group was updated.
+func updateDynamicGroupPeer(group *nbgroup.Group, peerID string, matches bool) (activity.Activity, bool) {
+	switch {
+	case matches && group.AddPeer(peerID):
+		return activity.GroupAddedToPeer, true
+	case !matches && group.RemovePeer(peerID):
+		return activity.GroupRemovedFromPeer, true
+	default:
+		return 0, false
+	}
+}
+
+// dynamicGroupPeerEvent returns the function storing the membership event of the peer in the dynamic group
+func (am *DefaultAccountManager) dynamicGroupPeerEvent(ctx context.Context, accountID string, group *nbgroup.Group, peer *nbpeer.Peer, action activity.Activity) func() {
+	return func() {
+		meta := map[string]any{
+			"group": group.Name, "group_id": group.ID,
+			"peer_ip": peer.IP.String(), "peer_fqdn": peer.FQDN(am.GetDNSDomain()),
+		}
+		am.StoreEvent(ctx, activity.SystemInitiator, peer.ID, accountID, action, meta)
+	}
+}
+
+// syncPeerDynamicGroups updates the membership of the peer in the dynamic groups of the account.
+// It returns the updated groups and whether the membership changes affect other peers.
+func (am *DefaultAccountManager) syncPeerDynamicGroups(ctx context.Context, accountID string, peer *nbpeer.Peer) ([]*nbgroup.Group, bool, error) {
+	var updatedGroups []*nbgroup.Group
+	var eventsToStore []func()
+	var updateAccountPeers bool
+
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		var err error
+		updatedGroups, eventsToStore, err = am.updatePeerDynamicGroups(ctx, transaction, accountID, peer)
+		if err != nil {
+			return err
+		}
+
+		groupIDs := make([]string, 0, len(updatedGroups))
+		for _, group := range updatedGroups {
+			groupIDs = append(groupIDs, group.ID)
+		}
+
+		updateAccountPeers, err = areGroupChangesAffectPeers(ctx, transaction, accountID, groupIDs)
+		return err
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to update dynamic groups of peer %s: %w", peer.ID, err)
+	}
+
+	for _, storeEvent := range eventsToStore {
+		storeEvent()
+	}
+
+	return updatedGroups, updateAccountPeers, nil
+}
@@ -25,6 +25,10 @@ type Group struct {
 	// Peers list of the group
 	Peers []string `gorm:"serializer:json"`
 
+	// Rule is an optional expression evaluated against the peer attributes. When set, the group membership
+	// is managed by the system and recomputed whenever peers log in or sync. See ParseRule for the syntax.
+	Rule string
+
 	IntegrationReference integration_reference.IntegrationReference `gorm:"embedded;embeddedPrefix:integration_ref_"`
 }
 
@@ -39,6 +43,7 @@ func (g *Group) Copy() *Group {
 		Name:                 g.Name,
 		Issued:               g.Issued,
 		Peers:                make([]string, len(g.Peers)),
+		Rule:                 g.Rule,
 		IntegrationReference: g.IntegrationReference,
 	}
 	copy(group.Peers, g.Peers)
@@ -50,6 +55,11 @@ func (g *Group) HasPeers() bool {
 	return len(g.Peers) > 0
 }
 
+// IsDynamic checks if the group membership is defined by a rule.
+func (g *Group) IsDynamic() bool {
+	return g.Rule != ""
+}
+
 // IsGroupAll checks if the group is a default "All" group.
 func (g *Group) IsGroupAll() bool {
 	return g.Name == "All"
@@ -0,0 +1,264 @@
+package group
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+)
+
+// Peer attributes that can be referenced by dynamic group rules.
+// Peer tags are referenced with the RuleAttributeTagPrefix followed by the tag key, e.g. "tag.env".
+const (
+	RuleAttributeHostname      = "hostname"
+	RuleAttributeOS            = "os"
+	RuleAttributeOSName        = "os_name"
+	RuleAttributeOSVersion     = "os_version"
+	RuleAttributeKernelVersion = "kernel_version"
+	RuleAttributePlatform      = "platform"
+	RuleAttributeVersion       = "version"
+	RuleAttributeUIVersion     = "ui_version"
+	RuleAttributeCountry       = "country"
+	RuleAttributeCity          = "city"
+	RuleAttributeUserID        = "user.id"
+	RuleAttributeUserRole      = "user.role"
+	RuleAttributeServiceUser   = "user.service_user"
+	RuleAttributeTagPrefix     = "tag."
+)
+
+var (
+	ruleAttributes = []string{
+		RuleAttributeHostname, RuleAttributeOS, RuleAttributeOSName, RuleAttributeOSVersion, RuleAttributeKernelVersion,
+		RuleAttributePlatform, RuleAttributeVersion, RuleAttributeUIVersion, RuleAttributeCountry, RuleAttributeCity,
+		RuleAttributeUserID, RuleAttributeUserRole, RuleAttributeServiceUser,
+	}
+
+	ruleRequirementRegexp = regexp.MustCompile(`^(!)?\s*([A-Za-z0-9._/-]+)\s*(?:(==|=|!=|>=|<=|>|<)\s*("[^"]*"|[^\s"()]+)|\s+(in|notin)\s*\((.*)\))?$`)
+)
+
+type ruleOperator string
+
+const (
+	ruleOpExists       ruleOperator = "exists"
+	ruleOpDoesNotExist ruleOperator = "!"
+	ruleOpEquals       ruleOperator = "="
+	ruleOpNotEquals    ruleOperator = "!="
+	ruleOpGreater      ruleOperator = ">"
+	ruleOpGreaterEqual ruleOperator = ">="
+	ruleOpLess         ruleOperator = "<"
+	ruleOpLessEqual    ruleOperator = "<="
+	ruleOpIn           ruleOperator = "in"
+	ruleOpNotIn        ruleOperator = "notin"
+)
+
+// Rule is a parsed dynamic group rule. A rule is a comma separated list of requirements on the peer attributes
+// that all have to be satisfied, e.g. `os=linux,version>=0.30.0,country in (DE,AT)`.
+// Values are compared case-insensitively, the ordering operators compare versions.
+type Rule struct {
+	requirements []ruleRequirement
+}
+
+type ruleRequirement struct {
+	attribute string
+	operator  ruleOperator
+	values    []string
+}
+
+// ParseRule parses a dynamic group rule expression.
+// Supported requirements are "attr", "!attr", "attr=value", "attr==value", "attr!=value", "attr>=version",
+// "attr>version", "attr<=version", "attr<version", "attr in (v1,v2)" and "attr notin (v1,v2)".
+// Values containing spaces can be double-quoted.
+func ParseRule(expr string) (*Rule, error) {
+	parts, err := splitRule(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &Rule{}
+	for _, part := range parts {
+		requirement, err := parseRuleRequirement(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group rule %q: %w", expr, err)
+		}
+		rule.requirements = append(rule.requirements, requirement)
+	}
+
+	return rule, nil
+}
+
+// Matches returns true if the given attributes satisfy all requirements of the rule
+func (r *Rule) Matches(attributes map[string]string) bool {
+	for _, requirement := range r.requirements {
+		if !requirement.matches(attributes) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r ruleRequirement) matches(attributes map[string]string) bool {
+	value, exists := attributes[r.attribute]
+	exists = exists && value != ""
+
+	contains := func() bool {
+		return slices.ContainsFunc(r.values, func(v string) bool { return strings.EqualFold(v, value) })
+	}
+
+	switch r.operator {
+	case ruleOpExists:
+		return exists
+	case ruleOpDoesNotExist:
+		return !exists
+	case ruleOpEquals, ruleOpIn:
+		return exists && contains()
+	case ruleOpNotEquals, ruleOpNotIn:
+		return !exists || !contains()
+	case ruleOpGreater, ruleOpGreaterEqual, ruleOpLess, ruleOpLessEqual:
+		return exists && compareVersions(value, r.operator, r.values[0])
+	default:
+		return false
+	}
+}
+
+func compareVersions(value string, operator ruleOperator, expected string) bool {
+	current, err := version.NewVersion(value)
+	if err != nil {
+		return false
+	}
+	target, err := version.NewVersion(expected)
+	if err != nil {
+		return false
+	}
+
+	switch operator {
+	case ruleOpGreater:
+		return current.GreaterThan(target)
+	case ruleOpGreaterEqual:
+		return current.GreaterThanOrEqual(target)
+	case ruleOpLess:
+		return current.LessThan(target)
+	case ruleOpLessEqual:
+		return current.LessThanOrEqual(target)
+	default:
+		return false
+	}
+}
+
+// splitRule splits the expression on commas that are not enclosed in parentheses or quotes
+func splitRule(expr string) ([]string, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, fmt.Errorf("group rule is empty")
+	}
+
+	var parts []string
+	depth := 0
+	start := 0
+	quoted := false
+	for i, c := range expr {
+		switch {
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == '(':
+			depth++
+			if depth > 1 {
+				return nil, fmt.Errorf("invalid group rule %q: nested parentheses", expr)
+			}
+		case c == ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("invalid group rule %q: unbalanced parentheses", expr)
+			}
+		case c == ',' && depth == 0:
+			parts = append(parts, strings.TrimSpace(expr[start:i]))
+			start = i + 1
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("invalid group rule %q: unterminated quote", expr)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("invalid group rule %q: unbalanced parentheses", expr)
+	}
+
+	return append(parts, strings.TrimSpace(expr[start:])), nil
+}
+
+func parseRuleRequirement(expr string) (ruleRequirement, error) {
+	if expr == "" {
+		return ruleRequirement{}, fmt.Errorf("empty requirement")
+	}
+
+	match := ruleRequirementRegexp.FindStringSubmatch(expr)
+	if match == nil {
+		return ruleRequirement{}, fmt.Errorf("unsupported requirement %q", expr)
+	}
+	negated, attribute, operator, value, setOperator, set := match[1], match[2], match[3], match[4], match[5], match[6]
+
+	if err := validateRuleAttribute(attribute); err != nil {
+		return ruleRequirement{}, err
+	}
+
+	switch {
+	case negated != "" && (operator != "" || setOperator != ""):
+		return ruleRequirement{}, fmt.Errorf("negation can only be used with an attribute in %q", expr)
+	case negated != "":
+		return ruleRequirement{attribute: attribute, operator: ruleOpDoesNotExist}, nil
+	case operator != "":
+		return parseComparison(attribute, ruleOperator(operator), unquote(value))
+	case setOperator != "":
+		values, err := parseValueSet(set)
+		if err != nil {
+			return ruleRequirement{}, fmt.Errorf("%w in %q", err, expr)
+		}
+		return ruleRequirement{attribute: attribute, operator: ruleOperator(setOperator), values: values}, nil
+	default:
+		return ruleRequirement{attribute: attribute, operator: ruleOpExists}, nil
+	}
+}
+
+func parseComparison(attribute string, operator ruleOperator, value string) (ruleRequirement, error) {
+	switch operator {
+	case "==":
+		operator = ruleOpEquals
+	case ruleOpGreater, ruleOpGreaterEqual, ruleOpLess, ruleOpLessEqual:
+		if _, err := version.NewVersion(value); err != nil {
+			return ruleRequirement{}, fmt.Errorf("operator %s requires a version, got %q", operator, value)
+		}
+	}
+	return ruleRequirement{attribute: attribute, operator: operator, values: []string{value}}, nil
+}
+
+func parseValueSet(set string) ([]string, error) {
+	var values []string
+	for _, value := range strings.Split(set, ",") {
+		value = unquote(strings.TrimSpace(value))
+		if value == "" {
+			continue
+		}
+		values = append(values, value)
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("empty value set")
+	}
+	return values, nil
+}
+
+func validateRuleAttribute(attribute string) error {
+	if strings.HasPrefix(attribute, RuleAttributeTagPrefix) && len(attribute) > len(RuleAttributeTagPrefix) {
+		return nil
+	}
+	if !slices.Contains(ruleAttributes, attribute) {
+		return fmt.Errorf("unknown attribute %q", attribute)
+	}
+	return nil
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
@@ -0,0 +1,69 @@
+package group
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRule(t *testing.T) {
+	attributes := map[string]string{
+		RuleAttributeOS:       "linux",
+		RuleAttributeOSName:   "Ubuntu 22.04",
+		RuleAttributeVersion:  "0.30.2",
+		RuleAttributeCountry:  "DE",
+		RuleAttributeUserRole: "admin",
+		"tag.env":             "prod",
+	}
+
+	tt := []struct {
+		name        string
+		expr        string
+		expectError bool
+		matches     bool
+	}{
+		{name: "equality", expr: "os=linux", matches: true},
+		{name: "equality is case insensitive", expr: "country==de", matches: true},
+		{name: "inequality", expr: "os!=linux", matches: false},
+		{name: "quoted value", expr: `os_name="Ubuntu 22.04"`, matches: true},
+		{name: "version greater or equal", expr: "version>=0.30.0", matches: true},
+		{name: "version greater", expr: "version>0.30.2", matches: false},
+		{name: "version less", expr: "version<0.31", matches: true},
+		{name: "version less or equal", expr: "version<=0.29.0", matches: false},
+		{name: "exists", expr: "user.role", matches: true},
+		{name: "does not exist", expr: "!city", matches: true},
+		{name: "in", expr: "country in (DE, AT)", matches: true},
+		{name: "notin", expr: "country notin (DE,AT)", matches: false},
+		{name: "tag", expr: "tag.env=prod", matches: true},
+		{name: "combined", expr: "os=linux,version>=0.30.0,country in (DE)", matches: true},
+		{name: "combined not matching", expr: "os=linux,country in (FR)", matches: false},
+		{name: "empty", expr: "", expectError: true},
+		{name: "empty requirement", expr: "os=linux,", expectError: true},
+		{name: "unknown attribute", expr: "serial=1234", expectError: true},
+		{name: "ordering requires version", expr: "os>=linux", expectError: true},
+		{name: "negated comparison", expr: "!os=linux", expectError: true},
+		{name: "unterminated quote", expr: `os_name="Ubuntu`, expectError: true},
+		{name: "unbalanced parentheses", expr: "country in (DE", expectError: true},
+		{name: "empty value set", expr: "country in ()", expectError: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := ParseRule(tc.expr)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.matches, rule.Matches(attributes))
+		})
+	}
+}
+
+func TestRuleMatchesInvalidVersion(t *testing.T) {
+	rule, err := ParseRule("version>=0.30.0")
+	require.NoError(t, err)
+	assert.False(t, rule.Matches(map[string]string{RuleAttributeVersion: "development"}))
+	assert.False(t, rule.Matches(map[string]string{}))
+}
@@ -10,9 +10,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
 
 	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/server/activity"
 	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/route"
 )
@@ -267,6 +270,112 @@ func TestDefaultAccountManager_DeleteGroups(t *testing.T) {
 	}
 }
 
+func TestDefaultAccountManager_DynamicGroup(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	userID := "account_creator"
+	account, err := createAccount(manager, "test_account", userID, "")
+	require.NoError(t, err)
+
+	setupKey, err := manager.CreateSetupKey(ctx, account.Id, "test-key", SetupKeyReusable, time.Hour, nil, 999, userID, false)
+	require.NoError(t, err)
+
+	addPeer := func(meta nbpeer.PeerSystemMeta) *nbpeer.Peer {
+		key, err := wgtypes.GeneratePrivateKey()
+		require.NoError(t, err)
+		peer, _, _, err := manager.AddPeer(ctx, setupKey.Key, "", &nbpeer.Peer{Key: key.PublicKey().String(), Meta: meta})
+		require.NoError(t, err)
+		return peer
+	}
+
+	linuxPeer := addPeer(nbpeer.PeerSystemMeta{Hostname: "linux-peer", GoOS: "linux", WtVersion: "0.30.1"})
+	windowsPeer := addPeer(nbpeer.PeerSystemMeta{Hostname: "windows-peer", GoOS: "windows", WtVersion: "0.29.0"})
+
+	group := &nbgroup.Group{
+		Name:   "linux servers",
+		Issued: nbgroup.GroupIssuedAPI,
+		Peers:  []string{windowsPeer.ID},
+		Rule:   "os=linux,version>=0.30.0",
+	}
+	err = manager.SaveGroup(ctx, account.Id, userID, group)
+	require.NoError(t, err)
+	assert.Equal(t, []string{linuxPeer.ID}, group.Peers, "the membership of dynamic groups should be computed from the rule")
+
+	t.Run("invalid rule", func(t *testing.T) {
+		err := manager.SaveGroup(ctx, account.Id, userID, &nbgroup.Group{Name: "invalid", Issued: nbgroup.GroupIssuedAPI, Rule: "os>=linux"})
+		require.Error(t, err)
+	})
+
+	t.Run("peers can't be added manually", func(t *testing.T) {
+		err := manager.GroupAddPeer(ctx, account.Id, group.ID, windowsPeer.ID)
+		require.Error(t, err)
+	})
+
+	t.Run("new peer is added", func(t *testing.T) {
+		newPeer := addPeer(nbpeer.PeerSystemMeta{Hostname: "new-peer", GoOS: "linux", WtVersion: "0.31.0"})
+
+		storedGroup, err := manager.Store.GetGroupByID(ctx, LockingStrengthShare, account.Id, group.ID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{linuxPeer.ID, newPeer.ID}, storedGroup.Peers)
+	})
+
+	t.Run("membership is updated on sync", func(t *testing.T) {
+		err := manager.SyncPeerMeta(ctx, windowsPeer.Key, nbpeer.PeerSystemMeta{Hostname: "windows-peer", GoOS: "linux", WtVersion: "0.30.0"})
+		require.NoError(t, err)
+		err = manager.SyncPeerMeta(ctx, linuxPeer.Key, nbpeer.PeerSystemMeta{Hostname: "linux-peer", GoOS: "linux", WtVersion: "0.29.5"})
+		require.NoError(t, err)
+
+		storedGroup, err := manager.Store.GetGroupByID(ctx, LockingStrengthShare, account.Id, group.ID)
+		require.NoError(t, err)
+		assert.Contains(t, storedGroup.Peers, windowsPeer.ID)
+		assert.NotContains(t, storedGroup.Peers, linuxPeer.ID)
+
+		assert.Eventually(t, func() bool {
+			events, err := manager.eventStore.Get(ctx, account.Id, 0, 100, false)
+			if err != nil {
+				return false
+			}
+			var added, removed bool
+			for _, event := range events {
+				added = added || event.Activity == activity.GroupAddedToPeer && event.TargetID == windowsPeer.ID
+				removed = removed || event.Activity == activity.GroupRemovedFromPeer && event.TargetID == linuxPeer.ID
+			}
+			return added && removed
+		}, time.Second, 10*time.Millisecond, "membership changes should be recorded as activity events")
+	})
+
+	t.Run("membership is updated on user role change", func(t *testing.T) {
+		_, err := manager.SaveOrAddUser(ctx, account.Id, userID, &User{Id: "role_user", Role: UserRoleUser, AutoGroups: []string{}}, true)
+		require.NoError(t, err)
+
+		key, err := wgtypes.GeneratePrivateKey()
+		require.NoError(t, err)
+		userPeer, _, _, err := manager.AddPeer(ctx, "", "role_user", &nbpeer.Peer{Key: key.PublicKey().String(), Meta: nbpeer.PeerSystemMeta{Hostname: "user-peer"}})
+		require.NoError(t, err)
+
+		adminsGroup := &nbgroup.Group{Name: "admin peers", Issued: nbgroup.GroupIssuedAPI, Rule: "user.role=admin"}
+		err = manager.SaveGroup(ctx, account.Id, userID, adminsGroup)
+		require.NoError(t, err)
+		assert.NotContains(t, adminsGroup.Peers, userPeer.ID)
+
+		_, err = manager.SaveOrAddUser(ctx, account.Id, userID, &User{Id: "role_user", Role: UserRoleAdmin, AutoGroups: []string{}}, false)
+		require.NoError(t, err)
+
+		storedGroup, err := manager.Store.GetGroupByID(ctx, LockingStrengthShare, account.Id, adminsGroup.ID)
+		require.NoError(t, err)
+		assert.Contains(t, storedGroup.Peers, userPeer.ID, "the peers of the user should be added when the role matches")
+
+		_, err = manager.SaveOrAddUser(ctx, account.Id, userID, &User{Id: "role_user", Role: UserRoleUser, AutoGroups: []string{}}, false)
+		require.NoError(t, err)
+
+		storedGroup, err = manager.Store.GetGroupByID(ctx, LockingStrengthShare, account.Id, adminsGroup.ID)
+		require.NoError(t, err)
+		assert.NotContains(t, storedGroup.Peers, userPeer.ID, "the peers of the user should be removed when the role doesn't match")
+	})
+}
+
 func initTestGroupAccount(am *DefaultAccountManager) (*DefaultAccountManager, *Account, error) {
 	accountID := "testingAcc"
 	domain := "example.com"
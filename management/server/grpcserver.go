@@ -16,6 +16,7 @@ import (
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/netbirdio/netbird/encryption"
 	"github.com/netbirdio/netbird/management/proto"
@@ -184,7 +185,9 @@ func (s *GRPCServer) Sync(req *proto.EncryptedMessage, srv proto.ManagementServi
 		return mapError(ctx, err)
 	}
 
-	err = s.sendInitialSync(ctx, peerKey, peer, netMap, postureChecks, srv)
+	deltas := newNetworkMapDeltas(syncReq.GetCapabilities())
+
+	err = s.sendInitialSync(ctx, peerKey, peer, netMap, postureChecks, deltas, srv)
 	if err != nil {
 		log.WithContext(ctx).Debugf("error while sending initial sync for %s: %v", peerKey.String(), err)
 		return err
@@ -203,11 +206,12 @@ func (s *GRPCServer) Sync(req *proto.EncryptedMessage, srv proto.ManagementServi
 	unlock()
 	unlock = nil
 
-	return s.handleUpdates(ctx, accountID, peerKey, peer, updates, srv)
+	return s.handleUpdates(ctx, accountID, peerKey, peer, updates, deltas, srv)
 }
 
 // handleUpdates sends updates to the connected peer until the updates channel is closed.
-func (s *GRPCServer) handleUpdates(ctx context.Context, accountID string, peerKey wgtypes.Key, peer *nbpeer.Peer, updates chan *UpdateMessage, srv proto.ManagementService_SyncServer) error {
+// Network maps are sent as deltas to the peers supporting them.
+func (s *GRPCServer) handleUpdates(ctx context.Context, accountID string, peerKey wgtypes.Key, peer *nbpeer.Peer, updates chan *UpdateMessage, deltas *networkMapDeltas, srv proto.ManagementService_SyncServer) error {
 	log.WithContext(ctx).Tracef("starting to handle updates for peer %s", peerKey.String())
 	for {
 		select {
@@ -224,6 +228,7 @@ func (s *GRPCServer) handleUpdates(ctx context.Context, accountID string, peerKe
 			}
 			log.WithContext(ctx).Debugf("received an update for peer %s", peerKey.String())
 
+			update = &UpdateMessage{Update: deltas.prepare(update.Update), NetworkMap: update.NetworkMap}
 			if err := s.sendUpdate(ctx, accountID, peerKey, peer, update, srv); err != nil {
 				return err
 			}
@@ -367,6 +372,37 @@ func extractPeerMeta(ctx context.Context, meta *proto.PeerSystemMeta) nbpeer.Pee
 		})
 	}
 
+	diskEncryption := make([]nbpeer.DiskEncryptionVolume, 0, len(meta.GetDiskEncryption()))
+	for _, volume := range meta.GetDiskEncryption() {
+		diskEncryption = append(diskEncryption, nbpeer.DiskEncryptionVolume{
+			Path:      volume.GetPath(),
+			Encrypted: volume.GetEncrypted(),
+		})
+	}
+
+	fileHashes := make([]nbpeer.FileHash, 0, len(meta.GetFileHashes()))
+	for _, file := range meta.GetFileHashes() {
+		fileHashes = append(fileHashes, nbpeer.FileHash{
+			Path:   file.GetPath(),
+			SHA256: file.GetSha256(),
+		})
+	}
+
+	fileContents := make([]nbpeer.FileContentMatch, 0, len(meta.GetFileContents()))
+	for _, file := range meta.GetFileContents() {
+		fileContents = append(fileContents, nbpeer.FileContentMatch{
+			Path:    file.GetPath(),
+			Pattern: file.GetPattern(),
+			Matched: file.GetMatched(),
+		})
+	}
+
+	tags := meta.GetTags()
+	if err := nbpeer.ValidateTags(tags); err != nil {
+		log.WithContext(ctx).Warnf("ignoring peer reported tags: %v", err)
+		tags = nil
+	}
+
 	return nbpeer.PeerSystemMeta{
 		Hostname:           meta.GetHostname(),
 		GoOS:               meta.GetGoOS(),
@@ -385,7 +421,12 @@ func extractPeerMeta(ctx context.Context, meta *proto.PeerSystemMeta) nbpeer.Pee
 			Cloud:    meta.GetEnvironment().GetCloud(),
 			Platform: meta.GetEnvironment().GetPlatform(),
 		},
-		Files: files,
+		Files:           files,
+		Tags:            tags,
+		DiskEncryption:  diskEncryption,
+		FirewallEnabled: meta.GetFirewallEnabled(),
+		FileHashes:      fileHashes,
+		FileContents:    fileContents,
 	}
 }
 
@@ -603,10 +644,37 @@ func toPeerConfig(peer *nbpeer.Peer, network *Network, dnsName string) *proto.Pe
 	netmask, _ := network.Net.Mask.Size()
 	fqdn := peer.FQDN(dnsName)
 	return &proto.PeerConfig{
-		Address:   fmt.Sprintf("%s/%d", peer.IP.String(), netmask), // take it from the network
-		SshConfig: &proto.SSHConfig{SshEnabled: peer.SSHEnabled},
-		Fqdn:      fqdn,
+		Address:          fmt.Sprintf("%s/%d", peer.IP.String(), netmask), // take it from the network
+		SshConfig:        &proto.SSHConfig{SshEnabled: peer.SSHEnabled},
+		Fqdn:             fqdn,
+		RequiresApproval: isPeerPendingApproval(peer),
+		PostureStatus:    toProtocolPostureStatus(peer.PostureStatus),
+	}
+}
+
+// toProtocolPostureStatus converts the posture status evaluated for the peer, so the client shows why its access is
+// restricted
+func toProtocolPostureStatus(postureStatus nbpeer.PostureStatus) *proto.PostureStatus {
+	if postureStatus.State == "" {
+		return nil
+	}
+
+	protoStatus := &proto.PostureStatus{
+		State:  string(postureStatus.State),
+		Checks: make([]*proto.PostureCheckStatus, 0, len(postureStatus.Checks)),
+	}
+	for _, check := range postureStatus.Checks {
+		protoCheck := &proto.PostureCheckStatus{
+			Name:    check.PostureCheckName,
+			State:   string(check.State),
+			Reasons: check.Reasons,
+		}
+		if !check.GraceExpiresAt.IsZero() {
+			protoCheck.GraceExpiresAt = timestamppb.New(check.GraceExpiresAt)
+		}
+		protoStatus.Checks = append(protoStatus.Checks, protoCheck)
 	}
+	return protoStatus
 }
 
 func toSyncResponse(ctx context.Context, config *Config, peer *nbpeer.Peer, turnCredentials *Token, relayCredentials *Token, networkMap *NetworkMap, dnsName string, checks []*posture.Checks, dnsCache *DNSConfigCache) *proto.SyncResponse {
@@ -632,7 +700,7 @@ func toSyncResponse(ctx context.Context, config *Config, peer *nbpeer.Peer, turn
 
 	response.NetworkMap.OfflinePeers = appendRemotePeerConfig(nil, networkMap.OfflinePeers, dnsName)
 
-	firewallRules := toProtocolFirewallRules(networkMap.FirewallRules)
+	firewallRules := toProtocolFirewallRules(filterPeerAuditRules(peer, networkMap.FirewallRules))
 	response.NetworkMap.FirewallRules = firewallRules
 	response.NetworkMap.FirewallRulesIsEmpty = len(firewallRules) == 0
 
@@ -661,7 +729,7 @@ func (s *GRPCServer) IsHealthy(ctx context.Context, req *proto.Empty) (*proto.Em
 }
 
 // sendInitialSync sends initial proto.SyncResponse to the peer requesting synchronization
-func (s *GRPCServer) sendInitialSync(ctx context.Context, peerKey wgtypes.Key, peer *nbpeer.Peer, networkMap *NetworkMap, postureChecks []*posture.Checks, srv proto.ManagementService_SyncServer) error {
+func (s *GRPCServer) sendInitialSync(ctx context.Context, peerKey wgtypes.Key, peer *nbpeer.Peer, networkMap *NetworkMap, postureChecks []*posture.Checks, deltas *networkMapDeltas, srv proto.ManagementService_SyncServer) error {
 	var err error
 
 	var turnToken *Token
@@ -680,7 +748,7 @@ func (s *GRPCServer) sendInitialSync(ctx context.Context, peerKey wgtypes.Key, p
 		}
 	}
 
-	plainResp := toSyncResponse(ctx, s.config, peer, turnToken, relayToken, networkMap, s.accountManager.GetDNSDomain(), postureChecks, nil)
+	plainResp := deltas.prepare(toSyncResponse(ctx, s.config, peer, turnToken, relayToken, networkMap, s.accountManager.GetDNSDomain(), postureChecks, nil))
 
 	encryptedResp, err := encryption.EncryptMessage(peerKey, s.wgKey, plainResp)
 	if err != nil {
@@ -822,6 +890,17 @@ func (s *GRPCServer) SyncMeta(ctx context.Context, req *proto.EncryptedMessage)
 		return nil, mapError(ctx, err)
 	}
 
+	if len(syncMetaReq.GetAuditCounts()) > 0 {
+		auditCounts := make(map[string]uint64, len(syncMetaReq.GetAuditCounts()))
+		for _, count := range syncMetaReq.GetAuditCounts() {
+			auditCounts[count.GetPolicyID()] += count.GetPackets()
+		}
+
+		if err = s.accountManager.SyncPeerAuditCounts(ctx, peerKey.String(), auditCounts); err != nil {
+			return nil, mapError(ctx, err)
+		}
+	}
+
 	return &proto.Empty{}, nil
 }
 
@@ -853,5 +932,24 @@ func toProtocolCheck(postureCheck *posture.Checks) *proto.Checks {
 		}
 	}
 
+	if check := postureCheck.Checks.FileContentCheck; check != nil {
+		for _, file := range check.Files {
+			for _, path := range []string{file.LinuxPath, file.MacPath, file.WindowsPath} {
+				if path == "" {
+					continue
+				}
+				if file.SHA256 != "" {
+					protoCheck.FileHashes = append(protoCheck.FileHashes, path)
+				}
+				if file.ContentRegex != "" {
+					protoCheck.FileContents = append(protoCheck.FileContents, &proto.FileContentPattern{
+						Path:    path,
+						Pattern: file.ContentRegex,
+					})
+				}
+			}
+		}
+	}
+
 	return protoCheck
 }
@@ -0,0 +1,173 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// AccessRequestsHandler is a handler that manages just-in-time access requests of the account
+type AccessRequestsHandler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+// NewAccessRequestsHandler creates a new AccessRequestsHandler
+func NewAccessRequestsHandler(accountManager server.AccountManager, authCfg AuthCfg) *AccessRequestsHandler {
+	return &AccessRequestsHandler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// GetAllAccessRequests list for the account. Regular users only get their own requests.
+func (h *AccessRequestsHandler) GetAllAccessRequests(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	requests, err := h.accountManager.ListAccessRequests(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	resp := make([]*api.AccessRequest, 0, len(requests))
+	for _, request := range requests {
+		resp = append(resp, toAccessRequestResponse(request))
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
+// CreateAccessRequest handles access request creation
+func (h *AccessRequestsHandler) CreateAccessRequest(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PostApiAccessRequestsJSONRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	if req.Reason == "" {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "access request reason shouldn't be empty"), w)
+		return
+	}
+
+	duration := time.Duration(req.Duration) * time.Second
+	request, err := h.accountManager.CreateAccessRequest(r.Context(), accountID, userID, req.Groups, duration, req.Reason)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toAccessRequestResponse(request))
+}
+
+// GetAccessRequest handles an access request Get request identified by ID
+func (h *AccessRequestsHandler) GetAccessRequest(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	requestID := mux.Vars(r)["requestId"]
+	if len(requestID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid access request ID"), w)
+		return
+	}
+
+	request, err := h.accountManager.GetAccessRequest(r.Context(), accountID, requestID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toAccessRequestResponse(request))
+}
+
+// ApproveAccessRequest handles the approval of an access request identified by ID
+func (h *AccessRequestsHandler) ApproveAccessRequest(w http.ResponseWriter, r *http.Request) {
+	h.reviewAccessRequest(w, r, h.accountManager.ApproveAccessRequest)
+}
+
+// DenyAccessRequest handles the denial of an access request identified by ID
+func (h *AccessRequestsHandler) DenyAccessRequest(w http.ResponseWriter, r *http.Request) {
+	h.reviewAccessRequest(w, r, h.accountManager.DenyAccessRequest)
+}
+
+type reviewAccessRequestFunc func(ctx context.Context, accountID, requestID, userID string) (*server.AccessRequest, error)
+
+// reviewAccessRequest handles approve and deny requests
+func (h *AccessRequestsHandler) reviewAccessRequest(w http.ResponseWriter, r *http.Request, review reviewAccessRequestFunc) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	requestID := mux.Vars(r)["requestId"]
+	if len(requestID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid access request ID"), w)
+		return
+	}
+
+	request, err := review(r.Context(), accountID, requestID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toAccessRequestResponse(request))
+}
+
+func toAccessRequestResponse(request *server.AccessRequest) *api.AccessRequest {
+	resp := &api.AccessRequest{
+		Id:        request.ID,
+		UserId:    request.UserID,
+		Groups:    request.Groups,
+		Reason:    request.Reason,
+		Duration:  int(request.Duration.Seconds()),
+		Status:    api.AccessRequestStatus(request.Status),
+		CreatedAt: request.CreatedAt,
+	}
+
+	if resp.Groups == nil {
+		resp.Groups = []string{}
+	}
+
+	if request.ReviewedBy != "" {
+		resp.ReviewedBy = &request.ReviewedBy
+		resp.ReviewedAt = &request.ReviewedAt
+	}
+
+	if !request.ExpiresAt.IsZero() {
+		resp.ExpiresAt = &request.ExpiresAt
+	}
+
+	return resp
+}
@@ -0,0 +1,133 @@
+package http
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/accountconfig"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// maxAccountConfigSize limits the size of an uploaded account configuration document
+const maxAccountConfigSize = 10 << 20
+
+// AccountConfigHandler is a handler that exports and applies declarative account configuration documents
+type AccountConfigHandler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+// NewAccountConfigHandler creates a new AccountConfigHandler
+func NewAccountConfigHandler(accountManager server.AccountManager, authCfg AuthCfg) *AccountConfigHandler {
+	return &AccountConfigHandler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// ExportConfig returns the account configuration document in the requested format, YAML by default
+func (h *AccountConfigHandler) ExportConfig(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	format := accountconfig.Format(r.URL.Query().Get("format"))
+	contentType := "application/yaml"
+	switch format {
+	case "", accountconfig.FormatYAML:
+		format = accountconfig.FormatYAML
+	case accountconfig.FormatJSON:
+		contentType = "application/json"
+	default:
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "unsupported format %s", format), w)
+		return
+	}
+
+	config, err := h.accountManager.ExportAccountConfig(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	data, err := accountconfig.Marshal(config, format)
+	if err != nil {
+		util.WriteError(r.Context(), fmt.Errorf("failed to encode account configuration: %w", err), w)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err = w.Write(data); err != nil {
+		log.WithContext(r.Context()).Errorf("failed to write account configuration: %v", err)
+	}
+}
+
+// PlanConfig returns the changes that applying the uploaded document would make
+func (h *AccountConfigHandler) PlanConfig(w http.ResponseWriter, r *http.Request) {
+	h.applyConfig(w, r, true)
+}
+
+// ApplyConfig applies the uploaded document to the account and returns the applied changes
+func (h *AccountConfigHandler) ApplyConfig(w http.ResponseWriter, r *http.Request) {
+	h.applyConfig(w, r, false)
+}
+
+func (h *AccountConfigHandler) applyConfig(w http.ResponseWriter, r *http.Request, dryRun bool) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxAccountConfigSize+1))
+	if err != nil {
+		util.WriteErrorResponse("couldn't read request body", http.StatusBadRequest, w)
+		return
+	}
+	if len(data) > maxAccountConfigSize {
+		util.WriteErrorResponse("account configuration document is too large", http.StatusRequestEntityTooLarge, w)
+		return
+	}
+
+	config, err := accountconfig.Parse(data)
+	if err != nil {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid account configuration: %v", err), w)
+		return
+	}
+
+	var changes []*accountconfig.Change
+	if dryRun {
+		changes, err = h.accountManager.PlanAccountConfig(r.Context(), accountID, userID, config)
+	} else {
+		changes, err = h.accountManager.ApplyAccountConfig(r.Context(), accountID, userID, config)
+	}
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	resp := make([]api.AccountConfigChange, 0, len(changes))
+	for _, change := range changes {
+		resp = append(resp, api.AccountConfigChange{
+			Kind:   api.AccountConfigChangeKind(change.Kind),
+			Action: api.AccountConfigChangeAction(change.Action),
+			Name:   change.Name,
+		})
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
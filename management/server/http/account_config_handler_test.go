@@ -0,0 +1,140 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/accountconfig"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+)
+
+func initAccountConfigTestData() *AccountConfigHandler {
+	changes := []*accountconfig.Change{{Kind: accountconfig.KindGroup, Action: accountconfig.ActionCreate, Name: "devs"}}
+	return &AccountConfigHandler{
+		accountManager: &mock_server.MockAccountManager{
+			ExportAccountConfigFunc: func(_ context.Context, accountID, userID string) (*accountconfig.Config, error) {
+				return &accountconfig.Config{
+					Version: accountconfig.Version,
+					Groups:  []*accountconfig.Group{{Name: "devs", Peers: []string{"peer-1"}}},
+				}, nil
+			},
+			PlanAccountConfigFunc: func(_ context.Context, accountID, userID string, config *accountconfig.Config) ([]*accountconfig.Change, error) {
+				return changes, nil
+			},
+			ApplyAccountConfigFunc: func(_ context.Context, accountID, userID string, config *accountconfig.Config) ([]*accountconfig.Change, error) {
+				return changes, nil
+			},
+			GetAccountIDFromTokenFunc: func(_ context.Context, claims jwtclaims.AuthorizationClaims) (string, string, error) {
+				return claims.AccountId, claims.UserId, nil
+			},
+		},
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithFromRequestContext(func(r *http.Request) jwtclaims.AuthorizationClaims {
+				return jwtclaims.AuthorizationClaims{
+					UserId:    "test_user",
+					Domain:    "hotmail.com",
+					AccountId: "test_account",
+				}
+			}),
+		),
+	}
+}
+
+func TestAccountConfigHandlers(t *testing.T) {
+	tt := []struct {
+		name           string
+		requestType    string
+		requestPath    string
+		requestBody    string
+		expectedStatus int
+		expectedType   string
+		expectedBody   string
+	}{
+		{
+			name:           "Export YAML",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/config/export",
+			expectedStatus: http.StatusOK,
+			expectedType:   "application/yaml",
+			expectedBody:   "name: devs",
+		},
+		{
+			name:           "Export JSON",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/config/export?format=json",
+			expectedStatus: http.StatusOK,
+			expectedType:   "application/json",
+			expectedBody:   `"name": "devs"`,
+		},
+		{
+			name:           "Export unsupported format",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/config/export?format=xml",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Plan YAML",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/config/plan",
+			requestBody:    "version: v1\ngroups:\n  - name: devs\n",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Apply JSON",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/config/apply",
+			requestBody:    `{"version": "v1", "groups": [{"name": "devs"}]}`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Apply unknown field",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/config/apply",
+			requestBody:    `{"version": "v1", "group": [{"name": "devs"}]}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	handler := initAccountConfigTestData()
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.requestType, tc.requestPath, bytes.NewBufferString(tc.requestBody))
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/config/export", handler.ExportConfig).Methods("GET")
+			router.HandleFunc("/api/config/plan", handler.PlanConfig).Methods("POST")
+			router.HandleFunc("/api/config/apply", handler.ApplyConfig).Methods("POST")
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			require.Equal(t, tc.expectedStatus, res.StatusCode, recorder.Body.String())
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			if tc.requestType == http.MethodGet {
+				assert.Equal(t, tc.expectedType, res.Header.Get("Content-Type"))
+				assert.Contains(t, recorder.Body.String(), tc.expectedBody)
+				return
+			}
+
+			var changes []api.AccountConfigChange
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &changes))
+			assert.Equal(t, []api.AccountConfigChange{{Kind: api.AccountConfigChangeKindGroup, Action: api.AccountConfigChangeActionCreate, Name: "devs"}}, changes)
+		})
+	}
+}
@@ -85,6 +85,9 @@ func (h *AccountsHandler) UpdateAccount(w http.ResponseWriter, r *http.Request)
 
 	if req.Settings.Extra != nil {
 		settings.Extra = &account.ExtraSettings{PeerApprovalEnabled: *req.Settings.Extra.PeerApprovalEnabled}
+		if req.Settings.Extra.PeerApprovalRules != nil {
+			settings.Extra.PeerApprovalRules = toPeerApprovalRules(*req.Settings.Extra.PeerApprovalRules)
+		}
 	}
 
 	if req.Settings.JwtGroupsEnabled != nil {
@@ -149,7 +152,11 @@ func toAccountResponse(accountID string, settings *server.Settings) *api.Account
 	}
 
 	if settings.Extra != nil {
-		apiSettings.Extra = &api.AccountExtraSettings{PeerApprovalEnabled: &settings.Extra.PeerApprovalEnabled}
+		peerApprovalRules := toPeerApprovalRulesResponse(settings.Extra.PeerApprovalRules)
+		apiSettings.Extra = &api.AccountExtraSettings{
+			PeerApprovalEnabled: &settings.Extra.PeerApprovalEnabled,
+			PeerApprovalRules:   &peerApprovalRules,
+		}
 	}
 
 	return &api.Account{
@@ -157,3 +164,31 @@ func toAccountResponse(accountID string, settings *server.Settings) *api.Account
 		Settings: apiSettings,
 	}
 }
+
+func toPeerApprovalRules(apiRules []api.PeerApprovalRule) []account.PeerApprovalRule {
+	rules := make([]account.PeerApprovalRule, 0, len(apiRules))
+	for _, apiRule := range apiRules {
+		var rule account.PeerApprovalRule
+		if apiRule.SetupKeyId != nil {
+			rule.SetupKeyID = *apiRule.SetupKeyId
+		}
+		if apiRule.SourceRanges != nil {
+			rule.SourceRanges = *apiRule.SourceRanges
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func toPeerApprovalRulesResponse(rules []account.PeerApprovalRule) []api.PeerApprovalRule {
+	apiRules := make([]api.PeerApprovalRule, 0, len(rules))
+	for _, rule := range rules {
+		setupKeyID := rule.SetupKeyID
+		sourceRanges := append([]string{}, rule.SourceRanges...)
+		apiRules = append(apiRules, api.PeerApprovalRule{
+			SetupKeyId:   &setupKeyID,
+			SourceRanges: &sourceRanges,
+		})
+	}
+	return apiRules
+}
@@ -163,6 +163,31 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 			expectedArray: false,
 			expectedID:    accountID,
 		},
+		{
+			name:           "PutAccount OK with peer approval rules",
+			expectedBody:   true,
+			requestType:    http.MethodPut,
+			requestPath:    "/api/accounts/" + accountID,
+			requestBody:    bytes.NewBufferString("{\"settings\": {\"peer_login_expiration\": 554400,\"peer_login_expiration_enabled\": true,\"extra\":{\"peer_approval_enabled\":true,\"peer_approval_rules\":[{\"setup_key_id\":\"key1\",\"source_ranges\":[\"203.0.113.0/24\"]}]}}}"),
+			expectedStatus: http.StatusOK,
+			expectedSettings: api.AccountSettings{
+				PeerLoginExpiration:        554400,
+				PeerLoginExpirationEnabled: true,
+				GroupsPropagationEnabled:   br(false),
+				JwtGroupsClaimName:         sr(""),
+				JwtGroupsEnabled:           br(false),
+				JwtAllowGroups:             &[]string{},
+				RegularUsersViewBlocked:    false,
+				Extra: &api.AccountExtraSettings{
+					PeerApprovalEnabled: br(true),
+					PeerApprovalRules: &[]api.PeerApprovalRule{
+						{SetupKeyId: sr("key1"), SourceRanges: &[]string{"203.0.113.0/24"}},
+					},
+				},
+			},
+			expectedArray: false,
+			expectedID:    accountID,
+		},
 		{
 			name:           "Update account failure with high peer_login_expiration more than 180 days",
 			expectedBody:   true,
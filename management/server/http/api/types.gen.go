@@ -12,6 +12,46 @@ const (
 	TokenAuthScopes  = "TokenAuth.Scopes"
 )
 
+// Defines values for AccessRequestStatus.
+const (
+	AccessRequestStatusApproved AccessRequestStatus = "approved"
+	AccessRequestStatusDenied   AccessRequestStatus = "denied"
+	AccessRequestStatusExpired  AccessRequestStatus = "expired"
+	AccessRequestStatusPending  AccessRequestStatus = "pending"
+)
+
+// Defines values for AccountConfigChangeAction.
+const (
+	AccountConfigChangeActionCreate AccountConfigChangeAction = "create"
+	AccountConfigChangeActionDelete AccountConfigChangeAction = "delete"
+	AccountConfigChangeActionUpdate AccountConfigChangeAction = "update"
+)
+
+// Defines values for AccountConfigChangeKind.
+const (
+	AccountConfigChangeKindDnsSettings     AccountConfigChangeKind = "dns_settings"
+	AccountConfigChangeKindGroup           AccountConfigChangeKind = "group"
+	AccountConfigChangeKindNameserverGroup AccountConfigChangeKind = "nameserver_group"
+	AccountConfigChangeKindPolicy          AccountConfigChangeKind = "policy"
+	AccountConfigChangeKindPostureCheck    AccountConfigChangeKind = "posture_check"
+	AccountConfigChangeKindRoute           AccountConfigChangeKind = "route"
+	AccountConfigChangeKindService         AccountConfigChangeKind = "service"
+)
+
+// Defines values for BulkOperationAction.
+const (
+	BulkOperationActionCreate BulkOperationAction = "create"
+	BulkOperationActionDelete BulkOperationAction = "delete"
+	BulkOperationActionUpdate BulkOperationAction = "update"
+)
+
+// Defines values for BulkOperationResultStatus.
+const (
+	BulkOperationResultStatusApplied BulkOperationResultStatus = "applied"
+	BulkOperationResultStatusFailed  BulkOperationResultStatus = "failed"
+	BulkOperationResultStatusSkipped BulkOperationResultStatus = "skipped"
+)
+
 // Defines values for EventActivityCode.
 const (
 	EventActivityCodeAccountCreate                            EventActivityCode = "account.create"
@@ -63,6 +103,32 @@ const (
 	EventActivityCodeUserUnblock                              EventActivityCode = "user.unblock"
 )
 
+// Defines values for GetApiEventsParamsOrder.
+const (
+	GetApiEventsParamsOrderAsc  GetApiEventsParamsOrder = "asc"
+	GetApiEventsParamsOrderDesc GetApiEventsParamsOrder = "desc"
+)
+
+// Defines values for GetApiConfigExportParamsFormat.
+const (
+	GetApiConfigExportParamsFormatJson GetApiConfigExportParamsFormat = "json"
+	GetApiConfigExportParamsFormatYaml GetApiConfigExportParamsFormat = "yaml"
+)
+
+// Defines values for FirewallRuleExplanationDirection.
+const (
+	FirewallRuleExplanationDirectionIn  FirewallRuleExplanationDirection = "in"
+	FirewallRuleExplanationDirectionOut FirewallRuleExplanationDirection = "out"
+)
+
+// Defines values for GetApiPeersPeerIdExplainParamsProtocol.
+const (
+	GetApiPeersPeerIdExplainParamsProtocolAll  GetApiPeersPeerIdExplainParamsProtocol = "all"
+	GetApiPeersPeerIdExplainParamsProtocolIcmp GetApiPeersPeerIdExplainParamsProtocol = "icmp"
+	GetApiPeersPeerIdExplainParamsProtocolTcp  GetApiPeersPeerIdExplainParamsProtocol = "tcp"
+	GetApiPeersPeerIdExplainParamsProtocolUdp  GetApiPeersPeerIdExplainParamsProtocol = "udp"
+)
+
 // Defines values for GeoLocationCheckAction.
 const (
 	GeoLocationCheckActionAllow GeoLocationCheckAction = "allow"
@@ -94,9 +160,38 @@ const (
 	PeerNetworkRangeCheckActionDeny  PeerNetworkRangeCheckAction = "deny"
 )
 
+// Defines values for PeerPostureCheckStatusState.
+const (
+	PeerPostureCheckStatusStateCompliant    PeerPostureCheckStatusState = "compliant"
+	PeerPostureCheckStatusStateGrace        PeerPostureCheckStatusState = "grace"
+	PeerPostureCheckStatusStateNonCompliant PeerPostureCheckStatusState = "non-compliant"
+)
+
+// Defines values for PeerPostureStatusState.
+const (
+	PeerPostureStatusStateCompliant    PeerPostureStatusState = "compliant"
+	PeerPostureStatusStateGrace        PeerPostureStatusState = "grace"
+	PeerPostureStatusStateNonCompliant PeerPostureStatusState = "non-compliant"
+)
+
+// Defines values for PolicyExplainRequestProtocol.
+const (
+	PolicyExplainRequestProtocolAll  PolicyExplainRequestProtocol = "all"
+	PolicyExplainRequestProtocolIcmp PolicyExplainRequestProtocol = "icmp"
+	PolicyExplainRequestProtocolTcp  PolicyExplainRequestProtocol = "tcp"
+	PolicyExplainRequestProtocolUdp  PolicyExplainRequestProtocol = "udp"
+)
+
+// Defines values for PolicyExplanationVerdict.
+const (
+	PolicyExplanationVerdictAllow PolicyExplanationVerdict = "allow"
+	PolicyExplanationVerdictDeny  PolicyExplanationVerdict = "deny"
+)
+
 // Defines values for PolicyRuleAction.
 const (
 	PolicyRuleActionAccept PolicyRuleAction = "accept"
+	PolicyRuleActionAudit  PolicyRuleAction = "audit"
 	PolicyRuleActionDrop   PolicyRuleAction = "drop"
 )
 
@@ -108,9 +203,17 @@ const (
 	PolicyRuleProtocolUdp  PolicyRuleProtocol = "udp"
 )
 
+// Defines values for PolicyRuleExplanationAction.
+const (
+	PolicyRuleExplanationActionAccept PolicyRuleExplanationAction = "accept"
+	PolicyRuleExplanationActionAudit  PolicyRuleExplanationAction = "audit"
+	PolicyRuleExplanationActionDrop   PolicyRuleExplanationAction = "drop"
+)
+
 // Defines values for PolicyRuleMinimumAction.
 const (
 	PolicyRuleMinimumActionAccept PolicyRuleMinimumAction = "accept"
+	PolicyRuleMinimumActionAudit  PolicyRuleMinimumAction = "audit"
 	PolicyRuleMinimumActionDrop   PolicyRuleMinimumAction = "drop"
 )
 
@@ -125,6 +228,7 @@ const (
 // Defines values for PolicyRuleUpdateAction.
 const (
 	PolicyRuleUpdateActionAccept PolicyRuleUpdateAction = "accept"
+	PolicyRuleUpdateActionAudit  PolicyRuleUpdateAction = "audit"
 	PolicyRuleUpdateActionDrop   PolicyRuleUpdateAction = "drop"
 )
 
@@ -136,6 +240,38 @@ const (
 	PolicyRuleUpdateProtocolUdp  PolicyRuleUpdateProtocol = "udp"
 )
 
+// Defines values for RolePermissionOperations.
+const (
+	RolePermissionOperationsCreate RolePermissionOperations = "create"
+	RolePermissionOperationsDelete RolePermissionOperations = "delete"
+	RolePermissionOperationsRead   RolePermissionOperations = "read"
+	RolePermissionOperationsUpdate RolePermissionOperations = "update"
+)
+
+// Defines values for RolePermissionResource.
+const (
+	RolePermissionResourceDns       RolePermissionResource = "dns"
+	RolePermissionResourceEvents    RolePermissionResource = "events"
+	RolePermissionResourceGroups    RolePermissionResource = "groups"
+	RolePermissionResourcePeers     RolePermissionResource = "peers"
+	RolePermissionResourcePolicies  RolePermissionResource = "policies"
+	RolePermissionResourceRoutes    RolePermissionResource = "routes"
+	RolePermissionResourceSetupKeys RolePermissionResource = "setup_keys"
+	RolePermissionResourceUsers     RolePermissionResource = "users"
+)
+
+// Defines values for ServiceProtocol.
+const (
+	ServiceProtocolTcp ServiceProtocol = "tcp"
+	ServiceProtocolUdp ServiceProtocol = "udp"
+)
+
+// Defines values for ServiceRequestProtocol.
+const (
+	ServiceRequestProtocolTcp ServiceRequestProtocol = "tcp"
+	ServiceRequestProtocolUdp ServiceRequestProtocol = "udp"
+)
+
 // Defines values for UserStatus.
 const (
 	UserStatusActive  UserStatus = "active"
@@ -186,6 +322,54 @@ type AccessiblePeer struct {
 	UserId string `json:"user_id"`
 }
 
+// AccessRequest defines model for AccessRequest.
+type AccessRequest struct {
+	// CreatedAt Access request creation date
+	CreatedAt time.Time `json:"created_at"`
+
+	// Duration Duration of the access in seconds once approved
+	Duration int `json:"duration"`
+
+	// ExpiresAt Date when the granted access is revoked
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Groups List of group IDs the user requests access to
+	Groups []string `json:"groups"`
+
+	// Id Access request ID
+	Id string `json:"id"`
+
+	// Reason Justification of the request
+	Reason string `json:"reason"`
+
+	// ReviewedAt Date when the request was approved or denied
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+
+	// ReviewedBy ID of the user who approved or denied the request
+	ReviewedBy *string `json:"reviewed_by,omitempty"`
+
+	// Status Access request status
+	Status AccessRequestStatus `json:"status"`
+
+	// UserId ID of the user requesting access
+	UserId string `json:"user_id"`
+}
+
+// AccessRequestStatus Access request status
+type AccessRequestStatus string
+
+// AccessRequestCreate defines model for AccessRequestCreate.
+type AccessRequestCreate struct {
+	// Duration Duration of the access in seconds once approved
+	Duration int `json:"duration"`
+
+	// Groups List of group IDs the user requests access to
+	Groups []string `json:"groups"`
+
+	// Reason Justification of the request
+	Reason string `json:"reason"`
+}
+
 // Account defines model for Account.
 type Account struct {
 	// Id Account ID
@@ -193,10 +377,36 @@ type Account struct {
 	Settings AccountSettings `json:"settings"`
 }
 
+// AccountConfig Versioned document describing the groups, posture checks, policies, routes, nameserver groups and DNS settings
+// of the account. Objects reference each other by name. A section omitted from the document is left untouched,
+// objects missing from a present section are deleted on apply.
+type AccountConfig map[string]interface{}
+
+// AccountConfigChange defines model for AccountConfigChange.
+type AccountConfigChange struct {
+	// Action Operation performed on the object
+	Action AccountConfigChangeAction `json:"action"`
+
+	// Kind Type of the configuration object
+	Kind AccountConfigChangeKind `json:"kind"`
+
+	// Name Name of the object. Routes are identified by ID, new routes by network ID.
+	Name string `json:"name"`
+}
+
+// AccountConfigChangeAction Operation performed on the object
+type AccountConfigChangeAction string
+
+// AccountConfigChangeKind Type of the configuration object
+type AccountConfigChangeKind string
+
 // AccountExtraSettings defines model for AccountExtraSettings.
 type AccountExtraSettings struct {
 	// PeerApprovalEnabled (Cloud only) Enables or disables peer approval globally. If enabled, all peers added will be in pending state until approved by an admin.
 	PeerApprovalEnabled *bool `json:"peer_approval_enabled,omitempty"`
+
+	// PeerApprovalRules New peers matching one of the rules are approved automatically when peer approval is enabled
+	PeerApprovalRules *[]PeerApprovalRule `json:"peer_approval_rules,omitempty"`
 }
 
 // AccountRequest defines model for AccountRequest.
@@ -236,8 +446,49 @@ type AccountSettings struct {
 	RegularUsersViewBlocked bool `json:"regular_users_view_blocked"`
 }
 
+// BulkOperationAction Action of a bulk operation
+type BulkOperationAction string
+
+// BulkOperationResult defines model for BulkOperationResult.
+type BulkOperationResult struct {
+	// Action Action of a bulk operation
+	Action BulkOperationAction `json:"action"`
+
+	// Error Reason of the failure of the operation
+	Error *string `json:"error,omitempty"`
+
+	// Id ID of the resource, set for the created resources
+	Id *string `json:"id,omitempty"`
+
+	// Status Outcome of the operation. Skipped operations succeeded but were rolled back because other operations of
+	// the request failed
+	Status BulkOperationResultStatus `json:"status"`
+}
+
+// BulkOperationResultStatus Outcome of the operation. Skipped operations succeeded but were rolled back because other operations of
+// the request failed
+type BulkOperationResultStatus string
+
+// BulkResponse defines model for BulkResponse.
+type BulkResponse struct {
+	// Applied Indicates whether the operations have been applied. Either all operations are applied or none
+	Applied bool `json:"applied"`
+
+	// Results Results of the operations in the order of the request
+	Results []BulkOperationResult `json:"results"`
+}
+
 // Checks List of objects that perform the actual checks
 type Checks struct {
+	// DiskEncryptionCheck Posture check for the full-disk encryption of the peer's volumes
+	DiskEncryptionCheck *DiskEncryptionCheck `json:"disk_encryption_check,omitempty"`
+
+	// FileContentCheck Posture check for the hash or the content of files in the peer's system
+	FileContentCheck *FileContentCheck `json:"file_content_check,omitempty"`
+
+	// FirewallCheck Posture check for the host firewall of the peer to be enabled
+	FirewallCheck *FirewallCheck `json:"firewall_check,omitempty"`
+
 	// GeoLocationCheck Posture check for geo location
 	GeoLocationCheck *GeoLocationCheck `json:"geo_location_check,omitempty"`
 
@@ -292,6 +543,12 @@ type CreateSetupKeyRequest struct {
 	// Name Setup Key name
 	Name string `json:"name"`
 
+	// Restrictions Restrictions on the machines that can register with the setup key, empty restrictions allow any machine
+	Restrictions *SetupKeyRestrictions `json:"restrictions,omitempty"`
+
+	// Tags Key/value labels of a peer that can be used in policy and route tag selectors
+	Tags *PeerTags `json:"tags,omitempty"`
+
 	// Type Setup key type, one-off for single time usage and reusable
 	Type string `json:"type"`
 
@@ -305,6 +562,12 @@ type DNSSettings struct {
 	DisabledManagementGroups []string `json:"disabled_management_groups"`
 }
 
+// DiskEncryptionCheck Posture check for the full-disk encryption of the peer's volumes
+type DiskEncryptionCheck struct {
+	// Volumes List of volume mount points that must be encrypted. When empty, the system volume of the peer must be encrypted
+	Volumes *[]string `json:"volumes,omitempty"`
+}
+
 // Event defines model for Event.
 type Event struct {
 	// Activity The activity that occurred during the event
@@ -338,6 +601,53 @@ type Event struct {
 // EventActivityCode The string code of the activity that occurred during the event
 type EventActivityCode string
 
+// FileContent Describes a file with its expected hash or content. At least one of sha256 or content_regex must be set
+type FileContent struct {
+	// ContentRegex Regular expression the file content must match. Only the first MiB of the file is matched
+	ContentRegex *string `json:"content_regex,omitempty"`
+
+	// LinuxPath Path to the file in a Linux operating system
+	LinuxPath *string `json:"linux_path,omitempty"`
+
+	// MacPath Path to the file in a Mac operating system
+	MacPath *string `json:"mac_path,omitempty"`
+
+	// Sha256 Hex encoded SHA-256 hash the file must have. The whole file is hashed regardless of its size
+	Sha256 *string `json:"sha256,omitempty"`
+
+	// WindowsPath Path to the file in a Windows operating system
+	WindowsPath *string `json:"windows_path,omitempty"`
+}
+
+// FileContentCheck Posture check for the hash or the content of files in the peer's system
+type FileContentCheck struct {
+	Files []FileContent `json:"files"`
+}
+
+// FirewallCheck Posture check for the host firewall of the peer to be enabled
+type FirewallCheck = map[string]interface{}
+
+// FirewallRuleExplanation defines model for FirewallRuleExplanation.
+type FirewallRuleExplanation struct {
+	// Action Action applied to the traffic
+	Action string `json:"action"`
+
+	// Direction Direction of the traffic
+	Direction FirewallRuleExplanationDirection `json:"direction"`
+
+	// PeerIp IP address of the remote peer, 0.0.0.0 matches all peers
+	PeerIp string `json:"peer_ip"`
+
+	// Port Port of the traffic, empty matches all ports
+	Port string `json:"port"`
+
+	// Protocol Protocol of the traffic
+	Protocol string `json:"protocol"`
+}
+
+// FirewallRuleExplanationDirection Direction of the traffic
+type FirewallRuleExplanationDirection string
+
 // GeoLocationCheck Posture check for geo location
 type GeoLocationCheck struct {
 	// Action Action to take upon policy match
@@ -366,6 +676,31 @@ type Group struct {
 
 	// PeersCount Count of peers associated to the group
 	PeersCount int `json:"peers_count"`
+
+	// Rule Rule that makes the group dynamic. The membership of a dynamic group is computed from the peer attributes
+	// whenever peers log in or sync. A rule is a comma separated list of requirements that all have to match.
+	// Supported attributes are hostname, os, os_name, os_version, kernel_version, platform, version, ui_version,
+	// country, city, user.id, user.role, user.service_user and peer tags prefixed with `tag.`.
+	// Supported requirements are `attr`, `!attr`, `attr=value`, `attr!=value`, `attr in (v1,v2)`,
+	// `attr notin (v1,v2)` and the version comparisons `attr>=version`, `attr>version`, `attr<=version` and `attr<version`.
+	Rule *GroupRule `json:"rule,omitempty"`
+}
+
+// GroupBulkOperation defines model for GroupBulkOperation.
+type GroupBulkOperation struct {
+	// Action Action of a bulk operation
+	Action BulkOperationAction `json:"action"`
+	Create *GroupRequest       `json:"create,omitempty"`
+
+	// Id ID of the updated or deleted group
+	Id     *string       `json:"id,omitempty"`
+	Update *GroupRequest `json:"update,omitempty"`
+}
+
+// GroupBulkRequest defines model for GroupBulkRequest.
+type GroupBulkRequest struct {
+	// Operations Operations to apply
+	Operations []GroupBulkOperation `json:"operations"`
 }
 
 // GroupIssued How the group was issued (api, integration, jwt)
@@ -394,10 +729,26 @@ type GroupRequest struct {
 	// Name Group name identifier
 	Name string `json:"name"`
 
-	// Peers List of peers ids
+	// Peers List of peers ids. Ignored for dynamic groups.
 	Peers *[]string `json:"peers,omitempty"`
+
+	// Rule Rule that makes the group dynamic. The membership of a dynamic group is computed from the peer attributes
+	// whenever peers log in or sync. A rule is a comma separated list of requirements that all have to match.
+	// Supported attributes are hostname, os, os_name, os_version, kernel_version, platform, version, ui_version,
+	// country, city, user.id, user.role, user.service_user and peer tags prefixed with `tag.`.
+	// Supported requirements are `attr`, `!attr`, `attr=value`, `attr!=value`, `attr in (v1,v2)`,
+	// `attr notin (v1,v2)` and the version comparisons `attr>=version`, `attr>version`, `attr<=version` and `attr<version`.
+	Rule *GroupRule `json:"rule,omitempty"`
 }
 
+// GroupRule Rule that makes the group dynamic. The membership of a dynamic group is computed from the peer attributes
+// whenever peers log in or sync. A rule is a comma separated list of requirements that all have to match.
+// Supported attributes are hostname, os, os_name, os_version, kernel_version, platform, version, ui_version,
+// country, city, user.id, user.role, user.service_user and peer tags prefixed with `tag.`.
+// Supported requirements are `attr`, `!attr`, `attr=value`, `attr!=value`, `attr in (v1,v2)`,
+// `attr notin (v1,v2)` and the version comparisons `attr>=version`, `attr>version`, `attr<=version` and `attr<version`.
+type GroupRule = string
+
 // Location Describe geographical location information
 type Location struct {
 	// CityName Commonly used English name of the city
@@ -512,6 +863,75 @@ type OSVersionCheck struct {
 	Windows *MinKernelVersionCheck `json:"windows,omitempty"`
 }
 
+// Organization defines model for Organization.
+type Organization struct {
+	// AccountId ID of the account owning the organization
+	AccountId string `json:"account_id"`
+
+	// CreatedAt Date the organization was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy User ID of the user who created the organization
+	CreatedBy string `json:"created_by"`
+
+	// Id Organization ID
+	Id string `json:"id"`
+
+	// Name Organization name
+	Name string `json:"name"`
+}
+
+// OrganizationAccount defines model for OrganizationAccount.
+type OrganizationAccount struct {
+	// ConnectedPeersCount Number of peers of the account connected to the management service
+	ConnectedPeersCount int `json:"connected_peers_count"`
+
+	// CreatedAt Date the account was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// Domain Account domain
+	Domain string `json:"domain"`
+
+	// Id Account ID
+	Id string `json:"id"`
+
+	// PeersCount Number of peers of the account
+	PeersCount int `json:"peers_count"`
+
+	// UsersCount Number of users of the account, excluding the service users
+	UsersCount int `json:"users_count"`
+}
+
+// OrganizationAccountRequest defines model for OrganizationAccountRequest.
+type OrganizationAccountRequest struct {
+	// Domain Domain of the new account
+	Domain *string `json:"domain,omitempty"`
+}
+
+// OrganizationAdmin defines model for OrganizationAdmin.
+type OrganizationAdmin struct {
+	// CreatedAt Date the admin was added
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy User ID of the user who added the admin
+	CreatedBy string `json:"created_by"`
+
+	// UserId ID of a user with admin power in the account owning the organization
+	UserId string `json:"user_id"`
+}
+
+// OrganizationAdminRequest defines model for OrganizationAdminRequest.
+type OrganizationAdminRequest struct {
+	// UserId ID of a user with admin power in the account owning the organization
+	UserId string `json:"user_id"`
+}
+
+// OrganizationRequest defines model for OrganizationRequest.
+type OrganizationRequest struct {
+	// Name Organization name
+	Name string `json:"name"`
+}
+
 // Peer defines model for Peer.
 type Peer struct {
 	// ApprovalRequired (Cloud only) Indicates whether peer needs approval
@@ -571,12 +991,18 @@ type Peer struct {
 	// Os Peer's operating system and version
 	Os string `json:"os"`
 
+	// ReportedTags Key/value labels reported by the peer client, informational only and not matched by tag selectors
+	ReportedTags map[string]string `json:"reported_tags"`
+
 	// SerialNumber System serial number
 	SerialNumber string `json:"serial_number"`
 
 	// SshEnabled Indicates whether SSH server is enabled on this peer
 	SshEnabled bool `json:"ssh_enabled"`
 
+	// Tags Key/value labels of a peer that can be used in policy and route tag selectors
+	Tags PeerTags `json:"tags"`
+
 	// UiVersion Peer's desktop UI version
 	UiVersion string `json:"ui_version"`
 
@@ -587,6 +1013,21 @@ type Peer struct {
 	Version string `json:"version"`
 }
 
+// PeerApprovalRequest defines model for PeerApprovalRequest.
+type PeerApprovalRequest struct {
+	// PeerIds List of pending peer IDs
+	PeerIds []string `json:"peer_ids"`
+}
+
+// PeerApprovalRule defines model for PeerApprovalRule.
+type PeerApprovalRule struct {
+	// SetupKeyId Matches peers enrolled with the setup key. Any peer matches if empty
+	SetupKeyId *string `json:"setup_key_id,omitempty"`
+
+	// SourceRanges Matches peers connecting from one of the network ranges in CIDR notation. Any address matches if empty
+	SourceRanges *[]string `json:"source_ranges,omitempty"`
+}
+
 // PeerBatch defines model for PeerBatch.
 type PeerBatch struct {
 	// AccessiblePeersCount Number of accessible peers
@@ -649,12 +1090,18 @@ type PeerBatch struct {
 	// Os Peer's operating system and version
 	Os string `json:"os"`
 
+	// ReportedTags Key/value labels reported by the peer client, informational only and not matched by tag selectors
+	ReportedTags map[string]string `json:"reported_tags"`
+
 	// SerialNumber System serial number
 	SerialNumber string `json:"serial_number"`
 
 	// SshEnabled Indicates whether SSH server is enabled on this peer
 	SshEnabled bool `json:"ssh_enabled"`
 
+	// Tags Key/value labels of a peer that can be used in policy and route tag selectors
+	Tags PeerTags `json:"tags"`
+
 	// UiVersion Peer's desktop UI version
 	UiVersion string `json:"ui_version"`
 
@@ -665,6 +1112,22 @@ type PeerBatch struct {
 	Version string `json:"version"`
 }
 
+// PeerBulkOperation defines model for PeerBulkOperation.
+type PeerBulkOperation struct {
+	// Action Action of a bulk operation
+	Action BulkOperationAction `json:"action"`
+
+	// Id ID of the peer
+	Id     string       `json:"id"`
+	Update *PeerRequest `json:"update,omitempty"`
+}
+
+// PeerBulkRequest defines model for PeerBulkRequest.
+type PeerBulkRequest struct {
+	// Operations Operations to apply, peers can only be updated and deleted
+	Operations []PeerBulkOperation `json:"operations"`
+}
+
 // PeerMinimum defines model for PeerMinimum.
 type PeerMinimum struct {
 	// Id Peer ID
@@ -686,6 +1149,45 @@ type PeerNetworkRangeCheck struct {
 // PeerNetworkRangeCheckAction Action to take upon policy match
 type PeerNetworkRangeCheckAction string
 
+// PeerPostureCheckStatus defines model for PeerPostureCheckStatus.
+type PeerPostureCheckStatus struct {
+	// FailingSince Time the peer started to fail the posture check
+	FailingSince *time.Time `json:"failing_since,omitempty"`
+
+	// GraceExpiresAt Time the grace period of the failing posture check expires
+	GraceExpiresAt *time.Time `json:"grace_expires_at,omitempty"`
+
+	// PostureCheckId Posture check ID
+	PostureCheckId string `json:"posture_check_id"`
+
+	// PostureCheckName Posture check name
+	PostureCheckName string `json:"posture_check_name"`
+
+	// Reasons Failed checks with the reason of the failure
+	Reasons []string `json:"reasons"`
+
+	// State Compliance state of the peer with the posture check
+	State PeerPostureCheckStatusState `json:"state"`
+}
+
+// PeerPostureCheckStatusState Compliance state of the peer with the posture check
+type PeerPostureCheckStatusState string
+
+// PeerPostureStatus defines model for PeerPostureStatus.
+type PeerPostureStatus struct {
+	// Checks Results of the posture checks applied to the peer
+	Checks []PeerPostureCheckStatus `json:"checks"`
+
+	// State Compliance state of the peer, the worst state of the evaluated posture checks
+	State PeerPostureStatusState `json:"state"`
+
+	// UpdatedAt Last time the compliance status changed
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// PeerPostureStatusState Compliance state of the peer, the worst state of the evaluated posture checks
+type PeerPostureStatusState string
+
 // PeerRequest defines model for PeerRequest.
 type PeerRequest struct {
 	// ApprovalRequired (Cloud only) Indicates whether peer needs approval
@@ -694,8 +1196,14 @@ type PeerRequest struct {
 	LoginExpirationEnabled      bool   `json:"login_expiration_enabled"`
 	Name                        string `json:"name"`
 	SshEnabled                  bool   `json:"ssh_enabled"`
+
+	// Tags Key/value labels of a peer that can be used in policy and route tag selectors
+	Tags *PeerTags `json:"tags,omitempty"`
 }
 
+// PeerTags Key/value labels of a peer that can be used in policy and route tag selectors
+type PeerTags map[string]string
+
 // PersonalAccessToken defines model for PersonalAccessToken.
 type PersonalAccessToken struct {
 	// CreatedAt Date the token was created
@@ -751,10 +1259,89 @@ type Policy struct {
 	// Rules Policy rule object for policy UI editor
 	Rules []PolicyRule `json:"rules"`
 
+	// Schedule Time windows when the policy is applied. Policy without a schedule is always applied.
+	Schedule *PolicySchedule `json:"schedule,omitempty"`
+
 	// SourcePostureChecks Posture checks ID's applied to policy source groups
 	SourcePostureChecks []string `json:"source_posture_checks"`
 }
 
+// PolicyAudit defines model for PolicyAudit.
+type PolicyAudit struct {
+	// Packets Total number of packets matching the audit rules of the policy reported by the peers
+	Packets int64 `json:"packets"`
+
+	// Peers Packets matching the audit rules of the policy per reporting peer
+	Peers []PolicyAuditPeer `json:"peers"`
+
+	// PolicyId Policy ID
+	PolicyId string `json:"policy_id"`
+}
+
+// PolicyAuditPeer defines model for PolicyAuditPeer.
+type PolicyAuditPeer struct {
+	// LastSeen Last time the peer reported packets matching the audit rules of the policy
+	LastSeen time.Time `json:"last_seen"`
+
+	// Packets Number of packets matching the audit rules of the policy reported by the peer
+	Packets int64 `json:"packets"`
+
+	// PeerId Peer ID
+	PeerId string `json:"peer_id"`
+
+	// PeerName Peer name
+	PeerName string `json:"peer_name"`
+}
+
+// PolicyExplainRequest defines model for PolicyExplainRequest.
+type PolicyExplainRequest struct {
+	// Destination Peer ID or IP address of a peer or a routed network the connection goes to
+	Destination string        `json:"destination"`
+	Policy      *PolicyUpdate `json:"policy,omitempty"`
+
+	// Port Port of the connection. Omitted port matches any port.
+	Port *int `json:"port,omitempty"`
+
+	// Protocol Protocol of the connection. Omitted protocol matches any protocol.
+	Protocol *PolicyExplainRequestProtocol `json:"protocol,omitempty"`
+}
+
+// PolicyExplainRequestProtocol Protocol of the connection. Omitted protocol matches any protocol.
+type PolicyExplainRequestProtocol string
+
+// PolicyExplanation defines model for PolicyExplanation.
+type PolicyExplanation struct {
+	// DestinationIp IP address the connection goes to
+	DestinationIp string `json:"destination_ip"`
+
+	// DestinationPeerId Peer ID the connection goes to. Empty when the destination is a routed network.
+	DestinationPeerId *string `json:"destination_peer_id,omitempty"`
+
+	// FirewallRules Firewall rules of the destination peer matching the connection
+	FirewallRules []FirewallRuleExplanation `json:"firewall_rules"`
+
+	// Reason Summary of the verdict
+	Reason string `json:"reason"`
+
+	// RouteFirewallRules Route firewall rules of the routing peer matching the connection
+	RouteFirewallRules []RouteFirewallRuleExplanation `json:"route_firewall_rules"`
+
+	// Routes Routes to the destination IP address
+	Routes []RouteExplanation `json:"routes"`
+
+	// Rules Policy rules connecting the source with the destination
+	Rules []PolicyRuleExplanation `json:"rules"`
+
+	// SourcePeerId Peer ID the connection starts from
+	SourcePeerId string `json:"source_peer_id"`
+
+	// Verdict Final decision on the connection
+	Verdict PolicyExplanationVerdict `json:"verdict"`
+}
+
+// PolicyExplanationVerdict Final decision on the connection
+type PolicyExplanationVerdict string
+
 // PolicyMinimum defines model for PolicyMinimum.
 type PolicyMinimum struct {
 	// Description Policy friendly description
@@ -772,7 +1359,7 @@ type PolicyMinimum struct {
 
 // PolicyRule defines model for PolicyRule.
 type PolicyRule struct {
-	// Action Policy rule accept or drops packets
+	// Action Policy rule accept or drops packets, audit rules log the packets they would drop
 	Action PolicyRuleAction `json:"action"`
 
 	// Bidirectional Define if the rule is applicable in both directions, sources, and destinations.
@@ -781,6 +1368,9 @@ type PolicyRule struct {
 	// Description Policy rule friendly description
 	Description *string `json:"description,omitempty"`
 
+	// DestinationTagSelector Selects destination peers by their tags in addition to the destination groups
+	DestinationTagSelector *string `json:"destination_tag_selector,omitempty"`
+
 	// Destinations Policy rule destination group IDs
 	Destinations []GroupMinimum `json:"destinations"`
 
@@ -802,19 +1392,56 @@ type PolicyRule struct {
 	// Protocol Policy rule type of the traffic
 	Protocol PolicyRuleProtocol `json:"protocol"`
 
+	// Services Policy rule service IDs. The protocol, ports and port ranges of the services are applied instead of the ones of the rule.
+	// This property can not be set together with `ports` or `port_ranges`
+	Services *[]string `json:"services,omitempty"`
+
+	// SourceTagSelector Selects source peers by their tags in addition to the source groups
+	SourceTagSelector *string `json:"source_tag_selector,omitempty"`
+
 	// Sources Policy rule source group IDs
 	Sources []GroupMinimum `json:"sources"`
 }
 
-// PolicyRuleAction Policy rule accept or drops packets
+// PolicyRuleAction Policy rule accept or drops packets, audit rules log the packets they would drop
 type PolicyRuleAction string
 
 // PolicyRuleProtocol Policy rule type of the traffic
 type PolicyRuleProtocol string
 
+// PolicyRuleExplanation defines model for PolicyRuleExplanation.
+type PolicyRuleExplanation struct {
+	// Action Policy rule accept or drops packets, audit rules log the packets they would drop
+	Action PolicyRuleExplanationAction `json:"action"`
+
+	// FailedPostureChecks Source posture checks the peer doesn't pass
+	FailedPostureChecks []PostureCheckFailure `json:"failed_posture_checks"`
+
+	// Matched Indicates whether the rule applies to the connection
+	Matched bool `json:"matched"`
+
+	// PolicyId Policy ID
+	PolicyId string `json:"policy_id"`
+
+	// PolicyName Policy name
+	PolicyName string `json:"policy_name"`
+
+	// Reason Why the rule doesn't apply to the connection
+	Reason *string `json:"reason,omitempty"`
+
+	// RuleId Policy rule ID
+	RuleId string `json:"rule_id"`
+
+	// RuleName Policy rule name
+	RuleName string `json:"rule_name"`
+}
+
+// PolicyRuleExplanationAction Policy rule accept or drops packets, audit rules log the packets they would drop
+type PolicyRuleExplanationAction string
+
 // PolicyRuleMinimum defines model for PolicyRuleMinimum.
 type PolicyRuleMinimum struct {
-	// Action Policy rule accept or drops packets
+	// Action Policy rule accept or drops packets, audit rules log the packets they would drop
 	Action PolicyRuleMinimumAction `json:"action"`
 
 	// Bidirectional Define if the rule is applicable in both directions, sources, and destinations.
@@ -823,6 +1450,9 @@ type PolicyRuleMinimum struct {
 	// Description Policy rule friendly description
 	Description *string `json:"description,omitempty"`
 
+	// DestinationTagSelector Selects destination peers by their tags in addition to the destination groups
+	DestinationTagSelector *string `json:"destination_tag_selector,omitempty"`
+
 	// Enabled Policy rule status
 	Enabled bool `json:"enabled"`
 
@@ -840,9 +1470,16 @@ type PolicyRuleMinimum struct {
 
 	// Protocol Policy rule type of the traffic
 	Protocol PolicyRuleMinimumProtocol `json:"protocol"`
+
+	// Services Policy rule service IDs. The protocol, ports and port ranges of the services are applied instead of the ones of the rule.
+	// This property can not be set together with `ports` or `port_ranges`
+	Services *[]string `json:"services,omitempty"`
+
+	// SourceTagSelector Selects source peers by their tags in addition to the source groups
+	SourceTagSelector *string `json:"source_tag_selector,omitempty"`
 }
 
-// PolicyRuleMinimumAction Policy rule accept or drops packets
+// PolicyRuleMinimumAction Policy rule accept or drops packets, audit rules log the packets they would drop
 type PolicyRuleMinimumAction string
 
 // PolicyRuleMinimumProtocol Policy rule type of the traffic
@@ -850,7 +1487,7 @@ type PolicyRuleMinimumProtocol string
 
 // PolicyRuleUpdate defines model for PolicyRuleUpdate.
 type PolicyRuleUpdate struct {
-	// Action Policy rule accept or drops packets
+	// Action Policy rule accept or drops packets, audit rules log the packets they would drop
 	Action PolicyRuleUpdateAction `json:"action"`
 
 	// Bidirectional Define if the rule is applicable in both directions, sources, and destinations.
@@ -859,6 +1496,9 @@ type PolicyRuleUpdate struct {
 	// Description Policy rule friendly description
 	Description *string `json:"description,omitempty"`
 
+	// DestinationTagSelector Selects destination peers by their tags in addition to the destination groups
+	DestinationTagSelector *string `json:"destination_tag_selector,omitempty"`
+
 	// Destinations Policy rule destination group IDs
 	Destinations []string `json:"destinations"`
 
@@ -880,16 +1520,50 @@ type PolicyRuleUpdate struct {
 	// Protocol Policy rule type of the traffic
 	Protocol PolicyRuleUpdateProtocol `json:"protocol"`
 
+	// Services Policy rule service IDs. The protocol, ports and port ranges of the services are applied instead of the ones of the rule.
+	// This property can not be set together with `ports` or `port_ranges`
+	Services *[]string `json:"services,omitempty"`
+
+	// SourceTagSelector Selects source peers by their tags in addition to the source groups
+	SourceTagSelector *string `json:"source_tag_selector,omitempty"`
+
 	// Sources Policy rule source group IDs
 	Sources []string `json:"sources"`
 }
 
-// PolicyRuleUpdateAction Policy rule accept or drops packets
+// PolicyRuleUpdateAction Policy rule accept or drops packets, audit rules log the packets they would drop
 type PolicyRuleUpdateAction string
 
 // PolicyRuleUpdateProtocol Policy rule type of the traffic
 type PolicyRuleUpdateProtocol string
 
+// PolicySchedule Time windows when the policy is applied. Policy without a schedule is always applied.
+type PolicySchedule struct {
+	// Days Days of the week when the policy is active. Empty list means every day.
+	Days *[]string `json:"days,omitempty"`
+
+	// EndDate Time after which the policy is no longer active
+	EndDate *time.Time `json:"end_date,omitempty"`
+
+	// StartDate Time from which the policy becomes active
+	StartDate *time.Time `json:"start_date,omitempty"`
+
+	// TimeRanges Time ranges within a day when the policy is active. Empty list means the whole day.
+	TimeRanges *[]PolicyScheduleTimeRange `json:"time_ranges,omitempty"`
+
+	// Timezone IANA timezone name the days and time ranges are evaluated in. Defaults to UTC.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// PolicyScheduleTimeRange Daily time range in HH:MM format. A range with the end before the start wraps around midnight.
+type PolicyScheduleTimeRange struct {
+	// End The end of the time range
+	End string `json:"end"`
+
+	// Start The start of the time range
+	Start string `json:"start"`
+}
+
 // PolicyUpdate defines model for PolicyUpdate.
 type PolicyUpdate struct {
 	// Description Policy friendly description
@@ -907,6 +1581,9 @@ type PolicyUpdate struct {
 	// Rules Policy rule object for policy UI editor
 	Rules []PolicyRuleUpdate `json:"rules"`
 
+	// Schedule Time windows when the policy is applied. Policy without a schedule is always applied.
+	Schedule *PolicySchedule `json:"schedule,omitempty"`
+
 	// SourcePostureChecks Posture checks ID's applied to policy source groups
 	SourcePostureChecks *[]string `json:"source_posture_checks,omitempty"`
 }
@@ -919,6 +1596,9 @@ type PostureCheck struct {
 	// Description Posture check friendly description
 	Description *string `json:"description,omitempty"`
 
+	// GracePeriod Period in seconds peers failing the checks keep the access before being considered non-compliant
+	GracePeriod *int `json:"grace_period,omitempty"`
+
 	// Id Posture check ID
 	Id string `json:"id"`
 
@@ -926,6 +1606,24 @@ type PostureCheck struct {
 	Name string `json:"name"`
 }
 
+// PostureCheckFailure defines model for PostureCheckFailure.
+type PostureCheckFailure struct {
+	// Check Name of the failed check
+	Check string `json:"check"`
+
+	// PeerId ID of the peer failing the check
+	PeerId string `json:"peer_id"`
+
+	// PostureCheckId Posture check ID
+	PostureCheckId string `json:"posture_check_id"`
+
+	// PostureCheckName Posture check name
+	PostureCheckName string `json:"posture_check_name"`
+
+	// Reason Why the check failed
+	Reason string `json:"reason"`
+}
+
 // PostureCheckUpdate defines model for PostureCheckUpdate.
 type PostureCheckUpdate struct {
 	// Checks List of objects that perform the actual checks
@@ -934,6 +1632,9 @@ type PostureCheckUpdate struct {
 	// Description Posture check friendly description
 	Description string `json:"description"`
 
+	// GracePeriod Period in seconds peers failing the checks keep the access before being considered non-compliant
+	GracePeriod *int `json:"grace_period,omitempty"`
+
 	// Name Posture check name identifier
 	Name string `json:"name"`
 }
@@ -955,6 +1656,48 @@ type ProcessCheck struct {
 	Processes []Process `json:"processes"`
 }
 
+// Role defines model for Role.
+type Role struct {
+	// Description Role description
+	Description string `json:"description"`
+
+	// Id Role ID
+	Id string `json:"id"`
+
+	// Name Role name
+	Name string `json:"name"`
+
+	// Permissions Permissions granted by the role
+	Permissions []RolePermission `json:"permissions"`
+}
+
+// RolePermission defines model for RolePermission.
+type RolePermission struct {
+	// Operations Operations allowed on the resource
+	Operations []RolePermissionOperations `json:"operations"`
+
+	// Resource Account resource the permission applies to
+	Resource RolePermissionResource `json:"resource"`
+}
+
+// RolePermissionOperations defines model for RolePermission.Operations.
+type RolePermissionOperations string
+
+// RolePermissionResource Account resource the permission applies to
+type RolePermissionResource string
+
+// RoleRequest defines model for RoleRequest.
+type RoleRequest struct {
+	// Description Role description
+	Description *string `json:"description,omitempty"`
+
+	// Name Role name
+	Name string `json:"name"`
+
+	// Permissions Permissions granted by the role
+	Permissions []RolePermission `json:"permissions"`
+}
+
 // Route defines model for Route.
 type Route struct {
 	// AccessControlGroups Access control group identifier associated with route.
@@ -993,11 +1736,56 @@ type Route struct {
 	// NetworkType Network type indicating if it is a domain route or a IPv4/IPv6 route
 	NetworkType string `json:"network_type"`
 
-	// Peer Peer Identifier associated with route. This property can not be set together with `peer_groups`
+	// Peer Peer Identifier associated with route. This property can not be set together with `peer_groups` or `peer_tag_selector`
 	Peer *string `json:"peer,omitempty"`
 
-	// PeerGroups Peers Group Identifier associated with route. This property can not be set together with `peer`
+	// PeerGroups Peers Group Identifier associated with route. This property can not be set together with `peer` or `peer_tag_selector`
 	PeerGroups *[]string `json:"peer_groups,omitempty"`
+
+	// PeerTagSelector Tag selector of the routing peers. This property can not be set together with `peer` or `peer_groups`
+	PeerTagSelector *string `json:"peer_tag_selector,omitempty"`
+}
+
+// RouteExplanation defines model for RouteExplanation.
+type RouteExplanation struct {
+	// Matched Indicates whether the route forwards the connection
+	Matched bool `json:"matched"`
+
+	// Network Routed network range
+	Network string `json:"network"`
+
+	// NetworkId Route network identifier
+	NetworkId string `json:"network_id"`
+
+	// Reason Why the route doesn't forward the connection
+	Reason *string `json:"reason,omitempty"`
+
+	// RouteId Route ID
+	RouteId string `json:"route_id"`
+
+	// RoutingPeerId Routing peer the route firewall rules are evaluated on
+	RoutingPeerId *string `json:"routing_peer_id,omitempty"`
+}
+
+// RouteFirewallRuleExplanation defines model for RouteFirewallRuleExplanation.
+type RouteFirewallRuleExplanation struct {
+	// Action Action applied to the traffic
+	Action string `json:"action"`
+
+	// Destination Routed network range
+	Destination string `json:"destination"`
+
+	// Port Port of the traffic, 0 matches all ports
+	Port *int `json:"port,omitempty"`
+
+	// PortRange Policy rule affected ports range
+	PortRange *RulePortRange `json:"port_range,omitempty"`
+
+	// Protocol Protocol of the traffic
+	Protocol string `json:"protocol"`
+
+	// SourceRanges Source IP ranges allowed to use the route
+	SourceRanges []string `json:"source_ranges"`
 }
 
 // RouteRequest defines model for RouteRequest.
@@ -1032,11 +1820,14 @@ type RouteRequest struct {
 	// NetworkId Route network identifier, to group HA routes
 	NetworkId string `json:"network_id"`
 
-	// Peer Peer Identifier associated with route. This property can not be set together with `peer_groups`
+	// Peer Peer Identifier associated with route. This property can not be set together with `peer_groups` or `peer_tag_selector`
 	Peer *string `json:"peer,omitempty"`
 
-	// PeerGroups Peers Group Identifier associated with route. This property can not be set together with `peer`
+	// PeerGroups Peers Group Identifier associated with route. This property can not be set together with `peer` or `peer_tag_selector`
 	PeerGroups *[]string `json:"peer_groups,omitempty"`
+
+	// PeerTagSelector Tag selector of the routing peers. This property can not be set together with `peer` or `peer_groups`
+	PeerTagSelector *string `json:"peer_tag_selector,omitempty"`
 }
 
 // RulePortRange Policy rule affected ports range
@@ -1048,6 +1839,73 @@ type RulePortRange struct {
 	Start int `json:"start"`
 }
 
+// ScimToken defines model for ScimToken.
+type ScimToken struct {
+	// CreatedAt Date the token was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy User ID of the user who created the token
+	CreatedBy string `json:"created_by"`
+
+	// Id ID of the token
+	Id string `json:"id"`
+
+	// ServiceUserId ID of the service user the SCIM requests are performed as
+	ServiceUserId string `json:"service_user_id"`
+}
+
+// ScimTokenGenerated defines model for ScimTokenGenerated.
+type ScimTokenGenerated struct {
+	// PlainToken Plain text representation of the generated token
+	PlainToken string    `json:"plain_token"`
+	ScimToken  ScimToken `json:"scim_token"`
+}
+
+// Service defines model for Service.
+type Service struct {
+	// Description Service description
+	Description *string `json:"description,omitempty"`
+
+	// Id Service ID
+	Id string `json:"id"`
+
+	// Name Service name
+	Name string `json:"name"`
+
+	// PortRanges Port ranges of the service
+	PortRanges *[]RulePortRange `json:"port_ranges,omitempty"`
+
+	// Ports Ports of the service
+	Ports *[]string `json:"ports,omitempty"`
+
+	// Protocol Protocol of the service traffic
+	Protocol ServiceProtocol `json:"protocol"`
+}
+
+// ServiceProtocol Protocol of the service traffic
+type ServiceProtocol string
+
+// ServiceRequest defines model for ServiceRequest.
+type ServiceRequest struct {
+	// Description Service description
+	Description *string `json:"description,omitempty"`
+
+	// Name Service name
+	Name string `json:"name"`
+
+	// PortRanges Port ranges of the service
+	PortRanges *[]RulePortRange `json:"port_ranges,omitempty"`
+
+	// Ports Ports of the service
+	Ports *[]string `json:"ports,omitempty"`
+
+	// Protocol Protocol of the service traffic
+	Protocol ServiceRequestProtocol `json:"protocol"`
+}
+
+// ServiceRequestProtocol Protocol of the service traffic
+type ServiceRequestProtocol string
+
 // SetupKey defines model for SetupKey.
 type SetupKey struct {
 	// AutoGroups List of group IDs to auto-assign to peers registered with this key
@@ -1071,12 +1929,18 @@ type SetupKey struct {
 	// Name Setup key name identifier
 	Name string `json:"name"`
 
+	// Restrictions Restrictions on the machines that can register with the setup key, empty restrictions allow any machine
+	Restrictions SetupKeyRestrictions `json:"restrictions"`
+
 	// Revoked Setup key revocation status
 	Revoked bool `json:"revoked"`
 
 	// State Setup key status, "valid", "overused","expired" or "revoked"
 	State string `json:"state"`
 
+	// Tags Key/value labels of a peer that can be used in policy and route tag selectors
+	Tags PeerTags `json:"tags"`
+
 	// Type Setup key type, one-off for single time usage and reusable
 	Type string `json:"type"`
 
@@ -1113,12 +1977,18 @@ type SetupKeyBase struct {
 	// Name Setup key name identifier
 	Name string `json:"name"`
 
+	// Restrictions Restrictions on the machines that can register with the setup key, empty restrictions allow any machine
+	Restrictions SetupKeyRestrictions `json:"restrictions"`
+
 	// Revoked Setup key revocation status
 	Revoked bool `json:"revoked"`
 
 	// State Setup key status, "valid", "overused","expired" or "revoked"
 	State string `json:"state"`
 
+	// Tags Key/value labels of a peer that can be used in policy and route tag selectors
+	Tags PeerTags `json:"tags"`
+
 	// Type Setup key type, one-off for single time usage and reusable
 	Type string `json:"type"`
 
@@ -1158,12 +2028,18 @@ type SetupKeyClear struct {
 	// Name Setup key name identifier
 	Name string `json:"name"`
 
+	// Restrictions Restrictions on the machines that can register with the setup key, empty restrictions allow any machine
+	Restrictions SetupKeyRestrictions `json:"restrictions"`
+
 	// Revoked Setup key revocation status
 	Revoked bool `json:"revoked"`
 
 	// State Setup key status, "valid", "overused","expired" or "revoked"
 	State string `json:"state"`
 
+	// Tags Key/value labels of a peer that can be used in policy and route tag selectors
+	Tags PeerTags `json:"tags"`
+
 	// Type Setup key type, one-off for single time usage and reusable
 	Type string `json:"type"`
 
@@ -1180,13 +2056,34 @@ type SetupKeyClear struct {
 	Valid bool `json:"valid"`
 }
 
+// SetupKeyRestrictions Restrictions on the machines that can register with the setup key, empty restrictions allow any machine
+type SetupKeyRestrictions struct {
+	// AllowedOs List of operating systems of the peers allowed to register with the key, one of android, darwin, freebsd, ios, linux or windows
+	AllowedOs *[]string `json:"allowed_os,omitempty"`
+
+	// AllowedSourceRanges List of CIDRs the peers must connect from to register with the key
+	AllowedSourceRanges *[]string `json:"allowed_source_ranges,omitempty"`
+
+	// HostnamePattern Regular expression the whole hostname of the peers must match to register with the key
+	HostnamePattern *string `json:"hostname_pattern,omitempty"`
+
+	// PostureChecks List of posture check IDs the peers must pass to register with the key. Only NetBird version, OS version, geo location and peer network range checks are supported.
+	PostureChecks *[]string `json:"posture_checks,omitempty"`
+}
+
 // SetupKeyRequest defines model for SetupKeyRequest.
 type SetupKeyRequest struct {
 	// AutoGroups List of group IDs to auto-assign to peers registered with this key
 	AutoGroups []string `json:"auto_groups"`
 
+	// Restrictions Restrictions on the machines that can register with the setup key, empty restrictions allow any machine
+	Restrictions *SetupKeyRestrictions `json:"restrictions,omitempty"`
+
 	// Revoked Setup key revocation status
 	Revoked bool `json:"revoked"`
+
+	// Tags Key/value labels of a peer that can be used in policy and route tag selectors
+	Tags *PeerTags `json:"tags,omitempty"`
 }
 
 // User defines model for User.
@@ -1194,6 +2091,9 @@ type User struct {
 	// AutoGroups Group IDs to auto-assign to peers registered by this user
 	AutoGroups []string `json:"auto_groups"`
 
+	// CustomRoleId ID of the custom role extending the permissions of the user role
+	CustomRoleId *string `json:"custom_role_id,omitempty"`
+
 	// Email User's email address
 	Email string `json:"email"`
 
@@ -1229,6 +2129,23 @@ type User struct {
 // UserStatus User's status
 type UserStatus string
 
+// UserBulkOperation defines model for UserBulkOperation.
+type UserBulkOperation struct {
+	// Action Action of a bulk operation
+	Action BulkOperationAction `json:"action"`
+	Create *UserCreateRequest  `json:"create,omitempty"`
+
+	// Id ID of the updated or deleted user
+	Id     *string      `json:"id,omitempty"`
+	Update *UserRequest `json:"update,omitempty"`
+}
+
+// UserBulkRequest defines model for UserBulkRequest.
+type UserBulkRequest struct {
+	// Operations Operations to apply, only service users can be created
+	Operations []UserBulkOperation `json:"operations"`
+}
+
 // UserCreateRequest defines model for UserCreateRequest.
 type UserCreateRequest struct {
 	// AutoGroups Group IDs to auto-assign to peers registered by this user
@@ -1261,6 +2178,9 @@ type UserRequest struct {
 	// AutoGroups Group IDs to auto-assign to peers registered by this user
 	AutoGroups []string `json:"auto_groups"`
 
+	// CustomRoleId ID of the custom role extending the permissions of the user role. Empty value removes the custom role.
+	CustomRoleId *string `json:"custom_role_id,omitempty"`
+
 	// IsBlocked If set to true then user is blocked and can't use the system
 	IsBlocked bool `json:"is_blocked"`
 
@@ -1268,12 +2188,117 @@ type UserRequest struct {
 	Role string `json:"role"`
 }
 
+// WebhookEndpoint defines model for WebhookEndpoint.
+type WebhookEndpoint struct {
+	// CreatedAt Webhook endpoint creation date
+	CreatedAt time.Time `json:"created_at"`
+
+	// Enabled Webhook endpoint status
+	Enabled bool `json:"enabled"`
+
+	// Events List of activity codes the endpoint is subscribed to. Empty list subscribes to all events.
+	Events []string `json:"events"`
+
+	// Id Webhook endpoint ID
+	Id string `json:"id"`
+
+	// Name Webhook endpoint name
+	Name string `json:"name"`
+
+	// Url URL the activity events are posted to
+	Url string `json:"url"`
+}
+
+// WebhookEndpointRequest defines model for WebhookEndpointRequest.
+type WebhookEndpointRequest struct {
+	// Enabled Webhook endpoint status
+	Enabled bool `json:"enabled"`
+
+	// Events List of activity codes the endpoint is subscribed to. Empty list subscribes to all events.
+	Events *[]string `json:"events,omitempty"`
+
+	// Name Webhook endpoint name
+	Name string `json:"name"`
+
+	// Secret Secret used to sign the delivered payloads with HMAC-SHA256. Required on creation, keeps the existing secret on update if omitted.
+	Secret *string `json:"secret,omitempty"`
+
+	// Url URL the activity events are posted to
+	Url string `json:"url"`
+}
+
+// GetApiConfigExportParams defines parameters for GetApiConfigExport.
+type GetApiConfigExportParams struct {
+	// Format Encoding of the document
+	Format *GetApiConfigExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetApiConfigExportParamsFormat defines parameters for GetApiConfigExport.
+type GetApiConfigExportParamsFormat string
+
+// GetApiEventsParams defines parameters for GetApiEvents.
+type GetApiEventsParams struct {
+	// ActivityCode Returns only events with the given activity codes, e.g. activity_code=peer.rename&activity_code=route.add
+	ActivityCode *[]string `form:"activity_code,omitempty" json:"activity_code,omitempty"`
+
+	// InitiatorId Returns only events initiated by the given ID
+	InitiatorId *string `form:"initiator_id,omitempty" json:"initiator_id,omitempty"`
+
+	// TargetId Returns only events affecting the given ID
+	TargetId *string `form:"target_id,omitempty" json:"target_id,omitempty"`
+
+	// StartDate Returns only events that occurred at or after the given time
+	StartDate *time.Time `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Returns only events that occurred before the given time
+	EndDate *time.Time `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// Search Returns only events with metadata containing the given text or initiated by or targeting a user whose email or name contain it, case-insensitive
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Cursor Returns the page following the given cursor, taken from the X-Next-Cursor header of the previous page
+	Cursor *int64 `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of events to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Order Order of the events by their occurrence
+	Order *GetApiEventsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// GetApiEventsParamsOrder defines parameters for GetApiEvents.
+type GetApiEventsParamsOrder string
+
+// GetApiPeersPeerIdExplainParams defines parameters for GetApiPeersPeerIdExplain.
+type GetApiPeersPeerIdExplainParams struct {
+	// Destination Peer ID or IP address of a peer or a routed network
+	Destination string `form:"destination" json:"destination"`
+
+	// Protocol Protocol of the connection. Omitted protocol matches any protocol.
+	Protocol *GetApiPeersPeerIdExplainParamsProtocol `form:"protocol,omitempty" json:"protocol,omitempty"`
+
+	// Port Port of the connection. Omitted port matches any port.
+	Port *int `form:"port,omitempty" json:"port,omitempty"`
+}
+
+// GetApiPeersPeerIdExplainParamsProtocol defines parameters for GetApiPeersPeerIdExplain.
+type GetApiPeersPeerIdExplainParamsProtocol string
+
 // GetApiUsersParams defines parameters for GetApiUsers.
 type GetApiUsersParams struct {
 	// ServiceUser Filters users and returns either regular users or service users
 	ServiceUser *bool `form:"service_user,omitempty" json:"service_user,omitempty"`
 }
 
+// PostApiAccessRequestsJSONRequestBody defines body for PostApiAccessRequests for application/json ContentType.
+type PostApiAccessRequestsJSONRequestBody = AccessRequestCreate
+
+// PostApiConfigApplyJSONRequestBody defines body for PostApiConfigApply for application/json ContentType.
+type PostApiConfigApplyJSONRequestBody = AccountConfig
+
+// PostApiConfigPlanJSONRequestBody defines body for PostApiConfigPlan for application/json ContentType.
+type PostApiConfigPlanJSONRequestBody = AccountConfig
+
 // PutApiAccountsAccountIdJSONRequestBody defines body for PutApiAccountsAccountId for application/json ContentType.
 type PutApiAccountsAccountIdJSONRequestBody = AccountRequest
 
@@ -1289,12 +2314,39 @@ type PutApiDnsSettingsJSONRequestBody = DNSSettings
 // PostApiGroupsJSONRequestBody defines body for PostApiGroups for application/json ContentType.
 type PostApiGroupsJSONRequestBody = GroupRequest
 
+// PostApiGroupsBulkJSONRequestBody defines body for PostApiGroupsBulk for application/json ContentType.
+type PostApiGroupsBulkJSONRequestBody = GroupBulkRequest
+
 // PutApiGroupsGroupIdJSONRequestBody defines body for PutApiGroupsGroupId for application/json ContentType.
 type PutApiGroupsGroupIdJSONRequestBody = GroupRequest
 
+// PostApiOrganizationsJSONRequestBody defines body for PostApiOrganizations for application/json ContentType.
+type PostApiOrganizationsJSONRequestBody = OrganizationRequest
+
+// PutApiOrganizationsOrganizationIdJSONRequestBody defines body for PutApiOrganizationsOrganizationId for application/json ContentType.
+type PutApiOrganizationsOrganizationIdJSONRequestBody = OrganizationRequest
+
+// PostApiOrganizationsOrganizationIdAccountsJSONRequestBody defines body for PostApiOrganizationsOrganizationIdAccounts for application/json ContentType.
+type PostApiOrganizationsOrganizationIdAccountsJSONRequestBody = OrganizationAccountRequest
+
+// PostApiOrganizationsOrganizationIdAdminsJSONRequestBody defines body for PostApiOrganizationsOrganizationIdAdmins for application/json ContentType.
+type PostApiOrganizationsOrganizationIdAdminsJSONRequestBody = OrganizationAdminRequest
+
+// PostApiPeersBulkJSONRequestBody defines body for PostApiPeersBulk for application/json ContentType.
+type PostApiPeersBulkJSONRequestBody = PeerBulkRequest
+
+// PostApiPeersPendingApproveJSONRequestBody defines body for PostApiPeersPendingApprove for application/json ContentType.
+type PostApiPeersPendingApproveJSONRequestBody = PeerApprovalRequest
+
+// PostApiPeersPendingRejectJSONRequestBody defines body for PostApiPeersPendingReject for application/json ContentType.
+type PostApiPeersPendingRejectJSONRequestBody = PeerApprovalRequest
+
 // PutApiPeersPeerIdJSONRequestBody defines body for PutApiPeersPeerId for application/json ContentType.
 type PutApiPeersPeerIdJSONRequestBody = PeerRequest
 
+// PostApiPeersPeerIdExplainJSONRequestBody defines body for PostApiPeersPeerIdExplain for application/json ContentType.
+type PostApiPeersPeerIdExplainJSONRequestBody = PolicyExplainRequest
+
 // PostApiPoliciesJSONRequestBody defines body for PostApiPolicies for application/json ContentType.
 type PostApiPoliciesJSONRequestBody = PolicyUpdate
 
@@ -1307,12 +2359,24 @@ type PostApiPostureChecksJSONRequestBody = PostureCheckUpdate
 // PutApiPostureChecksPostureCheckIdJSONRequestBody defines body for PutApiPostureChecksPostureCheckId for application/json ContentType.
 type PutApiPostureChecksPostureCheckIdJSONRequestBody = PostureCheckUpdate
 
+// PostApiRolesJSONRequestBody defines body for PostApiRoles for application/json ContentType.
+type PostApiRolesJSONRequestBody = RoleRequest
+
+// PutApiRolesRoleIdJSONRequestBody defines body for PutApiRolesRoleId for application/json ContentType.
+type PutApiRolesRoleIdJSONRequestBody = RoleRequest
+
 // PostApiRoutesJSONRequestBody defines body for PostApiRoutes for application/json ContentType.
 type PostApiRoutesJSONRequestBody = RouteRequest
 
 // PutApiRoutesRouteIdJSONRequestBody defines body for PutApiRoutesRouteId for application/json ContentType.
 type PutApiRoutesRouteIdJSONRequestBody = RouteRequest
 
+// PostApiServicesJSONRequestBody defines body for PostApiServices for application/json ContentType.
+type PostApiServicesJSONRequestBody = ServiceRequest
+
+// PutApiServicesServiceIdJSONRequestBody defines body for PutApiServicesServiceId for application/json ContentType.
+type PutApiServicesServiceIdJSONRequestBody = ServiceRequest
+
 // PostApiSetupKeysJSONRequestBody defines body for PostApiSetupKeys for application/json ContentType.
 type PostApiSetupKeysJSONRequestBody = CreateSetupKeyRequest
 
@@ -1322,8 +2386,17 @@ type PutApiSetupKeysKeyIdJSONRequestBody = SetupKeyRequest
 // PostApiUsersJSONRequestBody defines body for PostApiUsers for application/json ContentType.
 type PostApiUsersJSONRequestBody = UserCreateRequest
 
+// PostApiUsersBulkJSONRequestBody defines body for PostApiUsersBulk for application/json ContentType.
+type PostApiUsersBulkJSONRequestBody = UserBulkRequest
+
 // PutApiUsersUserIdJSONRequestBody defines body for PutApiUsersUserId for application/json ContentType.
 type PutApiUsersUserIdJSONRequestBody = UserRequest
 
 // PostApiUsersUserIdTokensJSONRequestBody defines body for PostApiUsersUserIdTokens for application/json ContentType.
 type PostApiUsersUserIdTokensJSONRequestBody = PersonalAccessTokenRequest
+
+// PostApiWebhooksJSONRequestBody defines body for PostApiWebhooks for application/json ContentType.
+type PostApiWebhooksJSONRequestBody = WebhookEndpointRequest
+
+// PutApiWebhooksWebhookIdJSONRequestBody defines body for PutApiWebhooksWebhookId for application/json ContentType.
+type PutApiWebhooksWebhookIdJSONRequestBody = WebhookEndpointRequest
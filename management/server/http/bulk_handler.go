@@ -0,0 +1,271 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// BulkHandler is a handler that applies many operations on peers, groups or users of the account in a single request
+type BulkHandler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+// NewBulkHandler creates a new BulkHandler HTTP handler
+func NewBulkHandler(accountManager server.AccountManager, authCfg AuthCfg) *BulkHandler {
+	return &BulkHandler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// ApplyPeerOperations handles the update and deletion of many peers
+func (h *BulkHandler) ApplyPeerOperations(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PostApiPeersBulkJSONRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	operations := make([]*server.PeerBulkOperation, 0, len(req.Operations))
+	for i, op := range req.Operations {
+		peer := &nbpeer.Peer{ID: op.Id}
+		if op.Action == api.BulkOperationActionUpdate {
+			if op.Update == nil {
+				util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "operation %d: update is missing", i), w)
+				return
+			}
+			peer = toPeerUpdate(op.Id, op.Update)
+		}
+
+		operations = append(operations, &server.PeerBulkOperation{Action: server.BulkAction(op.Action), Peer: peer})
+	}
+
+	result, err := h.accountManager.ApplyPeerBulkOperations(r.Context(), accountID, userID, operations)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toBulkResponse(r.Context(), result))
+}
+
+// ApplyGroupOperations handles the creation, update and deletion of many groups
+func (h *BulkHandler) ApplyGroupOperations(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PostApiGroupsBulkJSONRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	operations := make([]*server.GroupBulkOperation, 0, len(req.Operations))
+	for i, op := range req.Operations {
+		group := &nbgroup.Group{}
+		if op.Id != nil {
+			group.ID = *op.Id
+		}
+
+		var groupReq *api.GroupRequest
+		switch op.Action {
+		case api.BulkOperationActionCreate:
+			groupReq = op.Create
+		case api.BulkOperationActionUpdate:
+			groupReq = op.Update
+		}
+
+		if op.Action != api.BulkOperationActionCreate && group.ID == "" {
+			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "operation %d: group ID is missing", i), w)
+			return
+		}
+
+		if op.Action != api.BulkOperationActionDelete {
+			if groupReq == nil {
+				util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "operation %d: %s is missing", i, op.Action), w)
+				return
+			}
+
+			group.Name = groupReq.Name
+			if groupReq.Peers != nil {
+				group.Peers = *groupReq.Peers
+			}
+			if groupReq.Rule != nil {
+				group.Rule = *groupReq.Rule
+			}
+		}
+
+		operations = append(operations, &server.GroupBulkOperation{Action: server.BulkAction(op.Action), Group: group})
+	}
+
+	result, err := h.accountManager.ApplyGroupBulkOperations(r.Context(), accountID, userID, operations)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toBulkResponse(r.Context(), result))
+}
+
+// ApplyUserOperations handles the creation of many service users and the update and deletion of many users
+func (h *BulkHandler) ApplyUserOperations(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PostApiUsersBulkJSONRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	operations := make([]*server.UserBulkOperation, 0, len(req.Operations))
+	for i, op := range req.Operations {
+		operation := &server.UserBulkOperation{Action: server.BulkAction(op.Action), User: &server.User{}}
+		if op.Id != nil {
+			operation.User.Id = *op.Id
+		}
+
+		if op.Action != api.BulkOperationActionCreate && operation.User.Id == "" {
+			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "operation %d: user ID is missing", i), w)
+			return
+		}
+
+		switch op.Action {
+		case api.BulkOperationActionCreate:
+			if op.Create == nil {
+				util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "operation %d: create is missing", i), w)
+				return
+			}
+
+			operation.User.IsServiceUser = op.Create.IsServiceUser
+			operation.User.Role = server.StrRoleToUserRole(op.Create.Role)
+			operation.User.AutoGroups = op.Create.AutoGroups
+			if op.Create.Name != nil {
+				operation.User.ServiceUserName = *op.Create.Name
+			}
+		case api.BulkOperationActionUpdate:
+			if op.Update == nil {
+				util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "operation %d: update is missing", i), w)
+				return
+			}
+
+			operation.User.Role = server.StrRoleToUserRole(op.Update.Role)
+			if operation.User.Role == server.UserRoleUnknown {
+				util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "operation %d: invalid user role", i), w)
+				return
+			}
+
+			operation.User.AutoGroups = op.Update.AutoGroups
+			operation.User.Blocked = op.Update.IsBlocked
+			operation.CustomRoleID = op.Update.CustomRoleId
+		}
+
+		operations = append(operations, operation)
+	}
+
+	result, err := h.accountManager.ApplyUserBulkOperations(r.Context(), accountID, userID, operations)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toBulkResponse(r.Context(), result))
+}
+
+func toPeerUpdate(peerID string, req *api.PeerRequest) *nbpeer.Peer {
+	update := &nbpeer.Peer{
+		ID:                     peerID,
+		SSHEnabled:             req.SshEnabled,
+		Name:                   req.Name,
+		LoginExpirationEnabled: req.LoginExpirationEnabled,
+
+		InactivityExpirationEnabled: req.InactivityExpirationEnabled,
+	}
+
+	if req.Tags != nil {
+		update.Tags = *req.Tags
+	}
+
+	if req.ApprovalRequired != nil {
+		update.Status = &nbpeer.PeerStatus{
+			RequiresApproval: *req.ApprovalRequired,
+		}
+	}
+
+	return update
+}
+
+func toBulkResponse(ctx context.Context, result *server.BulkResult) *api.BulkResponse {
+	resp := &api.BulkResponse{
+		Applied: result.Applied,
+		Results: make([]api.BulkOperationResult, 0, len(result.Results)),
+	}
+
+	for _, operationResult := range result.Results {
+		item := api.BulkOperationResult{
+			Action: api.BulkOperationAction(operationResult.Action),
+			Status: api.BulkOperationResultStatus(operationResult.Status),
+		}
+
+		if operationResult.ID != "" {
+			id := operationResult.ID
+			item.Id = &id
+		}
+
+		if operationResult.Error != nil {
+			msg := toBulkErrorMessage(ctx, operationResult.Error)
+			item.Error = &msg
+		}
+
+		resp.Results = append(resp.Results, item)
+	}
+
+	return resp
+}
+
+// toBulkErrorMessage returns the message of a failed operation the same way util.WriteError does for single requests
+func toBulkErrorMessage(ctx context.Context, err error) string {
+	if _, ok := status.FromError(err); ok {
+		return strings.ToLower(err.Error())
+	}
+
+	var linkErr *server.GroupLinkError
+	if errors.As(err, &linkErr) {
+		return err.Error()
+	}
+
+	log.WithContext(ctx).Errorf("got unhandled error in bulk operation: %s", err)
+	return "internal server error"
+}
@@ -0,0 +1,166 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+func initBulkTestData() *BulkHandler {
+	return &BulkHandler{
+		accountManager: &mock_server.MockAccountManager{
+			GetAccountIDFromTokenFunc: func(_ context.Context, claims jwtclaims.AuthorizationClaims) (string, string, error) {
+				return claims.AccountId, claims.UserId, nil
+			},
+			ApplyPeerBulkOperationsFunc: func(_ context.Context, accountID, userID string, operations []*server.PeerBulkOperation) (*server.BulkResult, error) {
+				result := &server.BulkResult{Applied: true}
+				for _, operation := range operations {
+					opResult := &server.BulkOperationResult{Action: operation.Action, ID: operation.Peer.ID, Status: server.BulkOperationApplied}
+					if operation.Peer.ID == "unknown" {
+						result.Applied = false
+						opResult.Status = server.BulkOperationFailed
+						opResult.Error = status.Errorf(status.NotFound, "peer unknown not found")
+					}
+					result.Results = append(result.Results, opResult)
+				}
+				if !result.Applied {
+					for _, opResult := range result.Results {
+						if opResult.Status == server.BulkOperationApplied {
+							opResult.Status = server.BulkOperationSkipped
+						}
+					}
+				}
+				return result, nil
+			},
+			ApplyGroupBulkOperationsFunc: func(_ context.Context, accountID, userID string, operations []*server.GroupBulkOperation) (*server.BulkResult, error) {
+				result := &server.BulkResult{Applied: true}
+				for _, operation := range operations {
+					groupID := operation.Group.ID
+					if operation.Action == server.BulkActionCreate {
+						groupID = "new_group"
+					}
+					result.Results = append(result.Results, &server.BulkOperationResult{Action: operation.Action, ID: groupID, Status: server.BulkOperationApplied})
+				}
+				return result, nil
+			},
+			ApplyUserBulkOperationsFunc: func(_ context.Context, accountID, userID string, operations []*server.UserBulkOperation) (*server.BulkResult, error) {
+				result := &server.BulkResult{Applied: true}
+				for _, operation := range operations {
+					id := operation.User.Id
+					if operation.Action == server.BulkActionCreate {
+						id = "new_user"
+					}
+					result.Results = append(result.Results, &server.BulkOperationResult{Action: operation.Action, ID: id, Status: server.BulkOperationApplied})
+				}
+				return result, nil
+			},
+		},
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithFromRequestContext(func(r *http.Request) jwtclaims.AuthorizationClaims {
+				return jwtclaims.AuthorizationClaims{
+					UserId:    existingUserID,
+					Domain:    testDomain,
+					AccountId: "test_id",
+				}
+			}),
+		),
+	}
+}
+
+func TestBulkHandlers(t *testing.T) {
+	tt := []struct {
+		name           string
+		requestPath    string
+		requestBody    string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Apply peer operations",
+			requestPath:    "/api/peers/bulk",
+			requestBody:    `{"operations":[{"action":"update","id":"peer1","update":{"name":"peer1","ssh_enabled":true,"login_expiration_enabled":false,"inactivity_expiration_enabled":false}},{"action":"delete","id":"peer2"}]}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"applied":true,"results":[{"action":"update","id":"peer1","status":"applied"},{"action":"delete","id":"peer2","status":"applied"}]}`,
+		},
+		{
+			name:           "Apply peer operations with failed operation",
+			requestPath:    "/api/peers/bulk",
+			requestBody:    `{"operations":[{"action":"delete","id":"peer1"},{"action":"delete","id":"unknown"}]}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"applied":false,"results":[{"action":"delete","id":"peer1","status":"skipped"},{"action":"delete","id":"unknown","status":"failed","error":"peer unknown not found"}]}`,
+		},
+		{
+			name:           "Apply peer update without update",
+			requestPath:    "/api/peers/bulk",
+			requestBody:    `{"operations":[{"action":"update","id":"peer1"}]}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Apply peer operations with invalid body",
+			requestPath:    "/api/peers/bulk",
+			requestBody:    `{"operations":`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Apply group operations",
+			requestPath:    "/api/groups/bulk",
+			requestBody:    `{"operations":[{"action":"create","create":{"name":"new"}},{"action":"update","id":"group1","update":{"name":"group1","peers":["peer1"]}},{"action":"delete","id":"group2"}]}`,
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"applied":true,"results":[{"action":"create","id":"new_group","status":"applied"},` +
+				`{"action":"update","id":"group1","status":"applied"},{"action":"delete","id":"group2","status":"applied"}]}`,
+		},
+		{
+			name:           "Apply group delete without ID",
+			requestPath:    "/api/groups/bulk",
+			requestBody:    `{"operations":[{"action":"delete"}]}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Apply user operations",
+			requestPath:    "/api/users/bulk",
+			requestBody:    `{"operations":[{"action":"create","create":{"name":"service","role":"user","auto_groups":[],"is_service_user":true}},{"action":"update","id":"user1","update":{"role":"admin","auto_groups":[],"is_blocked":false}}]}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"applied":true,"results":[{"action":"create","id":"new_user","status":"applied"},{"action":"update","id":"user1","status":"applied"}]}`,
+		},
+		{
+			name:           "Apply user update with invalid role",
+			requestPath:    "/api/users/bulk",
+			requestBody:    `{"operations":[{"action":"update","id":"user1","update":{"role":"superuser","auto_groups":[],"is_blocked":false}}]}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	handler := initBulkTestData()
+
+	router := mux.NewRouter()
+	router.HandleFunc("/api/peers/bulk", handler.ApplyPeerOperations).Methods("POST")
+	router.HandleFunc("/api/groups/bulk", handler.ApplyGroupOperations).Methods("POST")
+	router.HandleFunc("/api/users/bulk", handler.ApplyUserOperations).Methods("POST")
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, tc.requestPath, bytes.NewBufferString(tc.requestBody))
+
+			router.ServeHTTP(recorder, req)
+
+			if !assert.Equal(t, tc.expectedStatus, recorder.Code, recorder.Body.String()) {
+				return
+			}
+
+			if tc.expectedBody != "" {
+				assert.JSONEq(t, tc.expectedBody, recorder.Body.String())
+			}
+		})
+	}
+}
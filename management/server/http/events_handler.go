@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
@@ -12,8 +15,12 @@ import (
 	"github.com/netbirdio/netbird/management/server/http/api"
 	"github.com/netbirdio/netbird/management/server/http/util"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/status"
 )
 
+// nextCursorHeader holds the cursor to request the next page of events with
+const nextCursorHeader = "X-Next-Cursor"
+
 // EventsHandler HTTP handler
 type EventsHandler struct {
 	accountManager  server.AccountManager
@@ -41,7 +48,13 @@ func (h *EventsHandler) GetAllEvents(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	accountEvents, err := h.accountManager.GetEvents(r.Context(), accountID, userID)
+	filter, err := eventsFilterFromQuery(r.URL.Query())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountEvents, err := h.accountManager.GetEvents(r.Context(), accountID, userID, filter)
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
 		return
@@ -57,9 +70,71 @@ func (h *EventsHandler) GetAllEvents(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// an empty page means there are no more events
+	if len(accountEvents) > 0 {
+		w.Header().Set(nextCursorHeader, strconv.FormatUint(accountEvents[len(accountEvents)-1].ID, 10))
+	}
+
 	util.WriteJSONObject(r.Context(), w, events)
 }
 
+// eventsFilterFromQuery builds an events filter from the GET /events query parameters
+func eventsFilterFromQuery(query url.Values) (activity.Filter, error) {
+	filter := activity.Filter{
+		InitiatorID: query.Get("initiator_id"),
+		TargetID:    query.Get("target_id"),
+		Search:      query.Get("search"),
+		Descending:  true,
+	}
+
+	for _, code := range query["activity_code"] {
+		a, ok := activity.ActivityFromStringCode(code)
+		if !ok {
+			return filter, status.Errorf(status.InvalidArgument, "invalid activity_code query parameter: %s", code)
+		}
+		filter.Activities = append(filter.Activities, a)
+	}
+
+	var err error
+	if startDate := query.Get("start_date"); startDate != "" {
+		filter.StartTime, err = time.Parse(time.RFC3339, startDate)
+		if err != nil {
+			return filter, status.Errorf(status.InvalidArgument, "invalid start_date query parameter, expected RFC 3339 format")
+		}
+	}
+
+	if endDate := query.Get("end_date"); endDate != "" {
+		filter.EndTime, err = time.Parse(time.RFC3339, endDate)
+		if err != nil {
+			return filter, status.Errorf(status.InvalidArgument, "invalid end_date query parameter, expected RFC 3339 format")
+		}
+	}
+
+	if cursor := query.Get("cursor"); cursor != "" {
+		filter.Cursor, err = strconv.ParseUint(cursor, 10, 64)
+		if err != nil {
+			return filter, status.Errorf(status.InvalidArgument, "invalid cursor query parameter")
+		}
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		filter.Limit, err = strconv.Atoi(limit)
+		if err != nil || filter.Limit < 1 {
+			return filter, status.Errorf(status.InvalidArgument, "invalid limit query parameter, expected a positive number")
+		}
+	}
+
+	switch api.GetApiEventsParamsOrder(query.Get("order")) {
+	case "", api.GetApiEventsParamsOrderDesc:
+	case api.GetApiEventsParamsOrderAsc:
+		filter.Descending = false
+	default:
+		return filter, status.Errorf(status.InvalidArgument, "invalid order query parameter, expected asc or desc")
+	}
+
+	return filter, nil
+}
+
 func (h *EventsHandler) fillEventsWithUserInfo(ctx context.Context, events []*api.Event, accountId, userId string) error {
 	// build email, name maps based on users
 	userInfos, err := h.accountManager.GetUsersFromAccount(ctx, accountId, userId)
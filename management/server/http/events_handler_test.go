@@ -6,12 +6,14 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"testing"
 	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/netbirdio/netbird/management/server"
 	"github.com/netbirdio/netbird/management/server/activity"
@@ -23,7 +25,7 @@ import (
 func initEventsTestData(account string, events ...*activity.Event) *EventsHandler {
 	return &EventsHandler{
 		accountManager: &mock_server.MockAccountManager{
-			GetEventsFunc: func(_ context.Context, accountID, userID string) ([]*activity.Event, error) {
+			GetEventsFunc: func(_ context.Context, accountID, userID string, _ activity.Filter) ([]*activity.Event, error) {
 				if accountID == account {
 					return events, nil
 				}
@@ -246,3 +248,40 @@ func TestEvents_GetEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestEvents_FilterFromQuery(t *testing.T) {
+	filter, err := eventsFilterFromQuery(url.Values{
+		"activity_code": {activity.PeerAddedByUser.StringCode(), activity.UserJoined.StringCode()},
+		"initiator_id":  {"test_user"},
+		"search":        {"100.64"},
+		"start_date":    {"2024-01-01T00:00:00Z"},
+		"cursor":        {"42"},
+		"limit":         {"10"},
+		"order":         {"asc"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []activity.Activity{activity.PeerAddedByUser, activity.UserJoined}, filter.Activities)
+	assert.Equal(t, "test_user", filter.InitiatorID)
+	assert.Equal(t, "100.64", filter.Search)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), filter.StartTime)
+	assert.True(t, filter.EndTime.IsZero())
+	assert.Equal(t, uint64(42), filter.Cursor)
+	assert.Equal(t, 10, filter.Limit)
+	assert.False(t, filter.Descending)
+
+	filter, err = eventsFilterFromQuery(url.Values{})
+	require.NoError(t, err)
+	assert.True(t, filter.Descending, "newest events should be returned first by default")
+
+	invalid := []url.Values{
+		{"activity_code": {"unknown.code"}},
+		{"start_date": {"yesterday"}},
+		{"cursor": {"-1"}},
+		{"limit": {"0"}},
+		{"order": {"random"}},
+	}
+	for _, query := range invalid {
+		_, err = eventsFilterFromQuery(query)
+		assert.Error(t, err, query.Encode())
+	}
+}
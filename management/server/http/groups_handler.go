@@ -125,6 +125,9 @@ func (h *GroupsHandler) UpdateGroup(w http.ResponseWriter, r *http.Request) {
 		Issued:               existingGroup.Issued,
 		IntegrationReference: existingGroup.IntegrationReference,
 	}
+	if req.Rule != nil {
+		group.Rule = *req.Rule
+	}
 
 	if err := h.accountManager.SaveGroup(r.Context(), accountID, userID, &group); err != nil {
 		log.WithContext(r.Context()).Errorf("failed updating group %s under account %s %v", groupID, accountID, err)
@@ -173,6 +176,9 @@ func (h *GroupsHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
 		Peers:  peers,
 		Issued: nbgroup.GroupIssuedAPI,
 	}
+	if req.Rule != nil {
+		group.Rule = *req.Rule
+	}
 
 	err = h.accountManager.SaveGroup(r.Context(), accountID, userID, &group)
 	if err != nil {
@@ -261,6 +267,10 @@ func toGroupResponse(peers []*nbpeer.Peer, group *nbgroup.Group) *api.Group {
 		Issued: (*api.GroupIssued)(&group.Issued),
 	}
 
+	if group.IsDynamic() {
+		gr.Rule = &group.Rule
+	}
+
 	for _, pid := range group.Peers {
 		_, ok := cache[pid]
 		if !ok {
@@ -18,7 +18,10 @@ import (
 	"github.com/netbirdio/netbird/management/server/telemetry"
 )
 
-const apiPrefix = "/api"
+const (
+	apiPrefix  = "/api"
+	scimPrefix = "/scim/v2"
+)
 
 // AuthCfg contains parameters for authentication middleware
 type AuthCfg struct {
@@ -51,6 +54,7 @@ func APIHandler(ctx context.Context, accountManager s.AccountManager, LocationMa
 		jwtValidator.ValidateAndParse,
 		accountManager.MarkPATUsed,
 		accountManager.CheckUserAccessByJWTGroups,
+		accountManager.GetOrganizationAccountClaims,
 		claimsExtractor,
 		authCfg.Audience,
 		authCfg.UserIDClaim,
@@ -66,7 +70,8 @@ func APIHandler(ctx context.Context, accountManager s.AccountManager, LocationMa
 	acMiddleware := middleware.NewAccessControl(
 		authCfg.Audience,
 		authCfg.UserIDClaim,
-		accountManager.GetUser)
+		accountManager.GetUser,
+		accountManager.GetUserCustomRole)
 
 	rootRouter := mux.NewRouter()
 	metricsMiddleware := appMetrics.HTTPMiddleware()
@@ -87,18 +92,30 @@ func APIHandler(ctx context.Context, accountManager s.AccountManager, LocationMa
 	}
 
 	api.addAccountsEndpoint()
+	api.addBulkEndpoint()
 	api.addPeersEndpoint()
 	api.addUsersEndpoint()
 	api.addUsersTokensEndpoint()
 	api.addSetupKeysEndpoint()
 	api.addPoliciesEndpoint()
+	api.addAccessRequestsEndpoint()
 	api.addGroupsEndpoint()
 	api.addRoutesEndpoint()
 	api.addDNSNameserversEndpoint()
 	api.addDNSSettingEndpoint()
 	api.addEventsEndpoint()
+	api.addWebhooksEndpoint()
+	api.addRolesEndpoint()
+	api.addAccountConfigEndpoint()
 	api.addPostureCheckEndpoint()
+	api.addServicesEndpoint()
 	api.addLocationsEndpoint()
+	api.addSCIMTokenEndpoint()
+	api.addOrganizationsEndpoint()
+
+	scimRouter := rootRouter.PathPrefix(scimPrefix).Subrouter()
+	scimRouter.Use(metricsMiddleware.Handler, corsMiddleware.Handler, middleware.NewSCIMAuthMiddleware(accountManager.GetAccountIDFromSCIMToken).Handler)
+	addSCIMEndpoints(scimRouter, accountManager)
 
 	return rootRouter, nil
 }
@@ -110,12 +127,25 @@ func (apiHandler *apiHandler) addAccountsEndpoint() {
 	apiHandler.Router.HandleFunc("/accounts", accountsHandler.GetAllAccounts).Methods("GET", "OPTIONS")
 }
 
+func (apiHandler *apiHandler) addBulkEndpoint() {
+	bulkHandler := NewBulkHandler(apiHandler.AccountManager, apiHandler.AuthCfg)
+	apiHandler.Router.HandleFunc("/peers/bulk", bulkHandler.ApplyPeerOperations).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/groups/bulk", bulkHandler.ApplyGroupOperations).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/users/bulk", bulkHandler.ApplyUserOperations).Methods("POST", "OPTIONS")
+}
+
 func (apiHandler *apiHandler) addPeersEndpoint() {
 	peersHandler := NewPeersHandler(apiHandler.AccountManager, apiHandler.AuthCfg)
 	apiHandler.Router.HandleFunc("/peers", peersHandler.GetAllPeers).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/peers/pending", peersHandler.GetPendingPeers).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/peers/pending/approve", peersHandler.ApprovePendingPeers).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/peers/pending/reject", peersHandler.RejectPendingPeers).Methods("POST", "OPTIONS")
 	apiHandler.Router.HandleFunc("/peers/{peerId}", peersHandler.HandlePeer).
 		Methods("GET", "PUT", "DELETE", "OPTIONS")
 	apiHandler.Router.HandleFunc("/peers/{peerId}/accessible-peers", peersHandler.GetAccessiblePeers).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/peers/{peerId}/posture", peersHandler.GetPeerPostureStatus).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/peers/{peerId}/explain", peersHandler.ExplainPeer).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/peers/{peerId}/explain", peersHandler.DryRunExplainPeer).Methods("POST", "OPTIONS")
 }
 
 func (apiHandler *apiHandler) addUsersEndpoint() {
@@ -151,6 +181,16 @@ func (apiHandler *apiHandler) addPoliciesEndpoint() {
 	apiHandler.Router.HandleFunc("/policies/{policyId}", policiesHandler.UpdatePolicy).Methods("PUT", "OPTIONS")
 	apiHandler.Router.HandleFunc("/policies/{policyId}", policiesHandler.GetPolicy).Methods("GET", "OPTIONS")
 	apiHandler.Router.HandleFunc("/policies/{policyId}", policiesHandler.DeletePolicy).Methods("DELETE", "OPTIONS")
+	apiHandler.Router.HandleFunc("/policies/{policyId}/audit", policiesHandler.GetPolicyAudit).Methods("GET", "OPTIONS")
+}
+
+func (apiHandler *apiHandler) addAccessRequestsEndpoint() {
+	accessRequestsHandler := NewAccessRequestsHandler(apiHandler.AccountManager, apiHandler.AuthCfg)
+	apiHandler.Router.HandleFunc("/access-requests", accessRequestsHandler.GetAllAccessRequests).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/access-requests", accessRequestsHandler.CreateAccessRequest).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/access-requests/{requestId}", accessRequestsHandler.GetAccessRequest).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/access-requests/{requestId}/approve", accessRequestsHandler.ApproveAccessRequest).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/access-requests/{requestId}/deny", accessRequestsHandler.DenyAccessRequest).Methods("POST", "OPTIONS")
 }
 
 func (apiHandler *apiHandler) addGroupsEndpoint() {
@@ -191,6 +231,31 @@ func (apiHandler *apiHandler) addEventsEndpoint() {
 	apiHandler.Router.HandleFunc("/events", eventsHandler.GetAllEvents).Methods("GET", "OPTIONS")
 }
 
+func (apiHandler *apiHandler) addWebhooksEndpoint() {
+	webhooksHandler := NewWebhooksHandler(apiHandler.AccountManager, apiHandler.AuthCfg)
+	apiHandler.Router.HandleFunc("/webhooks", webhooksHandler.GetAllWebhooks).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/webhooks", webhooksHandler.CreateWebhook).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/webhooks/{webhookId}", webhooksHandler.UpdateWebhook).Methods("PUT", "OPTIONS")
+	apiHandler.Router.HandleFunc("/webhooks/{webhookId}", webhooksHandler.GetWebhook).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/webhooks/{webhookId}", webhooksHandler.DeleteWebhook).Methods("DELETE", "OPTIONS")
+}
+
+func (apiHandler *apiHandler) addRolesEndpoint() {
+	rolesHandler := NewRolesHandler(apiHandler.AccountManager, apiHandler.AuthCfg)
+	apiHandler.Router.HandleFunc("/roles", rolesHandler.GetAllRoles).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/roles", rolesHandler.CreateRole).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/roles/{roleId}", rolesHandler.UpdateRole).Methods("PUT", "OPTIONS")
+	apiHandler.Router.HandleFunc("/roles/{roleId}", rolesHandler.GetRole).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/roles/{roleId}", rolesHandler.DeleteRole).Methods("DELETE", "OPTIONS")
+}
+
+func (apiHandler *apiHandler) addAccountConfigEndpoint() {
+	accountConfigHandler := NewAccountConfigHandler(apiHandler.AccountManager, apiHandler.AuthCfg)
+	apiHandler.Router.HandleFunc("/config/export", accountConfigHandler.ExportConfig).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/config/plan", accountConfigHandler.PlanConfig).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/config/apply", accountConfigHandler.ApplyConfig).Methods("POST", "OPTIONS")
+}
+
 func (apiHandler *apiHandler) addPostureCheckEndpoint() {
 	postureCheckHandler := NewPostureChecksHandler(apiHandler.AccountManager, apiHandler.geolocationManager, apiHandler.AuthCfg)
 	apiHandler.Router.HandleFunc("/posture-checks", postureCheckHandler.GetAllPostureChecks).Methods("GET", "OPTIONS")
@@ -200,8 +265,57 @@ func (apiHandler *apiHandler) addPostureCheckEndpoint() {
 	apiHandler.Router.HandleFunc("/posture-checks/{postureCheckId}", postureCheckHandler.DeletePostureCheck).Methods("DELETE", "OPTIONS")
 }
 
+func (apiHandler *apiHandler) addServicesEndpoint() {
+	servicesHandler := NewServicesHandler(apiHandler.AccountManager, apiHandler.AuthCfg)
+	apiHandler.Router.HandleFunc("/services", servicesHandler.GetAllServices).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/services", servicesHandler.CreateService).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/services/{serviceId}", servicesHandler.UpdateService).Methods("PUT", "OPTIONS")
+	apiHandler.Router.HandleFunc("/services/{serviceId}", servicesHandler.GetService).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/services/{serviceId}", servicesHandler.DeleteService).Methods("DELETE", "OPTIONS")
+	apiHandler.Router.HandleFunc("/services/{serviceId}/policies", servicesHandler.GetServicePolicies).Methods("GET", "OPTIONS")
+}
+
 func (apiHandler *apiHandler) addLocationsEndpoint() {
 	locationHandler := NewGeolocationsHandlerHandler(apiHandler.AccountManager, apiHandler.geolocationManager, apiHandler.AuthCfg)
 	apiHandler.Router.HandleFunc("/locations/countries", locationHandler.GetAllCountries).Methods("GET", "OPTIONS")
 	apiHandler.Router.HandleFunc("/locations/countries/{country}/cities", locationHandler.GetCitiesByCountry).Methods("GET", "OPTIONS")
 }
+
+func (apiHandler *apiHandler) addSCIMTokenEndpoint() {
+	scimTokenHandler := NewSCIMTokenHandler(apiHandler.AccountManager, apiHandler.AuthCfg)
+	apiHandler.Router.HandleFunc("/scim-token", scimTokenHandler.GetSCIMToken).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/scim-token", scimTokenHandler.CreateSCIMToken).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/scim-token", scimTokenHandler.DeleteSCIMToken).Methods("DELETE", "OPTIONS")
+}
+
+func (apiHandler *apiHandler) addOrganizationsEndpoint() {
+	organizationsHandler := NewOrganizationsHandler(apiHandler.AccountManager, apiHandler.AuthCfg)
+	apiHandler.Router.HandleFunc("/organizations", organizationsHandler.GetAllOrganizations).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/organizations", organizationsHandler.CreateOrganization).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/organizations/{organizationId}", organizationsHandler.GetOrganization).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/organizations/{organizationId}", organizationsHandler.UpdateOrganization).Methods("PUT", "OPTIONS")
+	apiHandler.Router.HandleFunc("/organizations/{organizationId}", organizationsHandler.DeleteOrganization).Methods("DELETE", "OPTIONS")
+	apiHandler.Router.HandleFunc("/organizations/{organizationId}/accounts", organizationsHandler.GetOrganizationAccounts).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/organizations/{organizationId}/accounts", organizationsHandler.CreateOrganizationAccount).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/organizations/{organizationId}/accounts/{accountId}", organizationsHandler.RemoveOrganizationAccount).Methods("DELETE", "OPTIONS")
+	apiHandler.Router.HandleFunc("/organizations/{organizationId}/admins", organizationsHandler.GetOrganizationAdmins).Methods("GET", "OPTIONS")
+	apiHandler.Router.HandleFunc("/organizations/{organizationId}/admins", organizationsHandler.AddOrganizationAdmin).Methods("POST", "OPTIONS")
+	apiHandler.Router.HandleFunc("/organizations/{organizationId}/admins/{userId}", organizationsHandler.RemoveOrganizationAdmin).Methods("DELETE", "OPTIONS")
+}
+
+func addSCIMEndpoints(router *mux.Router, accountManager s.AccountManager) {
+	scimHandler := NewSCIMHandler(accountManager)
+	router.HandleFunc("/ServiceProviderConfig", scimHandler.GetServiceProviderConfig).Methods("GET", "OPTIONS")
+	router.HandleFunc("/Users", scimHandler.GetUsers).Methods("GET", "OPTIONS")
+	router.HandleFunc("/Users", scimHandler.CreateUser).Methods("POST", "OPTIONS")
+	router.HandleFunc("/Users/{userId}", scimHandler.GetUser).Methods("GET", "OPTIONS")
+	router.HandleFunc("/Users/{userId}", scimHandler.ReplaceUser).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/Users/{userId}", scimHandler.PatchUser).Methods("PATCH", "OPTIONS")
+	router.HandleFunc("/Users/{userId}", scimHandler.DeleteUser).Methods("DELETE", "OPTIONS")
+	router.HandleFunc("/Groups", scimHandler.GetGroups).Methods("GET", "OPTIONS")
+	router.HandleFunc("/Groups", scimHandler.CreateGroup).Methods("POST", "OPTIONS")
+	router.HandleFunc("/Groups/{groupId}", scimHandler.GetGroup).Methods("GET", "OPTIONS")
+	router.HandleFunc("/Groups/{groupId}", scimHandler.ReplaceGroup).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/Groups/{groupId}", scimHandler.PatchGroup).Methods("PATCH", "OPTIONS")
+	router.HandleFunc("/Groups/{groupId}", scimHandler.DeleteGroup).Methods("DELETE", "OPTIONS")
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/netbirdio/netbird/management/server"
 	"github.com/netbirdio/netbird/management/server/http/middleware/bypass"
 	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/rbac"
 	"github.com/netbirdio/netbird/management/server/status"
 
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
@@ -18,25 +19,57 @@ import (
 // GetUser function defines a function to fetch user from Account by jwtclaims.AuthorizationClaims
 type GetUser func(ctx context.Context, claims jwtclaims.AuthorizationClaims) (*server.User, error)
 
+// GetUserCustomRole function defines a function to fetch the custom role assigned to the user
+type GetUserCustomRole func(ctx context.Context, user *server.User) (*rbac.Role, error)
+
 // AccessControl middleware to restrict to make POST/PUT/DELETE requests by admin only
 type AccessControl struct {
 	claimsExtract jwtclaims.ClaimsExtractor
 	getUser       GetUser
+	getCustomRole GetUserCustomRole
 }
 
 // NewAccessControl instance constructor
-func NewAccessControl(audience, userIDClaim string, getUser GetUser) *AccessControl {
+func NewAccessControl(audience, userIDClaim string, getUser GetUser, getCustomRole GetUserCustomRole) *AccessControl {
 	return &AccessControl{
 		claimsExtract: *jwtclaims.NewClaimsExtractor(
 			jwtclaims.WithAudience(audience),
 			jwtclaims.WithUserIDClaim(userIDClaim),
 		),
-		getUser: getUser,
+		getUser:       getUser,
+		getCustomRole: getCustomRole,
 	}
 }
 
 var tokenPathRegexp = regexp.MustCompile(`^.*/api/users/.*/tokens.*$`)
 
+// accessRequestPathRegexp matches access request creation which is allowed for regular users
+var accessRequestPathRegexp = regexp.MustCompile(`^.*/api/access-requests$`)
+
+// resourcePathRegexp matches paths of the resources that can be managed with custom role permissions
+var resourcePathRegexp = regexp.MustCompile(`^.*/api/(peers|groups|policies|routes|dns|setup-keys|users|events)(/.*)?$`)
+
+// bulkPathRegexp matches the bulk endpoints where every operation is checked against the custom role by the account manager
+var bulkPathRegexp = regexp.MustCompile(`^.*/api/(peers|groups|users)/bulk$`)
+
+var pathResources = map[string]rbac.Resource{
+	"peers":      rbac.ResourcePeers,
+	"groups":     rbac.ResourceGroups,
+	"policies":   rbac.ResourcePolicies,
+	"routes":     rbac.ResourceRoutes,
+	"dns":        rbac.ResourceDNS,
+	"setup-keys": rbac.ResourceSetupKeys,
+	"users":      rbac.ResourceUsers,
+	"events":     rbac.ResourceEvents,
+}
+
+var methodOperations = map[string]rbac.Operation{
+	http.MethodPost:   rbac.OperationCreate,
+	http.MethodPut:    rbac.OperationUpdate,
+	http.MethodPatch:  rbac.OperationUpdate,
+	http.MethodDelete: rbac.OperationDelete,
+}
+
 // Handler method of the middleware which forbids all modify requests for non admin users
 func (a *AccessControl) Handler(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -63,12 +96,18 @@ func (a *AccessControl) Handler(h http.Handler) http.Handler {
 			switch r.Method {
 			case http.MethodDelete, http.MethodPost, http.MethodPatch, http.MethodPut:
 
-				if tokenPathRegexp.MatchString(r.URL.Path) {
+				if tokenPathRegexp.MatchString(r.URL.Path) ||
+					(r.Method == http.MethodPost && accessRequestPathRegexp.MatchString(r.URL.Path)) {
 					log.WithContext(r.Context()).Debugf("valid Path")
 					h.ServeHTTP(w, r)
 					return
 				}
 
+				if a.customRoleAllows(r, user) {
+					h.ServeHTTP(w, r)
+					return
+				}
+
 				util.WriteError(r.Context(), status.Errorf(status.PermissionDenied, "only users with admin power can perform this operation"), w)
 				return
 			}
@@ -77,3 +116,30 @@ func (a *AccessControl) Handler(h http.Handler) http.Handler {
 		h.ServeHTTP(w, r)
 	})
 }
+
+// customRoleAllows checks whether the custom role assigned to the user grants the request operation on the requested resource
+func (a *AccessControl) customRoleAllows(r *http.Request, user *server.User) bool {
+	if user.CustomRoleID == "" || a.getCustomRole == nil {
+		return false
+	}
+
+	matches := resourcePathRegexp.FindStringSubmatch(r.URL.Path)
+	if matches == nil {
+		return false
+	}
+
+	role, err := a.getCustomRole(r.Context(), user)
+	if err != nil {
+		log.WithContext(r.Context()).Errorf("failed to get custom role %s of user %s: %s", user.CustomRoleID, user.Id, err)
+		return false
+	}
+
+	resource := pathResources[matches[1]]
+	if r.Method == http.MethodPost && bulkPathRegexp.MatchString(r.URL.Path) {
+		return role.Allows(resource, rbac.OperationCreate) ||
+			role.Allows(resource, rbac.OperationUpdate) ||
+			role.Allows(resource, rbac.OperationDelete)
+	}
+
+	return role.Allows(resource, methodOperations[r.Method])
+}
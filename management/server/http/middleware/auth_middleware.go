@@ -31,37 +31,45 @@ type MarkPATUsedFunc func(ctx context.Context, token string) error
 // CheckUserAccessByJWTGroupsFunc function
 type CheckUserAccessByJWTGroupsFunc func(ctx context.Context, claims jwtclaims.AuthorizationClaims) error
 
+// GetOrganizationAccountClaimsFunc function
+type GetOrganizationAccountClaimsFunc func(ctx context.Context, userID, accountID string) (jwtclaims.AuthorizationClaims, error)
+
 // AuthMiddleware middleware to verify personal access tokens (PAT) and JWT tokens
 type AuthMiddleware struct {
-	getAccountFromPAT          GetAccountFromPATFunc
-	validateAndParseToken      ValidateAndParseTokenFunc
-	markPATUsed                MarkPATUsedFunc
-	checkUserAccessByJWTGroups CheckUserAccessByJWTGroupsFunc
-	claimsExtractor            *jwtclaims.ClaimsExtractor
-	audience                   string
-	userIDClaim                string
+	getAccountFromPAT            GetAccountFromPATFunc
+	validateAndParseToken        ValidateAndParseTokenFunc
+	markPATUsed                  MarkPATUsedFunc
+	checkUserAccessByJWTGroups   CheckUserAccessByJWTGroupsFunc
+	getOrganizationAccountClaims GetOrganizationAccountClaimsFunc
+	claimsExtractor              *jwtclaims.ClaimsExtractor
+	audience                     string
+	userIDClaim                  string
 }
 
 const (
 	userProperty = "user"
+	// accountQueryParam selects the account of the organization the request is performed in
+	accountQueryParam = "account"
 )
 
 // NewAuthMiddleware instance constructor
 func NewAuthMiddleware(getAccountFromPAT GetAccountFromPATFunc, validateAndParseToken ValidateAndParseTokenFunc,
-	markPATUsed MarkPATUsedFunc, checkUserAccessByJWTGroups CheckUserAccessByJWTGroupsFunc, claimsExtractor *jwtclaims.ClaimsExtractor,
+	markPATUsed MarkPATUsedFunc, checkUserAccessByJWTGroups CheckUserAccessByJWTGroupsFunc,
+	getOrganizationAccountClaims GetOrganizationAccountClaimsFunc, claimsExtractor *jwtclaims.ClaimsExtractor,
 	audience string, userIdClaim string) *AuthMiddleware {
 	if userIdClaim == "" {
 		userIdClaim = jwtclaims.UserIDClaim
 	}
 
 	return &AuthMiddleware{
-		getAccountFromPAT:          getAccountFromPAT,
-		validateAndParseToken:      validateAndParseToken,
-		markPATUsed:                markPATUsed,
-		checkUserAccessByJWTGroups: checkUserAccessByJWTGroups,
-		claimsExtractor:            claimsExtractor,
-		audience:                   audience,
-		userIDClaim:                userIdClaim,
+		getAccountFromPAT:            getAccountFromPAT,
+		validateAndParseToken:        validateAndParseToken,
+		markPATUsed:                  markPATUsed,
+		checkUserAccessByJWTGroups:   checkUserAccessByJWTGroups,
+		getOrganizationAccountClaims: getOrganizationAccountClaims,
+		claimsExtractor:              claimsExtractor,
+		audience:                     audience,
+		userIDClaim:                  userIdClaim,
 	}
 }
 
@@ -101,6 +109,16 @@ func (m *AuthMiddleware) Handler(h http.Handler) http.Handler {
 			util.WriteError(r.Context(), status.Errorf(status.Unauthorized, "no valid authentication provided"), w)
 			return
 		}
+
+		if accountID := r.URL.Query().Get(accountQueryParam); accountID != "" {
+			err := m.switchAccount(r, accountID)
+			if err != nil {
+				log.WithContext(r.Context()).Debugf("Error when switching to the account %s: %s", accountID, err.Error())
+				util.WriteError(r.Context(), err, w)
+				return
+			}
+		}
+
 		claims := m.claimsExtractor.FromRequestContext(r)
 		//nolint
 		ctx := context.WithValue(r.Context(), nbContext.UserIDKey, claims.UserId)
@@ -170,16 +188,45 @@ func (m *AuthMiddleware) checkPATFromRequest(w http.ResponseWriter, r *http.Requ
 		return err
 	}
 
+	m.setRequestClaims(r, jwtclaims.AuthorizationClaims{
+		UserId:         user.Id,
+		AccountId:      account.Id,
+		Domain:         account.Domain,
+		DomainCategory: account.DomainCategory,
+	})
+	return nil
+}
+
+// switchAccount replaces the claims of an organization admin with the claims of its service user in the
+// requested account of the organization
+func (m *AuthMiddleware) switchAccount(r *http.Request, accountID string) error {
+	claims := m.claimsExtractor.FromRequestContext(r)
+
+	accountClaims, err := m.getOrganizationAccountClaims(r.Context(), claims.UserId, accountID)
+	if err != nil {
+		return err
+	}
+
+	// the requested account is the user account, the original claims are kept
+	if accountClaims.UserId == claims.UserId {
+		return nil
+	}
+
+	m.setRequestClaims(r, accountClaims)
+	return nil
+}
+
+// setRequestClaims sets a token with the claims as the user property of the request
+func (m *AuthMiddleware) setRequestClaims(r *http.Request, claims jwtclaims.AuthorizationClaims) {
 	claimMaps := jwt.MapClaims{}
-	claimMaps[m.userIDClaim] = user.Id
-	claimMaps[m.audience+jwtclaims.AccountIDSuffix] = account.Id
-	claimMaps[m.audience+jwtclaims.DomainIDSuffix] = account.Domain
-	claimMaps[m.audience+jwtclaims.DomainCategorySuffix] = account.DomainCategory
+	claimMaps[m.userIDClaim] = claims.UserId
+	claimMaps[m.audience+jwtclaims.AccountIDSuffix] = claims.AccountId
+	claimMaps[m.audience+jwtclaims.DomainIDSuffix] = claims.Domain
+	claimMaps[m.audience+jwtclaims.DomainCategorySuffix] = claims.DomainCategory
 	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, claimMaps)
 	newRequest := r.WithContext(context.WithValue(r.Context(), jwtclaims.TokenUserProperty, jwtToken)) //nolint
 	// Update the current request with the new context information.
 	*r = *newRequest
-	return nil
 }
 
 // getTokenFromJWTRequest is a "TokenExtractor" that takes auth header parts and extracts
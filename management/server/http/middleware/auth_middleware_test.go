@@ -9,10 +9,12 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/netbirdio/netbird/management/server"
 	"github.com/netbirdio/netbird/management/server/http/middleware/bypass"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/status"
 )
 
 const (
@@ -26,6 +28,9 @@ const (
 	PAT            = "nbp_PAT"
 	JWT            = "JWT"
 	wrongToken     = "wrongToken"
+
+	organizationAccountID = "organizationAccountID"
+	delegatedUserID       = "delegatedUserID"
 )
 
 var testAccount = &server.Account{
@@ -88,6 +93,21 @@ func mockCheckUserAccessByJWTGroups(_ context.Context, claims jwtclaims.Authoriz
 	return nil
 }
 
+func mockGetOrganizationAccountClaims(_ context.Context, claimUserID, claimAccountID string) (jwtclaims.AuthorizationClaims, error) {
+	if claimUserID != userID {
+		return jwtclaims.AuthorizationClaims{}, status.Errorf(status.NotFound, "user %s not found", claimUserID)
+	}
+
+	switch claimAccountID {
+	case accountID:
+		return jwtclaims.AuthorizationClaims{UserId: userID, AccountId: accountID}, nil
+	case organizationAccountID:
+		return jwtclaims.AuthorizationClaims{UserId: delegatedUserID, AccountId: organizationAccountID}, nil
+	default:
+		return jwtclaims.AuthorizationClaims{}, status.NewUserNotPartOfAccountError()
+	}
+}
+
 func TestAuthMiddleware_Handler(t *testing.T) {
 	tt := []struct {
 		name               string
@@ -169,6 +189,7 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 		mockValidateAndParseToken,
 		mockMarkPATUsed,
 		mockCheckUserAccessByJWTGroups,
+		mockGetOrganizationAccountClaims,
 		claimsExtractor,
 		audience,
 		userIDClaim,
@@ -199,3 +220,88 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthMiddleware_SwitchAccount(t *testing.T) {
+	tt := []struct {
+		name               string
+		authHeader         string
+		account            string
+		expectedStatusCode int
+		expectedUserID     string
+		expectedAccountID  string
+	}{
+		{
+			name:               "Own account with PAT",
+			authHeader:         "Token " + PAT,
+			account:            accountID,
+			expectedStatusCode: http.StatusOK,
+			expectedUserID:     userID,
+			expectedAccountID:  accountID,
+		},
+		{
+			name:               "Organization account with PAT",
+			authHeader:         "Token " + PAT,
+			account:            organizationAccountID,
+			expectedStatusCode: http.StatusOK,
+			expectedUserID:     delegatedUserID,
+			expectedAccountID:  organizationAccountID,
+		},
+		{
+			name:               "Organization account with JWT",
+			authHeader:         "Bearer " + JWT,
+			account:            organizationAccountID,
+			expectedStatusCode: http.StatusOK,
+			expectedUserID:     delegatedUserID,
+			expectedAccountID:  organizationAccountID,
+		},
+		{
+			name:               "Account outside the organization",
+			authHeader:         "Bearer " + JWT,
+			account:            "otherAccountID",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "Invalid token",
+			authHeader:         "Bearer " + wrongToken,
+			account:            organizationAccountID,
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	claimsExtractor := jwtclaims.NewClaimsExtractor(
+		jwtclaims.WithAudience(audience),
+		jwtclaims.WithUserIDClaim(userIDClaim),
+	)
+
+	authMiddleware := NewAuthMiddleware(
+		mockGetAccountFromPAT,
+		mockValidateAndParseToken,
+		mockMarkPATUsed,
+		mockCheckUserAccessByJWTGroups,
+		mockGetOrganizationAccountClaims,
+		claimsExtractor,
+		audience,
+		userIDClaim,
+	)
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var claims jwtclaims.AuthorizationClaims
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				claims = claimsExtractor.FromRequestContext(r)
+			})
+
+			req := httptest.NewRequest("GET", "http://testing/test?account="+tc.account, nil)
+			req.Header.Set("Authorization", tc.authHeader)
+			rec := httptest.NewRecorder()
+
+			authMiddleware.Handler(nextHandler).ServeHTTP(rec, req)
+
+			result := rec.Result()
+			defer result.Body.Close()
+			assert.Equal(t, tc.expectedStatusCode, result.StatusCode)
+			assert.Equal(t, tc.expectedUserID, claims.UserId)
+			assert.Equal(t, tc.expectedAccountID, claims.AccountId)
+		})
+	}
+}
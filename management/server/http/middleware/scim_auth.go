@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	nbContext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/http/util"
+)
+
+// GetAccountIDFromSCIMTokenFunc function
+type GetAccountIDFromSCIMTokenFunc func(ctx context.Context, token string) (string, string, error)
+
+// SCIMAuthMiddleware middleware to verify the SCIM provisioning tokens
+type SCIMAuthMiddleware struct {
+	getAccountIDFromSCIMToken GetAccountIDFromSCIMTokenFunc
+}
+
+// NewSCIMAuthMiddleware instance constructor
+func NewSCIMAuthMiddleware(getAccountIDFromSCIMToken GetAccountIDFromSCIMTokenFunc) *SCIMAuthMiddleware {
+	return &SCIMAuthMiddleware{
+		getAccountIDFromSCIMToken: getAccountIDFromSCIMToken,
+	}
+}
+
+// Handler method of the middleware which authenticates the SCIM client by the bearer token
+// and sets the account and the service user of the token in the request context
+func (m *SCIMAuthMiddleware) Handler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := strings.Split(r.Header.Get("Authorization"), " ")
+		if len(auth) != 2 || strings.ToLower(auth[0]) != "bearer" {
+			util.WriteSCIMErrorResponse(r.Context(), w, http.StatusUnauthorized, "", "no valid authentication provided")
+			return
+		}
+
+		accountID, userID, err := m.getAccountIDFromSCIMToken(r.Context(), auth[1])
+		if err != nil {
+			log.WithContext(r.Context()).Debugf("Error when validating SCIM token: %s", err.Error())
+			util.WriteSCIMErrorResponse(r.Context(), w, http.StatusUnauthorized, "", "token invalid")
+			return
+		}
+
+		//nolint
+		ctx := context.WithValue(r.Context(), nbContext.UserIDKey, userID)
+		//nolint
+		ctx = context.WithValue(ctx, nbContext.AccountIDKey, accountID)
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
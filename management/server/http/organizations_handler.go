@@ -0,0 +1,324 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/organization"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// OrganizationsHandler is a handler that manages the organizations grouping the accounts of a service provider
+type OrganizationsHandler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+// NewOrganizationsHandler creates a new OrganizationsHandler
+func NewOrganizationsHandler(accountManager server.AccountManager, authCfg AuthCfg) *OrganizationsHandler {
+	return &OrganizationsHandler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// GetAllOrganizations returns the organizations administered by the user
+func (h *OrganizationsHandler) GetAllOrganizations(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	_, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	orgs, err := h.accountManager.GetOrganizations(r.Context(), userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	resp := make([]*api.Organization, 0, len(orgs))
+	for _, org := range orgs {
+		resp = append(resp, toOrganizationResponse(org))
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
+// CreateOrganization handles organization creation request
+func (h *OrganizationsHandler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PostApiOrganizationsJSONRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	org, err := h.accountManager.CreateOrganization(r.Context(), accountID, userID, req.Name)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toOrganizationResponse(org))
+}
+
+// GetOrganization handles an organization Get request identified by ID
+func (h *OrganizationsHandler) GetOrganization(w http.ResponseWriter, r *http.Request) {
+	userID, organizationID, err := h.getUserAndOrganizationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	org, err := h.accountManager.GetOrganization(r.Context(), organizationID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toOrganizationResponse(org))
+}
+
+// UpdateOrganization handles update to an organization identified by a given ID
+func (h *OrganizationsHandler) UpdateOrganization(w http.ResponseWriter, r *http.Request) {
+	userID, organizationID, err := h.getUserAndOrganizationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PutApiOrganizationsOrganizationIdJSONRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	org, err := h.accountManager.UpdateOrganization(r.Context(), organizationID, userID, req.Name)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toOrganizationResponse(org))
+}
+
+// DeleteOrganization handles organization deletion request
+func (h *OrganizationsHandler) DeleteOrganization(w http.ResponseWriter, r *http.Request) {
+	userID, organizationID, err := h.getUserAndOrganizationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	if err = h.accountManager.DeleteOrganization(r.Context(), organizationID, userID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, emptyObject{})
+}
+
+// GetOrganizationAccounts returns the accounts of the organization with their peer and user counts
+func (h *OrganizationsHandler) GetOrganizationAccounts(w http.ResponseWriter, r *http.Request) {
+	userID, organizationID, err := h.getUserAndOrganizationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accounts, err := h.accountManager.GetOrganizationAccounts(r.Context(), organizationID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	resp := make([]*api.OrganizationAccount, 0, len(accounts))
+	for _, account := range accounts {
+		resp = append(resp, toOrganizationAccountResponse(account))
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
+// CreateOrganizationAccount handles the creation of an account managed by the organization
+func (h *OrganizationsHandler) CreateOrganizationAccount(w http.ResponseWriter, r *http.Request) {
+	userID, organizationID, err := h.getUserAndOrganizationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PostApiOrganizationsOrganizationIdAccountsJSONRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	var domain string
+	if req.Domain != nil {
+		domain = *req.Domain
+	}
+
+	account, err := h.accountManager.CreateOrganizationAccount(r.Context(), organizationID, userID, domain)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toOrganizationAccountResponse(account))
+}
+
+// RemoveOrganizationAccount handles the removal of an account from the organization
+func (h *OrganizationsHandler) RemoveOrganizationAccount(w http.ResponseWriter, r *http.Request) {
+	userID, organizationID, err := h.getUserAndOrganizationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID := mux.Vars(r)["accountId"]
+	if len(accountID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid account ID"), w)
+		return
+	}
+
+	if err = h.accountManager.RemoveOrganizationAccount(r.Context(), organizationID, accountID, userID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, emptyObject{})
+}
+
+// GetOrganizationAdmins returns the admins of the organization
+func (h *OrganizationsHandler) GetOrganizationAdmins(w http.ResponseWriter, r *http.Request) {
+	userID, organizationID, err := h.getUserAndOrganizationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	admins, err := h.accountManager.GetOrganizationAdmins(r.Context(), organizationID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	resp := make([]*api.OrganizationAdmin, 0, len(admins))
+	for _, admin := range admins {
+		resp = append(resp, toOrganizationAdminResponse(admin))
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
+// AddOrganizationAdmin handles the addition of an admin to the organization
+func (h *OrganizationsHandler) AddOrganizationAdmin(w http.ResponseWriter, r *http.Request) {
+	userID, organizationID, err := h.getUserAndOrganizationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PostApiOrganizationsOrganizationIdAdminsJSONRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	if req.UserId == "" {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "user ID shouldn't be empty"), w)
+		return
+	}
+
+	admin, err := h.accountManager.AddOrganizationAdmin(r.Context(), organizationID, userID, req.UserId)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toOrganizationAdminResponse(admin))
+}
+
+// RemoveOrganizationAdmin handles the removal of an admin from the organization
+func (h *OrganizationsHandler) RemoveOrganizationAdmin(w http.ResponseWriter, r *http.Request) {
+	userID, organizationID, err := h.getUserAndOrganizationID(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	adminUserID := mux.Vars(r)["userId"]
+	if len(adminUserID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid user ID"), w)
+		return
+	}
+
+	if err = h.accountManager.RemoveOrganizationAdmin(r.Context(), organizationID, userID, adminUserID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, emptyObject{})
+}
+
+// getUserAndOrganizationID returns the user performing the request and the organization ID from the path
+func (h *OrganizationsHandler) getUserAndOrganizationID(r *http.Request) (string, string, error) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	_, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		return "", "", err
+	}
+
+	organizationID := mux.Vars(r)["organizationId"]
+	if len(organizationID) == 0 {
+		return "", "", status.Errorf(status.InvalidArgument, "invalid organization ID")
+	}
+
+	return userID, organizationID, nil
+}
+
+func toOrganizationResponse(org *organization.Organization) *api.Organization {
+	return &api.Organization{
+		Id:        org.ID,
+		AccountId: org.AccountID,
+		Name:      org.Name,
+		CreatedBy: org.CreatedBy,
+		CreatedAt: org.CreatedAt,
+	}
+}
+
+func toOrganizationAccountResponse(account *organization.AccountSummary) *api.OrganizationAccount {
+	return &api.OrganizationAccount{
+		Id:                  account.ID,
+		Domain:              account.Domain,
+		CreatedAt:           account.CreatedAt,
+		PeersCount:          account.PeersCount,
+		ConnectedPeersCount: account.ConnectedPeersCount,
+		UsersCount:          account.UsersCount,
+	}
+}
+
+func toOrganizationAdminResponse(admin *organization.Admin) *api.OrganizationAdmin {
+	return &api.OrganizationAdmin{
+		UserId:    admin.UserID,
+		CreatedBy: admin.CreatedBy,
+		CreatedAt: admin.CreatedAt,
+	}
+}
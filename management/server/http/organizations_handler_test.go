@@ -0,0 +1,277 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/organization"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+const (
+	testOrganizationID = "test_organization"
+	testCustomerID     = "test_customer"
+)
+
+func initOrganizationsTestData() *OrganizationsHandler {
+	testOrganization := &organization.Organization{
+		ID:        testOrganizationID,
+		AccountID: "test_id",
+		Name:      "Acme MSP",
+		CreatedBy: existingUserID,
+		CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	getOrganization := func(organizationID string) (*organization.Organization, error) {
+		if organizationID != testOrganizationID {
+			return nil, status.NewOrganizationNotFoundError(organizationID)
+		}
+		return testOrganization, nil
+	}
+
+	return &OrganizationsHandler{
+		accountManager: &mock_server.MockAccountManager{
+			GetAccountIDFromTokenFunc: func(_ context.Context, claims jwtclaims.AuthorizationClaims) (string, string, error) {
+				return claims.AccountId, claims.UserId, nil
+			},
+			GetOrganizationsFunc: func(_ context.Context, userID string) ([]*organization.Organization, error) {
+				return []*organization.Organization{testOrganization}, nil
+			},
+			GetOrganizationFunc: func(_ context.Context, organizationID, userID string) (*organization.Organization, error) {
+				return getOrganization(organizationID)
+			},
+			CreateOrganizationFunc: func(_ context.Context, accountID, userID, name string) (*organization.Organization, error) {
+				if name == "" {
+					return nil, status.Errorf(status.InvalidArgument, "organization name shouldn't be empty")
+				}
+				return &organization.Organization{ID: "new_organization", AccountID: accountID, Name: name, CreatedBy: userID}, nil
+			},
+			UpdateOrganizationFunc: func(_ context.Context, organizationID, userID, name string) (*organization.Organization, error) {
+				org, err := getOrganization(organizationID)
+				if err != nil {
+					return nil, err
+				}
+				updated := *org
+				updated.Name = name
+				return &updated, nil
+			},
+			DeleteOrganizationFunc: func(_ context.Context, organizationID, userID string) error {
+				_, err := getOrganization(organizationID)
+				return err
+			},
+			GetOrganizationAccountsFunc: func(_ context.Context, organizationID, userID string) ([]*organization.AccountSummary, error) {
+				if _, err := getOrganization(organizationID); err != nil {
+					return nil, err
+				}
+				return []*organization.AccountSummary{
+					{ID: "test_id", Domain: "msp.com", UsersCount: 2},
+					{ID: testCustomerID, Domain: "customer.com", PeersCount: 5, ConnectedPeersCount: 3, UsersCount: 1},
+				}, nil
+			},
+			CreateOrganizationAccountFunc: func(_ context.Context, organizationID, userID, domain string) (*organization.AccountSummary, error) {
+				return &organization.AccountSummary{ID: "new_customer", Domain: domain}, nil
+			},
+			RemoveOrganizationAccountFunc: func(_ context.Context, organizationID, accountID, userID string) error {
+				if accountID != testCustomerID {
+					return status.Errorf(status.PreconditionFailed, "the account owning the organization can't be removed from it")
+				}
+				return nil
+			},
+			GetOrganizationAdminsFunc: func(_ context.Context, organizationID, userID string) ([]*organization.Admin, error) {
+				return []*organization.Admin{{OrganizationID: organizationID, UserID: existingUserID, CreatedBy: existingUserID}}, nil
+			},
+			AddOrganizationAdminFunc: func(_ context.Context, organizationID, userID, adminUserID string) (*organization.Admin, error) {
+				return &organization.Admin{OrganizationID: organizationID, UserID: adminUserID, CreatedBy: userID}, nil
+			},
+			RemoveOrganizationAdminFunc: func(_ context.Context, organizationID, userID, adminUserID string) error {
+				if adminUserID == existingUserID {
+					return status.Errorf(status.PreconditionFailed, "organization should have at least one admin")
+				}
+				return nil
+			},
+		},
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithFromRequestContext(func(r *http.Request) jwtclaims.AuthorizationClaims {
+				return jwtclaims.AuthorizationClaims{
+					UserId:    existingUserID,
+					Domain:    testDomain,
+					AccountId: "test_id",
+				}
+			}),
+		),
+	}
+}
+
+func TestOrganizationsHandlers(t *testing.T) {
+	tt := []struct {
+		name           string
+		requestType    string
+		requestPath    string
+		requestBody    string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Get organizations",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/organizations",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `[{"account_id":"test_id","created_at":"2024-01-01T00:00:00Z","created_by":"existingUserID","id":"test_organization","name":"Acme MSP"}]`,
+		},
+		{
+			name:           "Create organization",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/organizations",
+			requestBody:    `{"name":"New MSP"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"account_id":"test_id","created_at":"0001-01-01T00:00:00Z","created_by":"existingUserID","id":"new_organization","name":"New MSP"}`,
+		},
+		{
+			name:           "Create organization without name",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/organizations",
+			requestBody:    `{"name":""}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Create organization with invalid body",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/organizations",
+			requestBody:    `{"name":`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Get organization",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/organizations/" + testOrganizationID,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"account_id":"test_id","created_at":"2024-01-01T00:00:00Z","created_by":"existingUserID","id":"test_organization","name":"Acme MSP"}`,
+		},
+		{
+			name:           "Get unknown organization",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/organizations/unknown",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Update organization",
+			requestType:    http.MethodPut,
+			requestPath:    "/api/organizations/" + testOrganizationID,
+			requestBody:    `{"name":"Acme"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"account_id":"test_id","created_at":"2024-01-01T00:00:00Z","created_by":"existingUserID","id":"test_organization","name":"Acme"}`,
+		},
+		{
+			name:           "Delete organization",
+			requestType:    http.MethodDelete,
+			requestPath:    "/api/organizations/" + testOrganizationID,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{}`,
+		},
+		{
+			name:           "Get organization accounts",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/organizations/" + testOrganizationID + "/accounts",
+			expectedStatus: http.StatusOK,
+			expectedBody: `[{"connected_peers_count":0,"created_at":"0001-01-01T00:00:00Z","domain":"msp.com","id":"test_id","peers_count":0,"users_count":2},` +
+				`{"connected_peers_count":3,"created_at":"0001-01-01T00:00:00Z","domain":"customer.com","id":"test_customer","peers_count":5,"users_count":1}]`,
+		},
+		{
+			name:           "Create organization account",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/organizations/" + testOrganizationID + "/accounts",
+			requestBody:    `{"domain":"new.com"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"connected_peers_count":0,"created_at":"0001-01-01T00:00:00Z","domain":"new.com","id":"new_customer","peers_count":0,"users_count":0}`,
+		},
+		{
+			name:           "Remove organization account",
+			requestType:    http.MethodDelete,
+			requestPath:    "/api/organizations/" + testOrganizationID + "/accounts/" + testCustomerID,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{}`,
+		},
+		{
+			name:           "Remove account owning the organization",
+			requestType:    http.MethodDelete,
+			requestPath:    "/api/organizations/" + testOrganizationID + "/accounts/test_id",
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:           "Get organization admins",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/organizations/" + testOrganizationID + "/admins",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `[{"created_at":"0001-01-01T00:00:00Z","created_by":"existingUserID","user_id":"existingUserID"}]`,
+		},
+		{
+			name:           "Add organization admin",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/organizations/" + testOrganizationID + "/admins",
+			requestBody:    `{"user_id":"adminUserID"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"created_at":"0001-01-01T00:00:00Z","created_by":"existingUserID","user_id":"adminUserID"}`,
+		},
+		{
+			name:           "Add organization admin without user",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/organizations/" + testOrganizationID + "/admins",
+			requestBody:    `{"user_id":""}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Remove organization admin",
+			requestType:    http.MethodDelete,
+			requestPath:    "/api/organizations/" + testOrganizationID + "/admins/adminUserID",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{}`,
+		},
+		{
+			name:           "Remove last organization admin",
+			requestType:    http.MethodDelete,
+			requestPath:    "/api/organizations/" + testOrganizationID + "/admins/" + existingUserID,
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+	}
+
+	handler := initOrganizationsTestData()
+
+	router := mux.NewRouter()
+	router.HandleFunc("/api/organizations", handler.GetAllOrganizations).Methods("GET")
+	router.HandleFunc("/api/organizations", handler.CreateOrganization).Methods("POST")
+	router.HandleFunc("/api/organizations/{organizationId}", handler.GetOrganization).Methods("GET")
+	router.HandleFunc("/api/organizations/{organizationId}", handler.UpdateOrganization).Methods("PUT")
+	router.HandleFunc("/api/organizations/{organizationId}", handler.DeleteOrganization).Methods("DELETE")
+	router.HandleFunc("/api/organizations/{organizationId}/accounts", handler.GetOrganizationAccounts).Methods("GET")
+	router.HandleFunc("/api/organizations/{organizationId}/accounts", handler.CreateOrganizationAccount).Methods("POST")
+	router.HandleFunc("/api/organizations/{organizationId}/accounts/{accountId}", handler.RemoveOrganizationAccount).Methods("DELETE")
+	router.HandleFunc("/api/organizations/{organizationId}/admins", handler.GetOrganizationAdmins).Methods("GET")
+	router.HandleFunc("/api/organizations/{organizationId}/admins", handler.AddOrganizationAdmin).Methods("POST")
+	router.HandleFunc("/api/organizations/{organizationId}/admins/{userId}", handler.RemoveOrganizationAdmin).Methods("DELETE")
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.requestType, tc.requestPath, bytes.NewBufferString(tc.requestBody))
+
+			router.ServeHTTP(recorder, req)
+
+			if !assert.Equal(t, tc.expectedStatus, recorder.Code, recorder.Body.String()) {
+				return
+			}
+
+			if tc.expectedBody != "" {
+				assert.JSONEq(t, tc.expectedBody, recorder.Body.String())
+			}
+		})
+	}
+}
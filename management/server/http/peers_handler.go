@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	log "github.com/sirupsen/logrus"
@@ -92,6 +93,10 @@ func (h *PeersHandler) updatePeer(ctx context.Context, account *server.Account,
 		InactivityExpirationEnabled: req.InactivityExpirationEnabled,
 	}
 
+	if req.Tags != nil {
+		update.Tags = *req.Tags
+	}
+
 	if req.ApprovalRequired != nil {
 		// todo: looks like that we reset all status property, is it right?
 		update.Status = &nbpeer.PeerStatus{
@@ -228,6 +233,82 @@ func (h *PeersHandler) setApprovalRequiredFlag(respBody []*api.PeerBatch, approv
 	}
 }
 
+// GetPendingPeers returns a list of the account peers waiting to be approved by an administrator
+func (h *PeersHandler) GetPendingPeers(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	peers, err := h.accountManager.GetPendingPeers(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	groupsMap := map[string]*nbgroup.Group{}
+	groups, _ := h.accountManager.GetAllGroups(r.Context(), accountID, userID)
+	for _, group := range groups {
+		groupsMap[group.ID] = group
+	}
+
+	dnsDomain := h.accountManager.GetDNSDomain()
+
+	respBody := make([]*api.PeerBatch, 0, len(peers))
+	for _, peer := range peers {
+		peerToReturn, err := h.checkPeerStatus(peer)
+		if err != nil {
+			util.WriteError(r.Context(), err, w)
+			return
+		}
+
+		peerResponse := toPeerListItemResponse(peerToReturn, toGroupsInfo(groupsMap, peer.ID), dnsDomain, 0)
+		peerResponse.ApprovalRequired = true
+		respBody = append(respBody, peerResponse)
+	}
+
+	util.WriteJSONObject(r.Context(), w, respBody)
+}
+
+// ApprovePendingPeers approves the pending peers provided in the request
+func (h *PeersHandler) ApprovePendingPeers(w http.ResponseWriter, r *http.Request) {
+	h.handlePendingPeers(w, r, h.accountManager.ApprovePeers)
+}
+
+// RejectPendingPeers rejects and deletes the pending peers provided in the request
+func (h *PeersHandler) RejectPendingPeers(w http.ResponseWriter, r *http.Request) {
+	h.handlePendingPeers(w, r, h.accountManager.RejectPeers)
+}
+
+func (h *PeersHandler) handlePendingPeers(w http.ResponseWriter, r *http.Request, handle func(ctx context.Context, accountID, userID string, peerIDs []string) error) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PeerApprovalRequest
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	if len(req.PeerIds) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "peer_ids shouldn't be empty"), w)
+		return
+	}
+
+	if err = handle(r.Context(), accountID, userID, req.PeerIds); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, emptyObject{})
+}
+
 // GetAccessiblePeers returns a list of all peers that the specified peer can connect to within the network.
 func (h *PeersHandler) GetAccessiblePeers(w http.ResponseWriter, r *http.Request) {
 	claims := h.claimsExtractor.FromRequestContext(r)
@@ -286,6 +367,281 @@ func (h *PeersHandler) GetAccessiblePeers(w http.ResponseWriter, r *http.Request
 	util.WriteJSONObject(r.Context(), w, toAccessiblePeers(netMap, dnsDomain))
 }
 
+// GetPeerPostureStatus returns the compliance status of the peer with the posture checks applied to it
+func (h *PeersHandler) GetPeerPostureStatus(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	peerID := mux.Vars(r)["peerId"]
+	if len(peerID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid peer ID"), w)
+		return
+	}
+
+	postureStatus, err := h.accountManager.GetPeerPostureStatus(r.Context(), accountID, peerID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toPeerPostureStatusResponse(postureStatus))
+}
+
+func toPeerPostureStatusResponse(postureStatus *nbpeer.PostureStatus) *api.PeerPostureStatus {
+	state := postureStatus.State
+	if state == "" {
+		state = nbpeer.PostureStateCompliant
+	}
+
+	checks := make([]api.PeerPostureCheckStatus, 0, len(postureStatus.Checks))
+	for _, check := range postureStatus.Checks {
+		checkStatus := api.PeerPostureCheckStatus{
+			PostureCheckId:   check.PostureCheckID,
+			PostureCheckName: check.PostureCheckName,
+			State:            api.PeerPostureCheckStatusState(check.State),
+			Reasons:          append([]string{}, check.Reasons...),
+		}
+		if !check.FailingSince.IsZero() {
+			failingSince := check.FailingSince
+			checkStatus.FailingSince = &failingSince
+		}
+		if !check.GraceExpiresAt.IsZero() {
+			graceExpiresAt := check.GraceExpiresAt
+			checkStatus.GraceExpiresAt = &graceExpiresAt
+		}
+		checks = append(checks, checkStatus)
+	}
+
+	response := &api.PeerPostureStatus{
+		State:  api.PeerPostureStatusState(state),
+		Checks: checks,
+	}
+	if !postureStatus.UpdatedAt.IsZero() {
+		updatedAt := postureStatus.UpdatedAt
+		response.UpdatedAt = &updatedAt
+	}
+	return response
+}
+
+// ExplainPeer explains which policy rules, posture checks and routes allow or deny a connection from the peer
+func (h *PeersHandler) ExplainPeer(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	peerID := mux.Vars(r)["peerId"]
+	if len(peerID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid peer ID"), w)
+		return
+	}
+
+	query := r.URL.Query()
+	req := &server.PolicyExplainRequest{Destination: query.Get("destination")}
+
+	req.Protocol, err = toExplainProtocol(query.Get("protocol"))
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	if port := query.Get("port"); port != "" {
+		req.Port, err = toExplainPort(port)
+		if err != nil {
+			util.WriteError(r.Context(), err, w)
+			return
+		}
+	}
+
+	h.explainPeer(w, r, accountID, peerID, userID, req)
+}
+
+// DryRunExplainPeer explains a connection from the peer with an unsaved policy added to the account policies
+func (h *PeersHandler) DryRunExplainPeer(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	peerID := mux.Vars(r)["peerId"]
+	if len(peerID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid peer ID"), w)
+		return
+	}
+
+	var body api.PostApiPeersPeerIdExplainJSONRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&body); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	req := &server.PolicyExplainRequest{Destination: body.Destination}
+
+	if body.Protocol != nil {
+		req.Protocol, err = toExplainProtocol(string(*body.Protocol))
+		if err != nil {
+			util.WriteError(r.Context(), err, w)
+			return
+		}
+	}
+
+	if body.Port != nil {
+		req.Port, err = toExplainPort(strconv.Itoa(*body.Port))
+		if err != nil {
+			util.WriteError(r.Context(), err, w)
+			return
+		}
+	}
+
+	if body.Policy != nil {
+		policyID := ""
+		if body.Policy.Id != nil {
+			policyID = *body.Policy.Id
+		}
+
+		req.Policy, err = toPolicy(accountID, policyID, body.Policy)
+		if err != nil {
+			util.WriteError(r.Context(), err, w)
+			return
+		}
+	}
+
+	h.explainPeer(w, r, accountID, peerID, userID, req)
+}
+
+func (h *PeersHandler) explainPeer(w http.ResponseWriter, r *http.Request, accountID, peerID, userID string, req *server.PolicyExplainRequest) {
+	if req.Destination == "" {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "destination is required"), w)
+		return
+	}
+
+	explanation, err := h.accountManager.ExplainPeerConnection(r.Context(), accountID, peerID, userID, req)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toPolicyExplanationResponse(explanation))
+}
+
+func toExplainProtocol(protocol string) (server.PolicyRuleProtocolType, error) {
+	switch server.PolicyRuleProtocolType(protocol) {
+	case "":
+		return "", nil
+	case server.PolicyRuleProtocolALL, server.PolicyRuleProtocolTCP, server.PolicyRuleProtocolUDP, server.PolicyRuleProtocolICMP:
+		return server.PolicyRuleProtocolType(protocol), nil
+	default:
+		return "", status.Errorf(status.InvalidArgument, "invalid protocol %s", protocol)
+	}
+}
+
+func toExplainPort(port string) (uint16, error) {
+	value, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || value == 0 {
+		return 0, status.Errorf(status.InvalidArgument, "invalid port %s", port)
+	}
+	return uint16(value), nil
+}
+
+func toPolicyExplanationResponse(explanation *server.PolicyExplanation) *api.PolicyExplanation {
+	resp := &api.PolicyExplanation{
+		SourcePeerId:       explanation.SourcePeerID,
+		DestinationIp:      explanation.DestinationIP,
+		Verdict:            api.PolicyExplanationVerdict(explanation.Verdict),
+		Reason:             explanation.Reason,
+		Rules:              make([]api.PolicyRuleExplanation, 0, len(explanation.Rules)),
+		Routes:             make([]api.RouteExplanation, 0, len(explanation.Routes)),
+		FirewallRules:      make([]api.FirewallRuleExplanation, 0, len(explanation.FirewallRules)),
+		RouteFirewallRules: make([]api.RouteFirewallRuleExplanation, 0, len(explanation.RouteFirewallRules)),
+	}
+
+	if explanation.DestinationPeerID != "" {
+		resp.DestinationPeerId = &explanation.DestinationPeerID
+	}
+
+	for _, rule := range explanation.Rules {
+		ruleResp := api.PolicyRuleExplanation{
+			PolicyId:            rule.PolicyID,
+			PolicyName:          rule.PolicyName,
+			RuleId:              rule.RuleID,
+			RuleName:            rule.RuleName,
+			Action:              api.PolicyRuleExplanationAction(rule.Action),
+			Matched:             rule.Matched,
+			FailedPostureChecks: make([]api.PostureCheckFailure, 0, len(rule.FailedPostureChecks)),
+		}
+		if rule.Reason != "" {
+			ruleResp.Reason = &rule.Reason
+		}
+		for _, failure := range rule.FailedPostureChecks {
+			ruleResp.FailedPostureChecks = append(ruleResp.FailedPostureChecks, api.PostureCheckFailure{
+				PostureCheckId:   failure.PostureCheckID,
+				PostureCheckName: failure.PostureCheckName,
+				PeerId:           failure.PeerID,
+				Check:            failure.Check,
+				Reason:           failure.Reason,
+			})
+		}
+		resp.Rules = append(resp.Rules, ruleResp)
+	}
+
+	for _, route := range explanation.Routes {
+		routeResp := api.RouteExplanation{
+			RouteId:   string(route.RouteID),
+			NetworkId: string(route.NetworkID),
+			Network:   route.Network,
+			Matched:   route.Matched,
+		}
+		if route.RoutingPeerID != "" {
+			routeResp.RoutingPeerId = &route.RoutingPeerID
+		}
+		if route.Reason != "" {
+			routeResp.Reason = &route.Reason
+		}
+		resp.Routes = append(resp.Routes, routeResp)
+	}
+
+	for _, rule := range explanation.FirewallRules {
+		direction := api.FirewallRuleExplanationDirectionOut
+		if rule.IsInbound() {
+			direction = api.FirewallRuleExplanationDirectionIn
+		}
+		resp.FirewallRules = append(resp.FirewallRules, api.FirewallRuleExplanation{
+			PeerIp:    rule.PeerIP,
+			Direction: direction,
+			Action:    rule.Action,
+			Protocol:  rule.Protocol,
+			Port:      rule.Port,
+		})
+	}
+
+	for _, rule := range explanation.RouteFirewallRules {
+		ruleResp := api.RouteFirewallRuleExplanation{
+			SourceRanges: rule.SourceRanges,
+			Action:       rule.Action,
+			Destination:  rule.Destination,
+			Protocol:     rule.Protocol,
+		}
+		if rule.Port != 0 {
+			port := int(rule.Port)
+			ruleResp.Port = &port
+		}
+		if rule.PortRange.Start != 0 || rule.PortRange.End != 0 {
+			ruleResp.PortRange = &api.RulePortRange{Start: int(rule.PortRange.Start), End: int(rule.PortRange.End)}
+		}
+		resp.RouteFirewallRules = append(resp.RouteFirewallRules, ruleResp)
+	}
+
+	return resp
+}
+
 func toAccessiblePeers(netMap *server.NetworkMap, dnsDomain string) []api.AccessiblePeer {
 	accessiblePeers := make([]api.AccessiblePeer, 0, len(netMap.Peers)+len(netMap.OfflinePeers))
 	for _, p := range netMap.Peers {
@@ -370,6 +726,8 @@ func toSinglePeerResponse(peer *nbpeer.Peer, groupsInfo []api.GroupMinimum, dnsD
 		CityName:                    peer.Location.CityName,
 		SerialNumber:                peer.Meta.SystemSerialNumber,
 		InactivityExpirationEnabled: peer.InactivityExpirationEnabled,
+		Tags:                        peer.Tags,
+		ReportedTags:                peer.Meta.Tags,
 	}
 }
 
@@ -403,6 +761,8 @@ func toPeerListItemResponse(peer *nbpeer.Peer, groupsInfo []api.GroupMinimum, dn
 		CountryCode:            peer.Location.CountryCode,
 		CityName:               peer.Location.CityName,
 		SerialNumber:           peer.Meta.SystemSerialNumber,
+		Tags:                   peer.Tags,
+		ReportedTags:           peer.Meta.Tags,
 
 		InactivityExpirationEnabled: peer.InactivityExpirationEnabled,
 	}
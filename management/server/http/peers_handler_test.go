@@ -20,6 +20,7 @@ import (
 	"github.com/netbirdio/netbird/management/server/http/api"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/status"
 
 	"github.com/stretchr/testify/assert"
 
@@ -427,3 +428,352 @@ func TestGetAccessiblePeers(t *testing.T) {
 		})
 	}
 }
+
+func TestExplainPeer(t *testing.T) {
+	var explainRequest *server.PolicyExplainRequest
+	p := &PeersHandler{
+		accountManager: &mock_server.MockAccountManager{
+			GetAccountIDFromTokenFunc: func(_ context.Context, claims jwtclaims.AuthorizationClaims) (string, string, error) {
+				return claims.AccountId, claims.UserId, nil
+			},
+			ExplainPeerConnectionFunc: func(_ context.Context, accountID, peerID, userID string, req *server.PolicyExplainRequest) (*server.PolicyExplanation, error) {
+				explainRequest = req
+				return &server.PolicyExplanation{
+					SourcePeerID:      peerID,
+					DestinationPeerID: "peer2",
+					DestinationIP:     "100.64.0.2",
+					Verdict:           server.PolicyExplainVerdictAllow,
+					Reason:            "a firewall rule accepts the connection",
+					Rules: []*server.PolicyRuleExplanation{
+						{PolicyID: "policy", PolicyName: "policy", RuleID: "rule", RuleName: "rule", Action: server.PolicyTrafficActionAccept, Matched: true},
+					},
+					FirewallRules: []*server.FirewallRule{
+						{PeerIP: "100.64.0.1", Action: "accept", Protocol: "tcp", Port: "22"},
+					},
+				}, nil
+			},
+		},
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithFromRequestContext(func(r *http.Request) jwtclaims.AuthorizationClaims {
+				return jwtclaims.AuthorizationClaims{
+					UserId:    adminUser,
+					Domain:    "hotmail.com",
+					AccountId: "test_id",
+				}
+			}),
+		),
+	}
+
+	tt := []struct {
+		name            string
+		requestType     string
+		requestPath     string
+		requestBody     string
+		expectedStatus  int
+		expectedRequest *server.PolicyExplainRequest
+	}{
+		{
+			name:            "explain peer connection",
+			requestType:     http.MethodGet,
+			requestPath:     "/api/peers/peer1/explain?destination=peer2&protocol=tcp&port=22",
+			expectedStatus:  http.StatusOK,
+			expectedRequest: &server.PolicyExplainRequest{Destination: "peer2", Protocol: server.PolicyRuleProtocolTCP, Port: 22},
+		},
+		{
+			name:           "missing destination",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/peers/peer1/explain",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "invalid port",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/peers/peer1/explain?destination=peer2&port=70000",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "invalid protocol",
+			requestType:    http.MethodGet,
+			requestPath:    "/api/peers/peer1/explain?destination=peer2&protocol=sctp",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "dry run with unsaved policy",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/peers/peer1/explain",
+			requestBody:    `{"destination": "100.64.0.2", "protocol": "udp", "policy": {"name": "dry run", "enabled": true, "rules": [{"name": "rule", "enabled": true, "action": "accept", "bidirectional": false, "protocol": "udp", "ports": ["53"], "sources": ["group1"], "destinations": ["group2"]}]}}`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "dry run with invalid policy",
+			requestType:    http.MethodPost,
+			requestPath:    "/api/peers/peer1/explain",
+			requestBody:    `{"destination": "100.64.0.2", "policy": {"name": "", "rules": []}}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			explainRequest = nil
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.requestType, tc.requestPath, bytes.NewBufferString(tc.requestBody))
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/peers/{peerId}/explain", p.ExplainPeer).Methods("GET")
+			router.HandleFunc("/api/peers/{peerId}/explain", p.DryRunExplainPeer).Methods("POST")
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			if !assert.Equal(t, tc.expectedStatus, res.StatusCode, recorder.Body.String()) || tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			if tc.expectedRequest != nil {
+				assert.Equal(t, tc.expectedRequest, explainRequest)
+			}
+			if tc.requestType == http.MethodPost {
+				if assert.NotNil(t, explainRequest.Policy, "dry run should pass the policy") {
+					assert.Equal(t, "dry run", explainRequest.Policy.Name)
+					assert.Equal(t, server.PolicyRuleProtocolUDP, explainRequest.Protocol)
+				}
+			}
+
+			var explanation api.PolicyExplanation
+			err := json.Unmarshal(recorder.Body.Bytes(), &explanation)
+			if err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			assert.Equal(t, api.PolicyExplanationVerdictAllow, explanation.Verdict)
+			assert.Equal(t, "peer1", explanation.SourcePeerId)
+			assert.Len(t, explanation.Rules, 1)
+			assert.Equal(t, []api.FirewallRuleExplanation{
+				{PeerIp: "100.64.0.1", Direction: api.FirewallRuleExplanationDirectionIn, Action: "accept", Protocol: "tcp", Port: "22"},
+			}, explanation.FirewallRules)
+			assert.Empty(t, explanation.Routes)
+		})
+	}
+}
+
+func TestGetPeerPostureStatus(t *testing.T) {
+	failingSince := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := &PeersHandler{
+		accountManager: &mock_server.MockAccountManager{
+			GetAccountIDFromTokenFunc: func(_ context.Context, claims jwtclaims.AuthorizationClaims) (string, string, error) {
+				return claims.AccountId, claims.UserId, nil
+			},
+			GetPeerPostureStatusFunc: func(_ context.Context, accountID, peerID, userID string) (*nbpeer.PostureStatus, error) {
+				if peerID != "peer1" {
+					return nil, status.Errorf(status.NotFound, "peer %s not found", peerID)
+				}
+				return &nbpeer.PostureStatus{
+					State: nbpeer.PostureStateGrace,
+					Checks: []nbpeer.PostureCheckStatus{
+						{
+							PostureCheckID:   "firewall",
+							PostureCheckName: "Firewall",
+							State:            nbpeer.PostureStateGrace,
+							Reasons:          []string{"FirewallCheck failed"},
+							FailingSince:     failingSince,
+							GraceExpiresAt:   failingSince.Add(time.Hour),
+						},
+						{
+							PostureCheckID:   "version",
+							PostureCheckName: "Version",
+							State:            nbpeer.PostureStateCompliant,
+						},
+					},
+					UpdatedAt: failingSince,
+				}, nil
+			},
+		},
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithFromRequestContext(func(r *http.Request) jwtclaims.AuthorizationClaims {
+				return jwtclaims.AuthorizationClaims{
+					UserId:    adminUser,
+					Domain:    "hotmail.com",
+					AccountId: "test_id",
+				}
+			}),
+		),
+	}
+
+	tt := []struct {
+		name           string
+		requestPath    string
+		expectedStatus int
+	}{
+		{
+			name:           "peer posture status",
+			requestPath:    "/api/peers/peer1/posture",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unknown peer",
+			requestPath:    "/api/peers/peer2/posture",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tc.requestPath, nil)
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/peers/{peerId}/posture", p.GetPeerPostureStatus).Methods("GET")
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			if !assert.Equal(t, tc.expectedStatus, res.StatusCode, recorder.Body.String()) || tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var postureStatus api.PeerPostureStatus
+			err := json.Unmarshal(recorder.Body.Bytes(), &postureStatus)
+			if err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			assert.Equal(t, api.PeerPostureStatusStateGrace, postureStatus.State)
+			if assert.Len(t, postureStatus.Checks, 2) {
+				assert.Equal(t, []string{"FirewallCheck failed"}, postureStatus.Checks[0].Reasons)
+				assert.Equal(t, failingSince, *postureStatus.Checks[0].FailingSince)
+				assert.Equal(t, failingSince.Add(time.Hour), *postureStatus.Checks[0].GraceExpiresAt)
+				assert.Nil(t, postureStatus.Checks[1].FailingSince)
+				assert.Nil(t, postureStatus.Checks[1].GraceExpiresAt)
+			}
+		})
+	}
+}
+
+func TestPendingPeers(t *testing.T) {
+	pendingPeer := &nbpeer.Peer{
+		ID:       "pending",
+		Key:      "pendingKey",
+		IP:       net.ParseIP("100.64.0.10"),
+		Status:   &nbpeer.PeerStatus{RequiresApproval: true},
+		Name:     "pending",
+		DNSLabel: "pending",
+	}
+
+	var handledPeerIDs []string
+	handlePeers := func(_ context.Context, accountID, userID string, peerIDs []string) error {
+		if peerIDs[0] != pendingPeer.ID {
+			return status.Errorf(status.PreconditionFailed, "peer %s is not pending approval", peerIDs[0])
+		}
+		handledPeerIDs = peerIDs
+		return nil
+	}
+
+	p := &PeersHandler{
+		accountManager: &mock_server.MockAccountManager{
+			GetAccountIDFromTokenFunc: func(_ context.Context, claims jwtclaims.AuthorizationClaims) (string, string, error) {
+				return claims.AccountId, claims.UserId, nil
+			},
+			GetPendingPeersFunc: func(_ context.Context, accountID, userID string) ([]*nbpeer.Peer, error) {
+				return []*nbpeer.Peer{pendingPeer}, nil
+			},
+			GetAllGroupsFunc: func(_ context.Context, accountID, userID string) ([]*nbgroup.Group, error) {
+				return []*nbgroup.Group{{ID: "group1", Name: "group1", Peers: []string{pendingPeer.ID}}}, nil
+			},
+			GetDNSDomainFunc: func() string {
+				return "netbird.selfhosted"
+			},
+			HasConnectedChannelFunc: func(peerID string) bool {
+				return false
+			},
+			ApprovePeersFunc: handlePeers,
+			RejectPeersFunc:  handlePeers,
+		},
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithFromRequestContext(func(r *http.Request) jwtclaims.AuthorizationClaims {
+				return jwtclaims.AuthorizationClaims{
+					UserId:    adminUser,
+					Domain:    "hotmail.com",
+					AccountId: "test_id",
+				}
+			}),
+		),
+	}
+
+	router := mux.NewRouter()
+	router.HandleFunc("/api/peers/pending", p.GetPendingPeers).Methods("GET")
+	router.HandleFunc("/api/peers/pending/approve", p.ApprovePendingPeers).Methods("POST")
+	router.HandleFunc("/api/peers/pending/reject", p.RejectPendingPeers).Methods("POST")
+
+	t.Run("list pending peers", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/peers/pending", nil))
+		if !assert.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String()) {
+			return
+		}
+
+		var peers []api.PeerBatch
+		if err := json.Unmarshal(recorder.Body.Bytes(), &peers); err != nil {
+			t.Fatalf("failed to unmarshal response: %v", err)
+		}
+		if assert.Len(t, peers, 1) {
+			assert.Equal(t, pendingPeer.ID, peers[0].Id)
+			assert.True(t, peers[0].ApprovalRequired)
+			assert.Len(t, peers[0].Groups, 1)
+		}
+	})
+
+	tt := []struct {
+		name            string
+		requestPath     string
+		requestBody     string
+		expectedStatus  int
+		expectedPeerIDs []string
+	}{
+		{
+			name:            "approve pending peers",
+			requestPath:     "/api/peers/pending/approve",
+			requestBody:     `{"peer_ids":["pending"]}`,
+			expectedStatus:  http.StatusOK,
+			expectedPeerIDs: []string{"pending"},
+		},
+		{
+			name:            "reject pending peers",
+			requestPath:     "/api/peers/pending/reject",
+			requestBody:     `{"peer_ids":["pending"]}`,
+			expectedStatus:  http.StatusOK,
+			expectedPeerIDs: []string{"pending"},
+		},
+		{
+			name:           "approve peer not pending",
+			requestPath:    "/api/peers/pending/approve",
+			requestBody:    `{"peer_ids":["approved"]}`,
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:           "approve without peers",
+			requestPath:    "/api/peers/pending/approve",
+			requestBody:    `{"peer_ids":[]}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "reject invalid body",
+			requestPath:    "/api/peers/pending/reject",
+			requestBody:    `{"peer_ids":`,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			handledPeerIDs = nil
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, tc.requestPath, bytes.NewBufferString(tc.requestBody))
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code, recorder.Body.String())
+			assert.Equal(t, tc.expectedPeerIDs, handledPeerIDs)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gorilla/mux"
 	"github.com/netbirdio/netbird/management/server"
@@ -110,16 +111,43 @@ func (h *Policies) savePolicy(w http.ResponseWriter, r *http.Request, accountID
 		return
 	}
 
-	if req.Name == "" {
-		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "policy name shouldn't be empty"), w)
+	policy, err := toPolicy(accountID, policyID, &req)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
 		return
 	}
 
-	if len(req.Rules) == 0 {
-		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "policy rules shouldn't be empty"), w)
+	policy, err = h.accountManager.SavePolicy(r.Context(), accountID, userID, policy)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	allGroups, err := h.accountManager.GetAllGroups(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	resp := toPolicyResponse(allGroups, policy)
+	if len(resp.Rules) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.Internal, "no rules in the policy"), w)
 		return
 	}
 
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
+// toPolicy converts the policy request to a policy of the account
+func toPolicy(accountID, policyID string, req *api.PolicyUpdate) (*server.Policy, error) {
+	if req.Name == "" {
+		return nil, status.Errorf(status.InvalidArgument, "policy name shouldn't be empty")
+	}
+
+	if len(req.Rules) == 0 {
+		return nil, status.Errorf(status.InvalidArgument, "policy rules shouldn't be empty")
+	}
+
 	policy := &server.Policy{
 		ID:          policyID,
 		AccountID:   accountID,
@@ -146,15 +174,22 @@ func (h *Policies) savePolicy(w http.ResponseWriter, r *http.Request, accountID
 		if rule.Description != nil {
 			pr.Description = *rule.Description
 		}
+		if rule.SourceTagSelector != nil {
+			pr.SourceTagSelector = *rule.SourceTagSelector
+		}
+		if rule.DestinationTagSelector != nil {
+			pr.DestinationTagSelector = *rule.DestinationTagSelector
+		}
 
 		switch rule.Action {
 		case api.PolicyRuleUpdateActionAccept:
 			pr.Action = server.PolicyTrafficActionAccept
 		case api.PolicyRuleUpdateActionDrop:
 			pr.Action = server.PolicyTrafficActionDrop
+		case api.PolicyRuleUpdateActionAudit:
+			pr.Action = server.PolicyTrafficActionAudit
 		default:
-			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "unknown action type"), w)
-			return
+			return nil, status.Errorf(status.InvalidArgument, "unknown action type")
 		}
 
 		switch rule.Protocol {
@@ -167,20 +202,17 @@ func (h *Policies) savePolicy(w http.ResponseWriter, r *http.Request, accountID
 		case api.PolicyRuleUpdateProtocolIcmp:
 			pr.Protocol = server.PolicyRuleProtocolICMP
 		default:
-			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "unknown protocol type: %v", rule.Protocol), w)
-			return
+			return nil, status.Errorf(status.InvalidArgument, "unknown protocol type: %v", rule.Protocol)
 		}
 
 		if (rule.Ports != nil && len(*rule.Ports) != 0) && (rule.PortRanges != nil && len(*rule.PortRanges) != 0) {
-			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "specify either individual ports or port ranges, not both"), w)
-			return
+			return nil, status.Errorf(status.InvalidArgument, "specify either individual ports or port ranges, not both")
 		}
 
 		if rule.Ports != nil && len(*rule.Ports) != 0 {
 			for _, v := range *rule.Ports {
 				if port, err := strconv.Atoi(v); err != nil || port < 1 || port > 65535 {
-					util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "valid port value is in 1..65535 range"), w)
-					return
+					return nil, status.Errorf(status.InvalidArgument, "valid port value is in 1..65535 range")
 				}
 				pr.Ports = append(pr.Ports, v)
 			}
@@ -188,9 +220,8 @@ func (h *Policies) savePolicy(w http.ResponseWriter, r *http.Request, accountID
 
 		if rule.PortRanges != nil && len(*rule.PortRanges) != 0 {
 			for _, portRange := range *rule.PortRanges {
-				if portRange.Start < 1 || portRange.End > 65535 {
-					util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "valid port value is in 1..65535 range"), w)
-					return
+				if portRange.Start < 1 || portRange.Start > portRange.End || portRange.End > 65535 {
+					return nil, status.Errorf(status.InvalidArgument, "valid port value is in 1..65535 range")
 				}
 				pr.PortRanges = append(pr.PortRanges, server.RulePortRange{
 					Start: uint16(portRange.Start),
@@ -199,21 +230,28 @@ func (h *Policies) savePolicy(w http.ResponseWriter, r *http.Request, accountID
 			}
 		}
 
+		if rule.Services != nil && len(*rule.Services) != 0 {
+			if len(pr.Ports) != 0 || len(pr.PortRanges) != 0 {
+				return nil, status.Errorf(status.InvalidArgument, "specify either services or ports, not both")
+			}
+			// protocol and ports of the rule are taken from the services
+			pr.Services = *rule.Services
+			policy.Rules = append(policy.Rules, &pr)
+			continue
+		}
+
 		// validate policy object
 		switch pr.Protocol {
 		case server.PolicyRuleProtocolALL, server.PolicyRuleProtocolICMP:
 			if len(pr.Ports) != 0 || len(pr.PortRanges) != 0 {
-				util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol ports is not allowed"), w)
-				return
+				return nil, status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol ports is not allowed")
 			}
 			if !pr.Bidirectional {
-				util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol type flow can be only bi-directional"), w)
-				return
+				return nil, status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol type flow can be only bi-directional")
 			}
 		case server.PolicyRuleProtocolTCP, server.PolicyRuleProtocolUDP:
 			if !pr.Bidirectional && (len(pr.Ports) == 0 || len(pr.PortRanges) != 0) {
-				util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol type flow can be only bi-directional"), w)
-				return
+				return nil, status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol type flow can be only bi-directional")
 			}
 		}
 
@@ -224,25 +262,15 @@ func (h *Policies) savePolicy(w http.ResponseWriter, r *http.Request, accountID
 		policy.SourcePostureChecks = *req.SourcePostureChecks
 	}
 
-	policy, err := h.accountManager.SavePolicy(r.Context(), accountID, userID, policy)
-	if err != nil {
-		util.WriteError(r.Context(), err, w)
-		return
-	}
-
-	allGroups, err := h.accountManager.GetAllGroups(r.Context(), accountID, userID)
-	if err != nil {
-		util.WriteError(r.Context(), err, w)
-		return
-	}
-
-	resp := toPolicyResponse(allGroups, policy)
-	if len(resp.Rules) == 0 {
-		util.WriteError(r.Context(), status.Errorf(status.Internal, "no rules in the policy"), w)
-		return
+	if req.Schedule != nil {
+		schedule, err := toPolicySchedule(req.Schedule)
+		if err != nil {
+			return nil, err
+		}
+		policy.Schedule = schedule
 	}
 
-	util.WriteJSONObject(r.Context(), w, resp)
+	return policy, nil
 }
 
 // DeletePolicy handles policy deletion request
@@ -306,6 +334,56 @@ func (h *Policies) GetPolicy(w http.ResponseWriter, r *http.Request) {
 	util.WriteJSONObject(r.Context(), w, resp)
 }
 
+// GetPolicyAudit returns the packets matching the audit rules of the policy reported by the peers
+func (h *Policies) GetPolicyAudit(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	vars := mux.Vars(r)
+	policyID := vars["policyId"]
+	if len(policyID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid policy ID"), w)
+		return
+	}
+
+	counts, err := h.accountManager.GetPolicyAuditCounts(r.Context(), accountID, policyID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	peers, err := h.accountManager.GetPeers(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	peerNames := make(map[string]string, len(peers))
+	for _, peer := range peers {
+		peerNames[peer.ID] = peer.Name
+	}
+
+	resp := api.PolicyAudit{
+		PolicyId: policyID,
+		Peers:    make([]api.PolicyAuditPeer, 0, len(counts)),
+	}
+	for _, count := range counts {
+		resp.Packets += int64(count.Packets)
+		resp.Peers = append(resp.Peers, api.PolicyAuditPeer{
+			PeerId:   count.PeerID,
+			PeerName: peerNames[count.PeerID],
+			Packets:  int64(count.Packets),
+			LastSeen: count.UpdatedAt,
+		})
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
 func toPolicyResponse(groups []*nbgroup.Group, policy *server.Policy) *api.Policy {
 	groupsMap := make(map[string]*nbgroup.Group)
 	for _, group := range groups {
@@ -319,6 +397,7 @@ func toPolicyResponse(groups []*nbgroup.Group, policy *server.Policy) *api.Polic
 		Description:         policy.Description,
 		Enabled:             policy.Enabled,
 		SourcePostureChecks: policy.SourcePostureChecks,
+		Schedule:            toPolicyScheduleResponse(policy.Schedule),
 	}
 	for _, r := range policy.Rules {
 		rID := r.ID
@@ -338,6 +417,21 @@ func toPolicyResponse(groups []*nbgroup.Group, policy *server.Policy) *api.Polic
 			rule.Ports = &portsCopy
 		}
 
+		if len(r.Services) != 0 {
+			services := r.Services
+			rule.Services = &services
+		}
+
+		if r.SourceTagSelector != "" {
+			sourceTagSelector := r.SourceTagSelector
+			rule.SourceTagSelector = &sourceTagSelector
+		}
+
+		if r.DestinationTagSelector != "" {
+			destinationTagSelector := r.DestinationTagSelector
+			rule.DestinationTagSelector = &destinationTagSelector
+		}
+
 		if len(r.PortRanges) != 0 {
 			portRanges := make([]api.RulePortRange, 0, len(r.PortRanges))
 			for _, portRange := range r.PortRanges {
@@ -385,3 +479,55 @@ func toPolicyResponse(groups []*nbgroup.Group, policy *server.Policy) *api.Polic
 	}
 	return ap
 }
+
+func toPolicySchedule(req *api.PolicySchedule) (*server.PolicySchedule, error) {
+	var timezone string
+	if req.Timezone != nil {
+		timezone = *req.Timezone
+	}
+
+	var days []string
+	if req.Days != nil {
+		days = *req.Days
+	}
+
+	var timeRanges []server.PolicyScheduleTimeRange
+	if req.TimeRanges != nil {
+		for _, timeRange := range *req.TimeRanges {
+			timeRanges = append(timeRanges, server.PolicyScheduleTimeRange{
+				Start: timeRange.Start,
+				End:   timeRange.End,
+			})
+		}
+	}
+
+	return server.NewPolicySchedule(timezone, req.StartDate, req.EndDate, days, timeRanges)
+}
+
+func toPolicyScheduleResponse(schedule *server.PolicySchedule) *api.PolicySchedule {
+	if schedule == nil {
+		return nil
+	}
+
+	days := make([]string, 0, len(schedule.Days))
+	for _, day := range schedule.Days {
+		days = append(days, strings.ToLower(day.String()))
+	}
+
+	timeRanges := make([]api.PolicyScheduleTimeRange, 0, len(schedule.TimeRanges))
+	for _, timeRange := range schedule.TimeRanges {
+		timeRanges = append(timeRanges, api.PolicyScheduleTimeRange{
+			Start: timeRange.Start,
+			End:   timeRange.End,
+		})
+	}
+
+	timezone := schedule.Timezone
+	return &api.PolicySchedule{
+		Days:       &days,
+		TimeRanges: &timeRanges,
+		Timezone:   &timezone,
+		StartDate:  schedule.StartDate,
+		EndDate:    schedule.EndDate,
+	}
+}
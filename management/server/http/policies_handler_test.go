@@ -241,6 +241,156 @@ func TestPoliciesWritePolicy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:        "WritePolicy POST With Schedule",
+			requestType: http.MethodPost,
+			requestPath: "/api/policies",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+                    "Name":"Scheduled Policy",
+                    "Schedule": {
+                        "days": ["Monday", "friday"],
+                        "time_ranges": [{"start": "09:00", "end": "18:00"}],
+                        "timezone": "Europe/Berlin"
+                    },
+                    "Rules":[
+                        {
+                            "Name":"Scheduled Policy",
+                            "Description": "Description",
+                            "Protocol": "tcp",
+                            "Action": "accept",
+                            "Bidirectional":true
+                        }
+                ]}`)),
+			expectedStatus: http.StatusOK,
+			expectedBody:   true,
+			expectedPolicy: &api.Policy{
+				Id:   str("id-was-set"),
+				Name: "Scheduled Policy",
+				Schedule: &api.PolicySchedule{
+					Days:       &[]string{"monday", "friday"},
+					TimeRanges: &[]api.PolicyScheduleTimeRange{{Start: "09:00", End: "18:00"}},
+					Timezone:   str("Europe/Berlin"),
+				},
+				Rules: []api.PolicyRule{
+					{
+						Id:            str("id-was-set"),
+						Name:          "Scheduled Policy",
+						Description:   str("Description"),
+						Protocol:      "tcp",
+						Action:        "accept",
+						Bidirectional: true,
+					},
+				},
+			},
+		},
+		{
+			name:        "WritePolicy POST Invalid Schedule",
+			requestType: http.MethodPost,
+			requestPath: "/api/policies",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+                    "Name":"Scheduled Policy",
+                    "Schedule": {"days": ["someday"]},
+                    "Rules":[
+                        {
+                            "Name":"Scheduled Policy",
+                            "Protocol": "tcp",
+                            "Action": "accept",
+                            "Bidirectional":true
+                        }
+                ]}`)),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "WritePolicy POST Audit",
+			requestType: http.MethodPost,
+			requestPath: "/api/policies",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+                    "Name":"Audited Policy",
+                    "Rules":[
+                        {
+                            "Name":"Audited Policy",
+                            "Protocol": "tcp",
+                            "Action": "audit",
+                            "Bidirectional":true
+                        }
+                ]}`)),
+			expectedStatus: http.StatusOK,
+			expectedBody:   true,
+			expectedPolicy: &api.Policy{
+				Id:   str("id-was-set"),
+				Name: "Audited Policy",
+				Rules: []api.PolicyRule{
+					{
+						Id:            str("id-was-set"),
+						Name:          "Audited Policy",
+						Description:   str(""),
+						Protocol:      "tcp",
+						Action:        "audit",
+						Bidirectional: true,
+					},
+				},
+			},
+		},
+		{
+			name:        "WritePolicy POST With Tag Selectors And Port Ranges",
+			requestType: http.MethodPost,
+			requestPath: "/api/policies",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+                    "Name":"Tagged Policy",
+                    "Rules":[
+                        {
+                            "Name":"Tagged Policy",
+                            "Protocol": "tcp",
+                            "Action": "accept",
+                            "Bidirectional":true,
+                            "source_tag_selector": "env=prod",
+                            "destination_tag_selector": "role in (db,cache)",
+                            "port_ranges": [{"start": 8000, "end": 8080}]
+                        }
+                ]}`)),
+			expectedStatus: http.StatusOK,
+			expectedBody:   true,
+			expectedPolicy: &api.Policy{
+				Id:   str("id-was-set"),
+				Name: "Tagged Policy",
+				Rules: []api.PolicyRule{
+					{
+						Id:                     str("id-was-set"),
+						Name:                   "Tagged Policy",
+						Description:            str(""),
+						Protocol:               "tcp",
+						Action:                 "accept",
+						Bidirectional:          true,
+						SourceTagSelector:      str("env=prod"),
+						DestinationTagSelector: str("role in (db,cache)"),
+						PortRanges:             &[]api.RulePortRange{{Start: 8000, End: 8080}},
+					},
+				},
+			},
+		},
+		{
+			name:        "WritePolicy POST Port Ranges With Ports",
+			requestType: http.MethodPost,
+			requestPath: "/api/policies",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+                    "Name":"Tagged Policy",
+                    "Rules":[
+                        {
+                            "Name":"Tagged Policy",
+                            "Protocol": "tcp",
+                            "Action": "accept",
+                            "Bidirectional":true,
+                            "ports": ["22"],
+                            "port_ranges": [{"start": 8000, "end": 8080}]
+                        }
+                ]}`)),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
 		{
 			name:        "WritePolicy PUT Invalid Name",
 			requestType: http.MethodPut,
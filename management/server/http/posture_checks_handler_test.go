@@ -223,6 +223,7 @@ func TestGetPostureCheck(t *testing.T) {
 
 func TestPostureCheckUpdate(t *testing.T) {
 	str := func(s string) *string { return &s }
+	intPtr := func(i int) *int { return &i }
 	tt := []struct {
 		name                 string
 		expectedStatus       int
@@ -470,6 +471,115 @@ func TestPostureCheckUpdate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:        "Create Posture Checks Disk Encryption And Firewall",
+			requestType: http.MethodPost,
+			requestPath: "/api/posture-checks",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+					"name": "default",
+					"description": "default",
+					"checks": {
+						"disk_encryption_check": {
+							"volumes": ["/", "C:"]
+						},
+						"firewall_check": {}
+					}
+					}`)),
+			expectedStatus: http.StatusOK,
+			expectedBody:   true,
+			expectedPostureCheck: &api.PostureCheck{
+				Id:          "postureCheck",
+				Name:        "default",
+				Description: str("default"),
+				Checks: api.Checks{
+					DiskEncryptionCheck: &api.DiskEncryptionCheck{
+						Volumes: &[]string{"/", "C:"},
+					},
+					FirewallCheck: &api.FirewallCheck{},
+				},
+			},
+		},
+		{
+			name:        "Create Posture Checks With Grace Period",
+			requestType: http.MethodPost,
+			requestPath: "/api/posture-checks",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+					"name": "default",
+					"description": "default",
+					"grace_period": 3600,
+					"checks": {
+						"firewall_check": {}
+					}
+					}`)),
+			expectedStatus: http.StatusOK,
+			expectedBody:   true,
+			expectedPostureCheck: &api.PostureCheck{
+				Id:          "postureCheck",
+				Name:        "default",
+				Description: str("default"),
+				GracePeriod: intPtr(3600),
+				Checks: api.Checks{
+					FirewallCheck: &api.FirewallCheck{},
+				},
+			},
+		},
+		{
+			name:        "Create Posture Checks Invalid Grace Period",
+			requestType: http.MethodPost,
+			requestPath: "/api/posture-checks",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+					"name": "default",
+					"description": "default",
+					"grace_period": -1,
+					"checks": {
+						"firewall_check": {}
+					}
+					}`)),
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody:   false,
+		},
+		{
+			name:        "Create Posture Checks File Content",
+			requestType: http.MethodPost,
+			requestPath: "/api/posture-checks",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+					"name": "default",
+					"description": "default",
+					"checks": {
+						"file_content_check": {
+							"files": [
+								{
+									"linux_path": "/etc/edr/agent.conf",
+									"content_regex": "tamper_protection = true"
+								}
+							]
+						}
+					}
+					}`)),
+			expectedStatus: http.StatusOK,
+			expectedBody:   true,
+			expectedPostureCheck: &api.PostureCheck{
+				Id:          "postureCheck",
+				Name:        "default",
+				Description: str("default"),
+				Checks: api.Checks{
+					FileContentCheck: &api.FileContentCheck{
+						Files: []api.FileContent{
+							{
+								LinuxPath:    str("/etc/edr/agent.conf"),
+								MacPath:      str(""),
+								WindowsPath:  str(""),
+								ContentRegex: str("tamper_protection = true"),
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:        "Create Posture Checks Invalid Check",
 			requestType: http.MethodPost,
@@ -0,0 +1,152 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/rbac"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// RolesHandler is a handler that manages the custom roles of the account
+type RolesHandler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+// NewRolesHandler creates a new RolesHandler
+func NewRolesHandler(accountManager server.AccountManager, authCfg AuthCfg) *RolesHandler {
+	return &RolesHandler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// GetAllRoles returns the list of custom roles for the account
+func (h *RolesHandler) GetAllRoles(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	roles, err := h.accountManager.ListCustomRoles(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	resp := make([]*api.Role, 0, len(roles))
+	for _, role := range roles {
+		resp = append(resp, role.ToAPIResponse())
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
+// CreateRole handles custom role creation request
+func (h *RolesHandler) CreateRole(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	h.saveRole(w, r, accountID, userID, "")
+}
+
+// UpdateRole handles update to a custom role identified by a given ID
+func (h *RolesHandler) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	vars := mux.Vars(r)
+	roleID := vars["roleId"]
+	if len(roleID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid role ID"), w)
+		return
+	}
+
+	h.saveRole(w, r, accountID, userID, roleID)
+}
+
+// GetRole handles a custom role Get request identified by ID
+func (h *RolesHandler) GetRole(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	vars := mux.Vars(r)
+	roleID := vars["roleId"]
+	if len(roleID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid role ID"), w)
+		return
+	}
+
+	role, err := h.accountManager.GetCustomRole(r.Context(), accountID, roleID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, role.ToAPIResponse())
+}
+
+// DeleteRole handles custom role deletion request
+func (h *RolesHandler) DeleteRole(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	vars := mux.Vars(r)
+	roleID := vars["roleId"]
+	if len(roleID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid role ID"), w)
+		return
+	}
+
+	if err = h.accountManager.DeleteCustomRole(r.Context(), accountID, roleID, userID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, emptyObject{})
+}
+
+// saveRole handles custom role create and update
+func (h *RolesHandler) saveRole(w http.ResponseWriter, r *http.Request, accountID, userID, roleID string) {
+	var req api.PutApiRolesRoleIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	role, err := h.accountManager.SaveCustomRole(r.Context(), accountID, userID, rbac.NewRoleFromAPIRequest(&req, roleID))
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, role.ToAPIResponse())
+}
@@ -117,13 +117,18 @@ func (h *RoutesHandler) CreateRoute(w http.ResponseWriter, r *http.Request) {
 		peerGroupIds = *req.PeerGroups
 	}
 
+	var opts server.CreateRouteOptions
+	if req.PeerTagSelector != nil {
+		opts.PeerTagSelector = *req.PeerTagSelector
+	}
+
 	var accessControlGroupIds []string
 	if req.AccessControlGroups != nil {
 		accessControlGroupIds = *req.AccessControlGroups
 	}
 
-	newRoute, err := h.accountManager.CreateRoute(r.Context(), accountID, newPrefix, networkType, domains, peerId, peerGroupIds,
-		req.Description, route.NetID(req.NetworkId), req.Masquerade, req.Metric, req.Groups, accessControlGroupIds, req.Enabled, userID, req.KeepRoute)
+	newRoute, err := h.accountManager.CreateRouteWithOptions(r.Context(), accountID, newPrefix, networkType, domains, peerId, peerGroupIds,
+		req.Description, route.NetID(req.NetworkId), req.Masquerade, req.Metric, req.Groups, accessControlGroupIds, req.Enabled, userID, req.KeepRoute, opts)
 
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
@@ -148,12 +153,19 @@ func (h *RoutesHandler) validateRoute(req api.PostApiRoutesJSONRequestBody) erro
 		return status.Errorf(status.InvalidArgument, "either 'network' or 'domains' should be provided")
 	}
 
-	if req.Peer == nil && req.PeerGroups == nil {
-		return status.Errorf(status.InvalidArgument, "either 'peer' or 'peer_groups' should be provided")
+	routingPeerSources := 0
+	for _, isSet := range []bool{req.Peer != nil, req.PeerGroups != nil, req.PeerTagSelector != nil} {
+		if isSet {
+			routingPeerSources++
+		}
+	}
+
+	if routingPeerSources == 0 {
+		return status.Errorf(status.InvalidArgument, "either 'peer', 'peer_groups' or 'peer_tag_selector' should be provided")
 	}
 
-	if req.Peer != nil && req.PeerGroups != nil {
-		return status.Errorf(status.InvalidArgument, "only one of 'peer' or 'peer_groups' should be provided")
+	if routingPeerSources > 1 {
+		return status.Errorf(status.InvalidArgument, "only one of 'peer', 'peer_groups' or 'peer_tag_selector' should be provided")
 	}
 
 	if utf8.RuneCountInString(req.NetworkId) > route.MaxNetIDChar || req.NetworkId == "" {
@@ -238,6 +250,10 @@ func (h *RoutesHandler) UpdateRoute(w http.ResponseWriter, r *http.Request) {
 		newRoute.PeerGroups = *req.PeerGroups
 	}
 
+	if req.PeerTagSelector != nil {
+		newRoute.PeerTagSelector = *req.PeerTagSelector
+	}
+
 	if req.AccessControlGroups != nil {
 		newRoute.AccessControlGroups = *req.AccessControlGroups
 	}
@@ -335,6 +351,9 @@ func toRouteResponse(serverRoute *route.Route) (*api.Route, error) {
 	if len(serverRoute.PeerGroups) > 0 {
 		route.PeerGroups = &serverRoute.PeerGroups
 	}
+	if serverRoute.PeerTagSelector != "" {
+		route.PeerTagSelector = &serverRoute.PeerTagSelector
+	}
 	if len(serverRoute.AccessControlGroups) > 0 {
 		route.AccessControlGroups = &serverRoute.AccessControlGroups
 	}
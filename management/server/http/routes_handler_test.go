@@ -554,6 +554,43 @@ func TestRoutesHandlers(t *testing.T) {
 	}
 }
 
+func TestRoutesHandlers_CreateRouteWithPeerTagSelector(t *testing.T) {
+	p := initRoutesTestData()
+	var receivedOpts server.CreateRouteOptions
+	p.accountManager.(*mock_server.MockAccountManager).CreateRouteWithOptionsFunc = func(_ context.Context, _ string, prefix netip.Prefix, networkType route.NetworkType, _ domain.List, peerID string, peerGroups []string, description string, netID route.NetID, masquerade bool, metric int, groups, _ []string, enabled bool, _ string, keepRoute bool, opts server.CreateRouteOptions) (*route.Route, error) {
+		receivedOpts = opts
+		return &route.Route{
+			ID:              existingRouteID,
+			NetID:           netID,
+			PeerTagSelector: opts.PeerTagSelector,
+			Network:         prefix,
+			NetworkType:     networkType,
+			Description:     description,
+			Masquerade:      masquerade,
+			Enabled:         enabled,
+			Groups:          groups,
+			KeepRoute:       keepRoute,
+			Metric:          metric,
+		}, nil
+	}
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/routes", bytes.NewBuffer(
+		[]byte(fmt.Sprintf(`{"description":"Post","network":"192.168.0.0/16","network_id":"awesomeNet","peer_tag_selector":"env=prod","groups":["%s"]}`, existingGroupID))))
+
+	router := mux.NewRouter()
+	router.HandleFunc("/api/routes", p.CreateRoute).Methods("POST")
+	router.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	assert.Equal(t, receivedOpts.PeerTagSelector, "env=prod")
+
+	got := &api.Route{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), got))
+	require.NotNil(t, got.PeerTagSelector)
+	assert.Equal(t, *got.PeerTagSelector, "env=prod")
+}
+
 func TestValidateDomains(t *testing.T) {
 	tests := []struct {
 		name     string
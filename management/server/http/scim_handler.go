@@ -0,0 +1,287 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server"
+	nbContext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/scim"
+)
+
+// SCIMHandler is a handler of the SCIM 2.0 provisioning requests
+type SCIMHandler struct {
+	accountManager server.AccountManager
+}
+
+// NewSCIMHandler creates a new SCIMHandler
+func NewSCIMHandler(accountManager server.AccountManager) *SCIMHandler {
+	return &SCIMHandler{
+		accountManager: accountManager,
+	}
+}
+
+// GetServiceProviderConfig returns the SCIM features supported by the server
+func (h *SCIMHandler) GetServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	util.WriteSCIMResponse(r.Context(), w, http.StatusOK, scim.NewServiceProviderConfig())
+}
+
+// GetUsers handles the SCIM users query
+func (h *SCIMHandler) GetUsers(w http.ResponseWriter, r *http.Request) {
+	accountID, _ := scimRequestIdentity(r)
+
+	filter, startIndex, count, ok := parseSCIMListParams(w, r)
+	if !ok {
+		return
+	}
+
+	users, err := h.accountManager.ListSCIMUsers(r.Context(), accountID)
+	if err != nil {
+		util.WriteSCIMError(r.Context(), err, w)
+		return
+	}
+
+	matching := make([]*scim.User, 0, len(users))
+	for _, user := range users {
+		if filter.MatchUser(user) {
+			matching = append(matching, user)
+		}
+	}
+
+	util.WriteSCIMResponse(r.Context(), w, http.StatusOK, scim.NewListResponse(matching, startIndex, count))
+}
+
+// GetUser handles the SCIM user request identified by ID
+func (h *SCIMHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	accountID, _ := scimRequestIdentity(r)
+
+	user, err := h.accountManager.GetSCIMUser(r.Context(), accountID, mux.Vars(r)["userId"])
+	if err != nil {
+		util.WriteSCIMError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteSCIMResponse(r.Context(), w, http.StatusOK, user)
+}
+
+// CreateUser handles the SCIM user provisioning request
+func (h *SCIMHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	var user scim.User
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		util.WriteSCIMErrorResponse(r.Context(), w, http.StatusBadRequest, "invalidSyntax", "couldn't parse JSON request")
+		return
+	}
+
+	user.ID = ""
+	h.saveUser(w, r, &user, http.StatusCreated)
+}
+
+// ReplaceUser handles the SCIM request replacing the user identified by ID
+func (h *SCIMHandler) ReplaceUser(w http.ResponseWriter, r *http.Request) {
+	var user scim.User
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		util.WriteSCIMErrorResponse(r.Context(), w, http.StatusBadRequest, "invalidSyntax", "couldn't parse JSON request")
+		return
+	}
+
+	user.ID = mux.Vars(r)["userId"]
+	h.saveUser(w, r, &user, http.StatusOK)
+}
+
+// PatchUser handles the SCIM request modifying the user identified by ID
+func (h *SCIMHandler) PatchUser(w http.ResponseWriter, r *http.Request) {
+	accountID, _ := scimRequestIdentity(r)
+
+	var req scim.PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteSCIMErrorResponse(r.Context(), w, http.StatusBadRequest, "invalidSyntax", "couldn't parse JSON request")
+		return
+	}
+
+	user, err := h.accountManager.GetSCIMUser(r.Context(), accountID, mux.Vars(r)["userId"])
+	if err != nil {
+		util.WriteSCIMError(r.Context(), err, w)
+		return
+	}
+
+	if err = req.ApplyToUser(user); err != nil {
+		util.WriteSCIMErrorResponse(r.Context(), w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	h.saveUser(w, r, user, http.StatusOK)
+}
+
+// DeleteUser handles the SCIM user deprovisioning request
+func (h *SCIMHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	accountID, userID := scimRequestIdentity(r)
+
+	if err := h.accountManager.DeleteSCIMUser(r.Context(), accountID, userID, mux.Vars(r)["userId"]); err != nil {
+		util.WriteSCIMError(r.Context(), err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetGroups handles the SCIM groups query
+func (h *SCIMHandler) GetGroups(w http.ResponseWriter, r *http.Request) {
+	accountID, _ := scimRequestIdentity(r)
+
+	filter, startIndex, count, ok := parseSCIMListParams(w, r)
+	if !ok {
+		return
+	}
+
+	groups, err := h.accountManager.ListSCIMGroups(r.Context(), accountID)
+	if err != nil {
+		util.WriteSCIMError(r.Context(), err, w)
+		return
+	}
+
+	matching := make([]*scim.Group, 0, len(groups))
+	for _, group := range groups {
+		if filter.MatchGroup(group) {
+			matching = append(matching, group)
+		}
+	}
+
+	util.WriteSCIMResponse(r.Context(), w, http.StatusOK, scim.NewListResponse(matching, startIndex, count))
+}
+
+// GetGroup handles the SCIM group request identified by ID
+func (h *SCIMHandler) GetGroup(w http.ResponseWriter, r *http.Request) {
+	accountID, _ := scimRequestIdentity(r)
+
+	group, err := h.accountManager.GetSCIMGroup(r.Context(), accountID, mux.Vars(r)["groupId"])
+	if err != nil {
+		util.WriteSCIMError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteSCIMResponse(r.Context(), w, http.StatusOK, group)
+}
+
+// CreateGroup handles the SCIM group provisioning request
+func (h *SCIMHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	var group scim.Group
+	if err := json.NewDecoder(r.Body).Decode(&group); err != nil {
+		util.WriteSCIMErrorResponse(r.Context(), w, http.StatusBadRequest, "invalidSyntax", "couldn't parse JSON request")
+		return
+	}
+
+	group.ID = ""
+	h.saveGroup(w, r, &group, http.StatusCreated)
+}
+
+// ReplaceGroup handles the SCIM request replacing the group identified by ID
+func (h *SCIMHandler) ReplaceGroup(w http.ResponseWriter, r *http.Request) {
+	var group scim.Group
+	if err := json.NewDecoder(r.Body).Decode(&group); err != nil {
+		util.WriteSCIMErrorResponse(r.Context(), w, http.StatusBadRequest, "invalidSyntax", "couldn't parse JSON request")
+		return
+	}
+
+	group.ID = mux.Vars(r)["groupId"]
+	h.saveGroup(w, r, &group, http.StatusOK)
+}
+
+// PatchGroup handles the SCIM request modifying the group identified by ID
+func (h *SCIMHandler) PatchGroup(w http.ResponseWriter, r *http.Request) {
+	accountID, _ := scimRequestIdentity(r)
+
+	var req scim.PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteSCIMErrorResponse(r.Context(), w, http.StatusBadRequest, "invalidSyntax", "couldn't parse JSON request")
+		return
+	}
+
+	group, err := h.accountManager.GetSCIMGroup(r.Context(), accountID, mux.Vars(r)["groupId"])
+	if err != nil {
+		util.WriteSCIMError(r.Context(), err, w)
+		return
+	}
+
+	if err = req.ApplyToGroup(group); err != nil {
+		util.WriteSCIMErrorResponse(r.Context(), w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	h.saveGroup(w, r, group, http.StatusOK)
+}
+
+// DeleteGroup handles the SCIM group deprovisioning request
+func (h *SCIMHandler) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+	accountID, userID := scimRequestIdentity(r)
+
+	if err := h.accountManager.DeleteSCIMGroup(r.Context(), accountID, userID, mux.Vars(r)["groupId"]); err != nil {
+		util.WriteSCIMError(r.Context(), err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *SCIMHandler) saveUser(w http.ResponseWriter, r *http.Request, user *scim.User, httpStatus int) {
+	accountID, userID := scimRequestIdentity(r)
+
+	saved, err := h.accountManager.SaveSCIMUser(r.Context(), accountID, userID, user)
+	if err != nil {
+		util.WriteSCIMError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteSCIMResponse(r.Context(), w, httpStatus, saved)
+}
+
+func (h *SCIMHandler) saveGroup(w http.ResponseWriter, r *http.Request, group *scim.Group, httpStatus int) {
+	accountID, userID := scimRequestIdentity(r)
+
+	saved, err := h.accountManager.SaveSCIMGroup(r.Context(), accountID, userID, group)
+	if err != nil {
+		util.WriteSCIMError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteSCIMResponse(r.Context(), w, httpStatus, saved)
+}
+
+// scimRequestIdentity returns the account ID and the service user ID set by the SCIM authentication middleware
+func scimRequestIdentity(r *http.Request) (string, string) {
+	accountID, _ := r.Context().Value(nbContext.AccountIDKey).(string)
+	userID, _ := r.Context().Value(nbContext.UserIDKey).(string)
+	return accountID, userID
+}
+
+// parseSCIMListParams parses the filter and the pagination parameters of the SCIM query writing an error response if they are invalid
+func parseSCIMListParams(w http.ResponseWriter, r *http.Request) (*scim.Filter, int, int, bool) {
+	query := r.URL.Query()
+
+	filter, err := scim.ParseFilter(query.Get("filter"))
+	if err != nil {
+		util.WriteSCIMErrorResponse(r.Context(), w, http.StatusBadRequest, "invalidFilter", err.Error())
+		return nil, 0, 0, false
+	}
+
+	startIndex := 1
+	if value := query.Get("startIndex"); value != "" {
+		if startIndex, err = strconv.Atoi(value); err != nil {
+			util.WriteSCIMErrorResponse(r.Context(), w, http.StatusBadRequest, "invalidValue", "invalid startIndex")
+			return nil, 0, 0, false
+		}
+	}
+
+	count := -1
+	if value := query.Get("count"); value != "" {
+		if count, err = strconv.Atoi(value); err != nil || count < 0 {
+			util.WriteSCIMErrorResponse(r.Context(), w, http.StatusBadRequest, "invalidValue", "invalid count")
+			return nil, 0, 0, false
+		}
+	}
+
+	return filter, startIndex, count, true
+}
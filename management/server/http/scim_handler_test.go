@@ -0,0 +1,186 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/http/middleware"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/scim"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+const (
+	testSCIMToken         = "nbs_valid"
+	testSCIMAccountID     = "test_account"
+	testSCIMServiceUserID = "scim_service_user"
+)
+
+func initSCIMTestRouter(t *testing.T) *mux.Router {
+	t.Helper()
+
+	users := map[string]*scim.User{
+		"alice": {Schemas: []string{scim.SchemaUser}, ID: "alice", UserName: "alice@example.com"},
+		"bob":   {Schemas: []string{scim.SchemaUser}, ID: "bob", UserName: "bob@example.com"},
+	}
+
+	accountManager := &mock_server.MockAccountManager{
+		GetAccountIDFromSCIMTokenFunc: func(_ context.Context, token string) (string, string, error) {
+			if token != testSCIMToken {
+				return "", "", status.Errorf(status.Unauthorized, "invalid SCIM token")
+			}
+			return testSCIMAccountID, testSCIMServiceUserID, nil
+		},
+		ListSCIMUsersFunc: func(_ context.Context, accountID string) ([]*scim.User, error) {
+			return []*scim.User{users["alice"], users["bob"]}, nil
+		},
+		GetSCIMUserFunc: func(_ context.Context, accountID, userID string) (*scim.User, error) {
+			user, ok := users[userID]
+			if !ok {
+				return nil, status.NewUserNotFoundError(userID)
+			}
+			copied := *user
+			return &copied, nil
+		},
+		SaveSCIMUserFunc: func(_ context.Context, accountID, initiatorUserID string, user *scim.User) (*scim.User, error) {
+			assert.Equal(t, testSCIMAccountID, accountID)
+			assert.Equal(t, testSCIMServiceUserID, initiatorUserID)
+			if user.UserName == "alice@example.com" && user.ID == "" {
+				return nil, status.Errorf(status.AlreadyExists, "user with userName %s already exists", user.UserName)
+			}
+			if user.ID == "" {
+				user.ID = user.ExternalID
+			}
+			return user, nil
+		},
+		DeleteSCIMUserFunc: func(_ context.Context, accountID, initiatorUserID, userID string) error {
+			return nil
+		},
+	}
+
+	router := mux.NewRouter()
+	router.Use(middleware.NewSCIMAuthMiddleware(accountManager.GetAccountIDFromSCIMToken).Handler)
+	addSCIMEndpoints(router, accountManager)
+
+	return router
+}
+
+func TestSCIMHandlers(t *testing.T) {
+	tt := []struct {
+		name           string
+		requestType    string
+		requestPath    string
+		requestBody    string
+		token          string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Missing token",
+			requestType:    http.MethodGet,
+			requestPath:    "/Users",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Invalid token",
+			requestType:    http.MethodGet,
+			requestPath:    "/Users",
+			token:          "nbs_invalid",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "List users with filter",
+			requestType:    http.MethodGet,
+			requestPath:    `/Users?filter=userName+eq+%22BOB@example.com%22`,
+			token:          testSCIMToken,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"totalResults":1`,
+		},
+		{
+			name:           "List users with invalid filter",
+			requestType:    http.MethodGet,
+			requestPath:    `/Users?filter=userName+sw+%22bob%22`,
+			token:          testSCIMToken,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `"scimType":"invalidFilter"`,
+		},
+		{
+			name:           "Get unknown user",
+			requestType:    http.MethodGet,
+			requestPath:    "/Users/carol",
+			token:          testSCIMToken,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Create user",
+			requestType:    http.MethodPost,
+			requestPath:    "/Users",
+			requestBody:    `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"externalId":"carol","userName":"carol@example.com"}`,
+			token:          testSCIMToken,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `"id":"carol"`,
+		},
+		{
+			name:           "Create duplicate user",
+			requestType:    http.MethodPost,
+			requestPath:    "/Users",
+			requestBody:    `{"userName":"alice@example.com"}`,
+			token:          testSCIMToken,
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `"scimType":"uniqueness"`,
+		},
+		{
+			name:           "Deactivate user",
+			requestType:    http.MethodPatch,
+			requestPath:    "/Users/bob",
+			requestBody:    `{"Operations":[{"op":"Replace","path":"active","value":"False"}]}`,
+			token:          testSCIMToken,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"active":false`,
+		},
+		{
+			name:           "Delete user",
+			requestType:    http.MethodDelete,
+			requestPath:    "/Users/bob",
+			token:          testSCIMToken,
+			expectedStatus: http.StatusNoContent,
+		},
+	}
+
+	router := initSCIMTestRouter(t)
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.requestType, tc.requestPath, bytes.NewBufferString(tc.requestBody))
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+
+			content, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			if tc.expectedStatus != http.StatusNoContent {
+				assert.Equal(t, scim.ContentType, res.Header.Get("Content-Type"))
+				assert.True(t, json.Valid(content), "response should be a valid JSON")
+			}
+			assert.Contains(t, string(content), tc.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/scim"
+)
+
+// SCIMTokenHandler is a handler that manages the SCIM provisioning token of the account
+type SCIMTokenHandler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+// NewSCIMTokenHandler creates a new SCIMTokenHandler
+func NewSCIMTokenHandler(accountManager server.AccountManager, authCfg AuthCfg) *SCIMTokenHandler {
+	return &SCIMTokenHandler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// GetSCIMToken handles the SCIM token Get request of the account
+func (h *SCIMTokenHandler) GetSCIMToken(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	token, err := h.accountManager.GetSCIMToken(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toSCIMTokenResponse(token))
+}
+
+// CreateSCIMToken handles the SCIM token creation request. An existing token of the account is replaced.
+func (h *SCIMTokenHandler) CreateSCIMToken(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	generated, err := h.accountManager.CreateSCIMToken(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, &api.ScimTokenGenerated{
+		PlainToken: generated.PlainToken,
+		ScimToken:  *toSCIMTokenResponse(&generated.Token),
+	})
+}
+
+// DeleteSCIMToken handles the SCIM token deletion request
+func (h *SCIMTokenHandler) DeleteSCIMToken(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	if err = h.accountManager.DeleteSCIMToken(r.Context(), accountID, userID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, emptyObject{})
+}
+
+func toSCIMTokenResponse(token *scim.Token) *api.ScimToken {
+	return &api.ScimToken{
+		Id:            token.ID,
+		CreatedAt:     token.CreatedAt,
+		CreatedBy:     token.CreatedBy,
+		ServiceUserId: token.ServiceUserID,
+	}
+}
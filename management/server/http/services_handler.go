@@ -0,0 +1,194 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	nbservice "github.com/netbirdio/netbird/management/server/service"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// ServicesHandler is a handler that manages the services catalog of the account
+type ServicesHandler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+// NewServicesHandler creates a new ServicesHandler
+func NewServicesHandler(accountManager server.AccountManager, authCfg AuthCfg) *ServicesHandler {
+	return &ServicesHandler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// GetAllServices returns the list of services for the account
+func (h *ServicesHandler) GetAllServices(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	services, err := h.accountManager.ListServices(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	resp := make([]*api.Service, 0, len(services))
+	for _, service := range services {
+		resp = append(resp, service.ToAPIResponse())
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
+// CreateService handles service creation request
+func (h *ServicesHandler) CreateService(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	h.saveService(w, r, accountID, userID, "")
+}
+
+// UpdateService handles update to a service identified by a given ID
+func (h *ServicesHandler) UpdateService(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	vars := mux.Vars(r)
+	serviceID := vars["serviceId"]
+	if len(serviceID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid service ID"), w)
+		return
+	}
+
+	h.saveService(w, r, accountID, userID, serviceID)
+}
+
+// GetService handles a service Get request identified by ID
+func (h *ServicesHandler) GetService(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	vars := mux.Vars(r)
+	serviceID := vars["serviceId"]
+	if len(serviceID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid service ID"), w)
+		return
+	}
+
+	service, err := h.accountManager.GetService(r.Context(), accountID, serviceID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, service.ToAPIResponse())
+}
+
+// DeleteService handles service deletion request
+func (h *ServicesHandler) DeleteService(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	vars := mux.Vars(r)
+	serviceID := vars["serviceId"]
+	if len(serviceID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid service ID"), w)
+		return
+	}
+
+	if err = h.accountManager.DeleteService(r.Context(), accountID, serviceID, userID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, emptyObject{})
+}
+
+// GetServicePolicies returns the policies that reference the service identified by ID
+func (h *ServicesHandler) GetServicePolicies(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	vars := mux.Vars(r)
+	serviceID := vars["serviceId"]
+	if len(serviceID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid service ID"), w)
+		return
+	}
+
+	policies, err := h.accountManager.GetServicePolicies(r.Context(), accountID, serviceID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	allGroups, err := h.accountManager.GetAllGroups(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	resp := make([]*api.Policy, 0, len(policies))
+	for _, policy := range policies {
+		resp = append(resp, toPolicyResponse(allGroups, policy))
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
+// saveService handles service create and update
+func (h *ServicesHandler) saveService(w http.ResponseWriter, r *http.Request, accountID, userID, serviceID string) {
+	var req api.PutApiServicesServiceIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	newService, err := nbservice.NewServiceFromAPIRequest(&req, serviceID)
+	if err != nil {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "%s", err.Error()), w)
+		return
+	}
+
+	service, err := h.accountManager.SaveService(r.Context(), accountID, userID, newService)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, service.ToAPIResponse())
+}
@@ -75,8 +75,15 @@ func (h *SetupKeysHandler) CreateSetupKey(w http.ResponseWriter, r *http.Request
 		ephemeral = *req.Ephemeral
 	}
 
-	setupKey, err := h.accountManager.CreateSetupKey(r.Context(), accountID, req.Name, server.SetupKeyType(req.Type), expiresIn,
-		req.AutoGroups, req.UsageLimit, userID, ephemeral)
+	opts := server.SetupKeyOptions{
+		Restrictions: toSetupKeyRestrictions(req.Restrictions),
+	}
+	if req.Tags != nil {
+		opts.Tags = *req.Tags
+	}
+
+	setupKey, err := h.accountManager.CreateSetupKeyWithOptions(r.Context(), accountID, req.Name, server.SetupKeyType(req.Type), expiresIn,
+		req.AutoGroups, req.UsageLimit, userID, ephemeral, opts)
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
 		return
@@ -146,8 +153,17 @@ func (h *SetupKeysHandler) UpdateSetupKey(w http.ResponseWriter, r *http.Request
 	newKey.AutoGroups = req.AutoGroups
 	newKey.Revoked = req.Revoked
 	newKey.Id = keyID
+	if req.Tags != nil {
+		newKey.Tags = *req.Tags
+	}
 
-	newKey, err = h.accountManager.SaveSetupKey(r.Context(), accountID, newKey, userID)
+	// keep the restrictions of the key when they are not provided, so updating a key never lifts them by accident
+	opts := server.SaveSetupKeyOptions{KeepRestrictions: req.Restrictions == nil}
+	if req.Restrictions != nil {
+		newKey.Restrictions = toSetupKeyRestrictions(req.Restrictions)
+	}
+
+	newKey, err = h.accountManager.SaveSetupKeyWithOptions(r.Context(), accountID, newKey, userID, opts)
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
 		return
@@ -226,19 +242,56 @@ func toResponseBody(key *server.SetupKey) *api.SetupKey {
 	}
 
 	return &api.SetupKey{
-		Id:         key.Id,
-		Key:        key.KeySecret,
-		Name:       key.Name,
-		Expires:    key.ExpiresAt,
-		Type:       string(key.Type),
-		Valid:      key.IsValid(),
-		Revoked:    key.Revoked,
-		UsedTimes:  key.UsedTimes,
-		LastUsed:   key.LastUsed,
-		State:      state,
-		AutoGroups: key.AutoGroups,
-		UpdatedAt:  key.UpdatedAt,
-		UsageLimit: key.UsageLimit,
-		Ephemeral:  key.Ephemeral,
+		Id:           key.Id,
+		Key:          key.KeySecret,
+		Name:         key.Name,
+		Expires:      key.ExpiresAt,
+		Type:         string(key.Type),
+		Valid:        key.IsValid(),
+		Revoked:      key.Revoked,
+		UsedTimes:    key.UsedTimes,
+		LastUsed:     key.LastUsed,
+		State:        state,
+		AutoGroups:   key.AutoGroups,
+		UpdatedAt:    key.UpdatedAt,
+		UsageLimit:   key.UsageLimit,
+		Ephemeral:    key.Ephemeral,
+		Tags:         key.Tags,
+		Restrictions: toSetupKeyRestrictionsResponse(key.Restrictions),
+	}
+}
+
+func toSetupKeyRestrictions(restrictions *api.SetupKeyRestrictions) server.SetupKeyRestrictions {
+	if restrictions == nil {
+		return server.SetupKeyRestrictions{}
+	}
+
+	var setupKeyRestrictions server.SetupKeyRestrictions
+	if restrictions.AllowedSourceRanges != nil {
+		setupKeyRestrictions.AllowedSourceRanges = *restrictions.AllowedSourceRanges
+	}
+	if restrictions.HostnamePattern != nil {
+		setupKeyRestrictions.HostnamePattern = *restrictions.HostnamePattern
+	}
+	if restrictions.AllowedOs != nil {
+		setupKeyRestrictions.AllowedOS = *restrictions.AllowedOs
+	}
+	if restrictions.PostureChecks != nil {
+		setupKeyRestrictions.PostureChecks = *restrictions.PostureChecks
+	}
+	return setupKeyRestrictions
+}
+
+func toSetupKeyRestrictionsResponse(restrictions server.SetupKeyRestrictions) api.SetupKeyRestrictions {
+	allowedSourceRanges := append([]string{}, restrictions.AllowedSourceRanges...)
+	hostnamePattern := restrictions.HostnamePattern
+	allowedOS := append([]string{}, restrictions.AllowedOS...)
+	postureChecks := append([]string{}, restrictions.PostureChecks...)
+
+	return api.SetupKeyRestrictions{
+		AllowedSourceRanges: &allowedSourceRanges,
+		HostnamePattern:     &hostnamePattern,
+		AllowedOs:           &allowedOS,
+		PostureChecks:       &postureChecks,
 	}
 }
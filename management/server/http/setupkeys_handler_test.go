@@ -13,6 +13,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/netbirdio/netbird/management/server"
 	"github.com/netbirdio/netbird/management/server/http/api"
@@ -239,3 +240,75 @@ func assertKeys(t *testing.T, got *api.SetupKey, expected *api.SetupKey) {
 	assert.ElementsMatch(t, got.AutoGroups, expected.AutoGroups)
 	assert.Equal(t, got.Ephemeral, expected.Ephemeral)
 }
+
+func TestSetupKeysHandlers_Options(t *testing.T) {
+	defaultSetupKey, _ := server.GenerateDefaultSetupKey()
+	defaultSetupKey.Id = existingSetupKeyID
+
+	newSetupKey, plainKey := server.GenerateSetupKey(newSetupKeyName, server.SetupKeyReusable, 0, []string{"group-1"},
+		server.SetupKeyUnlimitedUsage, true)
+	newSetupKey.Key = plainKey
+
+	handler := initSetupKeysTestMetaData(defaultSetupKey, newSetupKey, defaultSetupKey, server.NewAdminUser("test_user"))
+	mockManager := handler.accountManager.(*mock_server.MockAccountManager)
+
+	var createOpts server.SetupKeyOptions
+	mockManager.CreateSetupKeyWithOptionsFunc = func(_ context.Context, _ string, _ string, _ server.SetupKeyType, _ time.Duration, _ []string,
+		_ int, _ string, _ bool, opts server.SetupKeyOptions,
+	) (*server.SetupKey, error) {
+		createOpts = opts
+		nk := newSetupKey.Copy()
+		nk.Restrictions = opts.Restrictions
+		return nk, nil
+	}
+
+	var saveOpts server.SaveSetupKeyOptions
+	mockManager.SaveSetupKeyWithOptionsFunc = func(_ context.Context, _ string, key *server.SetupKey, _ string, opts server.SaveSetupKeyOptions) (*server.SetupKey, error) {
+		saveOpts = opts
+		return key, nil
+	}
+
+	router := mux.NewRouter()
+	router.HandleFunc("/api/setup-keys", handler.CreateSetupKey).Methods("POST")
+	router.HandleFunc("/api/setup-keys/{keyId}", handler.UpdateSetupKey).Methods("PUT")
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(method, path, bytes.NewBufferString(body)))
+		return recorder
+	}
+
+	t.Run("create with tags and restrictions", func(t *testing.T) {
+		recorder := serve(http.MethodPost, "/api/setup-keys", fmt.Sprintf(`{"name":"%s","type":"%s","expires_in":86400,
+			"tags":{"env":"prod"},
+			"restrictions":{"allowed_source_ranges":["203.0.113.0/24"],"hostname_pattern":"web-[0-9]+","allowed_os":["linux"]}}`,
+			newSetupKey.Name, newSetupKey.Type))
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+		expectedRestrictions := server.SetupKeyRestrictions{
+			AllowedSourceRanges: []string{"203.0.113.0/24"},
+			HostnamePattern:     "web-[0-9]+",
+			AllowedOS:           []string{"linux"},
+		}
+		assert.Equal(t, map[string]string{"env": "prod"}, createOpts.Tags)
+		assert.Equal(t, expectedRestrictions, createOpts.Restrictions)
+
+		got := &api.SetupKey{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), got))
+		require.NotNil(t, got.Restrictions)
+		assert.Equal(t, &expectedRestrictions.AllowedSourceRanges, got.Restrictions.AllowedSourceRanges)
+	})
+
+	t.Run("update without restrictions keeps them", func(t *testing.T) {
+		recorder := serve(http.MethodPut, "/api/setup-keys/"+defaultSetupKey.Id, `{"auto_groups":["group-1"],"revoked":false}`)
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+		assert.True(t, saveOpts.KeepRestrictions)
+	})
+
+	t.Run("update with restrictions replaces them", func(t *testing.T) {
+		recorder := serve(http.MethodPut, "/api/setup-keys/"+defaultSetupKey.Id,
+			`{"auto_groups":["group-1"],"revoked":false,"restrictions":{"allowed_os":["linux"]}}`)
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+		assert.False(t, saveOpts.KeepRestrictions)
+	})
+}
@@ -78,9 +78,15 @@ func (h *UsersHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	customRoleID := existingUser.CustomRoleID
+	if req.CustomRoleId != nil {
+		customRoleID = *req.CustomRoleId
+	}
+
 	newUser, err := h.accountManager.SaveUser(r.Context(), accountID, userID, &server.User{
 		Id:                   targetUserID,
 		Role:                 userRole,
+		CustomRoleID:         customRoleID,
 		AutoGroups:           req.AutoGroups,
 		Blocked:              req.IsBlocked,
 		Issued:               existingUser.Issued,
@@ -273,12 +279,18 @@ func toUserResponse(user *server.UserInfo, currenUserID string) *api.User {
 		userStatus = api.UserStatusBlocked
 	}
 
+	var customRoleID *string
+	if user.CustomRoleID != "" {
+		customRoleID = &user.CustomRoleID
+	}
+
 	isCurrent := user.ID == currenUserID
 	return &api.User{
 		Id:            user.ID,
 		Name:          user.Name,
 		Email:         user.Email,
 		Role:          user.Role,
+		CustomRoleId:  customRoleID,
 		AutoGroups:    autoGroups,
 		Status:        userStatus,
 		IsCurrent:     &isCurrent,
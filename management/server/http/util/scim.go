@@ -0,0 +1,61 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/scim"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// WriteSCIMResponse writes the SCIM resource to the HTTP response with the given status code
+func WriteSCIMResponse(ctx context.Context, w http.ResponseWriter, httpStatus int, obj any) {
+	w.Header().Set("Content-Type", scim.ContentType)
+	w.WriteHeader(httpStatus)
+	if err := json.NewEncoder(w).Encode(obj); err != nil {
+		log.WithContext(ctx).Errorf("failed to encode SCIM response: %s", err)
+	}
+}
+
+// WriteSCIMError converts an error to a SCIM error response
+func WriteSCIMError(ctx context.Context, err error, w http.ResponseWriter) {
+	log.WithContext(ctx).Errorf("got a SCIM handler error: %s", err.Error())
+
+	httpStatus := http.StatusInternalServerError
+	scimType := ""
+	detail := "internal server error"
+	if errStatus, ok := status.FromError(err); ok {
+		switch errStatus.Type() {
+		case status.AlreadyExists, status.UserAlreadyExists:
+			httpStatus = http.StatusConflict
+			scimType = "uniqueness"
+		case status.InvalidArgument, status.BadRequest:
+			httpStatus = http.StatusBadRequest
+			scimType = "invalidValue"
+		case status.NotFound:
+			httpStatus = http.StatusNotFound
+		case status.PermissionDenied, status.PreconditionFailed:
+			httpStatus = http.StatusForbidden
+		case status.Unauthorized:
+			httpStatus = http.StatusUnauthorized
+		default:
+		}
+		detail = errStatus.Message
+	}
+
+	WriteSCIMErrorResponse(ctx, w, httpStatus, scimType, detail)
+}
+
+// WriteSCIMErrorResponse writes a SCIM error response
+func WriteSCIMErrorResponse(ctx context.Context, w http.ResponseWriter, httpStatus int, scimType, detail string) {
+	WriteSCIMResponse(ctx, w, httpStatus, &scim.Error{
+		Schemas:  []string{scim.SchemaError},
+		Status:   strconv.Itoa(httpStatus),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
@@ -0,0 +1,152 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/http/api"
+	"github.com/netbirdio/netbird/management/server/http/util"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/webhook"
+)
+
+// WebhooksHandler is a handler that manages the webhook endpoints of the account
+type WebhooksHandler struct {
+	accountManager  server.AccountManager
+	claimsExtractor *jwtclaims.ClaimsExtractor
+}
+
+// NewWebhooksHandler creates a new WebhooksHandler
+func NewWebhooksHandler(accountManager server.AccountManager, authCfg AuthCfg) *WebhooksHandler {
+	return &WebhooksHandler{
+		accountManager: accountManager,
+		claimsExtractor: jwtclaims.NewClaimsExtractor(
+			jwtclaims.WithAudience(authCfg.Audience),
+			jwtclaims.WithUserIDClaim(authCfg.UserIDClaim),
+		),
+	}
+}
+
+// GetAllWebhooks list for the account
+func (h *WebhooksHandler) GetAllWebhooks(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	endpoints, err := h.accountManager.ListWebhookEndpoints(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	resp := make([]*api.WebhookEndpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		resp = append(resp, endpoint.ToAPIResponse())
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
+// CreateWebhook handles webhook endpoint creation request
+func (h *WebhooksHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	h.saveWebhook(w, r, accountID, userID, "")
+}
+
+// UpdateWebhook handles update to a webhook endpoint identified by a given ID
+func (h *WebhooksHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	vars := mux.Vars(r)
+	endpointID := vars["webhookId"]
+	if len(endpointID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid webhook ID"), w)
+		return
+	}
+
+	h.saveWebhook(w, r, accountID, userID, endpointID)
+}
+
+// GetWebhook handles a webhook endpoint Get request identified by ID
+func (h *WebhooksHandler) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	vars := mux.Vars(r)
+	endpointID := vars["webhookId"]
+	if len(endpointID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid webhook ID"), w)
+		return
+	}
+
+	endpoint, err := h.accountManager.GetWebhookEndpoint(r.Context(), accountID, endpointID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, endpoint.ToAPIResponse())
+}
+
+// DeleteWebhook handles webhook endpoint deletion request
+func (h *WebhooksHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	claims := h.claimsExtractor.FromRequestContext(r)
+	accountID, userID, err := h.accountManager.GetAccountIDFromToken(r.Context(), claims)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	vars := mux.Vars(r)
+	endpointID := vars["webhookId"]
+	if len(endpointID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid webhook ID"), w)
+		return
+	}
+
+	if err = h.accountManager.DeleteWebhookEndpoint(r.Context(), accountID, endpointID, userID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, emptyObject{})
+}
+
+// saveWebhook handles webhook endpoint create and update
+func (h *WebhooksHandler) saveWebhook(w http.ResponseWriter, r *http.Request, accountID, userID, endpointID string) {
+	var req api.PutApiWebhooksWebhookIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	endpoint, err := h.accountManager.SaveWebhookEndpoint(r.Context(), accountID, userID, webhook.NewEndpointFromAPIRequest(&req, endpointID))
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, endpoint.ToAPIResponse())
+}
@@ -27,6 +27,12 @@ type Manager interface {
 	DeleteUser(ctx context.Context, userID string) error
 }
 
+// UserGroupsManager is implemented by the IdP managers able to look up the group names of a user.
+// It's used for JWT groups sync when the tokens don't carry the groups claim, e.g. with LDAP directories.
+type UserGroupsManager interface {
+	GetUserGroups(ctx context.Context, userID string) ([]string, error)
+}
+
 // ClientConfig defines common client configuration for all IdP manager
 type ClientConfig struct {
 	Issuer        string
@@ -182,6 +188,24 @@ func NewManager(ctx context.Context, config Config, appMetrics telemetry.AppMetr
 			APIToken: config.ExtraConfig["ApiToken"],
 		}
 		return NewJumpCloudManager(jumpcloudConfig, appMetrics)
+	case "ldap":
+		ldapConfig := LDAPClientConfig{
+			URL:                  config.ExtraConfig["Url"],
+			BindDN:               config.ExtraConfig["BindDn"],
+			BindPassword:         config.ExtraConfig["BindPassword"],
+			StartTLS:             strings.EqualFold(config.ExtraConfig["StartTls"], "true"),
+			InsecureSkipVerify:   strings.EqualFold(config.ExtraConfig["InsecureSkipVerify"], "true"),
+			UserSearchBase:       config.ExtraConfig["UserSearchBase"],
+			UserFilter:           config.ExtraConfig["UserFilter"],
+			UserIDAttribute:      config.ExtraConfig["UserIdAttribute"],
+			UserNameAttribute:    config.ExtraConfig["UserNameAttribute"],
+			UserEmailAttribute:   config.ExtraConfig["UserEmailAttribute"],
+			GroupSearchBase:      config.ExtraConfig["GroupSearchBase"],
+			GroupFilter:          config.ExtraConfig["GroupFilter"],
+			GroupNameAttribute:   config.ExtraConfig["GroupNameAttribute"],
+			GroupMemberAttribute: config.ExtraConfig["GroupMemberAttribute"],
+		}
+		return NewLDAPManager(ldapConfig, appMetrics)
 	default:
 		return nil, fmt.Errorf("invalid manager type: %s", config.ManagerType)
 	}
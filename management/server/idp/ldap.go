@@ -0,0 +1,410 @@
+package idp
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/netbirdio/netbird/management/server/telemetry"
+)
+
+const (
+	ldapTimeout  = 10 * time.Second
+	ldapPageSize = 500
+
+	defaultLDAPUserFilter           = "(objectClass=inetOrgPerson)"
+	defaultLDAPUserIDAttribute      = "uid"
+	defaultLDAPUserNameAttribute    = "cn"
+	defaultLDAPUserEmailAttribute   = "mail"
+	defaultLDAPGroupFilter          = "(objectClass=groupOfNames)"
+	defaultLDAPGroupNameAttribute   = "cn"
+	defaultLDAPGroupMemberAttribute = "member"
+
+	// posixGroupMemberAttribute holds the member user IDs instead of their DNs
+	posixGroupMemberAttribute = "memberUid"
+)
+
+// LDAPManager LDAP directory manager client instance.
+// It's suited for self-hosted directories like OpenLDAP or FreeIPA that don't provide a user management API.
+type LDAPManager struct {
+	clientConfig LDAPClientConfig
+	tlsConfig    *tls.Config
+	appMetrics   telemetry.AppMetrics
+
+	// conn is the bound connection shared by the directory lookups, opened on first use
+	conn   *ldap.Conn
+	connMu sync.Mutex
+}
+
+// LDAPClientConfig LDAP manager client configurations.
+type LDAPClientConfig struct {
+	// URL of the directory server, e.g. ldaps://ipa.example.com:636
+	URL string
+	// BindDN and BindPassword are the credentials of the directory lookups. Anonymous bind is used when BindDN is empty.
+	BindDN       string
+	BindPassword string
+	// StartTLS upgrades plain ldap:// connections to TLS
+	StartTLS           bool
+	InsecureSkipVerify bool
+
+	UserSearchBase string
+	UserFilter     string
+	// UserIDAttribute has to match the user ID claim of the tokens issued by the OIDC provider
+	UserIDAttribute    string
+	UserNameAttribute  string
+	UserEmailAttribute string
+
+	// GroupSearchBase defaults to UserSearchBase
+	GroupSearchBase    string
+	GroupFilter        string
+	GroupNameAttribute string
+	// GroupMemberAttribute holds either the member DNs (e.g. member) or the member user IDs (memberUid)
+	GroupMemberAttribute string
+}
+
+// NewLDAPManager creates a new instance of the LDAPManager.
+func NewLDAPManager(config LDAPClientConfig, appMetrics telemetry.AppMetrics) (*LDAPManager, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("ldap IdP configuration is incomplete, Url is missing")
+	}
+
+	if config.UserSearchBase == "" {
+		return nil, fmt.Errorf("ldap IdP configuration is incomplete, UserSearchBase is missing")
+	}
+
+	serverURL, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("ldap IdP configuration is invalid, failed to parse Url: %w", err)
+	}
+
+	setDefault(&config.UserFilter, defaultLDAPUserFilter)
+	setDefault(&config.UserIDAttribute, defaultLDAPUserIDAttribute)
+	setDefault(&config.UserNameAttribute, defaultLDAPUserNameAttribute)
+	setDefault(&config.UserEmailAttribute, defaultLDAPUserEmailAttribute)
+	setDefault(&config.GroupSearchBase, config.UserSearchBase)
+	setDefault(&config.GroupFilter, defaultLDAPGroupFilter)
+	setDefault(&config.GroupNameAttribute, defaultLDAPGroupNameAttribute)
+	setDefault(&config.GroupMemberAttribute, defaultLDAPGroupMemberAttribute)
+
+	return &LDAPManager{
+		clientConfig: config,
+		tlsConfig: &tls.Config{
+			ServerName:         serverURL.Hostname(),
+			InsecureSkipVerify: config.InsecureSkipVerify, //nolint:gosec
+		},
+		appMetrics: appMetrics,
+	}, nil
+}
+
+// connect opens a connection to the directory server bound with the configured credentials
+func (lm *LDAPManager) connect() (*ldap.Conn, error) {
+	conn, err := ldap.DialURL(
+		lm.clientConfig.URL,
+		ldap.DialWithDialer(&net.Dialer{Timeout: ldapTimeout}),
+		ldap.DialWithTLSConfig(lm.tlsConfig),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to the ldap server: %w", err)
+	}
+	conn.SetTimeout(ldapTimeout)
+
+	if lm.clientConfig.StartTLS {
+		if err = conn.StartTLS(lm.tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("unable to start tls: %w", err)
+		}
+	}
+
+	if lm.clientConfig.BindDN != "" {
+		if err = conn.Bind(lm.clientConfig.BindDN, lm.clientConfig.BindPassword); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("unable to bind to the ldap server: %w", err)
+		}
+	}
+
+	return conn, nil
+}
+
+// getConn returns the bound connection to the directory server, a new one is opened if it's missing or closed
+func (lm *LDAPManager) getConn() (*ldap.Conn, error) {
+	lm.connMu.Lock()
+	defer lm.connMu.Unlock()
+
+	if lm.conn != nil && !lm.conn.IsClosing() {
+		return lm.conn, nil
+	}
+
+	conn, err := lm.connect()
+	if err != nil {
+		return nil, err
+	}
+	lm.conn = conn
+
+	return conn, nil
+}
+
+// dropConn closes the connection if it's still the shared one, so the next lookup opens a new connection
+func (lm *LDAPManager) dropConn(conn *ldap.Conn) {
+	lm.connMu.Lock()
+	defer lm.connMu.Unlock()
+
+	if lm.conn == conn {
+		lm.conn = nil
+	}
+	conn.Close()
+}
+
+// search returns the entries under the search base matching the filter.
+// A search failing because the connection broke, e.g. when the server closed the idle connection, is retried once.
+func (lm *LDAPManager) search(baseDN, filter string, attributes []string) ([]*ldap.Entry, error) {
+	var result *ldap.SearchResult
+	for attempt := 0; attempt < 2; attempt++ {
+		conn, err := lm.getConn()
+		if err != nil {
+			if lm.appMetrics != nil {
+				lm.appMetrics.IDPMetrics().CountRequestError()
+			}
+			return nil, err
+		}
+
+		// the request is created for each attempt as it holds the paging state
+		request := ldap.NewSearchRequest(
+			baseDN,
+			ldap.ScopeWholeSubtree,
+			ldap.NeverDerefAliases,
+			0,
+			0,
+			false,
+			filter,
+			attributes,
+			nil,
+		)
+
+		result, err = conn.SearchWithPaging(request, ldapPageSize)
+		if err == nil {
+			break
+		}
+
+		if conn.IsClosing() || ldap.IsErrorWithCode(err, ldap.ErrorNetwork) {
+			lm.dropConn(conn)
+			if attempt == 0 {
+				continue
+			}
+		}
+
+		if lm.appMetrics != nil {
+			lm.appMetrics.IDPMetrics().CountRequestStatusError()
+		}
+		return nil, fmt.Errorf("unable to search %s: %w", baseDN, err)
+	}
+
+	return result.Entries, nil
+}
+
+// searchUsers returns the user entries matching the configured user filter combined with the given one
+func (lm *LDAPManager) searchUsers(filter string) ([]*ldap.Entry, error) {
+	if filter != "" {
+		filter = fmt.Sprintf("(&%s%s)", lm.clientConfig.UserFilter, filter)
+	} else {
+		filter = lm.clientConfig.UserFilter
+	}
+
+	attributes := []string{
+		lm.clientConfig.UserIDAttribute,
+		lm.clientConfig.UserNameAttribute,
+		lm.clientConfig.UserEmailAttribute,
+	}
+
+	return lm.search(lm.clientConfig.UserSearchBase, filter, attributes)
+}
+
+// getUserEntry returns the directory entry of the user with the given ID
+func (lm *LDAPManager) getUserEntry(userID string) (*ldap.Entry, error) {
+	entries, err := lm.searchUsers(equalityFilter(lm.clientConfig.UserIDAttribute, userID))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("unable to get user %s, user not found", userID)
+	}
+
+	return entries[0], nil
+}
+
+// getAllUsers returns all users found under the user search base
+func (lm *LDAPManager) getAllUsers() ([]*UserData, error) {
+	entries, err := lm.searchUsers("")
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*UserData, 0, len(entries))
+	for _, entry := range entries {
+		if userData := lm.parseLDAPUser(entry); userData != nil {
+			users = append(users, userData)
+		}
+	}
+
+	return users, nil
+}
+
+// UpdateUserAppMetadata updates user app metadata based on userID and metadata map.
+// The directory isn't modified, the account of the users is kept by the management service.
+func (lm *LDAPManager) UpdateUserAppMetadata(_ context.Context, _ string, _ AppMetadata) error {
+	return nil
+}
+
+// GetUserDataByID requests user data from the directory via ID.
+func (lm *LDAPManager) GetUserDataByID(_ context.Context, userID string, appMetadata AppMetadata) (*UserData, error) {
+	entry, err := lm.getUserEntry(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if lm.appMetrics != nil {
+		lm.appMetrics.IDPMetrics().CountGetUserDataByID()
+	}
+
+	userData := lm.parseLDAPUser(entry)
+	if userData == nil {
+		return nil, fmt.Errorf("unable to get user %s, %s attribute is missing", userID, lm.clientConfig.UserIDAttribute)
+	}
+	userData.AppMetadata = appMetadata
+
+	return userData, nil
+}
+
+// GetAccount returns all the users for a given profile.
+func (lm *LDAPManager) GetAccount(_ context.Context, accountID string) ([]*UserData, error) {
+	users, err := lm.getAllUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	if lm.appMetrics != nil {
+		lm.appMetrics.IDPMetrics().CountGetAccount()
+	}
+
+	for _, user := range users {
+		user.AppMetadata.WTAccountID = accountID
+	}
+
+	return users, nil
+}
+
+// GetAllAccounts gets all registered accounts with corresponding user data.
+// It returns a list of users indexed by accountID.
+func (lm *LDAPManager) GetAllAccounts(_ context.Context) (map[string][]*UserData, error) {
+	users, err := lm.getAllUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	if lm.appMetrics != nil {
+		lm.appMetrics.IDPMetrics().CountGetAllAccounts()
+	}
+
+	indexedUsers := make(map[string][]*UserData)
+	indexedUsers[UnsetAccountID] = append(indexedUsers[UnsetAccountID], users...)
+
+	return indexedUsers, nil
+}
+
+// CreateUser creates a new user in the directory and sends an invitation.
+func (lm *LDAPManager) CreateUser(_ context.Context, _, _, _, _ string) (*UserData, error) {
+	return nil, fmt.Errorf("method CreateUser not implemented")
+}
+
+// GetUserByEmail searches users with a given email.
+// If no users have been found, this function returns an empty list.
+func (lm *LDAPManager) GetUserByEmail(_ context.Context, email string) ([]*UserData, error) {
+	entries, err := lm.searchUsers(equalityFilter(lm.clientConfig.UserEmailAttribute, email))
+	if err != nil {
+		return nil, err
+	}
+
+	if lm.appMetrics != nil {
+		lm.appMetrics.IDPMetrics().CountGetUserByEmail()
+	}
+
+	users := make([]*UserData, 0)
+	for _, entry := range entries {
+		if userData := lm.parseLDAPUser(entry); userData != nil {
+			users = append(users, userData)
+		}
+	}
+
+	return users, nil
+}
+
+// GetUserGroups returns the names of the directory groups the user with the given ID is a member of.
+func (lm *LDAPManager) GetUserGroups(_ context.Context, userID string) ([]string, error) {
+	memberValue := userID
+	if !strings.EqualFold(lm.clientConfig.GroupMemberAttribute, posixGroupMemberAttribute) {
+		entry, err := lm.getUserEntry(userID)
+		if err != nil {
+			return nil, err
+		}
+		memberValue = entry.DN
+	}
+
+	filter := fmt.Sprintf("(&%s%s)", lm.clientConfig.GroupFilter, equalityFilter(lm.clientConfig.GroupMemberAttribute, memberValue))
+	entries, err := lm.search(lm.clientConfig.GroupSearchBase, filter, []string{lm.clientConfig.GroupNameAttribute})
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if name := entry.GetEqualFoldAttributeValue(lm.clientConfig.GroupNameAttribute); name != "" {
+			groups = append(groups, name)
+		}
+	}
+
+	return groups, nil
+}
+
+// InviteUserByID resend invitations to users who haven't activated,
+// their accounts prior to the expiration period.
+func (lm *LDAPManager) InviteUserByID(_ context.Context, _ string) error {
+	return fmt.Errorf("method InviteUserByID not implemented")
+}
+
+// DeleteUser isn't supported, the directory entries are managed by the directory administrators.
+func (lm *LDAPManager) DeleteUser(_ context.Context, _ string) error {
+	return fmt.Errorf("method DeleteUser not implemented")
+}
+
+// parseLDAPUser parse the directory user entry to UserData. Entries without the user ID attribute are ignored.
+func (lm *LDAPManager) parseLDAPUser(entry *ldap.Entry) *UserData {
+	userID := entry.GetEqualFoldAttributeValue(lm.clientConfig.UserIDAttribute)
+	if userID == "" {
+		return nil
+	}
+
+	return &UserData{
+		Email: entry.GetEqualFoldAttributeValue(lm.clientConfig.UserEmailAttribute),
+		Name:  entry.GetEqualFoldAttributeValue(lm.clientConfig.UserNameAttribute),
+		ID:    userID,
+	}
+}
+
+// equalityFilter returns an equality filter with the value escaped
+func equalityFilter(attribute, value string) string {
+	return fmt.Sprintf("(%s=%s)", attribute, ldap.EscapeFilter(value))
+}
+
+// setDefault sets the value to the default one if it's empty
+func setDefault(value *string, defaultValue string) {
+	if *value == "" {
+		*value = defaultValue
+	}
+}
@@ -0,0 +1,503 @@
+package idp
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/telemetry"
+)
+
+const (
+	testLDAPBindDN       = "cn=admin,dc=example,dc=com"
+	testLDAPBindPassword = "secret"
+)
+
+// testLDAPEntry is a directory entry of the in-process LDAP server
+type testLDAPEntry struct {
+	dn         string
+	attributes map[string][]string
+}
+
+// testLDAPServer is a minimal in-process LDAP server supporting simple bind and search operations
+type testLDAPServer struct {
+	listener net.Listener
+	mu       sync.Mutex
+	entries  []*testLDAPEntry
+	// conns are the accepted client connections
+	conns []net.Conn
+}
+
+func newTestLDAPServer(t *testing.T, entries []*testLDAPEntry) *testLDAPServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &testLDAPServer{listener: listener, entries: entries}
+	go server.serve()
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	return server
+}
+
+func (s *testLDAPServer) url() string {
+	return "ldap://" + s.listener.Addr().String()
+}
+
+func (s *testLDAPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		s.conns = append(s.conns, conn)
+		s.mu.Unlock()
+
+		go s.handle(conn)
+	}
+}
+
+func (s *testLDAPServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+
+		messageID := packet.Children[0].Value.(int64)
+		request := packet.Children[1]
+
+		switch request.Tag {
+		case ldap.ApplicationBindRequest:
+			bindDN := request.Children[1].Value.(string)
+			password := request.Children[2].Data.String()
+			resultCode := uint16(ldap.LDAPResultSuccess)
+			if bindDN != testLDAPBindDN || password != testLDAPBindPassword {
+				resultCode = ldap.LDAPResultInvalidCredentials
+			}
+			s.writeResult(conn, messageID, ldap.ApplicationBindResponse, resultCode)
+		case ldap.ApplicationSearchRequest:
+			baseDN := request.Children[0].Value.(string)
+			filter := request.Children[6]
+			for _, entry := range s.search(baseDN, filter) {
+				s.writeEntry(conn, messageID, entry)
+			}
+			s.writeResult(conn, messageID, ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess)
+		case ldap.ApplicationUnbindRequest:
+			return
+		default:
+			s.writeResult(conn, messageID, request.Tag+1, ldap.LDAPResultUnwillingToPerform)
+		}
+	}
+}
+
+func (s *testLDAPServer) search(baseDN string, filter *ber.Packet) []*testLDAPEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var entries []*testLDAPEntry
+	for _, entry := range s.entries {
+		if strings.HasSuffix(strings.ToLower(entry.dn), strings.ToLower(baseDN)) && entry.matches(filter) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// connections returns the number of accepted client connections
+func (s *testLDAPServer) connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.conns)
+}
+
+// closeConnections closes the client connections like a server dropping idle connections
+func (s *testLDAPServer) closeConnections() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, conn := range s.conns {
+		_ = conn.Close()
+	}
+}
+
+func (s *testLDAPServer) writeEntry(conn net.Conn, messageID int64, entry *testLDAPEntry) {
+	response := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	response.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.dn, "DN"))
+
+	attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for name, values := range entry.attributes {
+		attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, value := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+		}
+		attribute.AppendChild(set)
+		attributes.AppendChild(attribute)
+	}
+	response.AppendChild(attributes)
+
+	s.write(conn, messageID, response)
+}
+
+func (s *testLDAPServer) writeResult(conn net.Conn, messageID int64, tag ber.Tag, resultCode uint16) {
+	response := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Response")
+	response.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(resultCode), "Result Code"))
+	response.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	response.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+
+	s.write(conn, messageID, response)
+}
+
+func (s *testLDAPServer) write(conn net.Conn, messageID int64, response *ber.Packet) {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "Message ID"))
+	packet.AppendChild(response)
+
+	_, _ = conn.Write(packet.Bytes())
+}
+
+// matches evaluates the and, or, not, equality, substrings and present filters against the entry
+func (e *testLDAPEntry) matches(filter *ber.Packet) bool {
+	switch filter.Tag {
+	case ldap.FilterAnd:
+		for _, child := range filter.Children {
+			if !e.matches(child) {
+				return false
+			}
+		}
+		return true
+	case ldap.FilterOr:
+		for _, child := range filter.Children {
+			if e.matches(child) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterNot:
+		return !e.matches(filter.Children[0])
+	case ldap.FilterEqualityMatch:
+		for _, value := range e.values(filter.Children[0].Data.String()) {
+			if strings.EqualFold(value, filter.Children[1].Data.String()) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterSubstrings:
+		for _, value := range e.values(filter.Children[0].Data.String()) {
+			if matchesSubstrings(strings.ToLower(value), filter.Children[1].Children) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterPresent:
+		return len(e.values(filter.Data.String())) > 0
+	default:
+		return false
+	}
+}
+
+func (e *testLDAPEntry) values(attribute string) []string {
+	for name, values := range e.attributes {
+		if strings.EqualFold(name, attribute) {
+			return values
+		}
+	}
+	return nil
+}
+
+func matchesSubstrings(value string, substrings []*ber.Packet) bool {
+	for _, substring := range substrings {
+		part := strings.ToLower(substring.Data.String())
+		switch substring.Tag {
+		case ldap.FilterSubstringsInitial:
+			if !strings.HasPrefix(value, part) {
+				return false
+			}
+			value = value[len(part):]
+		case ldap.FilterSubstringsAny:
+			index := strings.Index(value, part)
+			if index < 0 {
+				return false
+			}
+			value = value[index+len(part):]
+		case ldap.FilterSubstringsFinal:
+			if !strings.HasSuffix(value, part) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func testLDAPDirectory() []*testLDAPEntry {
+	return []*testLDAPEntry{
+		{
+			dn: "uid=alice,ou=people,dc=example,dc=com",
+			attributes: map[string][]string{
+				"objectClass": {"inetOrgPerson"},
+				"uid":         {"alice"},
+				"cn":          {"Alice Smith"},
+				"mail":        {"alice@example.com"},
+			},
+		},
+		{
+			dn: "uid=bob,ou=people,dc=example,dc=com",
+			attributes: map[string][]string{
+				"objectClass": {"inetOrgPerson"},
+				"uid":         {"bob"},
+				"cn":          {"Bob Jones"},
+				"mail":        {"bob@example.com"},
+			},
+		},
+		{
+			dn: "cn=printer,ou=devices,dc=example,dc=com",
+			attributes: map[string][]string{
+				"objectClass": {"device"},
+				"cn":          {"printer"},
+			},
+		},
+		{
+			dn: "cn=admins,ou=groups,dc=example,dc=com",
+			attributes: map[string][]string{
+				"objectClass": {"groupOfNames"},
+				"cn":          {"admins"},
+				"member":      {"uid=alice,ou=people,dc=example,dc=com"},
+			},
+		},
+		{
+			dn: "cn=developers,ou=groups,dc=example,dc=com",
+			attributes: map[string][]string{
+				"objectClass": {"groupOfNames"},
+				"cn":          {"developers"},
+				"member":      {"uid=alice,ou=people,dc=example,dc=com", "uid=bob,ou=people,dc=example,dc=com"},
+			},
+		},
+		{
+			dn: "cn=ops,ou=posix,dc=example,dc=com",
+			attributes: map[string][]string{
+				"objectClass": {"posixGroup"},
+				"cn":          {"ops"},
+				"memberUid":   {"bob"},
+			},
+		},
+	}
+}
+
+var _ UserGroupsManager = (*LDAPManager)(nil)
+
+func newTestLDAPManager(t *testing.T, config LDAPClientConfig) (*LDAPManager, *testLDAPServer) {
+	t.Helper()
+
+	server := newTestLDAPServer(t, testLDAPDirectory())
+
+	config.URL = server.url()
+	if config.BindDN == "" {
+		config.BindDN = testLDAPBindDN
+		config.BindPassword = testLDAPBindPassword
+	}
+	if config.UserSearchBase == "" {
+		config.UserSearchBase = "ou=people,dc=example,dc=com"
+	}
+
+	manager, err := NewLDAPManager(config, nil)
+	require.NoError(t, err)
+
+	return manager, server
+}
+
+func TestNewLDAPManager(t *testing.T) {
+	type test struct {
+		name                 string
+		inputConfig          LDAPClientConfig
+		assertErrFunc        require.ErrorAssertionFunc
+		assertErrFuncMessage string
+	}
+
+	defaultTestConfig := LDAPClientConfig{
+		URL:            "ldaps://ipa.example.com",
+		UserSearchBase: "cn=users,cn=accounts,dc=example,dc=com",
+	}
+
+	testCase1 := test{
+		name:                 "Good Configuration",
+		inputConfig:          defaultTestConfig,
+		assertErrFunc:        require.NoError,
+		assertErrFuncMessage: "shouldn't return error",
+	}
+
+	testCase2Config := defaultTestConfig
+	testCase2Config.URL = ""
+
+	testCase2 := test{
+		name:                 "Missing URL Configuration",
+		inputConfig:          testCase2Config,
+		assertErrFunc:        require.Error,
+		assertErrFuncMessage: "should return error when field empty",
+	}
+
+	testCase3Config := defaultTestConfig
+	testCase3Config.UserSearchBase = ""
+
+	testCase3 := test{
+		name:                 "Missing UserSearchBase Configuration",
+		inputConfig:          testCase3Config,
+		assertErrFunc:        require.Error,
+		assertErrFuncMessage: "should return error when field empty",
+	}
+
+	for _, testCase := range []test{testCase1, testCase2, testCase3} {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := NewLDAPManager(testCase.inputConfig, &telemetry.MockAppMetrics{})
+			testCase.assertErrFunc(t, err, testCase.assertErrFuncMessage)
+		})
+	}
+}
+
+func TestLDAPManager_GetUserDataByID(t *testing.T) {
+	manager, _ := newTestLDAPManager(t, LDAPClientConfig{})
+
+	appMetadata := AppMetadata{WTAccountID: "account1"}
+	userData, err := manager.GetUserDataByID(context.Background(), "alice", appMetadata)
+	require.NoError(t, err)
+	assert.Equal(t, &UserData{
+		Email:       "alice@example.com",
+		Name:        "Alice Smith",
+		ID:          "alice",
+		AppMetadata: appMetadata,
+	}, userData)
+
+	_, err = manager.GetUserDataByID(context.Background(), "carol", appMetadata)
+	assert.Error(t, err, "should return error when the user doesn't exist")
+
+	_, err = manager.GetUserDataByID(context.Background(), "*", appMetadata)
+	assert.Error(t, err, "should escape the filter value")
+}
+
+func TestLDAPManager_GetAccount(t *testing.T) {
+	manager, _ := newTestLDAPManager(t, LDAPClientConfig{})
+
+	users, err := manager.GetAccount(context.Background(), "account1")
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	for _, user := range users {
+		assert.Equal(t, "account1", user.AppMetadata.WTAccountID)
+	}
+
+	accounts, err := manager.GetAllAccounts(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, accounts[UnsetAccountID], 2, "users should be indexed by the unset account ID")
+}
+
+func TestLDAPManager_UserFilter(t *testing.T) {
+	manager, _ := newTestLDAPManager(t, LDAPClientConfig{
+		UserSearchBase: "dc=example,dc=com",
+		UserFilter:     "(&(objectClass=inetOrgPerson)(mail=*@example.com)(!(uid=bob)))",
+	})
+
+	users, err := manager.GetAccount(context.Background(), "account1")
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, "alice", users[0].ID)
+}
+
+func TestLDAPManager_GetUserByEmail(t *testing.T) {
+	manager, _ := newTestLDAPManager(t, LDAPClientConfig{})
+
+	users, err := manager.GetUserByEmail(context.Background(), "BOB@example.com")
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, "bob", users[0].ID)
+
+	users, err = manager.GetUserByEmail(context.Background(), "carol@example.com")
+	require.NoError(t, err)
+	assert.Empty(t, users)
+}
+
+func TestLDAPManager_GetUserGroups(t *testing.T) {
+	testCases := []struct {
+		name           string
+		config         LDAPClientConfig
+		userID         string
+		expectedGroups []string
+	}{
+		{
+			name:           "Member DN",
+			config:         LDAPClientConfig{GroupSearchBase: "ou=groups,dc=example,dc=com"},
+			userID:         "alice",
+			expectedGroups: []string{"admins", "developers"},
+		},
+		{
+			name: "Member UID",
+			config: LDAPClientConfig{
+				GroupSearchBase:      "dc=example,dc=com",
+				GroupFilter:          "(objectClass=posixGroup)",
+				GroupMemberAttribute: "memberUid",
+			},
+			userID:         "bob",
+			expectedGroups: []string{"ops"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			manager, _ := newTestLDAPManager(t, testCase.config)
+
+			groups, err := manager.GetUserGroups(context.Background(), testCase.userID)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, testCase.expectedGroups, groups)
+		})
+	}
+}
+
+func TestLDAPManager_DeleteUser(t *testing.T) {
+	manager, _ := newTestLDAPManager(t, LDAPClientConfig{})
+
+	err := manager.DeleteUser(context.Background(), "bob")
+	assert.Error(t, err, "directory entries should not be deleted")
+
+	_, err = manager.GetUserDataByID(context.Background(), "bob", AppMetadata{})
+	assert.NoError(t, err, "user should still be found")
+}
+
+func TestLDAPManager_ReusesConnection(t *testing.T) {
+	manager, server := newTestLDAPManager(t, LDAPClientConfig{})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		_, err := manager.GetAccount(ctx, "account1")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, server.connections(), "lookups should share the bound connection")
+
+	server.closeConnections()
+
+	users, err := manager.GetAccount(ctx, "account1")
+	require.NoError(t, err, "lookup should reconnect when the connection was closed")
+	assert.Len(t, users, 2)
+	assert.Equal(t, 2, server.connections())
+}
+
+func TestLDAPManager_InvalidCredentials(t *testing.T) {
+	manager, _ := newTestLDAPManager(t, LDAPClientConfig{
+		BindDN:       testLDAPBindDN,
+		BindPassword: "wrong",
+	})
+
+	_, err := manager.GetAccount(context.Background(), "account1")
+	assert.Error(t, err, "should return error when the bind fails")
+}
@@ -73,6 +73,18 @@ func (am *DefaultAccountManager) GroupValidation(ctx context.Context, accountID
 	return true, nil
 }
 
+// GetValidatedPeers returns the peers validated by the integrated validator, excluding the peers pending approval.
 func (am *DefaultAccountManager) GetValidatedPeers(account *Account) (map[string]struct{}, error) {
-	return am.integratedPeerValidator.GetValidatedPeers(account.Id, account.Groups, account.Peers, account.Settings.Extra)
+	validatedPeers, err := am.integratedPeerValidator.GetValidatedPeers(account.Id, account.Groups, account.Peers, account.Settings.Extra)
+	if err != nil {
+		return nil, err
+	}
+
+	for peerID, peer := range account.Peers {
+		if isPeerPendingApproval(peer) {
+			delete(validatedPeers, peerID)
+		}
+	}
+
+	return validatedPeers, nil
 }
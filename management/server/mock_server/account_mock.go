@@ -12,12 +12,18 @@ import (
 	nbdns "github.com/netbirdio/netbird/dns"
 	"github.com/netbirdio/netbird/management/domain"
 	"github.com/netbirdio/netbird/management/server"
+	"github.com/netbirdio/netbird/management/server/accountconfig"
 	"github.com/netbirdio/netbird/management/server/activity"
 	"github.com/netbirdio/netbird/management/server/group"
 	"github.com/netbirdio/netbird/management/server/idp"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/organization"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/rbac"
+	"github.com/netbirdio/netbird/management/server/scim"
+	nbservice "github.com/netbirdio/netbird/management/server/service"
+	"github.com/netbirdio/netbird/management/server/webhook"
 	"github.com/netbirdio/netbird/route"
 )
 
@@ -26,6 +32,8 @@ type MockAccountManager struct {
 	GetAccountFunc               func(ctx context.Context, accountID string) (*server.Account, error)
 	CreateSetupKeyFunc           func(ctx context.Context, accountId string, keyName string, keyType server.SetupKeyType,
 		expiresIn time.Duration, autoGroups []string, usageLimit int, userID string, ephemeral bool) (*server.SetupKey, error)
+	CreateSetupKeyWithOptionsFunc func(ctx context.Context, accountId string, keyName string, keyType server.SetupKeyType,
+		expiresIn time.Duration, autoGroups []string, usageLimit int, userID string, ephemeral bool, opts server.SetupKeyOptions) (*server.SetupKey, error)
 	GetSetupKeyFunc                     func(ctx context.Context, accountID, userID, keyID string) (*server.SetupKey, error)
 	AccountExistsFunc                   func(ctx context.Context, accountID string) (bool, error)
 	GetAccountIDByUserIdFunc            func(ctx context.Context, userId, domain string) (string, error)
@@ -58,11 +66,13 @@ type MockAccountManager struct {
 	UpdatePeerMetaFunc                  func(ctx context.Context, peerID string, meta nbpeer.PeerSystemMeta) error
 	UpdatePeerFunc                      func(ctx context.Context, accountID, userID string, peer *nbpeer.Peer) (*nbpeer.Peer, error)
 	CreateRouteFunc                     func(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peer string, peerGroups []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool) (*route.Route, error)
+	CreateRouteWithOptionsFunc          func(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peer string, peerGroups []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool, opts server.CreateRouteOptions) (*route.Route, error)
 	GetRouteFunc                        func(ctx context.Context, accountID string, routeID route.ID, userID string) (*route.Route, error)
 	SaveRouteFunc                       func(ctx context.Context, accountID string, userID string, route *route.Route) error
 	DeleteRouteFunc                     func(ctx context.Context, accountID string, routeID route.ID, userID string) error
 	ListRoutesFunc                      func(ctx context.Context, accountID, userID string) ([]*route.Route, error)
 	SaveSetupKeyFunc                    func(ctx context.Context, accountID string, key *server.SetupKey, userID string) (*server.SetupKey, error)
+	SaveSetupKeyWithOptionsFunc         func(ctx context.Context, accountID string, key *server.SetupKey, userID string, opts server.SaveSetupKeyOptions) (*server.SetupKey, error)
 	ListSetupKeysFunc                   func(ctx context.Context, accountID, userID string) ([]*server.SetupKey, error)
 	SaveUserFunc                        func(ctx context.Context, accountID, userID string, user *server.User) (*server.UserInfo, error)
 	SaveOrAddUserFunc                   func(ctx context.Context, accountID, userID string, user *server.User, addIfNotExists bool) (*server.UserInfo, error)
@@ -84,7 +94,7 @@ type MockAccountManager struct {
 	DeleteAccountFunc                   func(ctx context.Context, accountID, userID string) error
 	GetDNSDomainFunc                    func() string
 	StoreEventFunc                      func(ctx context.Context, initiatorID, targetID, accountID string, activityID activity.ActivityDescriber, meta map[string]any)
-	GetEventsFunc                       func(ctx context.Context, accountID, userID string) ([]*activity.Event, error)
+	GetEventsFunc                       func(ctx context.Context, accountID, userID string, filter activity.Filter) ([]*activity.Event, error)
 	GetDNSSettingsFunc                  func(ctx context.Context, accountID, userID string) (*server.DNSSettings, error)
 	SaveDNSSettingsFunc                 func(ctx context.Context, accountID, userID string, dnsSettingsToSave *server.DNSSettings) error
 	GetPeerFunc                         func(ctx context.Context, accountID, peerID, userID string) (*nbpeer.Peer, error)
@@ -109,6 +119,62 @@ type MockAccountManager struct {
 	GetUserByIDFunc                     func(ctx context.Context, id string) (*server.User, error)
 	GetAccountSettingsFunc              func(ctx context.Context, accountID string, userID string) (*server.Settings, error)
 	DeleteSetupKeyFunc                  func(ctx context.Context, accountID, userID, keyID string) error
+	CreateAccessRequestFunc             func(ctx context.Context, accountID, userID string, groups []string, duration time.Duration, reason string) (*server.AccessRequest, error)
+	GetAccessRequestFunc                func(ctx context.Context, accountID, requestID, userID string) (*server.AccessRequest, error)
+	ListAccessRequestsFunc              func(ctx context.Context, accountID, userID string) ([]*server.AccessRequest, error)
+	ApproveAccessRequestFunc            func(ctx context.Context, accountID, requestID, userID string) (*server.AccessRequest, error)
+	DenyAccessRequestFunc               func(ctx context.Context, accountID, requestID, userID string) (*server.AccessRequest, error)
+	GetWebhookEndpointFunc              func(ctx context.Context, accountID, endpointID, userID string) (*webhook.Endpoint, error)
+	ListWebhookEndpointsFunc            func(ctx context.Context, accountID, userID string) ([]*webhook.Endpoint, error)
+	SaveWebhookEndpointFunc             func(ctx context.Context, accountID, userID string, endpoint *webhook.Endpoint) (*webhook.Endpoint, error)
+	DeleteWebhookEndpointFunc           func(ctx context.Context, accountID, endpointID, userID string) error
+	ExportAccountConfigFunc             func(ctx context.Context, accountID, userID string) (*accountconfig.Config, error)
+	PlanAccountConfigFunc               func(ctx context.Context, accountID, userID string, config *accountconfig.Config) ([]*accountconfig.Change, error)
+	ApplyAccountConfigFunc              func(ctx context.Context, accountID, userID string, config *accountconfig.Config) ([]*accountconfig.Change, error)
+	ExplainPeerConnectionFunc           func(ctx context.Context, accountID, peerID, userID string, req *server.PolicyExplainRequest) (*server.PolicyExplanation, error)
+	GetCustomRoleFunc                   func(ctx context.Context, accountID, roleID, userID string) (*rbac.Role, error)
+	ListCustomRolesFunc                 func(ctx context.Context, accountID, userID string) ([]*rbac.Role, error)
+	SaveCustomRoleFunc                  func(ctx context.Context, accountID, userID string, role *rbac.Role) (*rbac.Role, error)
+	DeleteCustomRoleFunc                func(ctx context.Context, accountID, roleID, userID string) error
+	GetUserCustomRoleFunc               func(ctx context.Context, user *server.User) (*rbac.Role, error)
+	CreateSCIMTokenFunc                 func(ctx context.Context, accountID, userID string) (*scim.TokenGenerated, error)
+	GetSCIMTokenFunc                    func(ctx context.Context, accountID, userID string) (*scim.Token, error)
+	DeleteSCIMTokenFunc                 func(ctx context.Context, accountID, userID string) error
+	GetAccountIDFromSCIMTokenFunc       func(ctx context.Context, token string) (string, string, error)
+	ListSCIMUsersFunc                   func(ctx context.Context, accountID string) ([]*scim.User, error)
+	GetSCIMUserFunc                     func(ctx context.Context, accountID, userID string) (*scim.User, error)
+	SaveSCIMUserFunc                    func(ctx context.Context, accountID, initiatorUserID string, user *scim.User) (*scim.User, error)
+	DeleteSCIMUserFunc                  func(ctx context.Context, accountID, initiatorUserID, userID string) error
+	ListSCIMGroupsFunc                  func(ctx context.Context, accountID string) ([]*scim.Group, error)
+	GetSCIMGroupFunc                    func(ctx context.Context, accountID, groupID string) (*scim.Group, error)
+	SaveSCIMGroupFunc                   func(ctx context.Context, accountID, initiatorUserID string, group *scim.Group) (*scim.Group, error)
+	DeleteSCIMGroupFunc                 func(ctx context.Context, accountID, initiatorUserID, groupID string) error
+	GetServiceFunc                      func(ctx context.Context, accountID, serviceID, userID string) (*nbservice.Service, error)
+	SaveServiceFunc                     func(ctx context.Context, accountID, userID string, service *nbservice.Service) (*nbservice.Service, error)
+	DeleteServiceFunc                   func(ctx context.Context, accountID, serviceID, userID string) error
+	ListServicesFunc                    func(ctx context.Context, accountID, userID string) ([]*nbservice.Service, error)
+	GetServicePoliciesFunc              func(ctx context.Context, accountID, serviceID, userID string) ([]*server.Policy, error)
+	GetPolicyAuditCountsFunc            func(ctx context.Context, accountID, policyID, userID string) ([]*server.PolicyAuditCount, error)
+	SyncPeerAuditCountsFunc             func(ctx context.Context, peerPubKey string, counts map[string]uint64) error
+	GetPeerPostureStatusFunc            func(ctx context.Context, accountID, peerID, userID string) (*nbpeer.PostureStatus, error)
+	GetPendingPeersFunc                 func(ctx context.Context, accountID, userID string) ([]*nbpeer.Peer, error)
+	ApprovePeersFunc                    func(ctx context.Context, accountID, userID string, peerIDs []string) error
+	RejectPeersFunc                     func(ctx context.Context, accountID, userID string, peerIDs []string) error
+	GetOrganizationsFunc                func(ctx context.Context, userID string) ([]*organization.Organization, error)
+	GetOrganizationFunc                 func(ctx context.Context, organizationID, userID string) (*organization.Organization, error)
+	CreateOrganizationFunc              func(ctx context.Context, accountID, userID, name string) (*organization.Organization, error)
+	UpdateOrganizationFunc              func(ctx context.Context, organizationID, userID, name string) (*organization.Organization, error)
+	DeleteOrganizationFunc              func(ctx context.Context, organizationID, userID string) error
+	GetOrganizationAccountsFunc         func(ctx context.Context, organizationID, userID string) ([]*organization.AccountSummary, error)
+	CreateOrganizationAccountFunc       func(ctx context.Context, organizationID, userID, domain string) (*organization.AccountSummary, error)
+	RemoveOrganizationAccountFunc       func(ctx context.Context, organizationID, accountID, userID string) error
+	GetOrganizationAdminsFunc           func(ctx context.Context, organizationID, userID string) ([]*organization.Admin, error)
+	AddOrganizationAdminFunc            func(ctx context.Context, organizationID, userID, adminUserID string) (*organization.Admin, error)
+	RemoveOrganizationAdminFunc         func(ctx context.Context, organizationID, userID, adminUserID string) error
+	GetOrganizationAccountClaimsFunc    func(ctx context.Context, userID, accountID string) (jwtclaims.AuthorizationClaims, error)
+	ApplyPeerBulkOperationsFunc         func(ctx context.Context, accountID, userID string, operations []*server.PeerBulkOperation) (*server.BulkResult, error)
+	ApplyGroupBulkOperationsFunc        func(ctx context.Context, accountID, userID string, operations []*server.GroupBulkOperation) (*server.BulkResult, error)
+	ApplyUserBulkOperationsFunc         func(ctx context.Context, accountID, userID string, operations []*server.UserBulkOperation) (*server.BulkResult, error)
 }
 
 func (am *MockAccountManager) DeleteSetupKey(ctx context.Context, accountID, userID, keyID string) error {
@@ -201,6 +267,29 @@ func (am *MockAccountManager) CreateSetupKey(
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSetupKey is not implemented")
 }
 
+// CreateSetupKeyWithOptions mock implementation of CreateSetupKeyWithOptions from server.AccountManager interface.
+// Without CreateSetupKeyWithOptionsFunc it falls back to CreateSetupKeyFunc, ignoring the options.
+func (am *MockAccountManager) CreateSetupKeyWithOptions(
+	ctx context.Context,
+	accountID string,
+	keyName string,
+	keyType server.SetupKeyType,
+	expiresIn time.Duration,
+	autoGroups []string,
+	usageLimit int,
+	userID string,
+	ephemeral bool,
+	opts server.SetupKeyOptions,
+) (*server.SetupKey, error) {
+	if am.CreateSetupKeyWithOptionsFunc != nil {
+		return am.CreateSetupKeyWithOptionsFunc(ctx, accountID, keyName, keyType, expiresIn, autoGroups, usageLimit, userID, ephemeral, opts)
+	}
+	if am.CreateSetupKeyFunc != nil {
+		return am.CreateSetupKeyFunc(ctx, accountID, keyName, keyType, expiresIn, autoGroups, usageLimit, userID, ephemeral)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSetupKeyWithOptions is not implemented")
+}
+
 // AccountExists mock implementation of AccountExists from server.AccountManager interface
 func (am *MockAccountManager) AccountExists(ctx context.Context, accountID string) (bool, error) {
 	if am.AccountExistsFunc != nil {
@@ -448,6 +537,18 @@ func (am *MockAccountManager) CreateRoute(ctx context.Context, accountID string,
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRoute is not implemented")
 }
 
+// CreateRouteWithOptions mock implementation of CreateRouteWithOptions from server.AccountManager interface.
+// Without CreateRouteWithOptionsFunc it falls back to CreateRouteFunc, ignoring the options.
+func (am *MockAccountManager) CreateRouteWithOptions(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupID []string, enabled bool, userID string, keepRoute bool, opts server.CreateRouteOptions) (*route.Route, error) {
+	if am.CreateRouteWithOptionsFunc != nil {
+		return am.CreateRouteWithOptionsFunc(ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, accessControlGroupID, enabled, userID, keepRoute, opts)
+	}
+	if am.CreateRouteFunc != nil {
+		return am.CreateRouteFunc(ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, accessControlGroupID, enabled, userID, keepRoute)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRouteWithOptions is not implemented")
+}
+
 // GetRoute mock implementation of GetRoute from server.AccountManager interface
 func (am *MockAccountManager) GetRoute(ctx context.Context, accountID string, routeID route.ID, userID string) (*route.Route, error) {
 	if am.GetRouteFunc != nil {
@@ -489,6 +590,19 @@ func (am *MockAccountManager) SaveSetupKey(ctx context.Context, accountID string
 	return nil, status.Errorf(codes.Unimplemented, "method SaveSetupKey is not implemented")
 }
 
+// SaveSetupKeyWithOptions mocks SaveSetupKeyWithOptions of the AccountManager interface.
+// Without SaveSetupKeyWithOptionsFunc it falls back to SaveSetupKeyFunc, ignoring the options.
+func (am *MockAccountManager) SaveSetupKeyWithOptions(ctx context.Context, accountID string, key *server.SetupKey, userID string, opts server.SaveSetupKeyOptions) (*server.SetupKey, error) {
+	if am.SaveSetupKeyWithOptionsFunc != nil {
+		return am.SaveSetupKeyWithOptionsFunc(ctx, accountID, key, userID, opts)
+	}
+	if am.SaveSetupKeyFunc != nil {
+		return am.SaveSetupKeyFunc(ctx, accountID, key, userID)
+	}
+
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSetupKeyWithOptions is not implemented")
+}
+
 // GetSetupKey mocks GetSetupKey of the AccountManager interface
 func (am *MockAccountManager) GetSetupKey(ctx context.Context, accountID, userID, keyID string) (*server.SetupKey, error) {
 	if am.GetSetupKeyFunc != nil {
@@ -634,9 +748,9 @@ func (am *MockAccountManager) GetDNSDomain() string {
 }
 
 // GetEvents mocks GetEvents of the AccountManager interface
-func (am *MockAccountManager) GetEvents(ctx context.Context, accountID, userID string) ([]*activity.Event, error) {
+func (am *MockAccountManager) GetEvents(ctx context.Context, accountID, userID string, filter activity.Filter) ([]*activity.Event, error) {
 	if am.GetEventsFunc != nil {
-		return am.GetEventsFunc(ctx, accountID, userID)
+		return am.GetEventsFunc(ctx, accountID, userID, filter)
 	}
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvents is not implemented")
 }
@@ -831,3 +945,451 @@ func (am *MockAccountManager) GetAccount(ctx context.Context, accountID string)
 	}
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccount is not implemented")
 }
+
+// CreateAccessRequest mock implementation of CreateAccessRequest from server.AccountManager interface
+func (am *MockAccountManager) CreateAccessRequest(ctx context.Context, accountID, userID string, groups []string, duration time.Duration, reason string) (*server.AccessRequest, error) {
+	if am.CreateAccessRequestFunc != nil {
+		return am.CreateAccessRequestFunc(ctx, accountID, userID, groups, duration, reason)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessRequest is not implemented")
+}
+
+// GetAccessRequest mock implementation of GetAccessRequest from server.AccountManager interface
+func (am *MockAccountManager) GetAccessRequest(ctx context.Context, accountID, requestID, userID string) (*server.AccessRequest, error) {
+	if am.GetAccessRequestFunc != nil {
+		return am.GetAccessRequestFunc(ctx, accountID, requestID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessRequest is not implemented")
+}
+
+// ListAccessRequests mock implementation of ListAccessRequests from server.AccountManager interface
+func (am *MockAccountManager) ListAccessRequests(ctx context.Context, accountID, userID string) ([]*server.AccessRequest, error) {
+	if am.ListAccessRequestsFunc != nil {
+		return am.ListAccessRequestsFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessRequests is not implemented")
+}
+
+// ApproveAccessRequest mock implementation of ApproveAccessRequest from server.AccountManager interface
+func (am *MockAccountManager) ApproveAccessRequest(ctx context.Context, accountID, requestID, userID string) (*server.AccessRequest, error) {
+	if am.ApproveAccessRequestFunc != nil {
+		return am.ApproveAccessRequestFunc(ctx, accountID, requestID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAccessRequest is not implemented")
+}
+
+// DenyAccessRequest mock implementation of DenyAccessRequest from server.AccountManager interface
+func (am *MockAccountManager) DenyAccessRequest(ctx context.Context, accountID, requestID, userID string) (*server.AccessRequest, error) {
+	if am.DenyAccessRequestFunc != nil {
+		return am.DenyAccessRequestFunc(ctx, accountID, requestID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method DenyAccessRequest is not implemented")
+}
+
+// GetWebhookEndpoint mock implementation of GetWebhookEndpoint from server.AccountManager interface
+func (am *MockAccountManager) GetWebhookEndpoint(ctx context.Context, accountID, endpointID, userID string) (*webhook.Endpoint, error) {
+	if am.GetWebhookEndpointFunc != nil {
+		return am.GetWebhookEndpointFunc(ctx, accountID, endpointID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookEndpoint is not implemented")
+}
+
+// ListWebhookEndpoints mock implementation of ListWebhookEndpoints from server.AccountManager interface
+func (am *MockAccountManager) ListWebhookEndpoints(ctx context.Context, accountID, userID string) ([]*webhook.Endpoint, error) {
+	if am.ListWebhookEndpointsFunc != nil {
+		return am.ListWebhookEndpointsFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookEndpoints is not implemented")
+}
+
+// SaveWebhookEndpoint mock implementation of SaveWebhookEndpoint from server.AccountManager interface
+func (am *MockAccountManager) SaveWebhookEndpoint(ctx context.Context, accountID, userID string, endpoint *webhook.Endpoint) (*webhook.Endpoint, error) {
+	if am.SaveWebhookEndpointFunc != nil {
+		return am.SaveWebhookEndpointFunc(ctx, accountID, userID, endpoint)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method SaveWebhookEndpoint is not implemented")
+}
+
+// DeleteWebhookEndpoint mock implementation of DeleteWebhookEndpoint from server.AccountManager interface
+func (am *MockAccountManager) DeleteWebhookEndpoint(ctx context.Context, accountID, endpointID, userID string) error {
+	if am.DeleteWebhookEndpointFunc != nil {
+		return am.DeleteWebhookEndpointFunc(ctx, accountID, endpointID, userID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteWebhookEndpoint is not implemented")
+}
+
+// ExportAccountConfig mock implementation of ExportAccountConfig from server.AccountManager interface
+func (am *MockAccountManager) ExportAccountConfig(ctx context.Context, accountID, userID string) (*accountconfig.Config, error) {
+	if am.ExportAccountConfigFunc != nil {
+		return am.ExportAccountConfigFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAccountConfig is not implemented")
+}
+
+// PlanAccountConfig mock implementation of PlanAccountConfig from server.AccountManager interface
+func (am *MockAccountManager) PlanAccountConfig(ctx context.Context, accountID, userID string, config *accountconfig.Config) ([]*accountconfig.Change, error) {
+	if am.PlanAccountConfigFunc != nil {
+		return am.PlanAccountConfigFunc(ctx, accountID, userID, config)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method PlanAccountConfig is not implemented")
+}
+
+// ApplyAccountConfig mock implementation of ApplyAccountConfig from server.AccountManager interface
+func (am *MockAccountManager) ApplyAccountConfig(ctx context.Context, accountID, userID string, config *accountconfig.Config) ([]*accountconfig.Change, error) {
+	if am.ApplyAccountConfigFunc != nil {
+		return am.ApplyAccountConfigFunc(ctx, accountID, userID, config)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyAccountConfig is not implemented")
+}
+
+// ExplainPeerConnection mock implementation of ExplainPeerConnection from server.AccountManager interface
+func (am *MockAccountManager) ExplainPeerConnection(ctx context.Context, accountID, peerID, userID string, req *server.PolicyExplainRequest) (*server.PolicyExplanation, error) {
+	if am.ExplainPeerConnectionFunc != nil {
+		return am.ExplainPeerConnectionFunc(ctx, accountID, peerID, userID, req)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainPeerConnection is not implemented")
+}
+
+// GetCustomRole mock implementation of GetCustomRole from server.AccountManager interface
+func (am *MockAccountManager) GetCustomRole(ctx context.Context, accountID, roleID, userID string) (*rbac.Role, error) {
+	if am.GetCustomRoleFunc != nil {
+		return am.GetCustomRoleFunc(ctx, accountID, roleID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomRole is not implemented")
+}
+
+// ListCustomRoles mock implementation of ListCustomRoles from server.AccountManager interface
+func (am *MockAccountManager) ListCustomRoles(ctx context.Context, accountID, userID string) ([]*rbac.Role, error) {
+	if am.ListCustomRolesFunc != nil {
+		return am.ListCustomRolesFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomRoles is not implemented")
+}
+
+// SaveCustomRole mock implementation of SaveCustomRole from server.AccountManager interface
+func (am *MockAccountManager) SaveCustomRole(ctx context.Context, accountID, userID string, role *rbac.Role) (*rbac.Role, error) {
+	if am.SaveCustomRoleFunc != nil {
+		return am.SaveCustomRoleFunc(ctx, accountID, userID, role)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method SaveCustomRole is not implemented")
+}
+
+// DeleteCustomRole mock implementation of DeleteCustomRole from server.AccountManager interface
+func (am *MockAccountManager) DeleteCustomRole(ctx context.Context, accountID, roleID, userID string) error {
+	if am.DeleteCustomRoleFunc != nil {
+		return am.DeleteCustomRoleFunc(ctx, accountID, roleID, userID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteCustomRole is not implemented")
+}
+
+// GetUserCustomRole mock implementation of GetUserCustomRole from server.AccountManager interface
+func (am *MockAccountManager) GetUserCustomRole(ctx context.Context, user *server.User) (*rbac.Role, error) {
+	if am.GetUserCustomRoleFunc != nil {
+		return am.GetUserCustomRoleFunc(ctx, user)
+	}
+	return nil, nil //nolint:nilnil
+}
+
+// CreateSCIMToken mock implementation of CreateSCIMToken from server.AccountManager interface
+func (am *MockAccountManager) CreateSCIMToken(ctx context.Context, accountID, userID string) (*scim.TokenGenerated, error) {
+	if am.CreateSCIMTokenFunc != nil {
+		return am.CreateSCIMTokenFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSCIMToken is not implemented")
+}
+
+// GetSCIMToken mock implementation of GetSCIMToken from server.AccountManager interface
+func (am *MockAccountManager) GetSCIMToken(ctx context.Context, accountID, userID string) (*scim.Token, error) {
+	if am.GetSCIMTokenFunc != nil {
+		return am.GetSCIMTokenFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetSCIMToken is not implemented")
+}
+
+// DeleteSCIMToken mock implementation of DeleteSCIMToken from server.AccountManager interface
+func (am *MockAccountManager) DeleteSCIMToken(ctx context.Context, accountID, userID string) error {
+	if am.DeleteSCIMTokenFunc != nil {
+		return am.DeleteSCIMTokenFunc(ctx, accountID, userID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteSCIMToken is not implemented")
+}
+
+// GetAccountIDFromSCIMToken mock implementation of GetAccountIDFromSCIMToken from server.AccountManager interface
+func (am *MockAccountManager) GetAccountIDFromSCIMToken(ctx context.Context, token string) (string, string, error) {
+	if am.GetAccountIDFromSCIMTokenFunc != nil {
+		return am.GetAccountIDFromSCIMTokenFunc(ctx, token)
+	}
+	return "", "", status.Errorf(codes.Unimplemented, "method GetAccountIDFromSCIMToken is not implemented")
+}
+
+// ListSCIMUsers mock implementation of ListSCIMUsers from server.AccountManager interface
+func (am *MockAccountManager) ListSCIMUsers(ctx context.Context, accountID string) ([]*scim.User, error) {
+	if am.ListSCIMUsersFunc != nil {
+		return am.ListSCIMUsersFunc(ctx, accountID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ListSCIMUsers is not implemented")
+}
+
+// GetSCIMUser mock implementation of GetSCIMUser from server.AccountManager interface
+func (am *MockAccountManager) GetSCIMUser(ctx context.Context, accountID, userID string) (*scim.User, error) {
+	if am.GetSCIMUserFunc != nil {
+		return am.GetSCIMUserFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetSCIMUser is not implemented")
+}
+
+// SaveSCIMUser mock implementation of SaveSCIMUser from server.AccountManager interface
+func (am *MockAccountManager) SaveSCIMUser(ctx context.Context, accountID, initiatorUserID string, user *scim.User) (*scim.User, error) {
+	if am.SaveSCIMUserFunc != nil {
+		return am.SaveSCIMUserFunc(ctx, accountID, initiatorUserID, user)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSCIMUser is not implemented")
+}
+
+// DeleteSCIMUser mock implementation of DeleteSCIMUser from server.AccountManager interface
+func (am *MockAccountManager) DeleteSCIMUser(ctx context.Context, accountID, initiatorUserID, userID string) error {
+	if am.DeleteSCIMUserFunc != nil {
+		return am.DeleteSCIMUserFunc(ctx, accountID, initiatorUserID, userID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteSCIMUser is not implemented")
+}
+
+// ListSCIMGroups mock implementation of ListSCIMGroups from server.AccountManager interface
+func (am *MockAccountManager) ListSCIMGroups(ctx context.Context, accountID string) ([]*scim.Group, error) {
+	if am.ListSCIMGroupsFunc != nil {
+		return am.ListSCIMGroupsFunc(ctx, accountID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ListSCIMGroups is not implemented")
+}
+
+// GetSCIMGroup mock implementation of GetSCIMGroup from server.AccountManager interface
+func (am *MockAccountManager) GetSCIMGroup(ctx context.Context, accountID, groupID string) (*scim.Group, error) {
+	if am.GetSCIMGroupFunc != nil {
+		return am.GetSCIMGroupFunc(ctx, accountID, groupID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetSCIMGroup is not implemented")
+}
+
+// SaveSCIMGroup mock implementation of SaveSCIMGroup from server.AccountManager interface
+func (am *MockAccountManager) SaveSCIMGroup(ctx context.Context, accountID, initiatorUserID string, group *scim.Group) (*scim.Group, error) {
+	if am.SaveSCIMGroupFunc != nil {
+		return am.SaveSCIMGroupFunc(ctx, accountID, initiatorUserID, group)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSCIMGroup is not implemented")
+}
+
+// DeleteSCIMGroup mock implementation of DeleteSCIMGroup from server.AccountManager interface
+func (am *MockAccountManager) DeleteSCIMGroup(ctx context.Context, accountID, initiatorUserID, groupID string) error {
+	if am.DeleteSCIMGroupFunc != nil {
+		return am.DeleteSCIMGroupFunc(ctx, accountID, initiatorUserID, groupID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteSCIMGroup is not implemented")
+}
+
+// GetService mock implementation of GetService from server.AccountManager interface
+func (am *MockAccountManager) GetService(ctx context.Context, accountID, serviceID, userID string) (*nbservice.Service, error) {
+	if am.GetServiceFunc != nil {
+		return am.GetServiceFunc(ctx, accountID, serviceID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetService is not implemented")
+}
+
+// SaveService mock implementation of SaveService from server.AccountManager interface
+func (am *MockAccountManager) SaveService(ctx context.Context, accountID, userID string, service *nbservice.Service) (*nbservice.Service, error) {
+	if am.SaveServiceFunc != nil {
+		return am.SaveServiceFunc(ctx, accountID, userID, service)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method SaveService is not implemented")
+}
+
+// DeleteService mock implementation of DeleteService from server.AccountManager interface
+func (am *MockAccountManager) DeleteService(ctx context.Context, accountID, serviceID, userID string) error {
+	if am.DeleteServiceFunc != nil {
+		return am.DeleteServiceFunc(ctx, accountID, serviceID, userID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteService is not implemented")
+}
+
+// ListServices mock implementation of ListServices from server.AccountManager interface
+func (am *MockAccountManager) ListServices(ctx context.Context, accountID, userID string) ([]*nbservice.Service, error) {
+	if am.ListServicesFunc != nil {
+		return am.ListServicesFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ListServices is not implemented")
+}
+
+// GetServicePolicies mock implementation of GetServicePolicies from server.AccountManager interface
+func (am *MockAccountManager) GetServicePolicies(ctx context.Context, accountID, serviceID, userID string) ([]*server.Policy, error) {
+	if am.GetServicePoliciesFunc != nil {
+		return am.GetServicePoliciesFunc(ctx, accountID, serviceID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetServicePolicies is not implemented")
+}
+
+// GetPolicyAuditCounts mock implementation of GetPolicyAuditCounts from server.AccountManager interface
+func (am *MockAccountManager) GetPolicyAuditCounts(ctx context.Context, accountID, policyID, userID string) ([]*server.PolicyAuditCount, error) {
+	if am.GetPolicyAuditCountsFunc != nil {
+		return am.GetPolicyAuditCountsFunc(ctx, accountID, policyID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetPolicyAuditCounts is not implemented")
+}
+
+// SyncPeerAuditCounts mock implementation of SyncPeerAuditCounts from server.AccountManager interface
+func (am *MockAccountManager) SyncPeerAuditCounts(ctx context.Context, peerPubKey string, counts map[string]uint64) error {
+	if am.SyncPeerAuditCountsFunc != nil {
+		return am.SyncPeerAuditCountsFunc(ctx, peerPubKey, counts)
+	}
+	return status.Errorf(codes.Unimplemented, "method SyncPeerAuditCounts is not implemented")
+}
+
+// GetPeerPostureStatus mock implementation of GetPeerPostureStatus from server.AccountManager interface
+func (am *MockAccountManager) GetPeerPostureStatus(ctx context.Context, accountID, peerID, userID string) (*nbpeer.PostureStatus, error) {
+	if am.GetPeerPostureStatusFunc != nil {
+		return am.GetPeerPostureStatusFunc(ctx, accountID, peerID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerPostureStatus is not implemented")
+}
+
+// GetPendingPeers mocks GetPendingPeers of the AccountManager interface
+func (am *MockAccountManager) GetPendingPeers(ctx context.Context, accountID, userID string) ([]*nbpeer.Peer, error) {
+	if am.GetPendingPeersFunc != nil {
+		return am.GetPendingPeersFunc(ctx, accountID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingPeers is not implemented")
+}
+
+// ApprovePeers mocks ApprovePeers of the AccountManager interface
+func (am *MockAccountManager) ApprovePeers(ctx context.Context, accountID, userID string, peerIDs []string) error {
+	if am.ApprovePeersFunc != nil {
+		return am.ApprovePeersFunc(ctx, accountID, userID, peerIDs)
+	}
+	return status.Errorf(codes.Unimplemented, "method ApprovePeers is not implemented")
+}
+
+// RejectPeers mocks RejectPeers of the AccountManager interface
+func (am *MockAccountManager) RejectPeers(ctx context.Context, accountID, userID string, peerIDs []string) error {
+	if am.RejectPeersFunc != nil {
+		return am.RejectPeersFunc(ctx, accountID, userID, peerIDs)
+	}
+	return status.Errorf(codes.Unimplemented, "method RejectPeers is not implemented")
+}
+
+// GetOrganizations mocks GetOrganizations of the AccountManager interface
+func (am *MockAccountManager) GetOrganizations(ctx context.Context, userID string) ([]*organization.Organization, error) {
+	if am.GetOrganizationsFunc != nil {
+		return am.GetOrganizationsFunc(ctx, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizations is not implemented")
+}
+
+// GetOrganization mocks GetOrganization of the AccountManager interface
+func (am *MockAccountManager) GetOrganization(ctx context.Context, organizationID, userID string) (*organization.Organization, error) {
+	if am.GetOrganizationFunc != nil {
+		return am.GetOrganizationFunc(ctx, organizationID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganization is not implemented")
+}
+
+// CreateOrganization mocks CreateOrganization of the AccountManager interface
+func (am *MockAccountManager) CreateOrganization(ctx context.Context, accountID, userID, name string) (*organization.Organization, error) {
+	if am.CreateOrganizationFunc != nil {
+		return am.CreateOrganizationFunc(ctx, accountID, userID, name)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization is not implemented")
+}
+
+// UpdateOrganization mocks UpdateOrganization of the AccountManager interface
+func (am *MockAccountManager) UpdateOrganization(ctx context.Context, organizationID, userID, name string) (*organization.Organization, error) {
+	if am.UpdateOrganizationFunc != nil {
+		return am.UpdateOrganizationFunc(ctx, organizationID, userID, name)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganization is not implemented")
+}
+
+// DeleteOrganization mocks DeleteOrganization of the AccountManager interface
+func (am *MockAccountManager) DeleteOrganization(ctx context.Context, organizationID, userID string) error {
+	if am.DeleteOrganizationFunc != nil {
+		return am.DeleteOrganizationFunc(ctx, organizationID, userID)
+	}
+	return status.Errorf(codes.Unimplemented, "method DeleteOrganization is not implemented")
+}
+
+// GetOrganizationAccounts mocks GetOrganizationAccounts of the AccountManager interface
+func (am *MockAccountManager) GetOrganizationAccounts(ctx context.Context, organizationID, userID string) ([]*organization.AccountSummary, error) {
+	if am.GetOrganizationAccountsFunc != nil {
+		return am.GetOrganizationAccountsFunc(ctx, organizationID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationAccounts is not implemented")
+}
+
+// CreateOrganizationAccount mocks CreateOrganizationAccount of the AccountManager interface
+func (am *MockAccountManager) CreateOrganizationAccount(ctx context.Context, organizationID, userID, domain string) (*organization.AccountSummary, error) {
+	if am.CreateOrganizationAccountFunc != nil {
+		return am.CreateOrganizationAccountFunc(ctx, organizationID, userID, domain)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganizationAccount is not implemented")
+}
+
+// RemoveOrganizationAccount mocks RemoveOrganizationAccount of the AccountManager interface
+func (am *MockAccountManager) RemoveOrganizationAccount(ctx context.Context, organizationID, accountID, userID string) error {
+	if am.RemoveOrganizationAccountFunc != nil {
+		return am.RemoveOrganizationAccountFunc(ctx, organizationID, accountID, userID)
+	}
+	return status.Errorf(codes.Unimplemented, "method RemoveOrganizationAccount is not implemented")
+}
+
+// GetOrganizationAdmins mocks GetOrganizationAdmins of the AccountManager interface
+func (am *MockAccountManager) GetOrganizationAdmins(ctx context.Context, organizationID, userID string) ([]*organization.Admin, error) {
+	if am.GetOrganizationAdminsFunc != nil {
+		return am.GetOrganizationAdminsFunc(ctx, organizationID, userID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationAdmins is not implemented")
+}
+
+// AddOrganizationAdmin mocks AddOrganizationAdmin of the AccountManager interface
+func (am *MockAccountManager) AddOrganizationAdmin(ctx context.Context, organizationID, userID, adminUserID string) (*organization.Admin, error) {
+	if am.AddOrganizationAdminFunc != nil {
+		return am.AddOrganizationAdminFunc(ctx, organizationID, userID, adminUserID)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrganizationAdmin is not implemented")
+}
+
+// RemoveOrganizationAdmin mocks RemoveOrganizationAdmin of the AccountManager interface
+func (am *MockAccountManager) RemoveOrganizationAdmin(ctx context.Context, organizationID, userID, adminUserID string) error {
+	if am.RemoveOrganizationAdminFunc != nil {
+		return am.RemoveOrganizationAdminFunc(ctx, organizationID, userID, adminUserID)
+	}
+	return status.Errorf(codes.Unimplemented, "method RemoveOrganizationAdmin is not implemented")
+}
+
+// GetOrganizationAccountClaims mocks GetOrganizationAccountClaims of the AccountManager interface
+func (am *MockAccountManager) GetOrganizationAccountClaims(ctx context.Context, userID, accountID string) (jwtclaims.AuthorizationClaims, error) {
+	if am.GetOrganizationAccountClaimsFunc != nil {
+		return am.GetOrganizationAccountClaimsFunc(ctx, userID, accountID)
+	}
+	return jwtclaims.AuthorizationClaims{}, status.Errorf(codes.Unimplemented, "method GetOrganizationAccountClaims is not implemented")
+}
+
+// ApplyPeerBulkOperations mocks ApplyPeerBulkOperations of the AccountManager interface
+func (am *MockAccountManager) ApplyPeerBulkOperations(ctx context.Context, accountID, userID string, operations []*server.PeerBulkOperation) (*server.BulkResult, error) {
+	if am.ApplyPeerBulkOperationsFunc != nil {
+		return am.ApplyPeerBulkOperationsFunc(ctx, accountID, userID, operations)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyPeerBulkOperations is not implemented")
+}
+
+// ApplyGroupBulkOperations mocks ApplyGroupBulkOperations of the AccountManager interface
+func (am *MockAccountManager) ApplyGroupBulkOperations(ctx context.Context, accountID, userID string, operations []*server.GroupBulkOperation) (*server.BulkResult, error) {
+	if am.ApplyGroupBulkOperationsFunc != nil {
+		return am.ApplyGroupBulkOperationsFunc(ctx, accountID, userID, operations)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyGroupBulkOperations is not implemented")
+}
+
+// ApplyUserBulkOperations mocks ApplyUserBulkOperations of the AccountManager interface
+func (am *MockAccountManager) ApplyUserBulkOperations(ctx context.Context, accountID, userID string, operations []*server.UserBulkOperation) (*server.BulkResult, error) {
+	if am.ApplyUserBulkOperationsFunc != nil {
+		return am.ApplyUserBulkOperationsFunc(ctx, accountID, userID, operations)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyUserBulkOperations is not implemented")
+}
@@ -12,6 +12,7 @@ import (
 	nbdns "github.com/netbirdio/netbird/dns"
 	"github.com/netbirdio/netbird/management/server/activity"
 	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	"github.com/netbirdio/netbird/management/server/rbac"
 	"github.com/netbirdio/netbird/management/server/status"
 )
 
@@ -28,7 +29,7 @@ func (am *DefaultAccountManager) GetNameServerGroup(ctx context.Context, account
 		return nil, status.NewUserNotPartOfAccountError()
 	}
 
-	if user.IsRegularUser() {
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourceDNS, rbac.OperationRead) {
 		return nil, status.NewAdminPermissionError()
 	}
 
@@ -206,7 +207,7 @@ func (am *DefaultAccountManager) ListNameServerGroups(ctx context.Context, accou
 		return nil, status.NewUserNotPartOfAccountError()
 	}
 
-	if user.IsRegularUser() {
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourceDNS, rbac.OperationRead) {
 		return nil, status.NewAdminPermissionError()
 	}
 
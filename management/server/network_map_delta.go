@@ -0,0 +1,54 @@
+package server
+
+import (
+	"slices"
+
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/netbirdio/netbird/management/proto"
+)
+
+// networkMapDeltas keeps the network map last sent on a sync stream to send the following network maps
+// as deltas to the peers supporting them
+type networkMapDeltas struct {
+	enabled bool
+	last    *proto.NetworkMap
+}
+
+func newNetworkMapDeltas(capabilities []proto.PeerCapability) *networkMapDeltas {
+	return &networkMapDeltas{
+		enabled: slices.Contains(capabilities, proto.PeerCapability_NETWORK_MAP_DELTA),
+	}
+}
+
+// prepare returns the sync response to send to the peer. The network map of the response is replaced by a delta
+// to the network map last sent when the peer supports it and the delta is smaller than the full network map.
+func (d *networkMapDeltas) prepare(response *proto.SyncResponse) *proto.SyncResponse {
+	networkMap := response.GetNetworkMap()
+	if !d.enabled || networkMap == nil {
+		return response
+	}
+
+	if d.last == nil {
+		d.last = networkMap
+		return response
+	}
+
+	// the peer ignores outdated network maps, the next delta has to apply to the last network map it has applied
+	if networkMap.GetSerial() < d.last.GetSerial() {
+		return response
+	}
+
+	delta := proto.NewNetworkMapDelta(d.last, networkMap)
+	d.last = networkMap
+
+	if pb.Size(delta) >= pb.Size(networkMap) {
+		return response
+	}
+
+	return &proto.SyncResponse{
+		WiretrusteeConfig: response.GetWiretrusteeConfig(),
+		Checks:            response.GetChecks(),
+		NetworkMapDelta:   delta,
+	}
+}
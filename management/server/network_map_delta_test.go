@@ -0,0 +1,122 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/netbirdio/netbird/management/proto"
+)
+
+func testSyncResponse(serial uint64, peers int) *proto.SyncResponse {
+	networkMap := &proto.NetworkMap{
+		Serial:                     serial,
+		PeerConfig:                 &proto.PeerConfig{Address: "100.64.0.1/16"},
+		DNSConfig:                  &proto.DNSConfig{},
+		FirewallRulesIsEmpty:       true,
+		RoutesFirewallRulesIsEmpty: true,
+	}
+	for i := 0; i < peers; i++ {
+		networkMap.RemotePeers = append(networkMap.RemotePeers, &proto.RemotePeerConfig{
+			WgPubKey:   fmt.Sprintf("peer%d", i),
+			AllowedIps: []string{fmt.Sprintf("100.64.0.%d/32", i+2)},
+			Fqdn:       fmt.Sprintf("peer%d.netbird.cloud", i),
+		})
+	}
+	networkMap.RemotePeersIsEmpty = len(networkMap.RemotePeers) == 0
+	return &proto.SyncResponse{NetworkMap: networkMap, Checks: []*proto.Checks{{Files: []string{"/etc/hosts"}}}}
+}
+
+func TestNetworkMapDeltas_Prepare(t *testing.T) {
+	t.Run("peers without the capability get full network maps", func(t *testing.T) {
+		deltas := newNetworkMapDeltas(nil)
+
+		for serial := uint64(1); serial < 3; serial++ {
+			response := testSyncResponse(serial, 10)
+			assert.Same(t, response, deltas.prepare(response))
+		}
+	})
+
+	t.Run("network maps following the first one are sent as deltas", func(t *testing.T) {
+		deltas := newNetworkMapDeltas([]proto.PeerCapability{proto.PeerCapability_NETWORK_MAP_DELTA})
+
+		initial := testSyncResponse(1, 10)
+		assert.Same(t, initial, deltas.prepare(initial))
+
+		response := deltas.prepare(testSyncResponse(2, 11))
+		assert.Nil(t, response.GetNetworkMap())
+		require.NotNil(t, response.GetNetworkMapDelta())
+		assert.Equal(t, uint64(1), response.GetNetworkMapDelta().GetBaseSerial())
+		assert.Equal(t, uint64(2), response.GetNetworkMapDelta().GetSerial())
+		assert.Len(t, response.GetNetworkMapDelta().GetAddedRemotePeers(), 1)
+		assert.Len(t, response.GetChecks(), 1)
+
+		applied, err := proto.ApplyNetworkMapDelta(initial.GetNetworkMap(), response.GetNetworkMapDelta())
+		require.NoError(t, err)
+		assert.Len(t, applied.GetRemotePeers(), 11)
+
+		update := &proto.SyncResponse{WiretrusteeConfig: &proto.WiretrusteeConfig{}}
+		assert.Same(t, update, deltas.prepare(update), "updates without network map are sent as is")
+	})
+
+	t.Run("outdated network maps don't change the delta base", func(t *testing.T) {
+		deltas := newNetworkMapDeltas([]proto.PeerCapability{proto.PeerCapability_NETWORK_MAP_DELTA})
+
+		deltas.prepare(testSyncResponse(5, 10))
+
+		outdated := testSyncResponse(4, 3)
+		assert.Same(t, outdated, deltas.prepare(outdated))
+
+		response := deltas.prepare(testSyncResponse(6, 10))
+		require.NotNil(t, response.GetNetworkMapDelta())
+		assert.Equal(t, uint64(5), response.GetNetworkMapDelta().GetBaseSerial())
+		assert.Empty(t, response.GetNetworkMapDelta().GetAddedRemotePeers())
+		assert.Empty(t, response.GetNetworkMapDelta().GetRemovedRemotePeers())
+	})
+
+	t.Run("applied deltas give the full network maps", func(t *testing.T) {
+		deltas := newNetworkMapDeltas([]proto.PeerCapability{proto.PeerCapability_NETWORK_MAP_DELTA})
+
+		withRules := func(response *proto.SyncResponse, ports ...string) *proto.SyncResponse {
+			for _, port := range ports {
+				response.NetworkMap.FirewallRules = append(response.NetworkMap.FirewallRules, &proto.FirewallRule{
+					PeerIP: "100.64.0.2", Direction: proto.RuleDirection_IN, Action: proto.RuleAction_ACCEPT, Protocol: proto.RuleProtocol_TCP, Port: port,
+				})
+			}
+			response.NetworkMap.FirewallRulesIsEmpty = len(response.NetworkMap.FirewallRules) == 0
+			return response
+		}
+
+		applied := withRules(testSyncResponse(1, 10), "22", "443").GetNetworkMap()
+		deltas.prepare(&proto.SyncResponse{NetworkMap: applied})
+
+		for _, full := range []*proto.SyncResponse{
+			withRules(testSyncResponse(2, 10), "22", "80", "443"),
+			withRules(testSyncResponse(3, 10), "80", "22", "443"),
+			withRules(testSyncResponse(4, 11), "80", "22", "443", "8080"),
+		} {
+			serial := full.GetNetworkMap().GetSerial()
+			expected := pb.Clone(full.GetNetworkMap()).(*proto.NetworkMap)
+
+			response := deltas.prepare(full)
+			require.NotNil(t, response.GetNetworkMapDelta(), "serial %d", serial)
+
+			var err error
+			applied, err = proto.ApplyNetworkMapDelta(applied, response.GetNetworkMapDelta())
+			require.NoError(t, err)
+			assert.True(t, pb.Equal(expected, applied), "serial %d\nexpected: %s\nactual: %s", serial, expected, applied)
+		}
+	})
+
+	t.Run("full network map is sent when smaller than the delta", func(t *testing.T) {
+		deltas := newNetworkMapDeltas([]proto.PeerCapability{proto.PeerCapability_NETWORK_MAP_DELTA})
+
+		deltas.prepare(testSyncResponse(1, 10))
+
+		response := testSyncResponse(2, 0)
+		assert.Same(t, response, deltas.prepare(response))
+	})
+}
@@ -0,0 +1,506 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/jwtclaims"
+	"github.com/netbirdio/netbird/management/server/organization"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// GetOrganizations returns the organizations administered by the user.
+func (am *DefaultAccountManager) GetOrganizations(ctx context.Context, userID string) ([]*organization.Organization, error) {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	orgs := make([]*organization.Organization, 0)
+	if !user.HasAdminPower() || user.Issued == UserIssuedOrganization {
+		return orgs, nil
+	}
+
+	userOrgs, err := am.Store.GetUserOrganizations(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, org := range userOrgs {
+		if org.AccountID == user.AccountID {
+			orgs = append(orgs, org)
+		}
+	}
+
+	return orgs, nil
+}
+
+// GetOrganization returns the organization if the user is one of its admins.
+func (am *DefaultAccountManager) GetOrganization(ctx context.Context, organizationID, userID string) (*organization.Organization, error) {
+	return am.getOrganizationAsAdmin(ctx, organizationID, userID)
+}
+
+// CreateOrganization creates an organization owned by the account. The account becomes the first account of the
+// organization and the user its first admin.
+func (am *DefaultAccountManager) CreateOrganization(ctx context.Context, accountID, userID, name string) (*organization.Organization, error) {
+	name, err := validateOrganizationName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if !user.HasAdminPower() || user.Issued == UserIssuedOrganization {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	org := &organization.Organization{
+		ID:        xid.New().String(),
+		AccountID: accountID,
+		Name:      name,
+		CreatedBy: userID,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		organizationID, err := transaction.GetAccountOrganizationID(ctx, LockingStrengthUpdate, accountID)
+		if err != nil {
+			return err
+		}
+
+		if organizationID != "" {
+			return status.Errorf(status.PreconditionFailed, "account is already part of an organization")
+		}
+
+		if err = transaction.SaveOrganization(ctx, LockingStrengthUpdate, org); err != nil {
+			return err
+		}
+
+		admin := &organization.Admin{
+			OrganizationID: org.ID,
+			UserID:         userID,
+			CreatedBy:      userID,
+			CreatedAt:      org.CreatedAt,
+		}
+		if err = transaction.SaveOrganizationAdmin(ctx, LockingStrengthUpdate, admin); err != nil {
+			return err
+		}
+
+		return transaction.SaveAccountOrganization(ctx, LockingStrengthUpdate, accountID, org.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, org.ID, accountID, activity.OrganizationCreated, org.EventMeta())
+
+	return org, nil
+}
+
+// UpdateOrganization renames the organization.
+func (am *DefaultAccountManager) UpdateOrganization(ctx context.Context, organizationID, userID, name string) (*organization.Organization, error) {
+	name, err := validateOrganizationName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := am.getOrganizationAsAdmin(ctx, organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	org.Name = name
+	if err = am.Store.SaveOrganization(ctx, LockingStrengthUpdate, org); err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, org.ID, org.AccountID, activity.OrganizationUpdated, org.EventMeta())
+
+	return org, nil
+}
+
+// DeleteOrganization deletes the organization. The other accounts have to be removed from the organization first.
+func (am *DefaultAccountManager) DeleteOrganization(ctx context.Context, organizationID, userID string) error {
+	org, err := am.getOrganizationAsAdmin(ctx, organizationID, userID)
+	if err != nil {
+		return err
+	}
+
+	accountIDs, err := am.Store.GetOrganizationAccountIDs(ctx, LockingStrengthShare, organizationID)
+	if err != nil {
+		return err
+	}
+
+	for _, accountID := range accountIDs {
+		if accountID != org.AccountID {
+			return status.Errorf(status.PreconditionFailed, "organization still manages other accounts, remove them first")
+		}
+	}
+
+	if err = am.Store.DeleteOrganization(ctx, LockingStrengthUpdate, organizationID); err != nil {
+		return err
+	}
+
+	am.StoreEvent(ctx, userID, org.ID, org.AccountID, activity.OrganizationDeleted, org.EventMeta())
+
+	return nil
+}
+
+// GetOrganizationAccounts returns the accounts of the organization with their peer and user counts.
+func (am *DefaultAccountManager) GetOrganizationAccounts(ctx context.Context, organizationID, userID string) ([]*organization.AccountSummary, error) {
+	if _, err := am.getOrganizationAsAdmin(ctx, organizationID, userID); err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetOrganizationAccountsSummary(ctx, LockingStrengthShare, organizationID)
+}
+
+// CreateOrganizationAccount creates a new account managed by the organization. The account is owned by the service
+// user acting on behalf of the organization admin until users of the customer are invited.
+func (am *DefaultAccountManager) CreateOrganizationAccount(ctx context.Context, organizationID, userID, domain string) (*organization.AccountSummary, error) {
+	org, err := am.getOrganizationAsAdmin(ctx, organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID := xid.New().String()
+	delegatedUserID := organization.DelegatedUserID(accountID, userID)
+
+	newAccount := newAccountWithId(ctx, accountID, delegatedUserID, strings.TrimSpace(domain))
+	newAccount.OrganizationID = org.ID
+
+	owner := newAccount.Users[delegatedUserID]
+	owner.IsServiceUser = true
+	owner.NonDeletable = true
+	owner.ServiceUserName = delegatedUserName(org, userID)
+	owner.Issued = UserIssuedOrganization
+
+	if err = am.Store.SaveAccount(ctx, newAccount); err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, delegatedUserID, accountID, accountID, activity.AccountCreated, nil)
+	am.StoreEvent(ctx, userID, accountID, org.AccountID, activity.OrganizationAccountAdded, org.EventMeta())
+
+	return &organization.AccountSummary{
+		ID:        newAccount.Id,
+		Domain:    newAccount.Domain,
+		CreatedAt: newAccount.CreatedAt,
+	}, nil
+}
+
+// RemoveOrganizationAccount removes the account from the organization and deletes the service users of the
+// organization admins in it. The account must have an owner outside the organization.
+func (am *DefaultAccountManager) RemoveOrganizationAccount(ctx context.Context, organizationID, accountID, userID string) error {
+	org, err := am.getOrganizationAsAdmin(ctx, organizationID, userID)
+	if err != nil {
+		return err
+	}
+
+	if accountID == org.AccountID {
+		return status.Errorf(status.PreconditionFailed, "the account owning the organization can't be removed from it")
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		accountOrganizationID, err := transaction.GetAccountOrganizationID(ctx, LockingStrengthUpdate, accountID)
+		if err != nil {
+			return err
+		}
+
+		if accountOrganizationID != organizationID {
+			return status.NewAccountNotFoundError(accountID)
+		}
+
+		users, err := transaction.GetAccountUsers(ctx, LockingStrengthUpdate, accountID)
+		if err != nil {
+			return err
+		}
+
+		hasOwner := false
+		for _, user := range users {
+			if user.Role == UserRoleOwner && user.Issued != UserIssuedOrganization {
+				hasOwner = true
+			}
+		}
+
+		if !hasOwner {
+			return status.Errorf(status.PreconditionFailed, "account has no owner outside the organization")
+		}
+
+		for _, user := range users {
+			if user.Issued != UserIssuedOrganization {
+				continue
+			}
+
+			if err = transaction.DeleteUser(ctx, LockingStrengthUpdate, accountID, user.Id); err != nil {
+				return err
+			}
+		}
+
+		return transaction.SaveAccountOrganization(ctx, LockingStrengthUpdate, accountID, "")
+	})
+	if err != nil {
+		return err
+	}
+
+	am.StoreEvent(ctx, userID, accountID, org.AccountID, activity.OrganizationAccountRemoved, org.EventMeta())
+
+	return nil
+}
+
+// GetOrganizationAdmins returns the admins of the organization.
+func (am *DefaultAccountManager) GetOrganizationAdmins(ctx context.Context, organizationID, userID string) ([]*organization.Admin, error) {
+	if _, err := am.getOrganizationAsAdmin(ctx, organizationID, userID); err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetOrganizationAdmins(ctx, LockingStrengthShare, organizationID)
+}
+
+// AddOrganizationAdmin makes a user with admin power in the account owning the organization an organization admin.
+func (am *DefaultAccountManager) AddOrganizationAdmin(ctx context.Context, organizationID, userID, adminUserID string) (*organization.Admin, error) {
+	org, err := am.getOrganizationAsAdmin(ctx, organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	adminUser, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, adminUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if adminUser.AccountID != org.AccountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if !adminUser.HasAdminPower() || adminUser.Issued == UserIssuedOrganization {
+		return nil, status.Errorf(status.InvalidArgument, "only users with admin power can be organization admins")
+	}
+
+	_, err = am.Store.GetOrganizationAdmin(ctx, LockingStrengthShare, organizationID, adminUserID)
+	if err == nil {
+		return nil, status.Errorf(status.AlreadyExists, "user %s is already an admin of the organization", adminUserID)
+	}
+
+	if sErr, ok := status.FromError(err); !ok || sErr.Type() != status.NotFound {
+		return nil, err
+	}
+
+	admin := &organization.Admin{
+		OrganizationID: organizationID,
+		UserID:         adminUserID,
+		CreatedBy:      userID,
+		CreatedAt:      time.Now().UTC(),
+	}
+	if err = am.Store.SaveOrganizationAdmin(ctx, LockingStrengthUpdate, admin); err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, adminUserID, org.AccountID, activity.OrganizationAdminAdded, org.EventMeta())
+
+	return admin, nil
+}
+
+// RemoveOrganizationAdmin removes the organization admin and deletes its service users in the accounts of the
+// organization, except the ones owning an account.
+func (am *DefaultAccountManager) RemoveOrganizationAdmin(ctx context.Context, organizationID, userID, adminUserID string) error {
+	org, err := am.getOrganizationAsAdmin(ctx, organizationID, userID)
+	if err != nil {
+		return err
+	}
+
+	admins, err := am.Store.GetOrganizationAdmins(ctx, LockingStrengthShare, organizationID)
+	if err != nil {
+		return err
+	}
+
+	if len(admins) == 1 && admins[0].UserID == adminUserID {
+		return status.Errorf(status.PreconditionFailed, "organization should have at least one admin")
+	}
+
+	accountIDs, err := am.Store.GetOrganizationAccountIDs(ctx, LockingStrengthShare, organizationID)
+	if err != nil {
+		return err
+	}
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		if err := transaction.DeleteOrganizationAdmin(ctx, LockingStrengthUpdate, organizationID, adminUserID); err != nil {
+			return err
+		}
+
+		for _, accountID := range accountIDs {
+			if err := deleteDelegatedUser(ctx, transaction, accountID, adminUserID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	am.StoreEvent(ctx, userID, adminUserID, org.AccountID, activity.OrganizationAdminRemoved, org.EventMeta())
+
+	return nil
+}
+
+// GetOrganizationAccountClaims returns the claims the organization admin uses to access an account of the
+// organization. The admin acts as a service user of the account created on the first access.
+// The claims of the user are returned unchanged when the account is its own account.
+func (am *DefaultAccountManager) GetOrganizationAccountClaims(ctx context.Context, userID, accountID string) (jwtclaims.AuthorizationClaims, error) {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return jwtclaims.AuthorizationClaims{}, err
+	}
+
+	if user.AccountID == accountID {
+		return jwtclaims.AuthorizationClaims{UserId: userID, AccountId: accountID}, nil
+	}
+
+	organizationID, err := am.Store.GetAccountOrganizationID(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return jwtclaims.AuthorizationClaims{}, err
+	}
+
+	if organizationID == "" {
+		return jwtclaims.AuthorizationClaims{}, status.NewUserNotPartOfAccountError()
+	}
+
+	org, err := am.getOrganizationAsAdmin(ctx, organizationID, userID)
+	if err != nil {
+		return jwtclaims.AuthorizationClaims{}, err
+	}
+
+	delegatedUserID, err := am.getOrCreateDelegatedUser(ctx, org, accountID, userID)
+	if err != nil {
+		return jwtclaims.AuthorizationClaims{}, err
+	}
+
+	domain, domainCategory, err := am.Store.GetAccountDomainAndCategory(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return jwtclaims.AuthorizationClaims{}, err
+	}
+
+	return jwtclaims.AuthorizationClaims{
+		UserId:         delegatedUserID,
+		AccountId:      accountID,
+		Domain:         domain,
+		DomainCategory: domainCategory,
+	}, nil
+}
+
+// getOrCreateDelegatedUser returns the ID of the service user acting on behalf of the organization admin in the
+// account, creating the user on the first access.
+func (am *DefaultAccountManager) getOrCreateDelegatedUser(ctx context.Context, org *organization.Organization, accountID, userID string) (string, error) {
+	delegatedUserID := organization.DelegatedUserID(accountID, userID)
+
+	_, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, delegatedUserID)
+	if err == nil {
+		return delegatedUserID, nil
+	}
+
+	if sErr, ok := status.FromError(err); !ok || sErr.Type() != status.NotFound {
+		return "", err
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	delegatedUser := NewUser(delegatedUserID, UserRoleAdmin, true, true, delegatedUserName(org, userID), []string{}, UserIssuedOrganization)
+	delegatedUser.AccountID = accountID
+	if err = am.Store.SaveUser(ctx, LockingStrengthUpdate, delegatedUser); err != nil {
+		return "", err
+	}
+
+	log.WithContext(ctx).Debugf("created service user %s for the admin %s of the organization %s in the account %s",
+		delegatedUserID, userID, org.ID, accountID)
+
+	meta := org.EventMeta()
+	meta["admin_id"] = userID
+	am.StoreEvent(ctx, delegatedUserID, delegatedUserID, accountID, activity.OrganizationAdminAccessGranted, meta)
+
+	return delegatedUserID, nil
+}
+
+// getOrganizationAsAdmin returns the organization if the user is one of its admins. The user must still have admin
+// power in the account owning the organization.
+func (am *DefaultAccountManager) getOrganizationAsAdmin(ctx context.Context, organizationID, userID string) (*organization.Organization, error) {
+	org, err := am.Store.GetOrganizationByID(ctx, LockingStrengthShare, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != org.AccountID {
+		return nil, status.NewOrganizationNotFoundError(organizationID)
+	}
+
+	if !user.HasAdminPower() || user.Issued == UserIssuedOrganization {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	if _, err = am.Store.GetOrganizationAdmin(ctx, LockingStrengthShare, organizationID, userID); err != nil {
+		if sErr, ok := status.FromError(err); ok && sErr.Type() == status.NotFound {
+			return nil, status.Errorf(status.PermissionDenied, "user is not an admin of the organization")
+		}
+		return nil, err
+	}
+
+	return org, nil
+}
+
+// deleteDelegatedUser deletes the service user of the organization admin in the account. The user is kept if it owns
+// the account so the account isn't left without owner.
+func deleteDelegatedUser(ctx context.Context, transaction Store, accountID, adminUserID string) error {
+	delegatedUserID := organization.DelegatedUserID(accountID, adminUserID)
+
+	user, err := transaction.GetUserByUserID(ctx, LockingStrengthUpdate, delegatedUserID)
+	if err != nil {
+		if sErr, ok := status.FromError(err); ok && sErr.Type() == status.NotFound {
+			return nil
+		}
+		return err
+	}
+
+	if user.Role == UserRoleOwner {
+		return nil
+	}
+
+	return transaction.DeleteUser(ctx, LockingStrengthUpdate, accountID, delegatedUserID)
+}
+
+func validateOrganizationName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", status.Errorf(status.InvalidArgument, "organization name shouldn't be empty")
+	}
+	return name, nil
+}
+
+func delegatedUserName(org *organization.Organization, userID string) string {
+	return fmt.Sprintf("%s admin (%s)", org.Name, userID)
+}
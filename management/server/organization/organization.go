@@ -0,0 +1,82 @@
+package organization
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// delegatedUserIDPrefix is the prefix of the users created for the organization admins in the managed accounts
+const delegatedUserIDPrefix = "org-"
+
+// Organization groups the accounts managed by a service provider. The admins of the organization can access
+// every account of the organization without being invited into them.
+type Organization struct {
+	// ID of the organization
+	ID string `gorm:"primaryKey"`
+
+	// AccountID is the account of the service provider owning the organization
+	AccountID string `json:"-" gorm:"uniqueIndex"`
+
+	// Name of the organization visible in the UI
+	Name string
+
+	// CreatedBy is the user that created the organization
+	CreatedBy string
+
+	// CreatedAt is the time the organization was created
+	CreatedAt time.Time
+}
+
+// EventMeta returns activity event meta related to the organization
+func (o *Organization) EventMeta() map[string]any {
+	return map[string]any{"name": o.Name}
+}
+
+// Admin is a user of the organization account that can access the accounts of the organization
+type Admin struct {
+	// OrganizationID is a reference to the Organization that this object belongs
+	OrganizationID string `gorm:"primaryKey"`
+
+	// UserID is the user of the organization account
+	UserID string `gorm:"primaryKey;index"`
+
+	// CreatedBy is the user that added the admin
+	CreatedBy string
+
+	// CreatedAt is the time the admin was added
+	CreatedAt time.Time
+}
+
+// TableName returns the name of the table for the Admin model in the database.
+func (*Admin) TableName() string {
+	return "organization_admins"
+}
+
+// AccountSummary holds the aggregated information of an account managed by an organization
+type AccountSummary struct {
+	// ID of the account
+	ID string
+
+	// Domain of the account
+	Domain string
+
+	// CreatedAt is the time the account was created
+	CreatedAt time.Time
+
+	// PeersCount is the number of peers of the account
+	PeersCount int
+
+	// ConnectedPeersCount is the number of peers of the account connected to the management service
+	ConnectedPeersCount int
+
+	// UsersCount is the number of users of the account, excluding the service users
+	UsersCount int
+}
+
+// DelegatedUserID returns the ID of the service user acting on behalf of the organization admin in the account.
+// The ID is derived from both IDs so the same user is used for every request of the admin.
+func DelegatedUserID(accountID, adminUserID string) string {
+	hash := sha256.Sum256([]byte(accountID + "|" + adminUserID))
+	return delegatedUserIDPrefix + hex.EncodeToString(hash[:])[:20]
+}
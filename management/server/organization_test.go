@@ -0,0 +1,255 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/organization"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+func TestDefaultAccountManager_Organizations(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	mspAccount, err := createAccount(manager, "msp_account", userID, "msp.com")
+	require.NoError(t, err)
+
+	adminUser := NewAdminUser("msp_admin")
+	adminUser.AccountID = mspAccount.Id
+	require.NoError(t, manager.Store.SaveUser(ctx, LockingStrengthUpdate, adminUser))
+
+	regularUser := NewRegularUser("msp_regular")
+	regularUser.AccountID = mspAccount.Id
+	require.NoError(t, manager.Store.SaveUser(ctx, LockingStrengthUpdate, regularUser))
+
+	otherAccount, err := createAccount(manager, "other_account", "other_owner", "other.com")
+	require.NoError(t, err)
+
+	assertStatus := func(t *testing.T, err error, expected status.Type) {
+		t.Helper()
+		sErr, ok := status.FromError(err)
+		require.True(t, ok, "expected status error, got %v", err)
+		assert.Equal(t, expected, sErr.Type())
+	}
+
+	_, err = manager.CreateOrganization(ctx, mspAccount.Id, regularUser.Id, "Acme MSP")
+	assertStatus(t, err, status.PermissionDenied)
+
+	_, err = manager.CreateOrganization(ctx, mspAccount.Id, userID, " ")
+	assertStatus(t, err, status.InvalidArgument)
+
+	org, err := manager.CreateOrganization(ctx, mspAccount.Id, userID, "Acme MSP")
+	require.NoError(t, err)
+	assert.Equal(t, mspAccount.Id, org.AccountID)
+
+	_, err = manager.CreateOrganization(ctx, mspAccount.Id, userID, "Acme MSP 2")
+	assertStatus(t, err, status.PreconditionFailed)
+
+	orgs, err := manager.GetOrganizations(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, orgs, 1)
+	assert.Equal(t, org.ID, orgs[0].ID)
+
+	orgs, err = manager.GetOrganizations(ctx, adminUser.Id)
+	require.NoError(t, err)
+	assert.Empty(t, orgs)
+
+	_, err = manager.GetOrganization(ctx, org.ID, adminUser.Id)
+	assertStatus(t, err, status.PermissionDenied)
+
+	_, err = manager.GetOrganization(ctx, org.ID, "other_owner")
+	assertStatus(t, err, status.NotFound)
+
+	customer, err := manager.CreateOrganizationAccount(ctx, org.ID, userID, "customer.com")
+	require.NoError(t, err)
+
+	t.Run("customer account is owned by the organization admin service user", func(t *testing.T) {
+		owner, err := manager.Store.GetUserByUserID(ctx, LockingStrengthShare, organization.DelegatedUserID(customer.ID, userID))
+		require.NoError(t, err)
+		assert.Equal(t, customer.ID, owner.AccountID)
+		assert.Equal(t, UserRoleOwner, owner.Role)
+		assert.True(t, owner.IsServiceUser)
+		assert.True(t, owner.NonDeletable)
+		assert.Equal(t, UserIssuedOrganization, owner.Issued)
+	})
+
+	t.Run("accounts are listed with their counts", func(t *testing.T) {
+		customerUser := NewRegularUser("customer_user")
+		customerUser.AccountID = customer.ID
+		require.NoError(t, manager.Store.SaveUser(ctx, LockingStrengthUpdate, customerUser))
+
+		for i, connected := range []bool{true, false} {
+			peer := &nbpeer.Peer{
+				ID:        "customer_peer" + string(rune('a'+i)),
+				AccountID: customer.ID,
+				Key:       "customer_key" + string(rune('a'+i)),
+				Status:    &nbpeer.PeerStatus{Connected: connected},
+			}
+			require.NoError(t, manager.Store.AddPeerToAccount(ctx, peer))
+		}
+
+		accounts, err := manager.GetOrganizationAccounts(ctx, org.ID, userID)
+		require.NoError(t, err)
+		require.Len(t, accounts, 2)
+
+		summaries := make(map[string]*organization.AccountSummary)
+		for _, summary := range accounts {
+			summaries[summary.ID] = summary
+		}
+
+		assert.Equal(t, "customer.com", summaries[customer.ID].Domain)
+		assert.Equal(t, 2, summaries[customer.ID].PeersCount)
+		assert.Equal(t, 1, summaries[customer.ID].ConnectedPeersCount)
+		assert.Equal(t, 1, summaries[customer.ID].UsersCount)
+		assert.Equal(t, 0, summaries[mspAccount.Id].PeersCount)
+		assert.Equal(t, 3, summaries[mspAccount.Id].UsersCount)
+	})
+
+	t.Run("admins switch into the organization accounts", func(t *testing.T) {
+		claims, err := manager.GetOrganizationAccountClaims(ctx, userID, mspAccount.Id)
+		require.NoError(t, err)
+		assert.Equal(t, userID, claims.UserId)
+
+		claims, err = manager.GetOrganizationAccountClaims(ctx, userID, customer.ID)
+		require.NoError(t, err)
+		assert.Equal(t, organization.DelegatedUserID(customer.ID, userID), claims.UserId)
+		assert.Equal(t, customer.ID, claims.AccountId)
+		assert.Equal(t, "customer.com", claims.Domain)
+
+		accountID, claimsUserID, err := manager.GetAccountIDFromToken(ctx, claims)
+		require.NoError(t, err)
+		assert.Equal(t, customer.ID, accountID)
+		assert.Equal(t, claims.UserId, claimsUserID)
+
+		_, err = manager.GetOrganizationAccountClaims(ctx, userID, otherAccount.Id)
+		assertStatus(t, err, status.PermissionDenied)
+
+		_, err = manager.GetOrganizationAccountClaims(ctx, adminUser.Id, customer.ID)
+		assertStatus(t, err, status.PermissionDenied)
+	})
+
+	t.Run("added admins get a service user on the first access", func(t *testing.T) {
+		_, err := manager.AddOrganizationAdmin(ctx, org.ID, userID, regularUser.Id)
+		assertStatus(t, err, status.InvalidArgument)
+
+		_, err = manager.AddOrganizationAdmin(ctx, org.ID, userID, "other_owner")
+		assertStatus(t, err, status.PermissionDenied)
+
+		admin, err := manager.AddOrganizationAdmin(ctx, org.ID, userID, adminUser.Id)
+		require.NoError(t, err)
+		assert.Equal(t, userID, admin.CreatedBy)
+
+		_, err = manager.AddOrganizationAdmin(ctx, org.ID, userID, adminUser.Id)
+		assertStatus(t, err, status.AlreadyExists)
+
+		claims, err := manager.GetOrganizationAccountClaims(ctx, adminUser.Id, customer.ID)
+		require.NoError(t, err)
+
+		delegatedUser, err := manager.Store.GetUserByUserID(ctx, LockingStrengthShare, claims.UserId)
+		require.NoError(t, err)
+		assert.Equal(t, UserRoleAdmin, delegatedUser.Role)
+		assert.Equal(t, "Acme MSP admin (msp_admin)", delegatedUser.ServiceUserName)
+
+		admins, err := manager.GetOrganizationAdmins(ctx, org.ID, adminUser.Id)
+		require.NoError(t, err)
+		assert.Len(t, admins, 2)
+	})
+
+	t.Run("removed admins lose their service users", func(t *testing.T) {
+		err := manager.RemoveOrganizationAdmin(ctx, org.ID, userID, adminUser.Id)
+		require.NoError(t, err)
+
+		_, err = manager.Store.GetUserByUserID(ctx, LockingStrengthShare, organization.DelegatedUserID(customer.ID, adminUser.Id))
+		assertStatus(t, err, status.NotFound)
+
+		_, err = manager.GetOrganizationAccountClaims(ctx, adminUser.Id, customer.ID)
+		assertStatus(t, err, status.PermissionDenied)
+
+		err = manager.RemoveOrganizationAdmin(ctx, org.ID, userID, userID)
+		assertStatus(t, err, status.PreconditionFailed)
+	})
+
+	t.Run("organization with customer accounts can't be deleted", func(t *testing.T) {
+		err := manager.DeleteOrganization(ctx, org.ID, userID)
+		assertStatus(t, err, status.PreconditionFailed)
+	})
+
+	t.Run("customer accounts need an owner to be removed", func(t *testing.T) {
+		err := manager.RemoveOrganizationAccount(ctx, org.ID, mspAccount.Id, userID)
+		assertStatus(t, err, status.PreconditionFailed)
+
+		err = manager.RemoveOrganizationAccount(ctx, org.ID, customer.ID, userID)
+		assertStatus(t, err, status.PreconditionFailed)
+
+		customerOwner := NewOwnerUser("customer_owner")
+		customerOwner.AccountID = customer.ID
+		require.NoError(t, manager.Store.SaveUser(ctx, LockingStrengthUpdate, customerOwner))
+
+		err = manager.RemoveOrganizationAccount(ctx, org.ID, customer.ID, userID)
+		require.NoError(t, err)
+
+		_, err = manager.Store.GetUserByUserID(ctx, LockingStrengthShare, organization.DelegatedUserID(customer.ID, userID))
+		assertStatus(t, err, status.NotFound)
+
+		_, err = manager.GetOrganizationAccountClaims(ctx, userID, customer.ID)
+		assertStatus(t, err, status.PermissionDenied)
+	})
+
+	t.Run("organization is deleted", func(t *testing.T) {
+		updated, err := manager.UpdateOrganization(ctx, org.ID, userID, "Acme")
+		require.NoError(t, err)
+		assert.Equal(t, "Acme", updated.Name)
+
+		require.NoError(t, manager.DeleteOrganization(ctx, org.ID, userID))
+
+		_, err = manager.GetOrganization(ctx, org.ID, userID)
+		assertStatus(t, err, status.NotFound)
+
+		organizationID, err := manager.Store.GetAccountOrganizationID(ctx, LockingStrengthShare, mspAccount.Id)
+		require.NoError(t, err)
+		assert.Empty(t, organizationID)
+	})
+}
+
+func TestSqlStore_DeleteAccountDeletesOrganization(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	mspAccount, err := createAccount(manager, "msp_account", userID, "msp.com")
+	require.NoError(t, err)
+
+	org, err := manager.CreateOrganization(ctx, mspAccount.Id, userID, "Acme MSP")
+	require.NoError(t, err)
+
+	customer, err := manager.CreateOrganizationAccount(ctx, org.ID, userID, "")
+	require.NoError(t, err)
+
+	account, err := manager.Store.GetAccount(ctx, mspAccount.Id)
+	require.NoError(t, err)
+	require.NoError(t, manager.Store.DeleteAccount(ctx, account))
+
+	_, err = manager.Store.GetOrganizationByID(ctx, LockingStrengthShare, org.ID)
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.NotFound, sErr.Type())
+
+	admins, err := manager.Store.GetOrganizationAdmins(ctx, LockingStrengthShare, org.ID)
+	require.NoError(t, err)
+	assert.Empty(t, admins)
+
+	organizationID, err := manager.Store.GetAccountOrganizationID(ctx, LockingStrengthShare, customer.ID)
+	require.NoError(t, err)
+	assert.Empty(t, organizationID)
+
+	_, err = manager.GetOrganizationAccountClaims(ctx, userID, customer.ID)
+	assert.Error(t, err)
+}
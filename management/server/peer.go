@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	b64 "encoding/base64"
 	"fmt"
+	"maps"
 	"net"
 	"slices"
 	"strings"
@@ -19,7 +20,9 @@ import (
 
 	"github.com/netbirdio/netbird/management/proto"
 	"github.com/netbirdio/netbird/management/server/activity"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/rbac"
 	"github.com/netbirdio/netbird/management/server/status"
 )
 
@@ -70,7 +73,7 @@ func (am *DefaultAccountManager) GetPeers(ctx context.Context, accountID, userID
 	peers := make([]*nbpeer.Peer, 0)
 	peersMap := make(map[string]*nbpeer.Peer)
 
-	regularUser := !user.HasAdminPower() && !user.IsServiceUser
+	regularUser := !user.HasAdminPower() && !user.IsServiceUser && !am.hasPermission(ctx, user, rbac.ResourcePeers, rbac.OperationRead)
 
 	if regularUser && account.Settings.RegularUsersViewBlocked {
 		return peers, nil
@@ -113,11 +116,25 @@ func (am *DefaultAccountManager) MarkPeerConnected(ctx context.Context, peerPubK
 		return fmt.Errorf("failed to find peer by pub key: %w", err)
 	}
 
+	oldLocation := peer.Location
 	expired, err := am.updatePeerStatusAndLocation(ctx, peer, connected, realIP, account)
 	if err != nil {
 		return fmt.Errorf("failed to update peer status and location: %w", err)
 	}
 
+	groupsAffectPeers := false
+	if peer.Location.CountryCode != oldLocation.CountryCode || peer.Location.CityName != oldLocation.CityName {
+		var updatedGroups []*nbgroup.Group
+		updatedGroups, groupsAffectPeers, err = am.syncPeerDynamicGroups(ctx, account.Id, peer)
+		if err != nil {
+			return err
+		}
+		for _, group := range updatedGroups {
+			account.Groups[group.ID] = group
+		}
+		account.invalidatePolicyIndex()
+	}
+
 	log.WithContext(ctx).Debugf("mark peer %s connected: %t", peer.ID, connected)
 
 	if peer.AddedWithSSOLogin() {
@@ -130,9 +147,10 @@ func (am *DefaultAccountManager) MarkPeerConnected(ctx context.Context, peerPubK
 		}
 	}
 
-	if expired {
+	if expired || groupsAffectPeers {
 		// we need to update other peers because when peer login expires all other peers are notified to disconnect from
 		// the expired one. Here we notify them that connection is now allowed again.
+		// A new location can also change the dynamic groups of the peer.
 		am.updateAccountPeers(ctx, account.Id)
 	}
 
@@ -188,29 +206,107 @@ func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, user
 		return nil, err
 	}
 
+	result, err := am.applyPeerUpdate(ctx, account, userID, update)
+	if err != nil {
+		return nil, err
+	}
+
+	err = am.Store.SaveAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, storeEvent := range result.events {
+		storeEvent()
+	}
+
+	am.schedulePeerExpirations(ctx, account, result)
+
+	if result.tagsUpdated {
+		if _, _, err = am.syncPeerDynamicGroups(ctx, accountID, result.peer); err != nil {
+			return nil, err
+		}
+	}
+
+	if result.affectsAccountPeers() {
+		am.updateAccountPeers(ctx, accountID)
+	}
+
+	return result.peer, nil
+}
+
+// peerUpdateResult holds the changes applied to a peer of the account by applyPeerUpdate
+type peerUpdateResult struct {
+	peer                *nbpeer.Peer
+	labelUpdated        bool
+	tagsUpdated         bool
+	requiresPeerUpdates bool
+
+	scheduleLoginExpiration      bool
+	scheduleInactivityExpiration bool
+
+	// events are stored once the account is saved
+	events []func()
+}
+
+// affectsAccountPeers returns true if the network maps of the account peers have to be updated
+func (r *peerUpdateResult) affectsAccountPeers() bool {
+	return r.labelUpdated || r.tagsUpdated || r.requiresPeerUpdates
+}
+
+// applyPeerUpdate validates the update with the integrated validator and applies it to the peer of the account
+// without saving the account.
+func (am *DefaultAccountManager) applyPeerUpdate(ctx context.Context, account *Account, userID string, update *nbpeer.Peer) (*peerUpdateResult, error) {
 	peer := account.GetPeer(update.ID)
 	if peer == nil {
 		return nil, status.Errorf(status.NotFound, "peer %s not found", update.ID)
 	}
 
-	var requiresPeerUpdates bool
-	update, requiresPeerUpdates, err = am.integratedPeerValidator.ValidatePeer(ctx, update, peer, userID, accountID, am.GetDNSDomain(), account.GetPeerGroupsList(peer.ID), account.Settings.Extra)
+	update, requiresPeerUpdates, err := am.integratedPeerValidator.ValidatePeer(ctx, update, peer, userID, account.Id, am.GetDNSDomain(), account.GetPeerGroupsList(peer.ID), account.Settings.Extra)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := am.applyValidatedPeerUpdate(ctx, account, userID, update)
 	if err != nil {
 		return nil, err
 	}
+	result.requiresPeerUpdates = requiresPeerUpdates
+
+	return result, nil
+}
 
+// applyValidatedPeerUpdate applies the update to the peer of the account without the integrated validator
+// and without saving the account.
+func (am *DefaultAccountManager) applyValidatedPeerUpdate(ctx context.Context, account *Account, userID string, update *nbpeer.Peer) (*peerUpdateResult, error) {
+	accountID := account.Id
+
+	peer := account.GetPeer(update.ID)
+	if peer == nil {
+		return nil, status.Errorf(status.NotFound, "peer %s not found", update.ID)
+	}
+
+	result := &peerUpdateResult{peer: peer}
+	addEvent := func(targetID string, event activity.Activity) {
+		meta := peer.EventMeta(am.GetDNSDomain())
+		result.events = append(result.events, func() {
+			am.StoreEvent(ctx, userID, targetID, accountID, event, meta)
+		})
+	}
+
+	var err error
 	if peer.SSHEnabled != update.SSHEnabled {
 		peer.SSHEnabled = update.SSHEnabled
 		event := activity.PeerSSHEnabled
 		if !update.SSHEnabled {
 			event = activity.PeerSSHDisabled
 		}
-		am.StoreEvent(ctx, userID, peer.IP.String(), accountID, event, peer.EventMeta(am.GetDNSDomain()))
+		addEvent(peer.IP.String(), event)
 	}
 
-	peerLabelUpdated := peer.Name != update.Name
+	result.labelUpdated = peer.Name != update.Name
 
-	if peerLabelUpdated {
+	if result.labelUpdated {
 		peer.Name = update.Name
 
 		existingLabels := account.getPeerDNSLabels()
@@ -222,7 +318,7 @@ func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, user
 
 		peer.DNSLabel = newLabel
 
-		am.StoreEvent(ctx, userID, peer.ID, accountID, activity.PeerRenamed, peer.EventMeta(am.GetDNSDomain()))
+		addEvent(peer.ID, activity.PeerRenamed)
 	}
 
 	if peer.LoginExpirationEnabled != update.LoginExpirationEnabled {
@@ -237,11 +333,9 @@ func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, user
 		if !update.LoginExpirationEnabled {
 			event = activity.PeerLoginExpirationDisabled
 		}
-		am.StoreEvent(ctx, userID, peer.IP.String(), accountID, event, peer.EventMeta(am.GetDNSDomain()))
+		addEvent(peer.IP.String(), event)
 
-		if peer.AddedWithSSOLogin() && peer.LoginExpirationEnabled && account.Settings.PeerLoginExpirationEnabled {
-			am.checkAndSchedulePeerLoginExpiration(ctx, account)
-		}
+		result.scheduleLoginExpiration = peer.AddedWithSSOLogin() && peer.LoginExpirationEnabled && account.Settings.PeerLoginExpirationEnabled
 	}
 
 	if peer.InactivityExpirationEnabled != update.InactivityExpirationEnabled {
@@ -256,29 +350,42 @@ func (am *DefaultAccountManager) UpdatePeer(ctx context.Context, accountID, user
 		if !update.InactivityExpirationEnabled {
 			event = activity.PeerInactivityExpirationDisabled
 		}
-		am.StoreEvent(ctx, userID, peer.IP.String(), accountID, event, peer.EventMeta(am.GetDNSDomain()))
+		addEvent(peer.IP.String(), event)
 
-		if peer.AddedWithSSOLogin() && peer.InactivityExpirationEnabled && account.Settings.PeerInactivityExpirationEnabled {
-			am.checkAndSchedulePeerInactivityExpiration(ctx, account)
+		result.scheduleInactivityExpiration = peer.AddedWithSSOLogin() && peer.InactivityExpirationEnabled && account.Settings.PeerInactivityExpirationEnabled
+	}
+
+	result.tagsUpdated = update.Tags != nil && !maps.Equal(peer.Tags, update.Tags)
+
+	if result.tagsUpdated {
+		if err = nbpeer.ValidateTags(update.Tags); err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "%s", err.Error())
 		}
+
+		peer.Tags = update.Tags
+
+		addEvent(peer.ID, activity.PeerTagsUpdated)
 	}
 
 	account.UpdatePeer(peer)
 
-	err = am.Store.SaveAccount(ctx, account)
-	if err != nil {
-		return nil, err
-	}
+	return result, nil
+}
 
-	if peerLabelUpdated || requiresPeerUpdates {
-		am.updateAccountPeers(ctx, accountID)
+// schedulePeerExpirations schedules the expiration jobs enabled by a saved peer update
+func (am *DefaultAccountManager) schedulePeerExpirations(ctx context.Context, account *Account, result *peerUpdateResult) {
+	if result.scheduleLoginExpiration {
+		am.checkAndSchedulePeerLoginExpiration(ctx, account)
 	}
 
-	return peer, nil
+	if result.scheduleInactivityExpiration {
+		am.checkAndSchedulePeerInactivityExpiration(ctx, account)
+	}
 }
 
-// deletePeers will delete all specified peers and send updates to the remote peers. Don't call without acquiring account lock
-func (am *DefaultAccountManager) deletePeers(ctx context.Context, account *Account, peerIDs []string, userID string) error {
+// deletePeers will delete all specified peers, storing the given event for each of them, and send updates to the remote peers.
+// Don't call without acquiring account lock
+func (am *DefaultAccountManager) deletePeers(ctx context.Context, account *Account, peerIDs []string, userID string, event activity.Activity) error {
 
 	// the first loop is needed to ensure all peers present under the account before modifying, otherwise
 	// we might have some inconsistencies
@@ -301,30 +408,35 @@ func (am *DefaultAccountManager) deletePeers(ctx context.Context, account *Accou
 		}
 
 		account.DeletePeer(peer.ID)
-		am.peersUpdateManager.SendUpdate(ctx, peer.ID,
-			&UpdateMessage{
-				Update: &proto.SyncResponse{
-					// fill those field for backward compatibility
-					RemotePeers:        []*proto.RemotePeerConfig{},
-					RemotePeersIsEmpty: true,
-					// new field
-					NetworkMap: &proto.NetworkMap{
-						Serial:               account.Network.CurrentSerial(),
-						RemotePeers:          []*proto.RemotePeerConfig{},
-						RemotePeersIsEmpty:   true,
-						FirewallRules:        []*proto.FirewallRule{},
-						FirewallRulesIsEmpty: true,
-					},
-				},
-				NetworkMap: &NetworkMap{},
-			})
-		am.peersUpdateManager.CloseChannel(ctx, peer.ID)
-		am.StoreEvent(ctx, userID, peer.ID, account.Id, activity.PeerRemovedByUser, peer.EventMeta(am.GetDNSDomain()))
+		am.disconnectDeletedPeer(ctx, account.Network.CurrentSerial(), peer.ID)
+		am.StoreEvent(ctx, userID, peer.ID, account.Id, event, peer.EventMeta(am.GetDNSDomain()))
 	}
 
 	return nil
 }
 
+// disconnectDeletedPeer sends an empty network map to the deleted peer and closes its update channel
+func (am *DefaultAccountManager) disconnectDeletedPeer(ctx context.Context, serial uint64, peerID string) {
+	am.peersUpdateManager.SendUpdate(ctx, peerID,
+		&UpdateMessage{
+			Update: &proto.SyncResponse{
+				// fill those field for backward compatibility
+				RemotePeers:        []*proto.RemotePeerConfig{},
+				RemotePeersIsEmpty: true,
+				// new field
+				NetworkMap: &proto.NetworkMap{
+					Serial:               serial,
+					RemotePeers:          []*proto.RemotePeerConfig{},
+					RemotePeersIsEmpty:   true,
+					FirewallRules:        []*proto.FirewallRule{},
+					FirewallRulesIsEmpty: true,
+				},
+			},
+			NetworkMap: &NetworkMap{},
+		})
+	am.peersUpdateManager.CloseChannel(ctx, peerID)
+}
+
 // DeletePeer removes peer from the account by its IP
 func (am *DefaultAccountManager) DeletePeer(ctx context.Context, accountID, peerID, userID string) error {
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
@@ -340,7 +452,7 @@ func (am *DefaultAccountManager) DeletePeer(ctx context.Context, accountID, peer
 		return err
 	}
 
-	err = am.deletePeers(ctx, account, []string{peerID}, userID)
+	err = am.deletePeers(ctx, account, []string{peerID}, userID, activity.PeerRemovedByUser)
 	if err != nil {
 		return err
 	}
@@ -374,7 +486,7 @@ func (am *DefaultAccountManager) GetNetworkMap(ctx context.Context, peerID strin
 		groups[groupID] = group.Peers
 	}
 
-	validatedPeers, err := am.integratedPeerValidator.GetValidatedPeers(account.Id, account.Groups, account.Peers, account.Settings.Extra)
+	validatedPeers, err := am.GetValidatedPeers(account)
 	if err != nil {
 		return nil, err
 	}
@@ -438,6 +550,15 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 		return nil, nil, nil, status.Errorf(status.PreconditionFailed, "peer has been already registered")
 	}
 
+	// A machine rejected while pending approval can't enroll again with the same WireGuard key
+	_, err = am.Store.GetRejectedPeerByKey(ctx, LockingStrengthShare, accountID, peer.Key)
+	if err == nil {
+		return nil, nil, nil, status.Errorf(status.PermissionDenied, "peer has been rejected by an administrator")
+	}
+	if s, ok := status.FromError(err); !ok || s.Type() != status.NotFound {
+		return nil, nil, nil, err
+	}
+
 	opEvent := &activity.Event{
 		Timestamp: time.Now().UTC(),
 		AccountID: accountID,
@@ -445,11 +566,15 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 
 	var newPeer *nbpeer.Peer
 	var groupsToAdd []string
+	var dynamicGroupEvents []func()
+	var restrictedKey *SetupKey
+	var restrictionViolation string
 
 	err = am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
 		var setupKeyID string
 		var setupKeyName string
 		var ephemeral bool
+		var tags map[string]string
 		if addedByUser {
 			user, err := transaction.GetUserByUserID(ctx, LockingStrengthUpdate, userID)
 			if err != nil {
@@ -471,8 +596,10 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 
 			opEvent.InitiatorID = sk.Id
 			opEvent.Activity = activity.PeerAddedWithSetupKey
+			restrictedKey = sk
 			groupsToAdd = sk.AutoGroups
 			ephemeral = sk.Ephemeral
+			tags = maps.Clone(sk.Tags)
 			setupKeyID = sk.Id
 			setupKeyName = sk.Name
 		}
@@ -515,6 +642,7 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 			Ephemeral:                   ephemeral,
 			Location:                    peer.Location,
 			InactivityExpirationEnabled: addedByUser,
+			Tags:                        tags,
 		}
 		opEvent.TargetID = newPeer.ID
 		opEvent.Meta = newPeer.EventMeta(am.GetDNSDomain())
@@ -533,11 +661,24 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 			}
 		}
 
+		if !addedByUser {
+			restrictionViolation, err = getSetupKeyRestrictionViolation(ctx, transaction, restrictedKey, newPeer)
+			if err != nil {
+				return fmt.Errorf("failed to check setup key restrictions: %w", err)
+			}
+			if restrictionViolation != "" {
+				return status.Errorf(status.PreconditionFailed, "couldn't add peer: setup key restriction violated, %s", restrictionViolation)
+			}
+		}
+
 		settings, err := transaction.GetAccountSettings(ctx, LockingStrengthShare, accountID)
 		if err != nil {
 			return fmt.Errorf("failed to get account settings: %w", err)
 		}
 		newPeer = am.integratedPeerValidator.PreparePeer(ctx, accountID, newPeer, groupsToAdd, settings.Extra)
+		if settings.Extra.PeerRequiresApproval(setupKeyID, newPeer.Location.ConnectionIP) {
+			newPeer.Status.RequiresApproval = true
+		}
 
 		err = transaction.AddPeerToAllGroup(ctx, accountID, newPeer.ID)
 		if err != nil {
@@ -558,6 +699,15 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 			return fmt.Errorf("failed to add peer to account: %w", err)
 		}
 
+		dynamicGroups, events, err := am.updatePeerDynamicGroups(ctx, transaction, accountID, newPeer)
+		if err != nil {
+			return fmt.Errorf("failed to update dynamic groups: %w", err)
+		}
+		for _, group := range dynamicGroups {
+			groupsToAdd = append(groupsToAdd, group.ID)
+		}
+		dynamicGroupEvents = events
+
 		err = transaction.IncrementNetworkSerial(ctx, LockingStrengthUpdate, accountID)
 		if err != nil {
 			return fmt.Errorf("failed to increment network serial: %w", err)
@@ -580,6 +730,14 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 	})
 
 	if err != nil {
+		if restrictionViolation != "" {
+			meta := restrictedKey.EventMeta()
+			meta["reason"] = restrictionViolation
+			meta["hostname"] = peer.Meta.Hostname
+			meta["os"] = peer.Meta.GoOS
+			meta["connection_ip"] = peer.Location.ConnectionIP.String()
+			am.StoreEvent(ctx, restrictedKey.Id, restrictedKey.Id, accountID, activity.SetupKeyRestrictionViolated, meta)
+		}
 		return nil, nil, nil, fmt.Errorf("failed to add peer to database: %w", err)
 	}
 
@@ -588,6 +746,9 @@ func (am *DefaultAccountManager) AddPeer(ctx context.Context, setupKey, userID s
 	}
 
 	am.StoreEvent(ctx, opEvent.InitiatorID, opEvent.TargetID, opEvent.AccountID, opEvent.Activity, opEvent.Meta)
+	for _, storeEvent := range dynamicGroupEvents {
+		storeEvent()
+	}
 
 	unlock()
 	unlock = nil
@@ -669,6 +830,7 @@ func (am *DefaultAccountManager) SyncPeer(ctx context.Context, sync PeerSync, ac
 		return nil, nil, nil, status.NewPeerLoginExpiredError()
 	}
 
+	reportedTags := peer.Meta.Tags
 	updated := peer.UpdateMetaIfNew(sync.Meta)
 	if updated {
 		am.metrics.AccountManagerMetrics().CountPeerMetUpdate()
@@ -679,7 +841,28 @@ func (am *DefaultAccountManager) SyncPeer(ctx context.Context, sync PeerSync, ac
 			return nil, nil, nil, fmt.Errorf("failed to save peer: %w", err)
 		}
 
-		if sync.UpdateAccountPeers {
+		updatedGroups, groupsAffectPeers, err := am.syncPeerDynamicGroups(ctx, account.Id, peer)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for _, group := range updatedGroups {
+			account.Groups[group.ID] = group
+		}
+
+		postureChanged, err := am.updatePeerPostureStatus(ctx, account, peer)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if postureChanged {
+			am.checkAndSchedulePostureGraceExpiration(ctx, account.Id)
+		}
+
+		// the peer metadata, groups and posture status are updated in place
+		account.invalidatePolicyIndex()
+
+		tagsChanged := !maps.Equal(reportedTags, peer.Meta.Tags)
+
+		if sync.UpdateAccountPeers || groupsAffectPeers || postureChanged || tagsChanged {
 			am.updateAccountPeers(ctx, account.Id)
 		}
 	}
@@ -691,7 +874,7 @@ func (am *DefaultAccountManager) SyncPeer(ctx context.Context, sync PeerSync, ac
 
 	var postureChecks []*posture.Checks
 
-	if peerNotValid {
+	if peerNotValid || isPeerPendingApproval(peer) {
 		emptyMap := &NetworkMap{
 			Network: account.Network.Copy(),
 		}
@@ -808,10 +991,14 @@ func (am *DefaultAccountManager) LoginPeer(ctx context.Context, login PeerLogin)
 		return nil, nil, nil, err
 	}
 
+	reportedTags := peer.Meta.Tags
 	updated := peer.UpdateMetaIfNew(login.Meta)
 	if updated {
 		am.metrics.AccountManagerMetrics().CountPeerMetUpdate()
 		shouldStorePeer = true
+		if !maps.Equal(reportedTags, peer.Meta.Tags) {
+			updateRemotePeers = true
+		}
 	}
 
 	if peer.SSHKey != login.SSHKey {
@@ -826,6 +1013,17 @@ func (am *DefaultAccountManager) LoginPeer(ctx context.Context, login PeerLogin)
 		}
 	}
 
+	// the rules of the dynamic groups match the peer meta and tags, so the membership changes only when the meta is updated
+	if updated {
+		_, groupsAffectPeers, err := am.syncPeerDynamicGroups(ctx, accountID, peer)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if groupsAffectPeers {
+			updateRemotePeers = true
+		}
+	}
+
 	unlockPeer()
 	unlockPeer = nil
 
@@ -834,11 +1032,22 @@ func (am *DefaultAccountManager) LoginPeer(ctx context.Context, login PeerLogin)
 		return nil, nil, nil, err
 	}
 
+	if updated {
+		postureChanged, err := am.updatePeerPostureStatus(ctx, account, peer)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if postureChanged {
+			am.checkAndSchedulePostureGraceExpiration(ctx, accountID)
+			updateRemotePeers = true
+		}
+	}
+
 	if updateRemotePeers || isStatusChanged {
 		am.updateAccountPeers(ctx, accountID)
 	}
 
-	return am.getValidatedPeerWithMap(ctx, isRequiresApproval, account, peer)
+	return am.getValidatedPeerWithMap(ctx, isRequiresApproval || isPeerPendingApproval(peer), account, peer)
 }
 
 // checkIFPeerNeedsLoginWithoutLock checks if the peer needs login without acquiring the account lock. The check validate if the peer was not added via SSO
@@ -962,7 +1171,9 @@ func (am *DefaultAccountManager) GetPeer(ctx context.Context, accountID, peerID,
 		return nil, err
 	}
 
-	if !user.HasAdminPower() && !user.IsServiceUser && account.Settings.RegularUsersViewBlocked {
+	canReadPeers := user.HasAdminPower() || user.IsServiceUser || am.hasPermission(ctx, user, rbac.ResourcePeers, rbac.OperationRead)
+
+	if !canReadPeers && account.Settings.RegularUsersViewBlocked {
 		return nil, status.Errorf(status.Internal, "user %s has no access to his own peer %s under account %s", userID, peerID, accountID)
 	}
 
@@ -972,7 +1183,7 @@ func (am *DefaultAccountManager) GetPeer(ctx context.Context, accountID, peerID,
 	}
 
 	// if admin or user owns this peer, return peer
-	if user.HasAdminPower() || user.IsServiceUser || peer.UserID == userID {
+	if canReadPeers || peer.UserID == userID {
 		return peer, nil
 	}
 
@@ -1002,7 +1213,13 @@ func (am *DefaultAccountManager) GetPeer(ctx context.Context, accountID, peerID,
 
 // updateAccountPeers updates all peers that belong to an account.
 // Should be called when changes have to be synced to peers.
+// The updates requested for an account within the buffer interval of the peers update buffer are sent once.
 func (am *DefaultAccountManager) updateAccountPeers(ctx context.Context, accountID string) {
+	am.peersUpdateBuffer.UpdateAccountPeers(ctx, accountID)
+}
+
+// sendAccountPeersUpdate computes the network maps of the connected peers of the account and sends them
+func (am *DefaultAccountManager) sendAccountPeersUpdate(ctx context.Context, accountID string) {
 	account, err := am.requestBuffer.GetAccountWithBackpressure(ctx, accountID)
 	if err != nil {
 		log.WithContext(ctx).Errorf("failed to send out updates to peers: %v", err)
@@ -1024,35 +1241,40 @@ func (am *DefaultAccountManager) updateAccountPeers(ctx context.Context, account
 		return
 	}
 
-	var wg sync.WaitGroup
-	semaphore := make(chan struct{}, 10)
-
 	dnsCache := &DNSConfigCache{}
 	customZone := account.GetPeersCustomZone(ctx, am.dnsDomain)
 
-	for _, peer := range peers {
-		if !am.peersUpdateManager.HasChannel(peer.ID) {
-			log.WithContext(ctx).Tracef("peer %s doesn't have a channel, skipping network map update", peer.ID)
-			continue
-		}
+	// compile the account policies once for all the peers
+	account.getPolicyIndex(ctx)
 
+	peersToUpdate := make(chan *nbpeer.Peer)
+	var wg sync.WaitGroup
+	for i := 0; i < max(am.networkMapWorkers, 1); i++ {
 		wg.Add(1)
-		semaphore <- struct{}{}
-		go func(p *nbpeer.Peer) {
+		go func() {
 			defer wg.Done()
-			defer func() { <-semaphore }()
+			for p := range peersToUpdate {
+				postureChecks, err := am.getPeerPostureChecks(ctx, account.Id, p.ID)
+				if err != nil {
+					log.WithContext(ctx).Errorf("failed to send out updates to peers, failed to get peer: %s posture checks: %v", p.ID, err)
+					continue
+				}
 
-			postureChecks, err := am.getPeerPostureChecks(ctx, account.Id, p.ID)
-			if err != nil {
-				log.WithContext(ctx).Errorf("failed to send out updates to peers, failed to get peer: %s posture checks: %v", p.ID, err)
-				return
+				remotePeerNetworkMap := account.GetPeerNetworkMap(ctx, p.ID, customZone, approvedPeersMap, am.metrics.AccountManagerMetrics())
+				update := toSyncResponse(ctx, nil, p, nil, nil, remotePeerNetworkMap, am.GetDNSDomain(), postureChecks, dnsCache)
+				am.peersUpdateManager.SendUpdate(ctx, p.ID, &UpdateMessage{Update: update, NetworkMap: remotePeerNetworkMap})
 			}
+		}()
+	}
 
-			remotePeerNetworkMap := account.GetPeerNetworkMap(ctx, p.ID, customZone, approvedPeersMap, am.metrics.AccountManagerMetrics())
-			update := toSyncResponse(ctx, nil, p, nil, nil, remotePeerNetworkMap, am.GetDNSDomain(), postureChecks, dnsCache)
-			am.peersUpdateManager.SendUpdate(ctx, p.ID, &UpdateMessage{Update: update, NetworkMap: remotePeerNetworkMap})
-		}(peer)
+	for _, peer := range peers {
+		if !am.peersUpdateManager.HasChannel(peer.ID) {
+			log.WithContext(ctx).Tracef("peer %s doesn't have a channel, skipping network map update", peer.ID)
+			continue
+		}
+		peersToUpdate <- peer
 	}
+	close(peersToUpdate)
 
 	wg.Wait()
 }
@@ -1,10 +1,12 @@
 package peer
 
 import (
+	"maps"
 	"net"
 	"net/netip"
 	"slices"
 	"sort"
+	"strings"
 	"time"
 )
 
@@ -47,6 +49,10 @@ type Peer struct {
 	Ephemeral bool
 	// Geo location based on connection IP
 	Location Location `gorm:"embedded;embeddedPrefix:location_"`
+	// Tags are key/value labels assigned to the peer by an administrator or a setup key
+	Tags map[string]string `gorm:"serializer:json"`
+	// PostureStatus is the compliance status of the peer with the posture checks applied to it
+	PostureStatus PostureStatus `gorm:"serializer:json"`
 }
 
 type PeerStatus struct { //nolint:revive
@@ -87,6 +93,25 @@ type File struct {
 	ProcessIsRunning bool
 }
 
+// FileHash is the SHA-256 hash of a file on the system.
+type FileHash struct {
+	Path   string
+	SHA256 string
+}
+
+// FileContentMatch is the result of matching the content of a file on the system against a pattern.
+type FileContentMatch struct {
+	Path    string
+	Pattern string
+	Matched bool
+}
+
+// DiskEncryptionVolume is the encryption state of a mounted volume.
+type DiskEncryptionVolume struct {
+	Path      string
+	Encrypted bool
+}
+
 // PeerSystemMeta is a metadata of a Peer machine system
 type PeerSystemMeta struct { //nolint:revive
 	Hostname           string
@@ -105,6 +130,16 @@ type PeerSystemMeta struct { //nolint:revive
 	SystemManufacturer string
 	Environment        Environment `gorm:"serializer:json"`
 	Files              []File      `gorm:"serializer:json"`
+	// Tags are key/value labels reported by the client, e.g. with the --tag flag
+	Tags map[string]string `gorm:"serializer:json"`
+	// DiskEncryption is the encryption state of the volumes mounted on the peer
+	DiskEncryption []DiskEncryptionVolume `gorm:"serializer:json"`
+	// FirewallEnabled indicates whether the host firewall of the peer is enabled
+	FirewallEnabled bool
+	// FileHashes are the hashes of the files requested by the posture checks
+	FileHashes []FileHash `gorm:"serializer:json"`
+	// FileContents are the results of the file content patterns requested by the posture checks
+	FileContents []FileContentMatch `gorm:"serializer:json"`
 }
 
 func (p PeerSystemMeta) isEqual(other PeerSystemMeta) bool {
@@ -134,6 +169,24 @@ func (p PeerSystemMeta) isEqual(other PeerSystemMeta) bool {
 		return false
 	}
 
+	if !maps.Equal(p.Tags, other.Tags) {
+		return false
+	}
+
+	diskEncryptionPath := func(volume DiskEncryptionVolume) string { return volume.Path }
+	if !slices.Equal(sortedByPath(p.DiskEncryption, diskEncryptionPath), sortedByPath(other.DiskEncryption, diskEncryptionPath)) {
+		return false
+	}
+
+	fileHashPath := func(hash FileHash) string { return hash.Path }
+	if !slices.Equal(sortedByPath(p.FileHashes, fileHashPath), sortedByPath(other.FileHashes, fileHashPath)) {
+		return false
+	}
+
+	if !slices.Equal(sortedFileContents(p.FileContents), sortedFileContents(other.FileContents)) {
+		return false
+	}
+
 	return p.Hostname == other.Hostname &&
 		p.GoOS == other.GoOS &&
 		p.Kernel == other.Kernel &&
@@ -148,7 +201,8 @@ func (p PeerSystemMeta) isEqual(other PeerSystemMeta) bool {
 		p.SystemProductName == other.SystemProductName &&
 		p.SystemManufacturer == other.SystemManufacturer &&
 		p.Environment.Cloud == other.Environment.Cloud &&
-		p.Environment.Platform == other.Environment.Platform
+		p.Environment.Platform == other.Environment.Platform &&
+		p.FirewallEnabled == other.FirewallEnabled
 }
 
 func (p PeerSystemMeta) isEmpty() bool {
@@ -168,7 +222,33 @@ func (p PeerSystemMeta) isEmpty() bool {
 		p.SystemManufacturer == "" &&
 		p.Environment.Cloud == "" &&
 		p.Environment.Platform == "" &&
-		len(p.Files) == 0
+		len(p.Files) == 0 &&
+		len(p.Tags) == 0 &&
+		len(p.DiskEncryption) == 0 &&
+		!p.FirewallEnabled &&
+		len(p.FileHashes) == 0 &&
+		len(p.FileContents) == 0
+}
+
+// sortedByPath returns a copy of the items sorted by path, the compared metas are left unchanged
+func sortedByPath[T any](items []T, path func(T) string) []T {
+	sorted := slices.Clone(items)
+	slices.SortFunc(sorted, func(a, b T) int {
+		return strings.Compare(path(a), path(b))
+	})
+	return sorted
+}
+
+// sortedFileContents returns a copy of the file content matches sorted by path and pattern
+func sortedFileContents(contents []FileContentMatch) []FileContentMatch {
+	sorted := slices.Clone(contents)
+	slices.SortFunc(sorted, func(a, b FileContentMatch) int {
+		if a.Path != b.Path {
+			return strings.Compare(a.Path, b.Path)
+		}
+		return strings.Compare(a.Pattern, b.Pattern)
+	})
+	return sorted
 }
 
 // AddedWithSSOLogin indicates whether this peer has been added with an SSO login by a user.
@@ -200,9 +280,17 @@ func (p *Peer) Copy() *Peer {
 		Ephemeral:                   p.Ephemeral,
 		Location:                    p.Location,
 		InactivityExpirationEnabled: p.InactivityExpirationEnabled,
+		Tags:                        maps.Clone(p.Tags),
+		PostureStatus:               p.PostureStatus.Copy(),
 	}
 }
 
+// GetTags returns the tags of the peer used by the tag selectors. Only the tags assigned on the management side
+// are returned, the tags reported by the client in the system meta can't be trusted to grant access.
+func (p *Peer) GetTags() map[string]string {
+	return maps.Clone(p.Tags)
+}
+
 // UpdateMetaIfNew updates peer's system metadata if new information is provided
 // returns true if meta was updated, false otherwise
 func (p *Peer) UpdateMetaIfNew(meta PeerSystemMeta) bool {
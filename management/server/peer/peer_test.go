@@ -83,3 +83,33 @@ func TestIsEqual(t *testing.T) {
 		t.Error("meta1 should be equal to meta2")
 	}
 }
+
+func TestIsEqual_DiskEncryption(t *testing.T) {
+	volumes := []DiskEncryptionVolume{{Path: "/home", Encrypted: true}, {Path: "/", Encrypted: true}}
+	meta1 := PeerSystemMeta{DiskEncryption: volumes}
+	meta2 := PeerSystemMeta{DiskEncryption: []DiskEncryptionVolume{{Path: "/", Encrypted: true}, {Path: "/home", Encrypted: true}}}
+
+	if !meta1.isEqual(meta2) {
+		t.Error("meta1 should be equal to meta2")
+	}
+	if volumes[0].Path != "/home" {
+		t.Error("compared disk encryption volumes shouldn't be sorted in place")
+	}
+}
+
+func TestIsEqual_FileChecks(t *testing.T) {
+	hashes := []FileHash{{Path: "/b", SHA256: "b"}, {Path: "/a", SHA256: "a"}}
+	contents := []FileContentMatch{{Path: "/a", Pattern: "y"}, {Path: "/a", Pattern: "x", Matched: true}}
+	meta1 := PeerSystemMeta{FileHashes: hashes, FileContents: contents}
+	meta2 := PeerSystemMeta{
+		FileHashes:   []FileHash{{Path: "/a", SHA256: "a"}, {Path: "/b", SHA256: "b"}},
+		FileContents: []FileContentMatch{{Path: "/a", Pattern: "x", Matched: true}, {Path: "/a", Pattern: "y"}},
+	}
+
+	if !meta1.isEqual(meta2) {
+		t.Error("meta1 should be equal to meta2")
+	}
+	if hashes[0].Path != "/b" || contents[0].Pattern != "y" {
+		t.Error("compared file checks shouldn't be sorted in place")
+	}
+}
@@ -0,0 +1,77 @@
+package peer
+
+import (
+	"slices"
+	"time"
+)
+
+// PostureState is the compliance state of a peer with the posture checks applied to it
+type PostureState string
+
+const (
+	// PostureStateCompliant indicates that the peer passes all the posture checks
+	PostureStateCompliant PostureState = "compliant"
+	// PostureStateGrace indicates that the peer fails posture checks but is still within their grace period
+	PostureStateGrace PostureState = "grace"
+	// PostureStateNonCompliant indicates that the peer fails posture checks and lost the access they grant
+	PostureStateNonCompliant PostureState = "non-compliant"
+)
+
+// PostureCheckStatus is the result of a posture check evaluated on the peer
+type PostureCheckStatus struct {
+	// PostureCheckID is the ID of the evaluated posture check
+	PostureCheckID string
+	// PostureCheckName is the name of the posture check at the time of the evaluation
+	PostureCheckName string
+	// State is the compliance state of the peer with the posture check
+	State PostureState
+	// Reasons are the failed checks with the reason of the failure
+	Reasons []string
+	// FailingSince is the time the peer started to fail the posture check, zero when the peer is compliant
+	FailingSince time.Time
+	// GraceExpiresAt is the time the grace period of the failing posture check expires, zero when not in grace
+	GraceExpiresAt time.Time
+}
+
+// PostureStatus is the compliance status of a peer with the posture checks applied to it
+type PostureStatus struct {
+	// State is the overall compliance state, the worst state of the evaluated posture checks
+	State PostureState
+	// Checks are the results of the evaluated posture checks
+	Checks []PostureCheckStatus
+	// UpdatedAt is the last time the compliance state changed
+	UpdatedAt time.Time
+}
+
+// Copy returns a copy of the posture status.
+func (s PostureStatus) Copy() PostureStatus {
+	checks := slices.Clone(s.Checks)
+	for i := range checks {
+		checks[i].Reasons = slices.Clone(checks[i].Reasons)
+	}
+	s.Checks = checks
+	return s
+}
+
+// GetCheck returns the status of the posture check with the given ID.
+func (s PostureStatus) GetCheck(postureCheckID string) (PostureCheckStatus, bool) {
+	for _, check := range s.Checks {
+		if check.PostureCheckID == postureCheckID {
+			return check, true
+		}
+	}
+	return PostureCheckStatus{}, false
+}
+
+// IsEqual checks if the states and the reasons of the posture statuses are equal, ignoring the update time.
+func (s PostureStatus) IsEqual(other PostureStatus) bool {
+	return s.State == other.State &&
+		slices.EqualFunc(s.Checks, other.Checks, func(check, oCheck PostureCheckStatus) bool {
+			return check.PostureCheckID == oCheck.PostureCheckID &&
+				check.PostureCheckName == oCheck.PostureCheckName &&
+				check.State == oCheck.State &&
+				check.FailingSince.Equal(oCheck.FailingSince) &&
+				check.GraceExpiresAt.Equal(oCheck.GraceExpiresAt) &&
+				slices.Equal(check.Reasons, oCheck.Reasons)
+		})
+}
@@ -0,0 +1,223 @@
+package peer
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	maxTagKeyLength   = 63
+	maxTagValueLength = 63
+)
+
+var (
+	tagKeyRegexp   = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._/-]*[A-Za-z0-9])?$`)
+	tagValueRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]*$`)
+)
+
+type selectorOperator string
+
+const (
+	selectorOpExists       selectorOperator = "exists"
+	selectorOpDoesNotExist selectorOperator = "!"
+	selectorOpEquals       selectorOperator = "="
+	selectorOpNotEquals    selectorOperator = "!="
+	selectorOpIn           selectorOperator = "in"
+	selectorOpNotIn        selectorOperator = "notin"
+)
+
+// TagSelector is a parsed tag selector expression. A selector is a comma separated list of requirements
+// that all have to be satisfied by the peer tags, e.g. "env=prod,role in (db,cache),!legacy".
+type TagSelector struct {
+	requirements []tagRequirement
+}
+
+type tagRequirement struct {
+	key      string
+	operator selectorOperator
+	values   []string
+}
+
+// ValidateTags checks that tag keys and values are well-formed
+func ValidateTags(tags map[string]string) error {
+	for key, value := range tags {
+		if err := validateTagKey(key); err != nil {
+			return err
+		}
+		if err := validateTagValue(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateTagKey(key string) error {
+	if len(key) > maxTagKeyLength || !tagKeyRegexp.MatchString(key) {
+		return fmt.Errorf("invalid tag key %q", key)
+	}
+	return nil
+}
+
+func validateTagValue(value string) error {
+	if len(value) > maxTagValueLength || !tagValueRegexp.MatchString(value) {
+		return fmt.Errorf("invalid tag value %q", value)
+	}
+	return nil
+}
+
+// ParseTagSelector parses a tag selector expression.
+// Supported requirements are "key", "!key", "key=value", "key==value", "key!=value",
+// "key in (v1,v2)" and "key notin (v1,v2)".
+func ParseTagSelector(expr string) (*TagSelector, error) {
+	parts, err := splitSelector(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := &TagSelector{}
+	for _, part := range parts {
+		requirement, err := parseTagRequirement(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag selector %q: %w", expr, err)
+		}
+		selector.requirements = append(selector.requirements, requirement)
+	}
+
+	return selector, nil
+}
+
+// Matches returns true if the given tags satisfy all requirements of the selector
+func (s *TagSelector) Matches(tags map[string]string) bool {
+	for _, requirement := range s.requirements {
+		if !requirement.matches(tags) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r tagRequirement) matches(tags map[string]string) bool {
+	value, exists := tags[r.key]
+	switch r.operator {
+	case selectorOpExists:
+		return exists
+	case selectorOpDoesNotExist:
+		return !exists
+	case selectorOpEquals, selectorOpIn:
+		return exists && slices.Contains(r.values, value)
+	case selectorOpNotEquals, selectorOpNotIn:
+		return !exists || !slices.Contains(r.values, value)
+	default:
+		return false
+	}
+}
+
+// splitSelector splits the expression on commas that are not enclosed in parentheses
+func splitSelector(expr string) ([]string, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, fmt.Errorf("tag selector is empty")
+	}
+
+	var parts []string
+	depth := 0
+	start := 0
+	for i, c := range expr {
+		switch c {
+		case '(':
+			depth++
+			if depth > 1 {
+				return nil, fmt.Errorf("invalid tag selector %q: nested parentheses", expr)
+			}
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("invalid tag selector %q: unbalanced parentheses", expr)
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(expr[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("invalid tag selector %q: unbalanced parentheses", expr)
+	}
+
+	return append(parts, strings.TrimSpace(expr[start:])), nil
+}
+
+func parseTagRequirement(expr string) (tagRequirement, error) {
+	if expr == "" {
+		return tagRequirement{}, fmt.Errorf("empty requirement")
+	}
+
+	if strings.HasPrefix(expr, "!") && !strings.Contains(expr, "=") {
+		key := strings.TrimSpace(expr[1:])
+		return tagRequirement{key: key, operator: selectorOpDoesNotExist}, validateTagKey(key)
+	}
+
+	for _, op := range []string{"!=", "==", "="} {
+		key, value, found := strings.Cut(expr, op)
+		if !found {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		if err := validateTagKey(key); err != nil {
+			return tagRequirement{}, err
+		}
+		if err := validateTagValue(value); err != nil {
+			return tagRequirement{}, err
+		}
+		operator := selectorOpEquals
+		if op == "!=" {
+			operator = selectorOpNotEquals
+		}
+		return tagRequirement{key: key, operator: operator, values: []string{value}}, nil
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) == 1 {
+		return tagRequirement{key: fields[0], operator: selectorOpExists}, validateTagKey(fields[0])
+	}
+
+	key, rest, _ := strings.Cut(expr, " ")
+	rest = strings.TrimSpace(rest)
+	var operator selectorOperator
+	switch {
+	case strings.HasPrefix(rest, string(selectorOpNotIn)):
+		operator = selectorOpNotIn
+	case strings.HasPrefix(rest, string(selectorOpIn)):
+		operator = selectorOpIn
+	default:
+		return tagRequirement{}, fmt.Errorf("unsupported requirement %q", expr)
+	}
+	if err := validateTagKey(key); err != nil {
+		return tagRequirement{}, err
+	}
+
+	set := strings.TrimSpace(strings.TrimPrefix(rest, string(operator)))
+	if !strings.HasPrefix(set, "(") || !strings.HasSuffix(set, ")") {
+		return tagRequirement{}, fmt.Errorf("expected a parenthesized value set in %q", expr)
+	}
+
+	var values []string
+	for _, value := range strings.Split(set[1:len(set)-1], ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if err := validateTagValue(value); err != nil {
+			return tagRequirement{}, err
+		}
+		values = append(values, value)
+	}
+	if len(values) == 0 {
+		return tagRequirement{}, fmt.Errorf("empty value set in %q", expr)
+	}
+
+	return tagRequirement{key: key, operator: operator, values: values}, nil
+}
@@ -0,0 +1,66 @@
+package peer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTagSelector(t *testing.T) {
+	tags := map[string]string{"env": "prod", "role": "db", "team": "infra"}
+
+	tt := []struct {
+		name        string
+		expr        string
+		expectError bool
+		matches     bool
+	}{
+		{name: "equality", expr: "env=prod", matches: true},
+		{name: "double equality", expr: "env == prod", matches: true},
+		{name: "inequality", expr: "env!=prod", matches: false},
+		{name: "inequality on missing key", expr: "zone!=eu", matches: true},
+		{name: "exists", expr: "team", matches: true},
+		{name: "does not exist", expr: "!legacy", matches: true},
+		{name: "does not exist on present key", expr: "!team", matches: false},
+		{name: "in", expr: "role in (db,cache)", matches: true},
+		{name: "notin", expr: "role notin (db, cache)", matches: false},
+		{name: "notin on missing key", expr: "zone notin (eu)", matches: true},
+		{name: "combined", expr: "env=prod,role in (db,cache),!legacy", matches: true},
+		{name: "combined not matching", expr: "env=prod,role in (web,cache)", matches: false},
+		{name: "empty", expr: " ", expectError: true},
+		{name: "empty requirement", expr: "env=prod,", expectError: true},
+		{name: "unbalanced parentheses", expr: "role in (db,cache", expectError: true},
+		{name: "missing value set", expr: "role in db", expectError: true},
+		{name: "empty value set", expr: "role in ()", expectError: true},
+		{name: "unsupported operator", expr: "role like db", expectError: true},
+		{name: "invalid key", expr: "-env=prod", expectError: true},
+		{name: "invalid value", expr: "env=prod stage", expectError: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			selector, err := ParseTagSelector(tc.expr)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.matches, selector.Matches(tags))
+		})
+	}
+}
+
+func TestValidateTags(t *testing.T) {
+	assert.NoError(t, ValidateTags(map[string]string{"env": "prod", "netbird.io/role": "db", "empty": ""}))
+	assert.Error(t, ValidateTags(map[string]string{"env prod": "prod"}))
+	assert.Error(t, ValidateTags(map[string]string{"env": "prod/eu"}))
+}
+
+func TestPeer_GetTags(t *testing.T) {
+	p := &Peer{
+		Meta: PeerSystemMeta{Tags: map[string]string{"env": "dev", "os": "linux"}},
+		Tags: map[string]string{"env": "prod"},
+	}
+	assert.Equal(t, map[string]string{"env": "prod"}, p.GetTags(), "reported tags shouldn't be returned")
+}
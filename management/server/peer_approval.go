@@ -0,0 +1,269 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/rbac"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// RejectedPeer is a peer rejected by an administrator while pending approval.
+// The WireGuard key of the peer is kept so the same machine can't enroll again and return to the approval queue.
+type RejectedPeer struct {
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `gorm:"primaryKey"`
+
+	// Key is the WireGuard public key of the rejected peer
+	Key string `gorm:"primaryKey"`
+
+	// Name of the rejected peer
+	Name string
+
+	// RejectedBy is the ID of the user who rejected the peer
+	RejectedBy string
+
+	// RejectedAt is the time the peer was rejected
+	RejectedAt time.Time
+}
+
+// GetPendingPeers returns the account peers waiting to be approved by an administrator, the oldest first.
+func (am *DefaultAccountManager) GetPendingPeers(ctx context.Context, accountID, userID string) ([]*nbpeer.Peer, error) {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourcePeers, rbac.OperationRead) {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	peers, err := am.Store.GetAccountPeers(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingPeers := make([]*nbpeer.Peer, 0)
+	for _, peer := range peers {
+		if isPeerPendingApproval(peer) {
+			pendingPeers = append(pendingPeers, peer)
+		}
+	}
+
+	sort.SliceStable(pendingPeers, func(i, j int) bool {
+		return pendingPeers[i].CreatedAt.Before(pendingPeers[j].CreatedAt)
+	})
+
+	return pendingPeers, nil
+}
+
+// ApprovePeers approves the pending peers so they receive their network map and can connect to other peers.
+// No peer is approved if one of them isn't pending.
+func (am *DefaultAccountManager) ApprovePeers(ctx context.Context, accountID, userID string, peerIDs []string) error {
+	if err := am.validatePeerApprovalRequest(ctx, accountID, userID, rbac.OperationUpdate, peerIDs); err != nil {
+		return err
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	var approvedPeers []*nbpeer.Peer
+
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		peers, err := getPendingPeersByIDs(ctx, transaction, accountID, peerIDs)
+		if err != nil {
+			return err
+		}
+
+		for _, peer := range peers {
+			peerStatus := *peer.Status
+			peerStatus.RequiresApproval = false
+			if err = transaction.SavePeerStatus(accountID, peer.ID, peerStatus); err != nil {
+				return err
+			}
+		}
+
+		if err = transaction.IncrementNetworkSerial(ctx, LockingStrengthUpdate, accountID); err != nil {
+			return err
+		}
+
+		approvedPeers = peers
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, peer := range approvedPeers {
+		am.StoreEvent(ctx, userID, peer.ID, accountID, activity.PeerApproved, peer.EventMeta(am.GetDNSDomain()))
+	}
+
+	am.updateAccountPeers(ctx, accountID)
+
+	return nil
+}
+
+// RejectPeers deletes the pending peers and blocks their WireGuard keys, so the rejected machines can't enroll again.
+// No peer is rejected if one of them isn't pending.
+func (am *DefaultAccountManager) RejectPeers(ctx context.Context, accountID, userID string, peerIDs []string) error {
+	if err := am.validatePeerApprovalRequest(ctx, accountID, userID, rbac.OperationDelete, peerIDs); err != nil {
+		return err
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	var pendingPeerIDs []string
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		peers, err := getPendingPeersByIDs(ctx, transaction, accountID, peerIDs)
+		if err != nil {
+			return err
+		}
+
+		rejectedAt := time.Now().UTC()
+		for _, peer := range peers {
+			err = transaction.SaveRejectedPeer(ctx, LockingStrengthUpdate, &RejectedPeer{
+				AccountID:  accountID,
+				Key:        peer.Key,
+				Name:       peer.Name,
+				RejectedBy: userID,
+				RejectedAt: rejectedAt,
+			})
+			if err != nil {
+				return err
+			}
+			pendingPeerIDs = append(pendingPeerIDs, peer.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	if err = am.deletePeers(ctx, account, pendingPeerIDs, userID, activity.PeerRejected); err != nil {
+		return err
+	}
+
+	// pending peers are not part of the network maps, the other peers don't need an update
+	return am.Store.SaveAccount(ctx, account)
+}
+
+// validatePeerApprovalRequest checks that the user can approve or reject peers of the account.
+func (am *DefaultAccountManager) validatePeerApprovalRequest(ctx context.Context, accountID, userID string, operation rbac.Operation, peerIDs []string) error {
+	if len(peerIDs) == 0 {
+		return status.Errorf(status.InvalidArgument, "at least one peer ID should be provided")
+	}
+
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.AccountID != accountID {
+		return status.NewUserNotPartOfAccountError()
+	}
+
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourcePeers, operation) {
+		return status.NewAdminPermissionError()
+	}
+
+	return nil
+}
+
+// mergeExtraSettings returns a copy of the current extra settings with the peer approval settings of the update.
+// The approval rules are only replaced when provided and the settings not managed by the update, like the
+// integrated validator groups, are kept.
+func mergeExtraSettings(current, update *account.ExtraSettings) *account.ExtraSettings {
+	if update == nil {
+		return current
+	}
+
+	if current == nil {
+		return update
+	}
+
+	merged := current.Copy()
+	merged.PeerApprovalEnabled = update.PeerApprovalEnabled
+	if update.PeerApprovalRules != nil {
+		merged.PeerApprovalRules = update.PeerApprovalRules
+	}
+	if update.IntegratedValidatorGroups != nil {
+		merged.IntegratedValidatorGroups = update.IntegratedValidatorGroups
+	}
+	return merged
+}
+
+// validatePeerApprovalRules checks the approval rules and that their setup keys exist in the account.
+func validatePeerApprovalRules(ctx context.Context, store Store, accountID string, extra *account.ExtraSettings) error {
+	if extra == nil {
+		return nil
+	}
+
+	for _, rule := range extra.PeerApprovalRules {
+		if err := rule.Validate(); err != nil {
+			return status.Errorf(status.InvalidArgument, "%s", err.Error())
+		}
+
+		if rule.SetupKeyID == "" {
+			continue
+		}
+
+		if _, err := store.GetSetupKeyByID(ctx, LockingStrengthShare, accountID, rule.SetupKeyID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// approveAllPendingPeers approves the pending peers of the account when the peer approval is disabled.
+// It returns the approved peers, the account has to be saved by the caller.
+func approveAllPendingPeers(account *Account) []*nbpeer.Peer {
+	var approvedPeers []*nbpeer.Peer
+	for _, peer := range account.Peers {
+		if isPeerPendingApproval(peer) {
+			peer.Status.RequiresApproval = false
+			approvedPeers = append(approvedPeers, peer)
+		}
+	}
+
+	if len(approvedPeers) > 0 {
+		account.Network.IncSerial()
+	}
+	return approvedPeers
+}
+
+// getPendingPeersByIDs returns the peers with the given IDs, failing if one of them doesn't exist or isn't pending.
+func getPendingPeersByIDs(ctx context.Context, store Store, accountID string, peerIDs []string) ([]*nbpeer.Peer, error) {
+	peers := make([]*nbpeer.Peer, 0, len(peerIDs))
+	for _, peerID := range slices.Compact(slices.Sorted(slices.Values(peerIDs))) {
+		peer, err := store.GetPeerByID(ctx, LockingStrengthUpdate, accountID, peerID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isPeerPendingApproval(peer) {
+			return nil, status.Errorf(status.PreconditionFailed, "peer %s is not pending approval", peerID)
+		}
+		peers = append(peers, peer)
+	}
+	return peers, nil
+}
+
+func isPeerPendingApproval(peer *nbpeer.Peer) bool {
+	return peer.Status != nil && peer.Status.RequiresApproval
+}
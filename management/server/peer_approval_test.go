@@ -0,0 +1,278 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+func TestExtraSettings_PeerRequiresApproval(t *testing.T) {
+	extra := &account.ExtraSettings{
+		PeerApprovalEnabled: true,
+		PeerApprovalRules: []account.PeerApprovalRule{
+			{SetupKeyID: "key1", SourceRanges: []string{"203.0.113.0/24"}},
+			{SourceRanges: []string{"198.51.100.0/24"}},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		extra        *account.ExtraSettings
+		setupKeyID   string
+		connectionIP string
+		expected     bool
+	}{
+		{
+			name:         "approval disabled",
+			extra:        &account.ExtraSettings{},
+			connectionIP: "192.0.2.1",
+		},
+		{
+			name:         "no extra settings",
+			connectionIP: "192.0.2.1",
+		},
+		{
+			name:         "matching setup key and source range",
+			extra:        extra,
+			setupKeyID:   "key1",
+			connectionIP: "203.0.113.10",
+		},
+		{
+			name:         "matching setup key from another range",
+			extra:        extra,
+			setupKeyID:   "key1",
+			connectionIP: "192.0.2.1",
+			expected:     true,
+		},
+		{
+			name:         "other setup key from the setup key range",
+			extra:        extra,
+			setupKeyID:   "key2",
+			connectionIP: "203.0.113.10",
+			expected:     true,
+		},
+		{
+			name:         "any peer from the source range",
+			extra:        extra,
+			connectionIP: "::ffff:198.51.100.10",
+		},
+		{
+			name:     "unknown connection IP",
+			extra:    extra,
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.extra.PeerRequiresApproval(tt.setupKeyID, net.ParseIP(tt.connectionIP)))
+		})
+	}
+}
+
+func TestMergeExtraSettings(t *testing.T) {
+	current := &account.ExtraSettings{
+		PeerApprovalEnabled:       true,
+		PeerApprovalRules:         []account.PeerApprovalRule{{SetupKeyID: "key1"}},
+		IntegratedValidatorGroups: []string{"group1"},
+	}
+
+	t.Run("no update keeps the current settings", func(t *testing.T) {
+		assert.Equal(t, current, mergeExtraSettings(current, nil))
+	})
+
+	t.Run("no current settings", func(t *testing.T) {
+		update := &account.ExtraSettings{PeerApprovalEnabled: true}
+		assert.Equal(t, update, mergeExtraSettings(nil, update))
+	})
+
+	t.Run("rules and validator groups not provided are kept", func(t *testing.T) {
+		merged := mergeExtraSettings(current, &account.ExtraSettings{})
+		assert.False(t, merged.PeerApprovalEnabled)
+		assert.Equal(t, current.PeerApprovalRules, merged.PeerApprovalRules)
+		assert.Equal(t, current.IntegratedValidatorGroups, merged.IntegratedValidatorGroups)
+		assert.True(t, current.PeerApprovalEnabled, "current settings shouldn't be modified")
+	})
+
+	t.Run("provided rules replace the current ones", func(t *testing.T) {
+		merged := mergeExtraSettings(current, &account.ExtraSettings{
+			PeerApprovalEnabled: true,
+			PeerApprovalRules:   []account.PeerApprovalRule{},
+		})
+		assert.Empty(t, merged.PeerApprovalRules)
+		assert.Equal(t, current.IntegratedValidatorGroups, merged.IntegratedValidatorGroups)
+	})
+}
+
+func TestDefaultAccountManager_PeerApproval(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	acc, err := createAccount(manager, "test_account", userID, "")
+	require.NoError(t, err)
+
+	setupKey, err := manager.CreateSetupKey(context.Background(), acc.Id, "key", SetupKeyReusable, time.Hour, nil, 0, userID, false)
+	require.NoError(t, err)
+
+	updateApprovalSettings := func(t *testing.T, extra *account.ExtraSettings) error {
+		t.Helper()
+		settings, err := manager.Store.GetAccountSettings(context.Background(), LockingStrengthShare, acc.Id)
+		require.NoError(t, err)
+		settings.Extra = extra
+		_, err = manager.UpdateAccountSettings(context.Background(), acc.Id, userID, settings)
+		return err
+	}
+
+	addPeer := func(t *testing.T, hostname, connectionIP string) *nbpeer.Peer {
+		t.Helper()
+		key, err := wgtypes.GeneratePrivateKey()
+		require.NoError(t, err)
+
+		peer, _, _, err := manager.AddPeer(context.Background(), setupKey.Key, "", &nbpeer.Peer{
+			Key:      key.PublicKey().String(),
+			Meta:     nbpeer.PeerSystemMeta{Hostname: hostname},
+			Location: nbpeer.Location{ConnectionIP: net.ParseIP(connectionIP)},
+		})
+		require.NoError(t, err)
+		return peer
+	}
+
+	t.Run("approval rules with unknown setup keys are rejected", func(t *testing.T) {
+		err := updateApprovalSettings(t, &account.ExtraSettings{
+			PeerApprovalEnabled: true,
+			PeerApprovalRules:   []account.PeerApprovalRule{{SetupKeyID: "unknown"}},
+		})
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.NotFound, sErr.Type())
+	})
+
+	t.Run("approval rules with invalid source ranges are rejected", func(t *testing.T) {
+		err := updateApprovalSettings(t, &account.ExtraSettings{
+			PeerApprovalEnabled: true,
+			PeerApprovalRules:   []account.PeerApprovalRule{{SourceRanges: []string{"203.0.113.0"}}},
+		})
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.InvalidArgument, sErr.Type())
+	})
+
+	require.NoError(t, updateApprovalSettings(t, &account.ExtraSettings{
+		PeerApprovalEnabled: true,
+		PeerApprovalRules:   []account.PeerApprovalRule{{SetupKeyID: setupKey.Id, SourceRanges: []string{"203.0.113.0/24"}}},
+	}))
+
+	approvedPeer := addPeer(t, "approved", "203.0.113.10")
+	pendingPeer1 := addPeer(t, "pending-1", "192.0.2.1")
+	pendingPeer2 := addPeer(t, "pending-2", "192.0.2.2")
+	pendingPeer3 := addPeer(t, "pending-3", "192.0.2.3")
+
+	t.Run("peers not matching the approval rules are pending", func(t *testing.T) {
+		assert.False(t, approvedPeer.Status.RequiresApproval)
+		assert.True(t, pendingPeer1.Status.RequiresApproval)
+
+		pendingPeers, err := manager.GetPendingPeers(context.Background(), acc.Id, userID)
+		require.NoError(t, err)
+		require.Len(t, pendingPeers, 3)
+		assert.Equal(t, pendingPeer1.ID, pendingPeers[0].ID)
+
+		account, err := manager.Store.GetAccount(context.Background(), acc.Id)
+		require.NoError(t, err)
+		validatedPeers, err := manager.GetValidatedPeers(account)
+		require.NoError(t, err)
+		assert.Contains(t, validatedPeers, approvedPeer.ID)
+		assert.NotContains(t, validatedPeers, pendingPeer1.ID)
+	})
+
+	t.Run("pending peers receive an empty network map", func(t *testing.T) {
+		account, err := manager.Store.GetAccount(context.Background(), acc.Id)
+		require.NoError(t, err)
+
+		_, networkMap, _, err := manager.SyncPeer(context.Background(), PeerSync{WireGuardPubKey: pendingPeer1.Key}, account)
+		require.NoError(t, err)
+		assert.Empty(t, networkMap.Peers)
+
+		_, networkMap, _, err = manager.SyncPeer(context.Background(), PeerSync{WireGuardPubKey: approvedPeer.Key}, account)
+		require.NoError(t, err)
+		for _, peer := range networkMap.Peers {
+			assert.NotEqual(t, pendingPeer1.ID, peer.ID, "pending peers shouldn't be part of the network map")
+		}
+	})
+
+	t.Run("no peer is approved if one of them isn't pending", func(t *testing.T) {
+		err := manager.ApprovePeers(context.Background(), acc.Id, userID, []string{pendingPeer1.ID, approvedPeer.ID})
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.PreconditionFailed, sErr.Type())
+
+		peer, err := manager.Store.GetPeerByID(context.Background(), LockingStrengthShare, acc.Id, pendingPeer1.ID)
+		require.NoError(t, err)
+		assert.True(t, peer.Status.RequiresApproval)
+	})
+
+	t.Run("approve pending peers", func(t *testing.T) {
+		err := manager.ApprovePeers(context.Background(), acc.Id, userID, []string{pendingPeer1.ID})
+		require.NoError(t, err)
+
+		account, err := manager.Store.GetAccount(context.Background(), acc.Id)
+		require.NoError(t, err)
+		validatedPeers, err := manager.GetValidatedPeers(account)
+		require.NoError(t, err)
+		assert.Contains(t, validatedPeers, pendingPeer1.ID)
+	})
+
+	t.Run("reject pending peers", func(t *testing.T) {
+		err := manager.RejectPeers(context.Background(), acc.Id, userID, []string{pendingPeer2.ID})
+		require.NoError(t, err)
+
+		_, err = manager.Store.GetPeerByID(context.Background(), LockingStrengthShare, acc.Id, pendingPeer2.ID)
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.NotFound, sErr.Type())
+
+		assert.Eventually(t, func() bool {
+			events, err := manager.GetEvents(context.Background(), acc.Id, userID, activity.Filter{})
+			if err != nil {
+				return false
+			}
+			for _, event := range events {
+				if event.TargetID == pendingPeer2.ID && event.Activity == activity.PeerRejected {
+					return true
+				}
+			}
+			return false
+		}, time.Second, 10*time.Millisecond, "the rejection should be recorded as an activity event")
+	})
+
+	t.Run("rejected peers can't enroll again", func(t *testing.T) {
+		_, _, _, err := manager.AddPeer(context.Background(), setupKey.Key, "", &nbpeer.Peer{
+			Key:  pendingPeer2.Key,
+			Meta: nbpeer.PeerSystemMeta{Hostname: "pending-2"},
+		})
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.PermissionDenied, sErr.Type())
+	})
+
+	t.Run("disabling the approval approves the pending peers", func(t *testing.T) {
+		require.NoError(t, updateApprovalSettings(t, &account.ExtraSettings{}))
+
+		pendingPeers, err := manager.GetPendingPeers(context.Background(), acc.Id, userID)
+		require.NoError(t, err)
+		assert.Empty(t, pendingPeers)
+
+		peer, err := manager.Store.GetPeerByID(context.Background(), LockingStrengthShare, acc.Id, pendingPeer3.ID)
+		require.NoError(t, err)
+		assert.False(t, peer.Status.RequiresApproval)
+	})
+}
@@ -0,0 +1,313 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/rbac"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// GetPeerPostureStatus returns the compliance status of the peer with the posture checks applied to it.
+func (am *DefaultAccountManager) GetPeerPostureStatus(ctx context.Context, accountID, peerID, userID string) (*nbpeer.PostureStatus, error) {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	peer, err := am.Store.GetPeerByID(ctx, LockingStrengthShare, accountID, peerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsRegularUser() && peer.UserID != userID && !am.hasPermission(ctx, user, rbac.ResourcePeers, rbac.OperationRead) {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	postureStatus := peer.PostureStatus.Copy()
+	return &postureStatus, nil
+}
+
+// updatePeerPostureStatus evaluates the posture checks applied to the peer and saves its compliance status if it changed.
+// Transitions between the compliance states are stored as activity events. It returns true if the status changed.
+// The status is evaluated against the one stored and saved in the same transaction, so concurrent evaluations of
+// the peer, e.g. on sync and after a policy change, don't overwrite each other or store the same transition twice.
+func (am *DefaultAccountManager) updatePeerPostureStatus(ctx context.Context, account *Account, peer *nbpeer.Peer) (bool, error) {
+	postureChecks := account.getPeerSourcePostureChecks(peer)
+
+	var changed bool
+	var previousState nbpeer.PostureState
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		storedPeer, err := transaction.GetPeerByID(ctx, LockingStrengthUpdate, account.Id, peer.ID)
+		if err != nil {
+			return err
+		}
+		previousState = storedPeer.PostureStatus.State
+
+		now := time.Now().UTC()
+		postureStatus := evaluatePeerPostureStatus(ctx, storedPeer, postureChecks, now)
+		if postureStatus.IsEqual(storedPeer.PostureStatus) {
+			peer.PostureStatus = storedPeer.PostureStatus
+			return nil
+		}
+		postureStatus.UpdatedAt = now
+
+		if err = transaction.SavePeerPostureStatus(ctx, LockingStrengthUpdate, account.Id, peer.ID, postureStatus); err != nil {
+			return err
+		}
+
+		peer.PostureStatus = postureStatus
+		changed = true
+		return nil
+	})
+	if err != nil || !changed {
+		return false, err
+	}
+
+	if previousState != peer.PostureStatus.State && (previousState != "" || peer.PostureStatus.State != nbpeer.PostureStateCompliant) {
+		am.storePeerPostureEvent(ctx, account.Id, peer)
+	}
+
+	return true, nil
+}
+
+// updateAccountPostureStatuses evaluates the posture checks of all the account peers and saves the changed statuses.
+// It returns true if the status of any peer changed.
+func (am *DefaultAccountManager) updateAccountPostureStatuses(ctx context.Context, account *Account) bool {
+	var changed bool
+	for _, peer := range account.Peers {
+		peerChanged, err := am.updatePeerPostureStatus(ctx, account, peer)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed to update posture status of peer %s: %v", peer.ID, err)
+			continue
+		}
+		changed = changed || peerChanged
+	}
+	return changed
+}
+
+// checkAccountPostureStatuses re-evaluates the posture checks of the account peers after changes to the policies or
+// the posture checks, so the grace periods of newly failing checks start right away.
+func (am *DefaultAccountManager) checkAccountPostureStatuses(ctx context.Context, accountID string) {
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed getting account %s to evaluate peers posture: %v", accountID, err)
+		return
+	}
+
+	if am.updateAccountPostureStatuses(ctx, account) {
+		am.schedulePostureGraceExpiration(ctx, account)
+	}
+}
+
+func (am *DefaultAccountManager) storePeerPostureEvent(ctx context.Context, accountID string, peer *nbpeer.Peer) {
+	var action activity.Activity
+	switch peer.PostureStatus.State {
+	case nbpeer.PostureStateCompliant:
+		action = activity.PeerPostureCompliant
+	case nbpeer.PostureStateGrace:
+		action = activity.PeerPostureGracePeriodStarted
+	default:
+		action = activity.PeerPostureNonCompliant
+	}
+
+	meta := peer.EventMeta(am.GetDNSDomain())
+	var reasons []string
+	for _, check := range peer.PostureStatus.Checks {
+		for _, reason := range check.Reasons {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", check.PostureCheckName, reason))
+		}
+	}
+	if len(reasons) > 0 {
+		meta["reasons"] = reasons
+	}
+
+	am.StoreEvent(ctx, activity.SystemInitiator, peer.ID, accountID, action, meta)
+}
+
+// postureGraceExpirationJob re-evaluates the posture of the account peers once their grace periods expire and
+// sends the updated network maps.
+func (am *DefaultAccountManager) postureGraceExpirationJob(ctx context.Context, accountID string) func() (time.Duration, bool) {
+	return func() (time.Duration, bool) {
+		unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+		account, err := am.Store.GetAccount(ctx, accountID)
+		if err != nil {
+			unlock()
+			log.WithContext(ctx).Errorf("failed getting account %s to expire posture grace periods: %v", accountID, err)
+			return 0, false
+		}
+
+		changed := am.updateAccountPostureStatuses(ctx, account)
+		if changed {
+			if err = am.Store.IncrementNetworkSerial(ctx, LockingStrengthUpdate, accountID); err != nil {
+				log.WithContext(ctx).Errorf("failed to increment network serial on posture grace expiration for account %s: %v", accountID, err)
+			}
+		}
+		unlock()
+
+		if changed {
+			log.WithContext(ctx).Debugf("posture grace period expired for account %s, updating peers", accountID)
+			am.updateAccountPeers(ctx, accountID)
+		}
+
+		return account.getNextPostureGraceExpiration(time.Now().UTC())
+	}
+}
+
+// checkAndSchedulePostureGraceExpiration schedules the re-evaluation of the account peers posture for the next moment
+// when the grace period of a peer expires
+func (am *DefaultAccountManager) checkAndSchedulePostureGraceExpiration(ctx context.Context, accountID string) {
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed getting account %s to schedule posture grace expiration: %v", accountID, err)
+		return
+	}
+
+	am.schedulePostureGraceExpiration(ctx, account)
+}
+
+// schedulePostureGraceExpiration schedules the re-evaluation of the account peers posture for the next moment when
+// the grace period of a peer expires
+func (am *DefaultAccountManager) schedulePostureGraceExpiration(ctx context.Context, account *Account) {
+	am.postureGraceExpiry.Cancel(ctx, []string{account.Id})
+	if nextRun, ok := account.getNextPostureGraceExpiration(time.Now().UTC()); ok {
+		go am.postureGraceExpiry.Schedule(ctx, nextRun, account.Id, am.postureGraceExpirationJob(ctx, account.Id))
+	}
+}
+
+// getNextPostureGraceExpiration returns the duration until the grace period of a peer of the account expires
+func (a *Account) getNextPostureGraceExpiration(now time.Time) (time.Duration, bool) {
+	var next time.Duration
+	var found bool
+	for _, peer := range a.Peers {
+		for _, check := range peer.PostureStatus.Checks {
+			if check.State != nbpeer.PostureStateGrace {
+				continue
+			}
+
+			in := check.GraceExpiresAt.Sub(now)
+			if in < time.Second {
+				in = time.Second
+			}
+			if !found || in < next {
+				next = in
+				found = true
+			}
+		}
+	}
+	return next, found
+}
+
+// getPeerSourcePostureChecks returns the posture checks of the enabled policies having the peer in the sources
+// of an enabled rule
+func (a *Account) getPeerSourcePostureChecks(peer *nbpeer.Peer) []*posture.Checks {
+	postureCheckIDs := make(map[string]struct{})
+	for _, policy := range a.Policies {
+		if !policy.Enabled || len(policy.SourcePostureChecks) == 0 {
+			continue
+		}
+
+		for _, rule := range policy.Rules {
+			if !rule.Enabled || !a.isPeerSelected(peer, rule.Sources, rule.SourceTagSelector) {
+				continue
+			}
+			for _, id := range policy.SourcePostureChecks {
+				postureCheckIDs[id] = struct{}{}
+			}
+			break
+		}
+	}
+
+	postureChecks := make([]*posture.Checks, 0, len(postureCheckIDs))
+	for _, postureCheck := range a.PostureChecks {
+		if _, ok := postureCheckIDs[postureCheck.ID]; ok {
+			postureChecks = append(postureChecks, postureCheck)
+		}
+	}
+	return postureChecks
+}
+
+// evaluatePeerPostureStatus runs the posture checks on the peer. A failing posture check is in the grace period
+// from the time the peer started failing it, which is kept from the previous status of the peer.
+func evaluatePeerPostureStatus(ctx context.Context, peer *nbpeer.Peer, postureChecks []*posture.Checks, now time.Time) nbpeer.PostureStatus {
+	postureStatus := nbpeer.PostureStatus{
+		State:     nbpeer.PostureStateCompliant,
+		UpdatedAt: peer.PostureStatus.UpdatedAt,
+	}
+
+	for _, postureCheck := range postureChecks {
+		checkStatus := nbpeer.PostureCheckStatus{
+			PostureCheckID:   postureCheck.ID,
+			PostureCheckName: postureCheck.Name,
+			State:            nbpeer.PostureStateCompliant,
+		}
+
+		for _, check := range postureCheck.GetChecks() {
+			isValid, err := check.Check(ctx, *peer)
+			if isValid {
+				continue
+			}
+
+			reason := check.Name() + " failed"
+			if err != nil {
+				reason = fmt.Sprintf("%s failed: %v", check.Name(), err)
+			}
+			checkStatus.Reasons = append(checkStatus.Reasons, reason)
+		}
+
+		if len(checkStatus.Reasons) > 0 {
+			checkStatus.State = nbpeer.PostureStateNonCompliant
+			checkStatus.FailingSince = now
+			if previous, ok := peer.PostureStatus.GetCheck(postureCheck.ID); ok && !previous.FailingSince.IsZero() {
+				checkStatus.FailingSince = previous.FailingSince
+			}
+
+			if graceExpiresAt := checkStatus.FailingSince.Add(postureCheck.GracePeriod); now.Before(graceExpiresAt) {
+				checkStatus.State = nbpeer.PostureStateGrace
+				checkStatus.GraceExpiresAt = graceExpiresAt
+			}
+		}
+
+		postureStatus.Checks = append(postureStatus.Checks, checkStatus)
+		if postureStateSeverity(checkStatus.State) > postureStateSeverity(postureStatus.State) {
+			postureStatus.State = checkStatus.State
+		}
+	}
+
+	return postureStatus
+}
+
+// isPeerInPostureGracePeriod checks if the peer started failing the posture check less than its grace period ago
+func isPeerInPostureGracePeriod(peer *nbpeer.Peer, postureChecks *posture.Checks, now time.Time) bool {
+	if postureChecks.GracePeriod <= 0 {
+		return false
+	}
+
+	checkStatus, ok := peer.PostureStatus.GetCheck(postureChecks.ID)
+	if !ok || checkStatus.FailingSince.IsZero() {
+		return false
+	}
+
+	return now.Before(checkStatus.FailingSince.Add(postureChecks.GracePeriod))
+}
+
+func postureStateSeverity(state nbpeer.PostureState) int {
+	switch state {
+	case nbpeer.PostureStateGrace:
+		return 1
+	case nbpeer.PostureStateNonCompliant:
+		return 2
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,258 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/posture"
+)
+
+func TestEvaluatePeerPostureStatus(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	firewallChecks := &posture.Checks{
+		ID:          "firewall",
+		Name:        "firewall",
+		GracePeriod: time.Hour,
+		Checks:      posture.ChecksDefinition{FirewallCheck: &posture.FirewallCheck{}},
+	}
+	encryptionChecks := &posture.Checks{
+		ID:     "encryption",
+		Name:   "encryption",
+		Checks: posture.ChecksDefinition{DiskEncryptionCheck: &posture.DiskEncryptionCheck{}},
+	}
+
+	t.Run("compliant peer", func(t *testing.T) {
+		peer := &nbpeer.Peer{Meta: nbpeer.PeerSystemMeta{FirewallEnabled: true}}
+
+		postureStatus := evaluatePeerPostureStatus(context.Background(), peer, []*posture.Checks{firewallChecks}, now)
+		assert.Equal(t, nbpeer.PostureStateCompliant, postureStatus.State)
+		require.Len(t, postureStatus.Checks, 1)
+		assert.Empty(t, postureStatus.Checks[0].Reasons)
+		assert.True(t, postureStatus.Checks[0].FailingSince.IsZero())
+	})
+
+	t.Run("failing peer enters the grace period", func(t *testing.T) {
+		peer := &nbpeer.Peer{}
+
+		postureStatus := evaluatePeerPostureStatus(context.Background(), peer, []*posture.Checks{firewallChecks}, now)
+		assert.Equal(t, nbpeer.PostureStateGrace, postureStatus.State)
+		require.Len(t, postureStatus.Checks, 1)
+		assert.Equal(t, []string{"FirewallCheck failed"}, postureStatus.Checks[0].Reasons)
+		assert.Equal(t, now, postureStatus.Checks[0].FailingSince)
+		assert.Equal(t, now.Add(time.Hour), postureStatus.Checks[0].GraceExpiresAt)
+	})
+
+	t.Run("grace period starts when the peer started failing", func(t *testing.T) {
+		peer := &nbpeer.Peer{PostureStatus: nbpeer.PostureStatus{
+			State: nbpeer.PostureStateGrace,
+			Checks: []nbpeer.PostureCheckStatus{
+				{PostureCheckID: "firewall", State: nbpeer.PostureStateGrace, FailingSince: now.Add(-2 * time.Hour)},
+			},
+		}}
+
+		postureStatus := evaluatePeerPostureStatus(context.Background(), peer, []*posture.Checks{firewallChecks}, now)
+		assert.Equal(t, nbpeer.PostureStateNonCompliant, postureStatus.State)
+		assert.Equal(t, now.Add(-2*time.Hour), postureStatus.Checks[0].FailingSince)
+		assert.True(t, postureStatus.Checks[0].GraceExpiresAt.IsZero())
+	})
+
+	t.Run("worst state of the posture checks", func(t *testing.T) {
+		peer := &nbpeer.Peer{Meta: nbpeer.PeerSystemMeta{GoOS: "linux"}}
+
+		postureStatus := evaluatePeerPostureStatus(context.Background(), peer, []*posture.Checks{firewallChecks, encryptionChecks}, now)
+		assert.Equal(t, nbpeer.PostureStateNonCompliant, postureStatus.State)
+		require.Len(t, postureStatus.Checks, 2)
+		assert.Equal(t, nbpeer.PostureStateGrace, postureStatus.Checks[0].State)
+		assert.Equal(t, nbpeer.PostureStateNonCompliant, postureStatus.Checks[1].State)
+	})
+
+	t.Run("grace period of the network map", func(t *testing.T) {
+		peer := &nbpeer.Peer{PostureStatus: nbpeer.PostureStatus{
+			Checks: []nbpeer.PostureCheckStatus{{PostureCheckID: "firewall", FailingSince: now.Add(-30 * time.Minute)}},
+		}}
+		assert.True(t, isPeerInPostureGracePeriod(peer, firewallChecks, now))
+		assert.False(t, isPeerInPostureGracePeriod(peer, firewallChecks, now.Add(time.Hour)))
+		assert.False(t, isPeerInPostureGracePeriod(peer, encryptionChecks, now))
+	})
+}
+
+func TestDefaultAccountManager_PeerPostureGracePeriod(t *testing.T) {
+	manager, account, peer1, peer2, _ := setupNetworkMapTest(t)
+	ctx := context.Background()
+
+	require.NoError(t, manager.DeletePolicy(ctx, account.Id, account.Policies[0].ID, userID))
+	require.NoError(t, manager.SaveGroups(ctx, account.Id, userID, []*nbgroup.Group{
+		{ID: "groupA", Name: "GroupA", Peers: []string{peer1.ID, peer2.ID}},
+	}))
+
+	postureChecks, err := manager.SavePostureChecks(ctx, account.Id, userID, &posture.Checks{
+		Name:        "firewall",
+		GracePeriod: time.Hour,
+		Checks:      posture.ChecksDefinition{FirewallCheck: &posture.FirewallCheck{}},
+	})
+	require.NoError(t, err)
+
+	_, err = manager.SavePolicy(ctx, account.Id, userID, &Policy{
+		Name:                "firewall required",
+		Enabled:             true,
+		SourcePostureChecks: []string{postureChecks.ID},
+		Rules: []*PolicyRule{
+			{
+				Name:          "firewall required",
+				Enabled:       true,
+				Action:        PolicyTrafficActionAccept,
+				Protocol:      PolicyRuleProtocolALL,
+				Sources:       []string{"groupA"},
+				Destinations:  []string{"groupA"},
+				Bidirectional: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	peerConnected := func(t *testing.T, peerID, remotePeerID string) bool {
+		t.Helper()
+		account, err := manager.Store.GetAccount(ctx, account.Id)
+		require.NoError(t, err)
+
+		validatedPeers, err := manager.GetValidatedPeers(account)
+		require.NoError(t, err)
+
+		networkMap := account.GetPeerNetworkMap(ctx, peerID, account.GetPeersCustomZone(ctx, manager.dnsDomain), validatedPeers, nil)
+		for _, peer := range networkMap.Peers {
+			if peer.ID == remotePeerID {
+				return true
+			}
+		}
+		return false
+	}
+
+	t.Run("failing peers keep the access during the grace period", func(t *testing.T) {
+		postureStatus, err := manager.GetPeerPostureStatus(ctx, account.Id, peer1.ID, userID)
+		require.NoError(t, err)
+		assert.Equal(t, nbpeer.PostureStateGrace, postureStatus.State)
+		require.Len(t, postureStatus.Checks, 1)
+		assert.Equal(t, postureChecks.ID, postureStatus.Checks[0].PostureCheckID)
+		assert.Equal(t, []string{"FirewallCheck failed"}, postureStatus.Checks[0].Reasons)
+
+		assert.True(t, peerConnected(t, peer1.ID, peer2.ID))
+	})
+
+	t.Run("peers lose the access once the grace period expires", func(t *testing.T) {
+		for _, peerID := range []string{peer1.ID, peer2.ID} {
+			peer, err := manager.Store.GetPeerByID(ctx, LockingStrengthShare, account.Id, peerID)
+			require.NoError(t, err)
+
+			postureStatus := peer.PostureStatus
+			postureStatus.Checks[0].FailingSince = time.Now().UTC().Add(-2 * time.Hour)
+			require.NoError(t, manager.Store.SavePeerPostureStatus(ctx, LockingStrengthUpdate, account.Id, peerID, postureStatus))
+		}
+
+		_, reschedule := manager.postureGraceExpirationJob(ctx, account.Id)()
+		assert.False(t, reschedule)
+
+		postureStatus, err := manager.GetPeerPostureStatus(ctx, account.Id, peer1.ID, userID)
+		require.NoError(t, err)
+		assert.Equal(t, nbpeer.PostureStateNonCompliant, postureStatus.State)
+
+		assert.False(t, peerConnected(t, peer1.ID, peer2.ID))
+
+		assert.Eventually(t, func() bool {
+			events, err := manager.eventStore.Get(ctx, account.Id, 0, 100, false)
+			if err != nil {
+				return false
+			}
+			for _, event := range events {
+				if event.Activity == activity.PeerPostureNonCompliant && event.TargetID == peer1.ID {
+					return true
+				}
+			}
+			return false
+		}, time.Second, 10*time.Millisecond, "the transition should be recorded as an activity event")
+	})
+
+	t.Run("peer becomes compliant once the check passes", func(t *testing.T) {
+		err := manager.SyncPeerMeta(ctx, peer1.Key, nbpeer.PeerSystemMeta{Hostname: peer1.Meta.Hostname, FirewallEnabled: true})
+		require.NoError(t, err)
+
+		postureStatus, err := manager.GetPeerPostureStatus(ctx, account.Id, peer1.ID, userID)
+		require.NoError(t, err)
+		assert.Equal(t, nbpeer.PostureStateCompliant, postureStatus.State)
+		assert.Empty(t, postureStatus.Checks[0].Reasons)
+	})
+
+	t.Run("concurrent evaluations store a single transition", func(t *testing.T) {
+		peer, err := manager.Store.GetPeerByID(ctx, LockingStrengthShare, account.Id, peer2.ID)
+		require.NoError(t, err)
+		peer.Meta.FirewallEnabled = true
+		require.NoError(t, manager.Store.SavePeer(ctx, account.Id, peer))
+
+		var changes atomic.Int32
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			account, err := manager.Store.GetAccount(ctx, account.Id)
+			require.NoError(t, err)
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				changed, err := manager.updatePeerPostureStatus(ctx, account, account.Peers[peer2.ID])
+				assert.NoError(t, err)
+				if changed {
+					changes.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, int32(1), changes.Load(), "the status should be changed once")
+
+		compliantEvents := func() int {
+			events, err := manager.eventStore.Get(ctx, account.Id, 0, 100, false)
+			require.NoError(t, err)
+			var count int
+			for _, event := range events {
+				if event.Activity == activity.PeerPostureCompliant && event.TargetID == peer2.ID {
+					count++
+				}
+			}
+			return count
+		}
+		assert.Eventually(t, func() bool { return compliantEvents() == 1 }, time.Second, 10*time.Millisecond)
+		time.Sleep(100 * time.Millisecond)
+		assert.Equal(t, 1, compliantEvents(), "the transition should be recorded once")
+	})
+}
+
+func TestToProtocolPostureStatus(t *testing.T) {
+	assert.Nil(t, toProtocolPostureStatus(nbpeer.PostureStatus{}), "peers never evaluated don't have a status")
+
+	graceExpiresAt := time.Now().Add(time.Hour).UTC()
+	protoStatus := toProtocolPostureStatus(nbpeer.PostureStatus{
+		State: nbpeer.PostureStateGrace,
+		Checks: []nbpeer.PostureCheckStatus{
+			{PostureCheckID: "version", PostureCheckName: "version", State: nbpeer.PostureStateCompliant},
+			{
+				PostureCheckID:   "firewall",
+				PostureCheckName: "firewall",
+				State:            nbpeer.PostureStateGrace,
+				Reasons:          []string{"FirewallCheck failed"},
+				GraceExpiresAt:   graceExpiresAt,
+			},
+		},
+	})
+
+	require.Len(t, protoStatus.GetChecks(), 2)
+	assert.Equal(t, string(nbpeer.PostureStateGrace), protoStatus.GetState())
+	assert.Nil(t, protoStatus.GetChecks()[0].GetGraceExpiresAt())
+	assert.Equal(t, []string{"FirewallCheck failed"}, protoStatus.GetChecks()[1].GetReasons())
+	assert.Equal(t, graceExpiresAt, protoStatus.GetChecks()[1].GetGraceExpiresAt().AsTime())
+}
@@ -3,9 +3,13 @@ package server
 import (
 	"context"
 	_ "embed"
+	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-version"
 	"github.com/netbirdio/netbird/management/proto"
 	"github.com/rs/xid"
 	log "github.com/sirupsen/logrus"
@@ -14,6 +18,8 @@ import (
 	nbgroup "github.com/netbirdio/netbird/management/server/group"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/rbac"
+	nbservice "github.com/netbirdio/netbird/management/server/service"
 	"github.com/netbirdio/netbird/management/server/status"
 )
 
@@ -34,6 +40,9 @@ const (
 	PolicyTrafficActionAccept = PolicyTrafficActionType("accept")
 	// PolicyTrafficActionDrop indicates that the traffic is dropped
 	PolicyTrafficActionDrop = PolicyTrafficActionType("drop")
+	// PolicyTrafficActionAudit indicates that the traffic is logged and counted by the peers instead of being dropped.
+	// It is used to preview the effect of a drop rule before enforcing it.
+	PolicyTrafficActionAudit = PolicyTrafficActionType("audit")
 )
 
 const (
@@ -108,6 +117,12 @@ type PolicyRule struct {
 	// Sources policy source groups
 	Sources []string `gorm:"serializer:json"`
 
+	// DestinationTagSelector selects destination peers by their tags, e.g. "env=prod,role in (db,cache)"
+	DestinationTagSelector string
+
+	// SourceTagSelector selects source peers by their tags
+	SourceTagSelector string
+
 	// Bidirectional define if the rule is applicable in both directions, sources, and destinations
 	Bidirectional bool
 
@@ -119,6 +134,10 @@ type PolicyRule struct {
 
 	// PortRanges a list of port ranges.
 	PortRanges []RulePortRange `gorm:"serializer:json"`
+
+	// Services are ID references to the account services catalog. When set, the rule protocol, ports and
+	// port ranges are taken from the services instead of the rule itself.
+	Services []string `gorm:"serializer:json"`
 }
 
 // Copy returns a copy of a policy rule
@@ -136,11 +155,16 @@ func (pm *PolicyRule) Copy() *PolicyRule {
 		Protocol:      pm.Protocol,
 		Ports:         make([]string, len(pm.Ports)),
 		PortRanges:    make([]RulePortRange, len(pm.PortRanges)),
+		Services:      make([]string, len(pm.Services)),
+
+		DestinationTagSelector: pm.DestinationTagSelector,
+		SourceTagSelector:      pm.SourceTagSelector,
 	}
 	copy(rule.Destinations, pm.Destinations)
 	copy(rule.Sources, pm.Sources)
 	copy(rule.Ports, pm.Ports)
 	copy(rule.PortRanges, pm.PortRanges)
+	copy(rule.Services, pm.Services)
 	return rule
 }
 
@@ -166,6 +190,9 @@ type Policy struct {
 
 	// SourcePostureChecks are ID references to Posture checks for policy source groups
 	SourcePostureChecks []string `gorm:"serializer:json"`
+
+	// Schedule defines the time windows when the policy is applied. Nil means the policy is always applied.
+	Schedule *PolicySchedule `gorm:"serializer:json"`
 }
 
 // Copy returns a copy of the policy.
@@ -178,6 +205,7 @@ func (p *Policy) Copy() *Policy {
 		Enabled:             p.Enabled,
 		Rules:               make([]*PolicyRule, len(p.Rules)),
 		SourcePostureChecks: make([]string, len(p.SourcePostureChecks)),
+		Schedule:            p.Schedule.Copy(),
 	}
 	for i, r := range p.Rules {
 		c.Rules[i] = r.Copy()
@@ -205,6 +233,21 @@ func (p *Policy) UpgradeAndFix() {
 	}
 }
 
+// IsActiveAt returns true if the policy is enabled and its schedule allows it to be applied at the given time
+func (p *Policy) IsActiveAt(t time.Time) bool {
+	return p.Enabled && p.Schedule.IsActive(t)
+}
+
+// hasTagSelectors returns true if any of the policy rules selects peers by tags
+func (p *Policy) hasTagSelectors() bool {
+	for _, rule := range p.Rules {
+		if rule.SourceTagSelector != "" || rule.DestinationTagSelector != "" {
+			return true
+		}
+	}
+	return false
+}
+
 // ruleGroups returns a list of all groups referenced in the policy's rules,
 // including sources and destinations.
 func (p *Policy) ruleGroups() []string {
@@ -217,6 +260,16 @@ func (p *Policy) ruleGroups() []string {
 	return groups
 }
 
+// usesService returns true if any of the policy rules references the service
+func (p *Policy) usesService(serviceID string) bool {
+	for _, rule := range p.Rules {
+		if slices.Contains(rule.Services, serviceID) {
+			return true
+		}
+	}
+	return false
+}
+
 // FirewallRule is a rule of the firewall.
 type FirewallRule struct {
 	// PeerIP of the peer
@@ -233,46 +286,24 @@ type FirewallRule struct {
 
 	// Port of the traffic
 	Port string
+
+	// PortRange of the traffic, set instead of Port when the policy rule allows a range of ports
+	PortRange RulePortRange
+
+	// PolicyID of the audit rule, used by the peers to report the matched traffic
+	PolicyID string
+}
+
+// IsInbound returns true if the rule applies to the traffic coming to the peer
+func (r *FirewallRule) IsInbound() bool {
+	return r.Direction == firewallRuleDirectionIN
 }
 
 // getPeerConnectionResources for a given peer
 //
 // This function returns the list of peers and firewall rules that are applicable to a given peer.
 func (a *Account) getPeerConnectionResources(ctx context.Context, peerID string, validatedPeersMap map[string]struct{}) ([]*nbpeer.Peer, []*FirewallRule) {
-	generateResources, getAccumulatedResources := a.connResourcesGenerator(ctx)
-	for _, policy := range a.Policies {
-		if !policy.Enabled {
-			continue
-		}
-
-		for _, rule := range policy.Rules {
-			if !rule.Enabled {
-				continue
-			}
-
-			sourcePeers, peerInSources := a.getAllPeersFromGroups(ctx, rule.Sources, peerID, policy.SourcePostureChecks, validatedPeersMap)
-			destinationPeers, peerInDestinations := a.getAllPeersFromGroups(ctx, rule.Destinations, peerID, nil, validatedPeersMap)
-
-			if rule.Bidirectional {
-				if peerInSources {
-					generateResources(rule, destinationPeers, firewallRuleDirectionIN)
-				}
-				if peerInDestinations {
-					generateResources(rule, sourcePeers, firewallRuleDirectionOUT)
-				}
-			}
-
-			if peerInSources {
-				generateResources(rule, destinationPeers, firewallRuleDirectionOUT)
-			}
-
-			if peerInDestinations {
-				generateResources(rule, sourcePeers, firewallRuleDirectionIN)
-			}
-		}
-	}
-
-	return getAccumulatedResources()
+	return a.getPolicyIndex(ctx).getPeerConnectionResources(peerID, validatedPeersMap)
 }
 
 // connResourcesGenerator returns generator and accumulator function which returns the result of generator calls
@@ -280,20 +311,17 @@ func (a *Account) getPeerConnectionResources(ctx context.Context, peerID string,
 // The generator function is used to generate the list of peers and firewall rules that are applicable to a given peer.
 // It safe to call the generator function multiple times for same peer and different rules no duplicates will be
 // generated. The accumulator function returns the result of all the generator calls.
-func (a *Account) connResourcesGenerator(ctx context.Context) (func(*PolicyRule, []*nbpeer.Peer, int), func() ([]*nbpeer.Peer, []*FirewallRule)) {
+func connResourcesGenerator(all *nbgroup.Group) (func(*PolicyRule, []*nbpeer.Peer, int), func() ([]*nbpeer.Peer, []*FirewallRule)) {
 	rulesExists := make(map[string]struct{})
 	peersExists := make(map[string]struct{})
 	rules := make([]*FirewallRule, 0)
 	peers := make([]*nbpeer.Peer, 0)
 
-	all, err := a.GetGroupAll()
-	if err != nil {
-		log.WithContext(ctx).Errorf("failed to get group all: %v", err)
-		all = &nbgroup.Group{}
-	}
-
 	return func(rule *PolicyRule, groupPeers []*nbpeer.Peer, direction int) {
 			isAll := (len(all.Peers) - 1) == len(groupPeers)
+			// the part of the rule ID which doesn't depend on the peer
+			ruleIDSuffix := strconv.Itoa(direction) + string(rule.Protocol) + string(rule.Action) +
+				strings.Join(rule.Ports, ",") + portRangesKey(rule.PortRanges)
 			for _, peer := range groupPeers {
 				if peer == nil {
 					continue
@@ -315,14 +343,17 @@ func (a *Account) connResourcesGenerator(ctx context.Context) (func(*PolicyRule,
 					fr.PeerIP = "0.0.0.0"
 				}
 
-				ruleID := rule.ID + fr.PeerIP + strconv.Itoa(direction) +
-					fr.Protocol + fr.Action + strings.Join(rule.Ports, ",")
+				if rule.Action == PolicyTrafficActionAudit {
+					fr.PolicyID = rule.PolicyID
+				}
+
+				ruleID := rule.ID + fr.PeerIP + ruleIDSuffix
 				if _, ok := rulesExists[ruleID]; ok {
 					continue
 				}
 				rulesExists[ruleID] = struct{}{}
 
-				if len(rule.Ports) == 0 {
+				if len(rule.Ports) == 0 && len(rule.PortRanges) == 0 {
 					rules = append(rules, &fr)
 					continue
 				}
@@ -332,12 +363,74 @@ func (a *Account) connResourcesGenerator(ctx context.Context) (func(*PolicyRule,
 					pr.Port = port
 					rules = append(rules, &pr)
 				}
+
+				for _, portRange := range rule.PortRanges {
+					pr := fr // clone rule and set the port range
+					if portRange.Start == portRange.End {
+						pr.Port = strconv.Itoa(int(portRange.Start))
+					} else {
+						pr.PortRange = portRange
+					}
+					rules = append(rules, &pr)
+				}
 			}
 		}, func() ([]*nbpeer.Peer, []*FirewallRule) {
 			return peers, rules
 		}
 }
 
+// portRangesKey returns a string representation of the port ranges used to deduplicate firewall rules
+func portRangesKey(portRanges []RulePortRange) string {
+	if len(portRanges) == 0 {
+		return ""
+	}
+
+	ranges := make([]string, 0, len(portRanges))
+	for _, portRange := range portRanges {
+		ranges = append(ranges, fmt.Sprintf("%d-%d", portRange.Start, portRange.End))
+	}
+	return "," + strings.Join(ranges, ",")
+}
+
+// expandRuleServices returns the rule itself if it doesn't reference any service, otherwise it returns
+// a copy of the rule for each of the referenced services with the service protocol, ports and port ranges.
+func (a *Account) expandRuleServices(rule *PolicyRule) []*PolicyRule {
+	if len(rule.Services) == 0 {
+		return []*PolicyRule{rule}
+	}
+
+	rules := make([]*PolicyRule, 0, len(rule.Services))
+	for _, serviceID := range rule.Services {
+		service := a.getService(serviceID)
+		if service == nil {
+			continue
+		}
+
+		serviceRule := rule.Copy()
+		serviceRule.ID = rule.ID + ":" + service.ID
+		serviceRule.Protocol = PolicyRuleProtocolType(service.Protocol)
+		serviceRule.Ports = slices.Clone(service.Ports)
+		serviceRule.PortRanges = make([]RulePortRange, 0, len(service.PortRanges))
+		for _, portRange := range service.PortRanges {
+			serviceRule.PortRanges = append(serviceRule.PortRanges, RulePortRange{Start: portRange.Start, End: portRange.End})
+		}
+		serviceRule.Services = nil
+		rules = append(rules, serviceRule)
+	}
+
+	return rules
+}
+
+// getService returns the account service with the given ID or nil if it doesn't exist
+func (a *Account) getService(serviceID string) *nbservice.Service {
+	for _, service := range a.Services {
+		if service.ID == serviceID {
+			return service
+		}
+	}
+	return nil
+}
+
 // GetPolicy from the store
 func (am *DefaultAccountManager) GetPolicy(ctx context.Context, accountID, policyID, userID string) (*Policy, error) {
 	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
@@ -349,7 +442,7 @@ func (am *DefaultAccountManager) GetPolicy(ctx context.Context, accountID, polic
 		return nil, status.NewUserNotPartOfAccountError()
 	}
 
-	if user.IsRegularUser() {
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourcePolicies, rbac.OperationRead) {
 		return nil, status.NewAdminPermissionError()
 	}
 
@@ -370,11 +463,17 @@ func (am *DefaultAccountManager) SavePolicy(ctx context.Context, accountID, user
 		return nil, status.NewUserNotPartOfAccountError()
 	}
 
-	if user.IsRegularUser() {
+	var isUpdate = policy.ID != ""
+
+	operation := rbac.OperationCreate
+	if isUpdate {
+		operation = rbac.OperationUpdate
+	}
+
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourcePolicies, operation) {
 		return nil, status.NewAdminPermissionError()
 	}
 
-	var isUpdate = policy.ID != ""
 	var updateAccountPeers bool
 	var action = activity.PolicyAdded
 
@@ -407,9 +506,12 @@ func (am *DefaultAccountManager) SavePolicy(ctx context.Context, accountID, user
 	am.StoreEvent(ctx, userID, policy.ID, accountID, action, policy.EventMeta())
 
 	if updateAccountPeers {
+		am.checkAccountPostureStatuses(ctx, accountID)
 		am.updateAccountPeers(ctx, accountID)
 	}
 
+	am.checkAndSchedulePolicyScheduleTransition(ctx, accountID)
+
 	return policy, nil
 }
 
@@ -427,7 +529,7 @@ func (am *DefaultAccountManager) DeletePolicy(ctx context.Context, accountID, po
 		return status.NewUserNotPartOfAccountError()
 	}
 
-	if user.IsRegularUser() {
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourcePolicies, rbac.OperationDelete) {
 		return status.NewAdminPermissionError()
 	}
 
@@ -449,6 +551,10 @@ func (am *DefaultAccountManager) DeletePolicy(ctx context.Context, accountID, po
 			return err
 		}
 
+		if err = transaction.DeletePolicyAuditCounts(ctx, LockingStrengthUpdate, accountID, policyID); err != nil {
+			return err
+		}
+
 		return transaction.DeletePolicy(ctx, LockingStrengthUpdate, accountID, policyID)
 	})
 	if err != nil {
@@ -458,9 +564,12 @@ func (am *DefaultAccountManager) DeletePolicy(ctx context.Context, accountID, po
 	am.StoreEvent(ctx, userID, policyID, accountID, activity.PolicyRemoved, policy.EventMeta())
 
 	if updateAccountPeers {
+		am.checkAccountPostureStatuses(ctx, accountID)
 		am.updateAccountPeers(ctx, accountID)
 	}
 
+	am.checkAndSchedulePolicyScheduleTransition(ctx, accountID)
+
 	return nil
 }
 
@@ -475,7 +584,7 @@ func (am *DefaultAccountManager) ListPolicies(ctx context.Context, accountID, us
 		return nil, status.NewUserNotPartOfAccountError()
 	}
 
-	if user.IsRegularUser() {
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourcePolicies, rbac.OperationRead) {
 		return nil, status.NewAdminPermissionError()
 	}
 
@@ -499,11 +608,15 @@ func arePolicyChangesAffectPeers(ctx context.Context, transaction Store, account
 			return false, err
 		}
 
-		if hasPeers {
+		if hasPeers || existingPolicy.hasTagSelectors() {
 			return true, nil
 		}
 	}
 
+	if policy.hasTagSelectors() {
+		return true, nil
+	}
+
 	return anyGroupHasPeers(ctx, transaction, policy.AccountID, policy.ruleGroups())
 }
 
@@ -529,7 +642,24 @@ func validatePolicy(ctx context.Context, transaction Store, accountID string, po
 		return err
 	}
 
+	if policy.Schedule != nil {
+		if err = policy.Schedule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err = validatePolicyServices(ctx, transaction, accountID, policy); err != nil {
+		return err
+	}
+
 	for i, rule := range policy.Rules {
+		if err = validateTagSelector(rule.SourceTagSelector); err != nil {
+			return err
+		}
+		if err = validateTagSelector(rule.DestinationTagSelector); err != nil {
+			return err
+		}
+
 		ruleCopy := rule.Copy()
 		if ruleCopy.ID == "" {
 			ruleCopy.ID = policy.ID // TODO: when policy can contain multiple rules, need refactor
@@ -548,6 +678,40 @@ func validatePolicy(ctx context.Context, transaction Store, accountID string, po
 	return nil
 }
 
+// validatePolicyServices checks that the services referenced by the policy rules exist
+// and aren't combined with rule ports or port ranges.
+func validatePolicyServices(ctx context.Context, transaction Store, accountID string, policy *Policy) error {
+	var referenced bool
+	for _, rule := range policy.Rules {
+		if len(rule.Services) == 0 {
+			continue
+		}
+		if len(rule.Ports) > 0 || len(rule.PortRanges) > 0 {
+			return status.Errorf(status.InvalidArgument, "policy rule %s can't have ports or port ranges together with services", rule.Name)
+		}
+		referenced = true
+	}
+
+	if !referenced {
+		return nil
+	}
+
+	services, err := transaction.GetAccountServices(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range policy.Rules {
+		for _, serviceID := range rule.Services {
+			if !slices.ContainsFunc(services, func(service *nbservice.Service) bool { return service.ID == serviceID }) {
+				return status.Errorf(status.InvalidArgument, "service %s referenced by policy rule %s doesn't exist", serviceID, rule.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
 // getAllPeersFromGroups for given peer ID and list of groups
 //
 // Returns a list of peers from specified groups that pass specified posture checks
@@ -591,6 +755,17 @@ func (a *Account) getAllPeersFromGroups(ctx context.Context, groups []string, pe
 	return filteredPeers, peerInGroups
 }
 
+// validateTagSelector checks that a non-empty tag selector expression can be parsed
+func validateTagSelector(expr string) error {
+	if expr == "" {
+		return nil
+	}
+	if _, err := nbpeer.ParseTagSelector(expr); err != nil {
+		return status.Errorf(status.InvalidArgument, "%s", err.Error())
+	}
+	return nil
+}
+
 // validatePostureChecksOnPeer validates the posture checks on a peer
 func (a *Account) validatePostureChecksOnPeer(ctx context.Context, sourcePostureChecksID []string, peerID string) bool {
 	peer, ok := a.Peers[peerID]
@@ -610,6 +785,10 @@ func (a *Account) validatePostureChecksOnPeer(ctx context.Context, sourcePosture
 				log.WithContext(ctx).Debugf("an error occurred check %s: on peer: %s :%s", check.Name(), peer.ID, err.Error())
 			}
 			if !isValid {
+				// peers keep the access during the grace period of the failing posture checks
+				if isPeerInPostureGracePeriod(peer, postureChecks, time.Now().UTC()) {
+					break
+				}
 				return false
 			}
 		}
@@ -650,6 +829,37 @@ func getValidGroupIDs(groups map[string]*nbgroup.Group, groupIDs []string) []str
 	return validIDs
 }
 
+// auditRulesMinPeerVersion is the first peer version supporting the audit rules.
+// Older peers fail to convert the audit action and don't apply any of the firewall rules they receive.
+const auditRulesMinPeerVersion = "0.31.0"
+
+// peerSupportsAuditRules returns true if the peer version supports the audit rules.
+// The development builds are considered up to date.
+func peerSupportsAuditRules(peer *nbpeer.Peer) bool {
+	if peer.Meta.WtVersion == "development" {
+		return true
+	}
+
+	peerVersion, err := version.NewVersion(peer.Meta.WtVersion)
+	if err != nil {
+		return false
+	}
+
+	return peerVersion.GreaterThanOrEqual(version.Must(version.NewVersion(auditRulesMinPeerVersion)))
+}
+
+// filterPeerAuditRules removes the audit rules from the firewall rules of a peer not supporting them.
+// Audit rules don't change the verdict of the traffic, so the other rules are applied the same way.
+func filterPeerAuditRules(peer *nbpeer.Peer, rules []*FirewallRule) []*FirewallRule {
+	if peerSupportsAuditRules(peer) {
+		return rules
+	}
+
+	return slices.DeleteFunc(slices.Clone(rules), func(rule *FirewallRule) bool {
+		return rule.Action == string(PolicyTrafficActionAudit)
+	})
+}
+
 // toProtocolFirewallRules converts the firewall rules to the protocol firewall rules.
 func toProtocolFirewallRules(rules []*FirewallRule) []*proto.FirewallRule {
 	result := make([]*proto.FirewallRule, len(rules))
@@ -662,6 +872,18 @@ func toProtocolFirewallRules(rules []*FirewallRule) []*proto.FirewallRule {
 			Action:    getProtoAction(rule.Action),
 			Protocol:  getProtoProtocol(rule.Protocol),
 			Port:      rule.Port,
+			PolicyID:  rule.PolicyID,
+		}
+
+		if rule.Port == "" && rule.PortRange.Start != 0 {
+			result[i].PortInfo = &proto.PortInfo{
+				PortSelection: &proto.PortInfo_Range_{
+					Range: &proto.PortInfo_Range{
+						Start: uint32(rule.PortRange.Start),
+						End:   uint32(rule.PortRange.End),
+					},
+				},
+			}
 		}
 	}
 	return result
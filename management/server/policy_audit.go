@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/rbac"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// PolicyAuditCount is the number of packets matching the audit rules of a policy reported by a peer.
+// Audit rules log the traffic on the peers instead of dropping it, so the counts show what the rules would block.
+type PolicyAuditCount struct {
+	// PolicyID of the policy with audit rules
+	PolicyID string `gorm:"primaryKey"`
+
+	// PeerID of the peer reporting the matched packets
+	PeerID string `gorm:"primaryKey"`
+
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `gorm:"index"`
+
+	// Packets is the total number of matched packets reported by the peer
+	Packets uint64
+
+	// UpdatedAt is the last time the peer reported matched packets
+	UpdatedAt time.Time
+}
+
+// GetPolicyAuditCounts returns the packets matching the audit rules of the policy reported by the account peers.
+func (am *DefaultAccountManager) GetPolicyAuditCounts(ctx context.Context, accountID, policyID, userID string) ([]*PolicyAuditCount, error) {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourcePolicies, rbac.OperationRead) {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	if _, err = am.Store.GetPolicyByID(ctx, LockingStrengthShare, accountID, policyID); err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetPolicyAuditCounts(ctx, LockingStrengthShare, accountID, policyID)
+}
+
+// SyncPeerAuditCounts adds the packets matched by the audit rules of the peer firewall since the last report.
+// Counts of policies that don't exist in the peer account are ignored, the policy could have been deleted in the meantime.
+func (am *DefaultAccountManager) SyncPeerAuditCounts(ctx context.Context, peerPubKey string, counts map[string]uint64) error {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	peer, err := am.Store.GetPeerByPeerPubKey(ctx, LockingStrengthShare, peerPubKey)
+	if err != nil {
+		return err
+	}
+
+	return am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		for policyID, packets := range counts {
+			if packets == 0 {
+				continue
+			}
+
+			_, err := transaction.GetPolicyByID(ctx, LockingStrengthShare, peer.AccountID, policyID)
+			if err != nil {
+				if sErr, ok := status.FromError(err); ok && sErr.Type() == status.NotFound {
+					log.WithContext(ctx).Debugf("ignoring audit counts of unknown policy %s reported by peer %s", policyID, peer.ID)
+					continue
+				}
+				return err
+			}
+
+			err = transaction.IncrementPolicyAuditCount(ctx, LockingStrengthUpdate, &PolicyAuditCount{
+				PolicyID:  policyID,
+				PeerID:    peer.ID,
+				AccountID: peer.AccountID,
+				Packets:   packets,
+				UpdatedAt: time.Now().UTC(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
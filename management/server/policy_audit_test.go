@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/proto"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+func TestDefaultAccountManager_PolicyAudit(t *testing.T) {
+	manager, account, peer1, peer2, _ := setupNetworkMapTest(t)
+
+	err := manager.SaveGroups(context.Background(), account.Id, userID, []*nbgroup.Group{
+		{ID: "groupA", Name: "GroupA", Peers: []string{peer1.ID, peer2.ID}},
+	})
+	require.NoError(t, err)
+
+	policy, err := manager.SavePolicy(context.Background(), account.Id, userID, &Policy{
+		Name:    "audit ssh",
+		Enabled: true,
+		Rules: []*PolicyRule{
+			{
+				Name:          "audit ssh",
+				Enabled:       true,
+				Action:        PolicyTrafficActionAudit,
+				Protocol:      PolicyRuleProtocolTCP,
+				Ports:         []string{"22"},
+				Sources:       []string{"groupA"},
+				Destinations:  []string{"groupA"},
+				Bidirectional: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("peers receive audit rules with the policy ID", func(t *testing.T) {
+		account, err := manager.Store.GetAccount(context.Background(), account.Id)
+		require.NoError(t, err)
+
+		validatedPeers := make(map[string]struct{})
+		for p := range account.Peers {
+			validatedPeers[p] = struct{}{}
+		}
+
+		_, firewallRules := account.getPeerConnectionResources(context.Background(), peer1.ID, validatedPeers)
+
+		var auditRules int
+		for _, rule := range toProtocolFirewallRules(firewallRules) {
+			if rule.Action != proto.RuleAction_AUDIT {
+				assert.Empty(t, rule.PolicyID, "only audit rules should carry the policy ID")
+				continue
+			}
+			auditRules++
+			assert.Equal(t, policy.ID, rule.PolicyID)
+			assert.Equal(t, "22", rule.Port)
+		}
+		assert.Equal(t, 2, auditRules, "inbound and outbound audit rules should be sent to the peer")
+	})
+
+	t.Run("audit rules aren't sent to older peers", func(t *testing.T) {
+		account, err := manager.Store.GetAccount(context.Background(), account.Id)
+		require.NoError(t, err)
+
+		validatedPeers := make(map[string]struct{})
+		for p := range account.Peers {
+			validatedPeers[p] = struct{}{}
+		}
+
+		_, firewallRules := account.getPeerConnectionResources(context.Background(), peer1.ID, validatedPeers)
+		require.NotEmpty(t, firewallRules)
+
+		oldPeer := account.Peers[peer1.ID].Copy()
+		oldPeer.Meta.WtVersion = "0.30.1"
+		filtered := filterPeerAuditRules(oldPeer, firewallRules)
+		assert.Len(t, filtered, len(firewallRules)-2, "inbound and outbound audit rules should be removed")
+		for _, rule := range filtered {
+			assert.NotEqual(t, string(PolicyTrafficActionAudit), rule.Action)
+		}
+
+		oldPeer.Meta.WtVersion = auditRulesMinPeerVersion
+		assert.Equal(t, firewallRules, filterPeerAuditRules(oldPeer, firewallRules))
+	})
+
+	t.Run("audit counts are accumulated per peer", func(t *testing.T) {
+		err := manager.SyncPeerAuditCounts(context.Background(), peer1.Key, map[string]uint64{policy.ID: 3, "unknown": 5})
+		require.NoError(t, err)
+
+		err = manager.SyncPeerAuditCounts(context.Background(), peer1.Key, map[string]uint64{policy.ID: 2})
+		require.NoError(t, err)
+
+		err = manager.SyncPeerAuditCounts(context.Background(), peer2.Key, map[string]uint64{policy.ID: 1})
+		require.NoError(t, err)
+
+		counts, err := manager.GetPolicyAuditCounts(context.Background(), account.Id, policy.ID, userID)
+		require.NoError(t, err)
+
+		packets := make(map[string]uint64)
+		for _, count := range counts {
+			packets[count.PeerID] = count.Packets
+		}
+		assert.Equal(t, map[string]uint64{peer1.ID: 5, peer2.ID: 1}, packets)
+	})
+
+	t.Run("audit counts are deleted with the policy", func(t *testing.T) {
+		require.NoError(t, manager.DeletePolicy(context.Background(), account.Id, policy.ID, userID))
+
+		_, err := manager.GetPolicyAuditCounts(context.Background(), account.Id, policy.ID, userID)
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.NotFound, sErr.Type())
+
+		counts, err := manager.Store.GetPolicyAuditCounts(context.Background(), LockingStrengthShare, account.Id, policy.ID)
+		require.NoError(t, err)
+		assert.Empty(t, counts)
+	})
+}
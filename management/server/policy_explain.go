@@ -0,0 +1,573 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/rs/xid"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/route"
+)
+
+// PolicyExplainVerdict is the outcome of a connection explanation
+type PolicyExplainVerdict string
+
+const (
+	// PolicyExplainVerdictAllow the connection is allowed
+	PolicyExplainVerdictAllow PolicyExplainVerdict = "allow"
+	// PolicyExplainVerdictDeny the connection is denied
+	PolicyExplainVerdictDeny PolicyExplainVerdict = "deny"
+)
+
+// PolicyExplainRequest describes the connection to explain
+type PolicyExplainRequest struct {
+	// Destination is a peer ID or an IP address of a peer or a routed network
+	Destination string
+
+	// Protocol of the connection. Empty matches any protocol.
+	Protocol PolicyRuleProtocolType
+
+	// Port of the connection. Zero matches any port.
+	Port uint16
+
+	// Policy is an unsaved policy evaluated together with the account policies.
+	// It replaces the account policy with the same ID, a policy without ID is added.
+	Policy *Policy
+}
+
+// PolicyExplanation explains why a peer can or can't reach a destination
+type PolicyExplanation struct {
+	// SourcePeerID is the peer the connection starts from
+	SourcePeerID string
+
+	// DestinationPeerID is set when the destination is a peer
+	DestinationPeerID string
+
+	// DestinationIP is the address the connection goes to
+	DestinationIP string
+
+	// Verdict is the final decision on the connection
+	Verdict PolicyExplainVerdict
+
+	// Reason summarises the verdict
+	Reason string
+
+	// Rules are the policy rules connecting the source with the destination
+	Rules []*PolicyRuleExplanation
+
+	// Routes are the routes to the destination IP distributed to the source peer
+	Routes []*RouteExplanation
+
+	// FirewallRules are the generated firewall rules of the destination peer matching the connection
+	FirewallRules []*FirewallRule
+
+	// RouteFirewallRules are the generated route firewall rules of the routing peer matching the connection
+	RouteFirewallRules []*RouteFirewallRule
+}
+
+// PolicyRuleExplanation explains whether a policy rule applies to the connection
+type PolicyRuleExplanation struct {
+	PolicyID   string
+	PolicyName string
+	RuleID     string
+	RuleName   string
+	Action     PolicyTrafficActionType
+
+	// Matched is true if the rule applies to the connection
+	Matched bool
+
+	// Reason explains why the rule doesn't apply
+	Reason string
+
+	// FailedPostureChecks are the source posture checks the peer doesn't pass
+	FailedPostureChecks []*PostureCheckFailure
+}
+
+// PostureCheckFailure is a source posture check a peer doesn't pass
+type PostureCheckFailure struct {
+	PostureCheckID   string
+	PostureCheckName string
+	PeerID           string
+	Check            string
+	Reason           string
+}
+
+// RouteExplanation explains whether a route forwards the connection
+type RouteExplanation struct {
+	RouteID   route.ID
+	NetworkID route.NetID
+	Network   string
+
+	// RoutingPeerID is the routing peer the route firewall rules are evaluated on
+	RoutingPeerID string
+
+	// Matched is true if the route forwards the connection
+	Matched bool
+
+	// Reason explains why the route doesn't forward the connection
+	Reason string
+}
+
+// ExplainPeerConnection explains which policy rules, posture checks and routes allow or deny a connection
+// from the peer to the destination. The request policy is evaluated without being saved.
+func (am *DefaultAccountManager) ExplainPeerConnection(ctx context.Context, accountID, peerID, userID string, req *PolicyExplainRequest) (*PolicyExplanation, error) {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if user.IsRegularUser() {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Policy != nil {
+		policy := req.Policy.Copy()
+		if err = validatePolicy(ctx, am.Store, accountID, policy); err != nil {
+			return nil, err
+		}
+
+		account = account.Copy()
+		account.setExplainPolicy(policy)
+	}
+
+	validatedPeers, err := am.GetValidatedPeers(account)
+	if err != nil {
+		return nil, err
+	}
+
+	return account.explainPeerConnection(ctx, peerID, req, validatedPeers, time.Now())
+}
+
+// setExplainPolicy adds the policy to the account replacing the policy with the same ID
+func (a *Account) setExplainPolicy(policy *Policy) {
+	policy = policy.Copy()
+	if policy.ID == "" {
+		policy.ID = xid.New().String()
+	}
+	policy.AccountID = a.Id
+
+	for i, rule := range policy.Rules {
+		if rule.ID == "" {
+			rule.ID = policy.ID + "-" + strconv.Itoa(i)
+		}
+		rule.PolicyID = policy.ID
+	}
+
+	for i, p := range a.Policies {
+		if p.ID == policy.ID {
+			a.Policies[i] = policy
+			return
+		}
+	}
+	a.Policies = append(a.Policies, policy)
+}
+
+func (a *Account) explainPeerConnection(ctx context.Context, peerID string, req *PolicyExplainRequest, validatedPeers map[string]struct{}, now time.Time) (*PolicyExplanation, error) {
+	source := a.GetPeer(peerID)
+	if source == nil {
+		return nil, status.NewPeerNotFoundError(peerID)
+	}
+
+	explanation := &PolicyExplanation{
+		SourcePeerID: source.ID,
+		Verdict:      PolicyExplainVerdictDeny,
+	}
+
+	destination, destinationIP, err := a.resolveExplainDestination(req.Destination)
+	if err != nil {
+		return nil, err
+	}
+	explanation.DestinationIP = destinationIP.String()
+
+	if destination != nil {
+		if destination.ID == source.ID {
+			return nil, status.Errorf(status.InvalidArgument, "source and destination should be different peers")
+		}
+		explanation.DestinationPeerID = destination.ID
+		a.explainPeerToPeer(ctx, explanation, source, destination, req, validatedPeers, now)
+		return explanation, nil
+	}
+
+	a.explainPeerToRoute(ctx, explanation, source, destinationIP, req, validatedPeers, now)
+	return explanation, nil
+}
+
+// resolveExplainDestination returns the destination peer if the destination is a peer ID or a peer IP
+func (a *Account) resolveExplainDestination(destination string) (*nbpeer.Peer, netip.Addr, error) {
+	if peer := a.GetPeer(destination); peer != nil {
+		addr, _ := netip.AddrFromSlice(peer.IP.To4())
+		return peer, addr, nil
+	}
+
+	addr, err := netip.ParseAddr(destination)
+	if err != nil {
+		return nil, netip.Addr{}, status.Errorf(status.InvalidArgument, "destination should be a peer ID or an IP address")
+	}
+	addr = addr.Unmap()
+
+	for _, peer := range a.Peers {
+		if peerAddr, ok := netip.AddrFromSlice(peer.IP.To4()); ok && peerAddr == addr {
+			return peer, addr, nil
+		}
+	}
+
+	return nil, addr, nil
+}
+
+func (a *Account) explainPeerToPeer(ctx context.Context, explanation *PolicyExplanation, source, destination *nbpeer.Peer, req *PolicyExplainRequest, validatedPeers map[string]struct{}, now time.Time) {
+	for _, policy := range a.Policies {
+		for _, rule := range policy.Rules {
+			forward := a.isPeerSelected(source, rule.Sources, rule.SourceTagSelector) && a.isPeerSelected(destination, rule.Destinations, rule.DestinationTagSelector)
+			backward := rule.Bidirectional && a.isPeerSelected(source, rule.Destinations, rule.DestinationTagSelector) && a.isPeerSelected(destination, rule.Sources, rule.SourceTagSelector)
+			if !forward && !backward {
+				continue
+			}
+
+			ruleExplanation := newPolicyRuleExplanation(policy, rule)
+			explanation.Rules = append(explanation.Rules, ruleExplanation)
+
+			switch {
+			case !policy.Enabled:
+				ruleExplanation.Reason = "policy is disabled"
+				continue
+			case !rule.Enabled:
+				ruleExplanation.Reason = "rule is disabled"
+				continue
+			case !policy.IsActiveAt(now):
+				ruleExplanation.Reason = "policy schedule is not active"
+				continue
+			}
+
+			if reason := a.explainRuleServicesTraffic(rule, req); reason != "" {
+				ruleExplanation.Reason = reason
+				continue
+			}
+
+			// source posture checks apply to the peer on the source side of the rule
+			var sourceSidePeers []*nbpeer.Peer
+			if forward {
+				sourceSidePeers = append(sourceSidePeers, source)
+			}
+			if backward {
+				sourceSidePeers = append(sourceSidePeers, destination)
+			}
+
+			for _, peer := range sourceSidePeers {
+				failures := a.explainPostureChecks(ctx, policy.SourcePostureChecks, peer)
+				if len(failures) == 0 {
+					ruleExplanation.Matched = true
+					ruleExplanation.FailedPostureChecks = nil
+					break
+				}
+				ruleExplanation.FailedPostureChecks = append(ruleExplanation.FailedPostureChecks, failures...)
+			}
+
+			if !ruleExplanation.Matched {
+				ruleExplanation.Reason = "source posture checks failed"
+			}
+		}
+	}
+
+	for _, peer := range []*nbpeer.Peer{source, destination} {
+		if _, ok := validatedPeers[peer.ID]; !ok {
+			explanation.Reason = fmt.Sprintf("peer %s is not validated", peer.ID)
+			return
+		}
+	}
+
+	// the verdict comes from the firewall rules the destination peer receives in its network map
+	_, firewallRules := a.getPeerConnectionResources(ctx, destination.ID, validatedPeers)
+	for _, rule := range firewallRules {
+		if !rule.IsInbound() {
+			continue
+		}
+		if rule.PeerIP != source.IP.String() && rule.PeerIP != "0.0.0.0" {
+			continue
+		}
+		if !firewallRuleMatchesTraffic(rule.Protocol, rule.Port, rule.PortRange, req) {
+			continue
+		}
+		explanation.FirewallRules = append(explanation.FirewallRules, rule)
+	}
+
+	explanation.Verdict, explanation.Reason = firewallVerdict(explanation.FirewallRules, func(rule *FirewallRule) string { return rule.Action })
+}
+
+func (a *Account) explainPeerToRoute(ctx context.Context, explanation *PolicyExplanation, source *nbpeer.Peer, destinationIP netip.Addr, req *PolicyExplainRequest, validatedPeers map[string]struct{}, now time.Time) {
+	sourceGroups := a.GetPeerGroupsList(source.ID)
+
+	for _, r := range a.Routes {
+		if r.IsDynamic() || !r.Network.Contains(destinationIP) {
+			continue
+		}
+
+		routeExplanation := &RouteExplanation{
+			RouteID:   r.ID,
+			NetworkID: r.NetID,
+			Network:   r.Network.String(),
+		}
+
+		switch {
+		case !r.Enabled:
+			routeExplanation.Reason = "route is disabled"
+		case !slices.ContainsFunc(r.Groups, func(groupID string) bool { return slices.Contains(sourceGroups, groupID) }):
+			routeExplanation.Reason = "route is not distributed to the source peer"
+		default:
+			routeExplanation.RoutingPeerID = a.getExplainRoutingPeer(r, source.ID, validatedPeers)
+			if routeExplanation.RoutingPeerID == "" {
+				routeExplanation.Reason = "route has no routing peer"
+			}
+		}
+		explanation.Routes = append(explanation.Routes, routeExplanation)
+
+		if routeExplanation.RoutingPeerID == "" {
+			continue
+		}
+
+		for _, policy := range getAllRoutePoliciesFromGroups(a, r.AccessControlGroups) {
+			for _, rule := range policy.Rules {
+				if !a.isPeerSelected(source, rule.Sources, rule.SourceTagSelector) || !slices.ContainsFunc(rule.Destinations, func(groupID string) bool { return slices.Contains(r.AccessControlGroups, groupID) }) {
+					continue
+				}
+
+				ruleExplanation := newPolicyRuleExplanation(policy, rule)
+				explanation.Rules = append(explanation.Rules, ruleExplanation)
+
+				switch {
+				case !policy.Enabled:
+					ruleExplanation.Reason = "policy is disabled"
+					continue
+				case !rule.Enabled:
+					ruleExplanation.Reason = "rule is disabled"
+					continue
+				case !policy.IsActiveAt(now):
+					ruleExplanation.Reason = "policy schedule is not active"
+					continue
+				}
+
+				if reason := a.explainRuleServicesTraffic(rule, req); reason != "" {
+					ruleExplanation.Reason = reason
+					continue
+				}
+
+				ruleExplanation.FailedPostureChecks = a.explainPostureChecks(ctx, policy.SourcePostureChecks, source)
+				if len(ruleExplanation.FailedPostureChecks) > 0 {
+					ruleExplanation.Reason = "source posture checks failed"
+					continue
+				}
+				ruleExplanation.Matched = true
+			}
+		}
+
+		// the verdict comes from the route firewall rules the routing peer receives in its network map
+		sourceRange := fmt.Sprintf(AllowedIPsFormat, source.IP)
+		for _, rule := range a.getPeerRoutesFirewallRules(ctx, routeExplanation.RoutingPeerID, validatedPeers) {
+			if rule.Destination != r.Network.String() {
+				continue
+			}
+			if !slices.Contains(rule.SourceRanges, sourceRange) && !slices.Contains(rule.SourceRanges, "0.0.0.0/0") {
+				continue
+			}
+			port := ""
+			if rule.Port != 0 {
+				port = strconv.Itoa(int(rule.Port))
+			}
+			if !firewallRuleMatchesTraffic(rule.Protocol, port, rule.PortRange, req) {
+				continue
+			}
+			explanation.RouteFirewallRules = append(explanation.RouteFirewallRules, rule)
+		}
+
+		routeExplanation.Matched = true
+	}
+
+	if !slices.ContainsFunc(explanation.Routes, func(r *RouteExplanation) bool { return r.Matched }) {
+		explanation.Reason = "destination is neither a peer nor in a network routed to the source peer"
+		return
+	}
+
+	explanation.Verdict, explanation.Reason = firewallVerdict(explanation.RouteFirewallRules, func(rule *RouteFirewallRule) string { return rule.Action })
+}
+
+// getExplainRoutingPeer returns a validated routing peer of the route other than the source peer
+func (a *Account) getExplainRoutingPeer(r *route.Route, sourcePeerID string, validatedPeers map[string]struct{}) string {
+	candidates := []string{r.Peer}
+	for _, groupID := range r.PeerGroups {
+		if group, ok := a.Groups[groupID]; ok {
+			candidates = append(candidates, group.Peers...)
+		}
+	}
+	if r.PeerTagSelector != "" {
+		for _, peer := range a.Peers {
+			if a.isPeerSelected(peer, nil, r.PeerTagSelector) {
+				candidates = append(candidates, peer.ID)
+			}
+		}
+	}
+
+	for _, peerID := range candidates {
+		if peerID == "" || peerID == sourcePeerID {
+			continue
+		}
+		if _, ok := validatedPeers[peerID]; ok && a.GetPeer(peerID) != nil {
+			return peerID
+		}
+	}
+	return ""
+}
+
+// isPeerSelected checks whether the peer is a member of any of the groups or matches the tag selector
+func (a *Account) isPeerSelected(peer *nbpeer.Peer, groupIDs []string, tagSelector string) bool {
+	if a.isPeerInGroups(peer.ID, groupIDs) {
+		return true
+	}
+	if tagSelector == "" {
+		return false
+	}
+	selector, err := nbpeer.ParseTagSelector(tagSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(peer.GetTags())
+}
+
+func (a *Account) isPeerInGroups(peerID string, groupIDs []string) bool {
+	for _, groupID := range groupIDs {
+		group, ok := a.Groups[groupID]
+		if ok && slices.Contains(group.Peers, peerID) {
+			return true
+		}
+	}
+	return false
+}
+
+// explainPostureChecks returns the posture checks the peer doesn't pass
+func (a *Account) explainPostureChecks(ctx context.Context, postureChecksIDs []string, peer *nbpeer.Peer) []*PostureCheckFailure {
+	var failures []*PostureCheckFailure
+	for _, postureChecksID := range postureChecksIDs {
+		postureChecks := a.getPostureChecks(postureChecksID)
+		if postureChecks == nil {
+			continue
+		}
+
+		// peers keep the access during the grace period of the failing posture checks
+		if isPeerInPostureGracePeriod(peer, postureChecks, time.Now().UTC()) {
+			continue
+		}
+
+		for _, check := range postureChecks.GetChecks() {
+			isValid, err := check.Check(ctx, *peer)
+			if isValid {
+				continue
+			}
+
+			failure := &PostureCheckFailure{
+				PostureCheckID:   postureChecks.ID,
+				PostureCheckName: postureChecks.Name,
+				PeerID:           peer.ID,
+				Check:            check.Name(),
+				Reason:           "check failed",
+			}
+			if err != nil {
+				failure.Reason = err.Error()
+			}
+			failures = append(failures, failure)
+		}
+	}
+	return failures
+}
+
+func newPolicyRuleExplanation(policy *Policy, rule *PolicyRule) *PolicyRuleExplanation {
+	return &PolicyRuleExplanation{
+		PolicyID:   policy.ID,
+		PolicyName: policy.Name,
+		RuleID:     rule.ID,
+		RuleName:   rule.Name,
+		Action:     rule.Action,
+	}
+}
+
+// explainRuleServicesTraffic returns why none of the services referenced by the rule match the requested
+// protocol and port, or empty string if one of them does. Rules without services are explained as is.
+func (a *Account) explainRuleServicesTraffic(rule *PolicyRule, req *PolicyExplainRequest) string {
+	reason := "rule services don't exist"
+	for _, serviceRule := range a.expandRuleServices(rule) {
+		if reason = explainRuleTraffic(serviceRule, req); reason == "" {
+			return ""
+		}
+	}
+	return reason
+}
+
+// explainRuleTraffic returns why the rule doesn't match the requested protocol and port, or empty string if it does
+func explainRuleTraffic(rule *PolicyRule, req *PolicyExplainRequest) string {
+	if req.Protocol != "" && rule.Protocol != PolicyRuleProtocolALL && rule.Protocol != req.Protocol {
+		return fmt.Sprintf("rule protocol is %s", rule.Protocol)
+	}
+
+	if req.Port == 0 || (len(rule.Ports) == 0 && len(rule.PortRanges) == 0) {
+		return ""
+	}
+
+	if slices.Contains(rule.Ports, strconv.Itoa(int(req.Port))) {
+		return ""
+	}
+
+	for _, portRange := range rule.PortRanges {
+		if req.Port >= portRange.Start && req.Port <= portRange.End {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("rule doesn't allow port %d", req.Port)
+}
+
+func firewallRuleMatchesTraffic(protocol, port string, portRange RulePortRange, req *PolicyExplainRequest) bool {
+	if req.Protocol != "" && protocol != string(PolicyRuleProtocolALL) && protocol != string(req.Protocol) {
+		return false
+	}
+
+	if req.Port == 0 {
+		return true
+	}
+
+	if port != "" {
+		return port == strconv.Itoa(int(req.Port))
+	}
+
+	if portRange.Start != 0 || portRange.End != 0 {
+		return req.Port >= portRange.Start && req.Port <= portRange.End
+	}
+
+	return true
+}
+
+// firewallVerdict returns the verdict of the matching firewall rules. Drop rules take precedence over accept rules.
+// Audit rules only log the matching traffic on the peers, so they don't change the verdict.
+func firewallVerdict[T any](rules []T, action func(T) string) (PolicyExplainVerdict, string) {
+	rules = slices.DeleteFunc(slices.Clone(rules), func(rule T) bool { return action(rule) == string(PolicyTrafficActionAudit) })
+	if len(rules) == 0 {
+		return PolicyExplainVerdictDeny, "no firewall rule allows the connection"
+	}
+
+	if slices.ContainsFunc(rules, func(rule T) bool { return action(rule) == string(PolicyTrafficActionDrop) }) {
+		return PolicyExplainVerdictDeny, "a firewall rule drops the connection"
+	}
+
+	return PolicyExplainVerdictAllow, "a firewall rule accepts the connection"
+}
@@ -0,0 +1,253 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/route"
+)
+
+func newExplainTestAccount() *Account {
+	return &Account{
+		Id:       "account",
+		Network:  &Network{},
+		Settings: &Settings{},
+		Peers: map[string]*nbpeer.Peer{
+			"peerA": {ID: "peerA", IP: net.ParseIP("100.64.0.1"), Status: &nbpeer.PeerStatus{}, Meta: nbpeer.PeerSystemMeta{WtVersion: "0.30.0"}},
+			"peerB": {ID: "peerB", IP: net.ParseIP("100.64.0.2"), Status: &nbpeer.PeerStatus{}},
+			"peerC": {ID: "peerC", IP: net.ParseIP("100.64.0.3"), Status: &nbpeer.PeerStatus{}, Meta: nbpeer.PeerSystemMeta{WtVersion: "0.20.0"}},
+			"peerR": {ID: "peerR", IP: net.ParseIP("100.64.0.4"), Status: &nbpeer.PeerStatus{}, Meta: nbpeer.PeerSystemMeta{GoOS: "linux"}},
+		},
+		Groups: map[string]*nbgroup.Group{
+			"all":     {ID: "all", Name: "All", Peers: []string{"peerA", "peerB", "peerC", "peerR"}},
+			"devs":    {ID: "devs", Name: "devs", Peers: []string{"peerA", "peerC"}},
+			"servers": {ID: "servers", Name: "servers", Peers: []string{"peerB"}},
+			"routers": {ID: "routers", Name: "routers", Peers: []string{"peerR"}},
+			"office":  {ID: "office", Name: "office"},
+		},
+		Policies: []*Policy{
+			{
+				ID:                  "ssh",
+				Name:                "devs to servers",
+				Enabled:             true,
+				SourcePostureChecks: []string{"version"},
+				Rules: []*PolicyRule{
+					{
+						ID:           "ssh-rule",
+						Name:         "ssh",
+						Enabled:      true,
+						Action:       PolicyTrafficActionAccept,
+						Protocol:     PolicyRuleProtocolTCP,
+						Ports:        []string{"22"},
+						Sources:      []string{"devs"},
+						Destinations: []string{"servers"},
+					},
+				},
+			},
+			{
+				ID:      "office",
+				Name:    "devs to office",
+				Enabled: true,
+				Rules: []*PolicyRule{
+					{
+						ID:           "office-rule",
+						Name:         "office",
+						Enabled:      true,
+						Action:       PolicyTrafficActionAccept,
+						Protocol:     PolicyRuleProtocolALL,
+						Sources:      []string{"devs"},
+						Destinations: []string{"office"},
+					},
+				},
+			},
+		},
+		PostureChecks: []*posture.Checks{
+			{
+				ID:     "version",
+				Name:   "min version",
+				Checks: posture.ChecksDefinition{NBVersionCheck: &posture.NBVersionCheck{MinVersion: "0.25.0"}},
+			},
+		},
+		Routes: map[route.ID]*route.Route{
+			"route": {
+				ID:                  "route",
+				NetID:               "office",
+				Network:             netip.MustParsePrefix("10.10.0.0/24"),
+				PeerGroups:          []string{"routers"},
+				Groups:              []string{"devs"},
+				AccessControlGroups: []string{"office"},
+				Enabled:             true,
+			},
+		},
+	}
+}
+
+func TestAccount_explainPeerConnection(t *testing.T) {
+	account := newExplainTestAccount()
+	validatedPeers := map[string]struct{}{"peerA": {}, "peerB": {}, "peerC": {}, "peerR": {}}
+	ctx := context.Background()
+
+	t.Run("allowed peer connection", func(t *testing.T) {
+		explanation, err := account.explainPeerConnection(ctx, "peerA", &PolicyExplainRequest{Destination: "100.64.0.2", Protocol: PolicyRuleProtocolTCP, Port: 22}, validatedPeers, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, PolicyExplainVerdictAllow, explanation.Verdict)
+		assert.Equal(t, "peerB", explanation.DestinationPeerID)
+		require.Len(t, explanation.Rules, 1)
+		assert.True(t, explanation.Rules[0].Matched)
+		require.Len(t, explanation.FirewallRules, 1)
+		assert.Equal(t, "100.64.0.1", explanation.FirewallRules[0].PeerIP)
+	})
+
+	t.Run("port not allowed", func(t *testing.T) {
+		explanation, err := account.explainPeerConnection(ctx, "peerA", &PolicyExplainRequest{Destination: "peerB", Protocol: PolicyRuleProtocolTCP, Port: 80}, validatedPeers, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, PolicyExplainVerdictDeny, explanation.Verdict)
+		require.Len(t, explanation.Rules, 1)
+		assert.False(t, explanation.Rules[0].Matched)
+		assert.Equal(t, "rule doesn't allow port 80", explanation.Rules[0].Reason)
+		assert.Empty(t, explanation.FirewallRules)
+	})
+
+	t.Run("failed posture checks", func(t *testing.T) {
+		explanation, err := account.explainPeerConnection(ctx, "peerC", &PolicyExplainRequest{Destination: "peerB"}, validatedPeers, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, PolicyExplainVerdictDeny, explanation.Verdict)
+		require.Len(t, explanation.Rules, 1)
+		require.Len(t, explanation.Rules[0].FailedPostureChecks, 1)
+		assert.Equal(t, "version", explanation.Rules[0].FailedPostureChecks[0].PostureCheckID)
+		assert.Equal(t, "peerC", explanation.Rules[0].FailedPostureChecks[0].PeerID)
+	})
+
+	t.Run("not validated peer", func(t *testing.T) {
+		explanation, err := account.explainPeerConnection(ctx, "peerA", &PolicyExplainRequest{Destination: "peerB"}, map[string]struct{}{"peerA": {}}, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, PolicyExplainVerdictDeny, explanation.Verdict)
+		assert.Equal(t, "peer peerB is not validated", explanation.Reason)
+	})
+
+	t.Run("routed network", func(t *testing.T) {
+		explanation, err := account.explainPeerConnection(ctx, "peerA", &PolicyExplainRequest{Destination: "10.10.0.10", Protocol: PolicyRuleProtocolUDP, Port: 53}, validatedPeers, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, PolicyExplainVerdictAllow, explanation.Verdict)
+		assert.Empty(t, explanation.DestinationPeerID)
+		require.Len(t, explanation.Routes, 1)
+		assert.True(t, explanation.Routes[0].Matched)
+		assert.Equal(t, "peerR", explanation.Routes[0].RoutingPeerID)
+		require.Len(t, explanation.Rules, 1)
+		assert.Equal(t, "office-rule", explanation.Rules[0].RuleID)
+		assert.NotEmpty(t, explanation.RouteFirewallRules)
+	})
+
+	t.Run("routed network outside of the policy schedule", func(t *testing.T) {
+		scheduled := account.Copy()
+		endDate := time.Now().Add(-time.Hour)
+		scheduled.Policies[1].Schedule = &PolicySchedule{EndDate: &endDate}
+
+		explanation, err := scheduled.explainPeerConnection(ctx, "peerA", &PolicyExplainRequest{Destination: "10.10.0.10"}, validatedPeers, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, PolicyExplainVerdictDeny, explanation.Verdict)
+		require.Len(t, explanation.Rules, 1)
+		assert.Equal(t, "policy schedule is not active", explanation.Rules[0].Reason)
+		assert.Empty(t, explanation.RouteFirewallRules)
+	})
+
+	t.Run("routed network with failed posture checks", func(t *testing.T) {
+		withChecks := account.Copy()
+		withChecks.Policies[1].SourcePostureChecks = []string{"version"}
+
+		explanation, err := withChecks.explainPeerConnection(ctx, "peerC", &PolicyExplainRequest{Destination: "10.10.0.10"}, validatedPeers, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, PolicyExplainVerdictDeny, explanation.Verdict)
+		require.Len(t, explanation.Rules, 1)
+		assert.False(t, explanation.Rules[0].Matched)
+		assert.Equal(t, "source posture checks failed", explanation.Rules[0].Reason)
+		require.Len(t, explanation.Rules[0].FailedPostureChecks, 1)
+		assert.Empty(t, explanation.RouteFirewallRules, "peer failing the posture checks should not be allowed by the routing peer")
+
+		explanation, err = withChecks.explainPeerConnection(ctx, "peerA", &PolicyExplainRequest{Destination: "10.10.0.10"}, validatedPeers, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, PolicyExplainVerdictAllow, explanation.Verdict)
+	})
+
+	t.Run("route not distributed to the peer", func(t *testing.T) {
+		explanation, err := account.explainPeerConnection(ctx, "peerB", &PolicyExplainRequest{Destination: "10.10.0.10"}, validatedPeers, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, PolicyExplainVerdictDeny, explanation.Verdict)
+		require.Len(t, explanation.Routes, 1)
+		assert.Equal(t, "route is not distributed to the source peer", explanation.Routes[0].Reason)
+	})
+
+	t.Run("invalid destination", func(t *testing.T) {
+		_, err := account.explainPeerConnection(ctx, "peerA", &PolicyExplainRequest{Destination: "unknown"}, validatedPeers, time.Now())
+		assert.Error(t, err)
+
+		_, err = account.explainPeerConnection(ctx, "peerA", &PolicyExplainRequest{Destination: "peerA"}, validatedPeers, time.Now())
+		assert.Error(t, err)
+	})
+}
+
+func TestAccount_explainPeerConnectionDryRun(t *testing.T) {
+	account := newExplainTestAccount()
+	validatedPeers := map[string]struct{}{"peerA": {}, "peerB": {}, "peerC": {}, "peerR": {}}
+	req := &PolicyExplainRequest{Destination: "peerA", Protocol: PolicyRuleProtocolTCP, Port: 443}
+
+	explanation, err := account.explainPeerConnection(context.Background(), "peerB", req, validatedPeers, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, PolicyExplainVerdictDeny, explanation.Verdict)
+
+	dryRun := account.Copy()
+	dryRun.setExplainPolicy(&Policy{
+		Name:    "servers to devs",
+		Enabled: true,
+		Rules: []*PolicyRule{
+			{
+				Name:         "https",
+				Enabled:      true,
+				Action:       PolicyTrafficActionAccept,
+				Protocol:     PolicyRuleProtocolTCP,
+				Ports:        []string{"443"},
+				Sources:      []string{"servers"},
+				Destinations: []string{"devs"},
+			},
+		},
+	})
+
+	explanation, err = dryRun.explainPeerConnection(context.Background(), "peerB", req, validatedPeers, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, PolicyExplainVerdictAllow, explanation.Verdict)
+	require.Len(t, explanation.Rules, 1)
+	assert.NotEmpty(t, explanation.Rules[0].PolicyID, "dry run policy should get an ID")
+	assert.Len(t, account.Policies, 2, "dry run should not change the account")
+}
+
+func TestDefaultAccountManager_ExplainPeerConnectionInvalidPolicy(t *testing.T) {
+	manager, account, peer1, peer2, _ := setupNetworkMapTest(t)
+	ctx := context.Background()
+
+	_, err := manager.ExplainPeerConnection(ctx, account.Id, peer1.ID, userID, &PolicyExplainRequest{
+		Destination: peer2.ID,
+		Policy:      &Policy{ID: "unknown", Name: "unknown", Enabled: true},
+	})
+	assert.Error(t, err, "dry run policy replacing an unknown policy should be rejected")
+
+	_, err = manager.ExplainPeerConnection(ctx, account.Id, peer1.ID, userID, &PolicyExplainRequest{
+		Destination: peer2.ID,
+		Policy: &Policy{
+			Name:    "invalid selector",
+			Enabled: true,
+			Rules: []*PolicyRule{
+				{Name: "rule", Enabled: true, Action: PolicyTrafficActionAccept, Protocol: PolicyRuleProtocolALL, SourceTagSelector: "role in (db,cache"},
+			},
+		},
+	})
+	assert.Error(t, err, "dry run policy with an invalid tag selector should be rejected")
+}
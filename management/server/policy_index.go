@@ -0,0 +1,258 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+// policyIndex is the compiled form of the account policies. It resolves the groups, tag selectors and source
+// posture checks of the active rules once, so the connection resources of each peer are computed from the rules
+// the peer is part of instead of evaluating all the policies of the account.
+type policyIndex struct {
+	// serial is the network serial of the account the index was compiled for
+	serial uint64
+	// all is the group All of the account, used to detect the rules allowing all the peers
+	all *nbgroup.Group
+	// peerRules are the rules each peer is a source or a destination of, in the order of the account policies
+	peerRules map[string][]*indexedRule
+}
+
+// indexedRule is an enabled rule of an active policy with its resolved source and destination peers
+type indexedRule struct {
+	// rules are the rule expanded by the services it references
+	rules []*PolicyRule
+	// sources are the source peers passing the source posture checks of the policy
+	sources []*nbpeer.Peer
+	// destinations are the destination peers
+	destinations []*nbpeer.Peer
+	sourceIDs    lookupMap
+	destIDs      lookupMap
+}
+
+// policyIndexCache holds the policy index compiled for an account loaded from the store
+type policyIndexCache struct {
+	mu    sync.Mutex
+	index *policyIndex
+}
+
+// getPolicyIndex returns the policy index of the account. The index is cached for accounts loaded from the store
+// until the network serial of the account changes or the account is updated in place.
+func (a *Account) getPolicyIndex(ctx context.Context) *policyIndex {
+	if a.policyIndexCache == nil {
+		return a.compilePolicyIndex(ctx, time.Now())
+	}
+
+	a.policyIndexCache.mu.Lock()
+	defer a.policyIndexCache.mu.Unlock()
+
+	serial := a.Network.CurrentSerial()
+	if index := a.policyIndexCache.index; index != nil && index.serial == serial {
+		return index
+	}
+
+	index := a.compilePolicyIndex(ctx, time.Now())
+	index.serial = serial
+	a.policyIndexCache.index = index
+	return index
+}
+
+// invalidatePolicyIndex drops the cached policy index, it has to be called when the peers, groups or policies
+// of the account are updated in place
+func (a *Account) invalidatePolicyIndex() {
+	if a.policyIndexCache == nil {
+		return
+	}
+
+	a.policyIndexCache.mu.Lock()
+	a.policyIndexCache.index = nil
+	a.policyIndexCache.mu.Unlock()
+}
+
+// compilePolicyIndex resolves the peers of the enabled rules of the policies active at the given time
+func (a *Account) compilePolicyIndex(ctx context.Context, now time.Time) *policyIndex {
+	index := &policyIndex{
+		peerRules: make(map[string][]*indexedRule),
+	}
+
+	all, err := a.GetGroupAll()
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get group all: %v", err)
+		all = &nbgroup.Group{}
+	}
+	index.all = all
+
+	groupPeers := make(map[string][]*nbpeer.Peer, len(a.Groups))
+	for _, policy := range a.Policies {
+		if !policy.IsActiveAt(now) {
+			continue
+		}
+
+		// the source posture checks are evaluated once per policy and peer
+		postureValid := make(map[string]bool)
+		isPostureValid := func(peer *nbpeer.Peer) bool {
+			if len(policy.SourcePostureChecks) == 0 {
+				return true
+			}
+			valid, ok := postureValid[peer.ID]
+			if !ok {
+				valid = a.validatePostureChecksOnPeer(ctx, policy.SourcePostureChecks, peer.ID)
+				postureValid[peer.ID] = valid
+			}
+			return valid
+		}
+
+		for _, rule := range policy.Rules {
+			if !rule.Enabled {
+				continue
+			}
+
+			indexed := &indexedRule{
+				rules:        a.expandRuleServices(rule),
+				sources:      a.resolveRulePeers(ctx, groupPeers, rule.Sources, rule.SourceTagSelector, isPostureValid),
+				destinations: a.resolveRulePeers(ctx, groupPeers, rule.Destinations, rule.DestinationTagSelector, nil),
+			}
+			indexed.sourceIDs = peerIDs(indexed.sources)
+			indexed.destIDs = peerIDs(indexed.destinations)
+
+			for peerID := range indexed.sourceIDs {
+				index.peerRules[peerID] = append(index.peerRules[peerID], indexed)
+			}
+			for peerID := range indexed.destIDs {
+				if _, ok := indexed.sourceIDs[peerID]; !ok {
+					index.peerRules[peerID] = append(index.peerRules[peerID], indexed)
+				}
+			}
+		}
+	}
+
+	return index
+}
+
+// resolveRulePeers returns the peers of the groups and the peers matching the tag selector which pass the filter.
+// The peers of the groups are resolved once and shared across the rules through groupPeers.
+// Like getAllPeersFromGroups, a peer is returned for each group or selector it is part of.
+func (a *Account) resolveRulePeers(ctx context.Context, groupPeers map[string][]*nbpeer.Peer, groups []string, tagSelector string, filter func(*nbpeer.Peer) bool) []*nbpeer.Peer {
+	peers := make([]*nbpeer.Peer, 0, len(groups))
+	for _, groupID := range groups {
+		members, ok := groupPeers[groupID]
+		if !ok {
+			members = a.getGroupPeers(groupID)
+			groupPeers[groupID] = members
+		}
+
+		for _, peer := range members {
+			if filter == nil || filter(peer) {
+				peers = append(peers, peer)
+			}
+		}
+	}
+
+	if tagSelector == "" {
+		return peers
+	}
+
+	selector, err := nbpeer.ParseTagSelector(tagSelector)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to parse tag selector %q: %v", tagSelector, err)
+		return peers
+	}
+
+	for _, peer := range a.Peers {
+		if peer == nil || !selector.Matches(peer.GetTags()) {
+			continue
+		}
+
+		if filter == nil || filter(peer) {
+			peers = append(peers, peer)
+		}
+	}
+
+	return peers
+}
+
+// getGroupPeers returns the existing peers of the group
+func (a *Account) getGroupPeers(groupID string) []*nbpeer.Peer {
+	group, ok := a.Groups[groupID]
+	if !ok {
+		return nil
+	}
+
+	peers := make([]*nbpeer.Peer, 0, len(group.Peers))
+	for _, peerID := range group.Peers {
+		if peer, ok := a.Peers[peerID]; ok && peer != nil {
+			peers = append(peers, peer)
+		}
+	}
+	return peers
+}
+
+func peerIDs(peers []*nbpeer.Peer) lookupMap {
+	ids := make(lookupMap, len(peers))
+	for _, peer := range peers {
+		ids[peer.ID] = struct{}{}
+	}
+	return ids
+}
+
+// getPeerConnectionResources returns the peers and firewall rules applicable to the peer from the rules it is part of
+func (i *policyIndex) getPeerConnectionResources(peerID string, validatedPeersMap map[string]struct{}) ([]*nbpeer.Peer, []*FirewallRule) {
+	generateResources, getAccumulatedResources := connResourcesGenerator(i.all)
+	if _, ok := validatedPeersMap[peerID]; !ok {
+		return getAccumulatedResources()
+	}
+
+	for _, rule := range i.peerRules[peerID] {
+		_, peerInSources := rule.sourceIDs[peerID]
+		_, peerInDestinations := rule.destIDs[peerID]
+
+		var sourcePeers, destinationPeers []*nbpeer.Peer
+		if peerInDestinations {
+			sourcePeers = filterRulePeers(rule.sources, peerID, validatedPeersMap)
+		}
+		if peerInSources {
+			destinationPeers = filterRulePeers(rule.destinations, peerID, validatedPeersMap)
+		}
+
+		for _, rule := range rule.rules {
+			if rule.Bidirectional {
+				if peerInSources {
+					generateResources(rule, destinationPeers, firewallRuleDirectionIN)
+				}
+				if peerInDestinations {
+					generateResources(rule, sourcePeers, firewallRuleDirectionOUT)
+				}
+			}
+
+			if peerInSources {
+				generateResources(rule, destinationPeers, firewallRuleDirectionOUT)
+			}
+
+			if peerInDestinations {
+				generateResources(rule, sourcePeers, firewallRuleDirectionIN)
+			}
+		}
+	}
+
+	return getAccumulatedResources()
+}
+
+// filterRulePeers returns the validated peers of the rule other than the given peer
+func filterRulePeers(peers []*nbpeer.Peer, peerID string, validatedPeersMap map[string]struct{}) []*nbpeer.Peer {
+	filtered := make([]*nbpeer.Peer, 0, len(peers))
+	for _, peer := range peers {
+		if peer.ID == peerID {
+			continue
+		}
+		if _, ok := validatedPeersMap[peer.ID]; !ok {
+			continue
+		}
+		filtered = append(filtered, peer)
+	}
+	return filtered
+}
@@ -0,0 +1,207 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/posture"
+)
+
+// newPolicyIndexTestAccount returns an in-memory account with the given number of peers spread across groups,
+// each group having a policy connecting its peers and every second group being allowed to reach the next one
+func newPolicyIndexTestAccount(peers, groups int) *Account {
+	account := newAccountWithId(context.Background(), "policy_index_account", "account_creator", "")
+	// drop the default policy connecting all the peers
+	account.Policies = nil
+	all, _ := account.GetGroupAll()
+
+	for i := 0; i < peers; i++ {
+		peer := &nbpeer.Peer{
+			ID:       fmt.Sprintf("peer-%d", i),
+			DNSLabel: fmt.Sprintf("peer-%d", i),
+			Key:      fmt.Sprintf("key-%d", i),
+			IP:       net.IPv4(100, byte(64+i/65536), byte(i/256), byte(i%256)),
+			Status:   &nbpeer.PeerStatus{},
+			Meta:     nbpeer.PeerSystemMeta{WtVersion: "0.30.0"},
+		}
+		account.Peers[peer.ID] = peer
+		all.Peers = append(all.Peers, peer.ID)
+	}
+
+	account.PostureChecks = []*posture.Checks{
+		{
+			ID: "version",
+			Checks: posture.ChecksDefinition{
+				NBVersionCheck: &posture.NBVersionCheck{MinVersion: "0.25.0"},
+			},
+		},
+	}
+
+	for i := 0; i < groups; i++ {
+		groupID := fmt.Sprintf("group-%d", i)
+		group := &nbgroup.Group{ID: groupID, Name: groupID}
+		for j := 0; j < peers/groups; j++ {
+			group.Peers = append(group.Peers, fmt.Sprintf("peer-%d", i*(peers/groups)+j))
+		}
+		account.Groups[groupID] = group
+
+		policy := &Policy{
+			ID:                  fmt.Sprintf("policy-%d", i),
+			Enabled:             true,
+			SourcePostureChecks: []string{"version"},
+			Rules: []*PolicyRule{
+				{
+					ID:            fmt.Sprintf("rule-%d", i),
+					Enabled:       true,
+					Sources:       []string{groupID},
+					Destinations:  []string{groupID},
+					Bidirectional: true,
+					Protocol:      PolicyRuleProtocolALL,
+					Action:        PolicyTrafficActionAccept,
+				},
+			},
+		}
+		if i%2 == 0 && i+1 < groups {
+			policy.Rules = append(policy.Rules, &PolicyRule{
+				ID:           fmt.Sprintf("rule-%d-ssh", i),
+				Enabled:      true,
+				Sources:      []string{groupID},
+				Destinations: []string{fmt.Sprintf("group-%d", i+1)},
+				Protocol:     PolicyRuleProtocolTCP,
+				Ports:        []string{"22"},
+				Action:       PolicyTrafficActionAccept,
+			})
+		}
+		account.Policies = append(account.Policies, policy)
+	}
+
+	return account
+}
+
+func allPeersValidated(account *Account) map[string]struct{} {
+	validatedPeers := make(map[string]struct{}, len(account.Peers))
+	for peerID := range account.Peers {
+		validatedPeers[peerID] = struct{}{}
+	}
+	return validatedPeers
+}
+
+func TestAccount_PolicyIndexCache(t *testing.T) {
+	account := newPolicyIndexTestAccount(10, 2)
+	ctx := context.Background()
+
+	assert.NotSame(t, account.getPolicyIndex(ctx), account.getPolicyIndex(ctx), "index shouldn't be cached without cache")
+
+	account.policyIndexCache = &policyIndexCache{}
+	index := account.getPolicyIndex(ctx)
+	assert.Same(t, index, account.getPolicyIndex(ctx), "index should be cached")
+
+	account.Network.IncSerial()
+	serialIndex := account.getPolicyIndex(ctx)
+	assert.NotSame(t, index, serialIndex, "index should be compiled again when the network serial changes")
+
+	account.UpdatePeer(account.Peers["peer-0"])
+	assert.NotSame(t, serialIndex, account.getPolicyIndex(ctx), "index should be compiled again when the account is updated in place")
+}
+
+func TestAccount_PolicyIndexPeerRules(t *testing.T) {
+	account := newPolicyIndexTestAccount(8, 2)
+	// peer-1 doesn't pass the source posture checks
+	account.Peers["peer-1"].Meta.WtVersion = "0.20.0"
+
+	index := account.compilePolicyIndex(context.Background(), time.Now())
+
+	require.Len(t, index.peerRules["peer-0"], 2, "peer-0 is a source of both rules of policy-0")
+	assert.Len(t, index.peerRules["peer-1"], 1, "peer-1 is only a destination of rule-0")
+	assert.Len(t, index.peerRules["peer-4"], 2, "peer-4 is part of rule-1 and a destination of rule-0-ssh")
+
+	validatedPeers := allPeersValidated(account)
+
+	peers, firewallRules := index.getPeerConnectionResources("peer-1", validatedPeers)
+	assert.Len(t, peers, 3, "peer-1 should only be connected to the other peers of group-0")
+	assert.Len(t, firewallRules, 6)
+
+	peers, firewallRules = index.getPeerConnectionResources("peer-0", validatedPeers)
+	assert.Len(t, peers, 7)
+	assert.Len(t, firewallRules, 6+4, "peer-0 gets the group-0 rules and an ssh rule for each peer of group-1")
+
+	delete(validatedPeers, "peer-2")
+	peers, _ = index.getPeerConnectionResources("peer-0", validatedPeers)
+	assert.Len(t, peers, 6, "peers not validated should be skipped")
+
+	peers, firewallRules = index.getPeerConnectionResources("peer-2", validatedPeers)
+	assert.Empty(t, peers, "peer not validated shouldn't get connection resources")
+	assert.Empty(t, firewallRules)
+}
+
+func BenchmarkGetPeerNetworkMap(b *testing.B) {
+	benchCases := []struct {
+		name   string
+		peers  int
+		groups int
+	}{
+		{"Medium", 1000, 10},
+		{"Large", 10000, 20},
+	}
+
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	ctx := context.Background()
+	for _, bc := range benchCases {
+		account := newPolicyIndexTestAccount(bc.peers, bc.groups)
+		validatedPeers := allPeersValidated(account)
+		customZone := account.GetPeersCustomZone(ctx, "netbird.cloud")
+
+		b.Run(bc.name+"/compile index", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				account.compilePolicyIndex(ctx, time.Now())
+			}
+		})
+
+		b.Run(bc.name+"/single peer", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				account.GetPeerNetworkMap(ctx, "peer-0", customZone, validatedPeers, nil)
+			}
+		})
+
+		// the default number of workers is the one set with NB_NETWORK_MAP_WORKERS or the number of CPUs
+		workerCounts := []int{1}
+		if workers := getNetworkMapWorkers(ctx); workers > 1 {
+			workerCounts = append(workerCounts, workers)
+		}
+
+		for _, workers := range workerCounts {
+			b.Run(fmt.Sprintf("%s/all peers update %d workers", bc.name, workers), func(b *testing.B) {
+				b.Setenv("NB_NETWORK_MAP_WORKERS", strconv.Itoa(workers))
+
+				manager, err := createManager(b)
+				require.NoError(b, err)
+				require.NoError(b, manager.Store.SaveAccount(ctx, account))
+
+				peerChannels := make(map[string]chan *UpdateMessage, len(account.Peers))
+				for peerID := range account.Peers {
+					peerChannels[peerID] = make(chan *UpdateMessage, channelBufferSize)
+				}
+				manager.peersUpdateManager.peerChannels = peerChannels
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					manager.sendAccountPeersUpdate(ctx, account.Id)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,356 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+const (
+	// policyScheduleTimeLayout is the layout of the time range boundaries, e.g. 09:00
+	policyScheduleTimeLayout = "15:04"
+	// policyScheduleLookaheadDays is the amount of days checked for the next schedule transition.
+	// A week plus one day is enough to cover any combination of days and time ranges.
+	policyScheduleLookaheadDays = 8
+)
+
+// weekdays maps the lowercase names of the week days to their values
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// ParseWeekday returns the week day of the given case-insensitive English name
+func ParseWeekday(name string) (time.Weekday, bool) {
+	weekday, ok := weekdays[strings.ToLower(name)]
+	return weekday, ok
+}
+
+// PolicyScheduleTimeRange is a daily time window in the schedule timezone.
+// When End is before Start the range wraps around midnight, e.g. 22:00-06:00.
+type PolicyScheduleTimeRange struct {
+	// Start of the time range in HH:MM format
+	Start string
+	// End of the time range in HH:MM format
+	End string
+}
+
+// PolicySchedule defines when a policy is active.
+// A policy with no schedule is always active.
+type PolicySchedule struct {
+	// Days of the week when the policy is active. Empty means every day.
+	Days []time.Weekday
+
+	// TimeRanges within a day when the policy is active. Empty means the whole day.
+	TimeRanges []PolicyScheduleTimeRange
+
+	// Timezone is an IANA timezone name the days and time ranges are evaluated in. Empty means UTC.
+	Timezone string
+
+	// StartDate is the time from which the policy becomes active. Nil means no start limit.
+	StartDate *time.Time
+
+	// EndDate is the time after which the policy is no longer active. Nil means no end limit.
+	EndDate *time.Time
+}
+
+// NewPolicySchedule returns a validated policy schedule active on the week days of the given names
+func NewPolicySchedule(timezone string, startDate, endDate *time.Time, days []string, timeRanges []PolicyScheduleTimeRange) (*PolicySchedule, error) {
+	schedule := &PolicySchedule{
+		Timezone:   timezone,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		TimeRanges: timeRanges,
+	}
+
+	for _, day := range days {
+		weekday, ok := ParseWeekday(day)
+		if !ok {
+			return nil, status.Errorf(status.InvalidArgument, "unknown schedule day: %s", day)
+		}
+		schedule.Days = append(schedule.Days, weekday)
+	}
+
+	if err := schedule.Validate(); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// Copy returns a copy of the policy schedule.
+func (s *PolicySchedule) Copy() *PolicySchedule {
+	if s == nil {
+		return nil
+	}
+
+	c := &PolicySchedule{
+		Days:       slices.Clone(s.Days),
+		TimeRanges: slices.Clone(s.TimeRanges),
+		Timezone:   s.Timezone,
+	}
+	if s.StartDate != nil {
+		startDate := *s.StartDate
+		c.StartDate = &startDate
+	}
+	if s.EndDate != nil {
+		endDate := *s.EndDate
+		c.EndDate = &endDate
+	}
+	return c
+}
+
+// Validate checks that the schedule timezone, days and time ranges are well-formed
+func (s *PolicySchedule) Validate() error {
+	if _, err := s.location(); err != nil {
+		return status.Errorf(status.InvalidArgument, "invalid schedule timezone %s", s.Timezone)
+	}
+
+	for _, day := range s.Days {
+		if day < time.Sunday || day > time.Saturday {
+			return status.Errorf(status.InvalidArgument, "invalid schedule day %d", day)
+		}
+	}
+
+	for _, timeRange := range s.TimeRanges {
+		if _, _, err := timeRange.minutes(); err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid schedule time range %s-%s: %v", timeRange.Start, timeRange.End, err)
+		}
+	}
+
+	if s.StartDate != nil && s.EndDate != nil && !s.EndDate.After(*s.StartDate) {
+		return status.Errorf(status.InvalidArgument, "schedule end date should be after the start date")
+	}
+
+	return nil
+}
+
+// IsActive returns true if the schedule allows the policy to be applied at the given time
+func (s *PolicySchedule) IsActive(t time.Time) bool {
+	if s == nil {
+		return true
+	}
+
+	if s.StartDate != nil && t.Before(*s.StartDate) {
+		return false
+	}
+
+	if s.EndDate != nil && !t.Before(*s.EndDate) {
+		return false
+	}
+
+	loc, err := s.location()
+	if err != nil {
+		return false
+	}
+	t = t.In(loc)
+
+	if len(s.TimeRanges) == 0 {
+		return s.isDayAllowed(t.Weekday())
+	}
+
+	minuteOfDay := t.Hour()*60 + t.Minute()
+	for _, timeRange := range s.TimeRanges {
+		start, end, err := timeRange.minutes()
+		if err != nil {
+			continue
+		}
+
+		if start < end {
+			if minuteOfDay >= start && minuteOfDay < end && s.isDayAllowed(t.Weekday()) {
+				return true
+			}
+			continue
+		}
+
+		// the range wraps around midnight, the part after midnight belongs to the previous day
+		if minuteOfDay >= start && s.isDayAllowed(t.Weekday()) {
+			return true
+		}
+		if minuteOfDay < end && s.isDayAllowed(t.AddDate(0, 0, -1).Weekday()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NextTransition returns the first moment after the given time when the schedule changes its active state.
+// If the schedule never changes its state again this function returns false.
+func (s *PolicySchedule) NextTransition(t time.Time) (time.Time, bool) {
+	if s == nil {
+		return time.Time{}, false
+	}
+
+	loc, err := s.location()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	// the schedule can't become active before the start date, the boundaries are looked up from there
+	from := t
+	if s.StartDate != nil && s.StartDate.After(t) {
+		from = *s.StartDate
+	}
+
+	candidates := s.boundaries(from.In(loc))
+	if s.StartDate != nil {
+		candidates = append(candidates, *s.StartDate)
+	}
+	if s.EndDate != nil {
+		candidates = append(candidates, *s.EndDate)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Before(candidates[j])
+	})
+
+	active := s.IsActive(t)
+	for _, candidate := range candidates {
+		if !candidate.After(t) {
+			continue
+		}
+		if s.IsActive(candidate) != active {
+			return candidate, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// boundaries returns the day and time range boundaries of the upcoming days starting from the given time
+func (s *PolicySchedule) boundaries(t time.Time) []time.Time {
+	var boundaries []time.Time
+	year, month, day := t.Date()
+	for i := 0; i <= policyScheduleLookaheadDays; i++ {
+		midnight := time.Date(year, month, day+i, 0, 0, 0, 0, t.Location())
+		boundaries = append(boundaries, midnight)
+
+		for _, timeRange := range s.TimeRanges {
+			start, end, err := timeRange.minutes()
+			if err != nil {
+				continue
+			}
+			boundaries = append(boundaries,
+				time.Date(year, month, day+i, start/60, start%60, 0, 0, t.Location()),
+				time.Date(year, month, day+i, end/60, end%60, 0, 0, t.Location()),
+			)
+		}
+	}
+	return boundaries
+}
+
+func (s *PolicySchedule) isDayAllowed(day time.Weekday) bool {
+	return len(s.Days) == 0 || slices.Contains(s.Days, day)
+}
+
+func (s *PolicySchedule) location() (*time.Location, error) {
+	if s.Timezone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(s.Timezone)
+}
+
+// minutes returns the start and end of the time range as minutes of the day
+func (r PolicyScheduleTimeRange) minutes() (int, int, error) {
+	start, err := time.Parse(policyScheduleTimeLayout, r.Start)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid start time: %w", err)
+	}
+
+	end, err := time.Parse(policyScheduleTimeLayout, r.End)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid end time: %w", err)
+	}
+
+	startMinutes := start.Hour()*60 + start.Minute()
+	endMinutes := end.Hour()*60 + end.Minute()
+	if startMinutes == endMinutes {
+		return 0, 0, fmt.Errorf("start and end time should differ")
+	}
+
+	return startMinutes, endMinutes, nil
+}
+
+// GetNextPolicyScheduleTransition returns the duration until the earliest moment when any enabled scheduled policy
+// of the account becomes active or inactive. If there is no such policy this function returns false and a duration of 0.
+func (a *Account) GetNextPolicyScheduleTransition() (time.Duration, bool) {
+	now := time.Now()
+	var nextTransition *time.Time
+	for _, policy := range a.Policies {
+		if !policy.Enabled || policy.Schedule == nil {
+			continue
+		}
+
+		transition, ok := policy.Schedule.NextTransition(now)
+		if !ok {
+			continue
+		}
+
+		if nextTransition == nil || transition.Before(*nextTransition) {
+			nextTransition = &transition
+		}
+	}
+
+	if nextTransition == nil {
+		return 0, false
+	}
+
+	// the ticker can't be set to <= 0
+	return max(nextTransition.Sub(now), time.Millisecond), true
+}
+
+// policyScheduleTransitionJob pushes fresh network maps to the account peers when scheduled policies become active or
+// inactive and returns the duration until the next transition
+func (am *DefaultAccountManager) policyScheduleTransitionJob(ctx context.Context, accountID string) func() (time.Duration, bool) {
+	return func() (time.Duration, bool) {
+		unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+		err := am.Store.IncrementNetworkSerial(ctx, LockingStrengthUpdate, accountID)
+		unlock()
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed to increment network serial on policy schedule transition for account %s: %v", accountID, err)
+		}
+
+		log.WithContext(ctx).Debugf("policy schedule transition for account %s, updating peers", accountID)
+		am.updateAccountPeers(ctx, accountID)
+
+		account, err := am.Store.GetAccount(ctx, accountID)
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed getting account %s to schedule the next policy transition: %v", accountID, err)
+			return 0, false
+		}
+
+		return account.GetNextPolicyScheduleTransition()
+	}
+}
+
+// checkAndSchedulePolicyScheduleTransition schedules a network map update for the next moment when any
+// scheduled policy of the account becomes active or inactive
+func (am *DefaultAccountManager) checkAndSchedulePolicyScheduleTransition(ctx context.Context, accountID string) {
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed getting account %s to schedule policy transitions: %v", accountID, err)
+		return
+	}
+
+	am.schedulePolicyScheduleTransition(ctx, account)
+}
+
+func (am *DefaultAccountManager) schedulePolicyScheduleTransition(ctx context.Context, account *Account) {
+	am.policyScheduleTransitions.Cancel(ctx, []string{account.Id})
+	if nextRun, ok := account.GetNextPolicyScheduleTransition(); ok {
+		go am.policyScheduleTransitions.Schedule(ctx, nextRun, account.Id, am.policyScheduleTransitionJob(ctx, account.Id))
+	}
+}
@@ -0,0 +1,324 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+func TestPolicySchedule_IsActive(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	startDate := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		schedule *PolicySchedule
+		time     time.Time
+		expected bool
+	}{
+		{
+			name:     "nil schedule is always active",
+			schedule: nil,
+			time:     time.Date(2024, 11, 4, 3, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			name: "inside working hours in the schedule timezone",
+			schedule: &PolicySchedule{
+				Days:       []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+				TimeRanges: []PolicyScheduleTimeRange{{Start: "09:00", End: "18:00"}},
+				Timezone:   "Europe/Berlin",
+			},
+			// Monday 09:30 in Berlin
+			time:     time.Date(2024, 11, 4, 9, 30, 0, 0, berlin),
+			expected: true,
+		},
+		{
+			name: "outside working hours in the schedule timezone",
+			schedule: &PolicySchedule{
+				TimeRanges: []PolicyScheduleTimeRange{{Start: "09:00", End: "18:00"}},
+				Timezone:   "Europe/Berlin",
+			},
+			// 17:30 UTC is 18:30 in Berlin
+			time:     time.Date(2024, 11, 4, 17, 30, 0, 0, time.UTC),
+			expected: false,
+		},
+		{
+			name: "end of the time range is exclusive",
+			schedule: &PolicySchedule{
+				TimeRanges: []PolicyScheduleTimeRange{{Start: "09:00", End: "18:00"}},
+			},
+			time:     time.Date(2024, 11, 4, 18, 0, 0, 0, time.UTC),
+			expected: false,
+		},
+		{
+			name: "day not allowed",
+			schedule: &PolicySchedule{
+				Days: []time.Weekday{time.Monday},
+			},
+			time:     time.Date(2024, 11, 5, 12, 0, 0, 0, time.UTC),
+			expected: false,
+		},
+		{
+			name: "range wrapping midnight belongs to the previous day",
+			schedule: &PolicySchedule{
+				Days:       []time.Weekday{time.Monday},
+				TimeRanges: []PolicyScheduleTimeRange{{Start: "22:00", End: "06:00"}},
+			},
+			// Tuesday 03:00
+			time:     time.Date(2024, 11, 5, 3, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			name: "range wrapping midnight on a not allowed day",
+			schedule: &PolicySchedule{
+				Days:       []time.Weekday{time.Monday},
+				TimeRanges: []PolicyScheduleTimeRange{{Start: "22:00", End: "06:00"}},
+			},
+			// Monday 03:00
+			time:     time.Date(2024, 11, 4, 3, 0, 0, 0, time.UTC),
+			expected: false,
+		},
+		{
+			name: "before the start date",
+			schedule: &PolicySchedule{
+				StartDate: &startDate,
+				EndDate:   &endDate,
+			},
+			time:     time.Date(2024, 10, 31, 12, 0, 0, 0, time.UTC),
+			expected: false,
+		},
+		{
+			name: "between start and end dates",
+			schedule: &PolicySchedule{
+				StartDate: &startDate,
+				EndDate:   &endDate,
+			},
+			time:     time.Date(2024, 11, 15, 12, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			name: "at the end date",
+			schedule: &PolicySchedule{
+				StartDate: &startDate,
+				EndDate:   &endDate,
+			},
+			time:     endDate,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.schedule.IsActive(tt.time))
+		})
+	}
+}
+
+func TestPolicySchedule_NextTransition(t *testing.T) {
+	endDate := time.Date(2024, 11, 6, 12, 0, 0, 0, time.UTC)
+	startDate := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		schedule   *PolicySchedule
+		time       time.Time
+		expected   time.Time
+		expectedOK bool
+	}{
+		{
+			name: "next window start",
+			schedule: &PolicySchedule{
+				Days:       []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+				TimeRanges: []PolicyScheduleTimeRange{{Start: "09:00", End: "18:00"}},
+			},
+			// Saturday noon, next window starts on Monday
+			time:       time.Date(2024, 11, 2, 12, 0, 0, 0, time.UTC),
+			expected:   time.Date(2024, 11, 4, 9, 0, 0, 0, time.UTC),
+			expectedOK: true,
+		},
+		{
+			name: "current window end",
+			schedule: &PolicySchedule{
+				TimeRanges: []PolicyScheduleTimeRange{{Start: "09:00", End: "18:00"}},
+				Timezone:   "Europe/Berlin",
+			},
+			time:       time.Date(2024, 11, 4, 12, 0, 0, 0, time.UTC),
+			expected:   time.Date(2024, 11, 4, 17, 0, 0, 0, time.UTC),
+			expectedOK: true,
+		},
+		{
+			name: "adjacent windows are merged",
+			schedule: &PolicySchedule{
+				TimeRanges: []PolicyScheduleTimeRange{{Start: "09:00", End: "12:00"}, {Start: "12:00", End: "18:00"}},
+			},
+			time:       time.Date(2024, 11, 4, 10, 0, 0, 0, time.UTC),
+			expected:   time.Date(2024, 11, 4, 18, 0, 0, 0, time.UTC),
+			expectedOK: true,
+		},
+		{
+			name: "end date cuts the window",
+			schedule: &PolicySchedule{
+				EndDate: &endDate,
+			},
+			time:       time.Date(2024, 11, 4, 10, 0, 0, 0, time.UTC),
+			expected:   endDate,
+			expectedOK: true,
+		},
+		{
+			name: "start date beyond the lookahead",
+			schedule: &PolicySchedule{
+				Days:       []time.Weekday{time.Monday},
+				TimeRanges: []PolicyScheduleTimeRange{{Start: "09:00", End: "18:00"}},
+				StartDate:  &startDate,
+			},
+			// start date is on Sunday 2024-12-01, the first window starts on Monday
+			time:       time.Date(2024, 11, 4, 10, 0, 0, 0, time.UTC),
+			expected:   time.Date(2024, 12, 2, 9, 0, 0, 0, time.UTC),
+			expectedOK: true,
+		},
+		{
+			name: "no transitions after the end date",
+			schedule: &PolicySchedule{
+				TimeRanges: []PolicyScheduleTimeRange{{Start: "09:00", End: "18:00"}},
+				EndDate:    &endDate,
+			},
+			time:       time.Date(2024, 11, 7, 10, 0, 0, 0, time.UTC),
+			expectedOK: false,
+		},
+		{
+			name:       "schedule without limits never transitions",
+			schedule:   &PolicySchedule{},
+			time:       time.Date(2024, 11, 7, 10, 0, 0, 0, time.UTC),
+			expectedOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transition, ok := tt.schedule.NextTransition(tt.time)
+			assert.Equal(t, tt.expectedOK, ok)
+			if tt.expectedOK {
+				assert.True(t, tt.expected.Equal(transition), "expected %s, got %s", tt.expected, transition)
+			}
+		})
+	}
+}
+
+func TestPolicySchedule_Validate(t *testing.T) {
+	startDate := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		schedule    *PolicySchedule
+		expectedErr bool
+	}{
+		{
+			name: "valid schedule",
+			schedule: &PolicySchedule{
+				Days:       []time.Weekday{time.Monday},
+				TimeRanges: []PolicyScheduleTimeRange{{Start: "22:00", End: "06:00"}},
+				Timezone:   "America/New_York",
+			},
+		},
+		{
+			name:        "unknown timezone",
+			schedule:    &PolicySchedule{Timezone: "Mars/Olympus"},
+			expectedErr: true,
+		},
+		{
+			name:        "invalid day",
+			schedule:    &PolicySchedule{Days: []time.Weekday{7}},
+			expectedErr: true,
+		},
+		{
+			name:        "invalid time format",
+			schedule:    &PolicySchedule{TimeRanges: []PolicyScheduleTimeRange{{Start: "9am", End: "18:00"}}},
+			expectedErr: true,
+		},
+		{
+			name:        "empty time range",
+			schedule:    &PolicySchedule{TimeRanges: []PolicyScheduleTimeRange{{Start: "09:00", End: "09:00"}}},
+			expectedErr: true,
+		},
+		{
+			name:        "end date before start date",
+			schedule:    &PolicySchedule{StartDate: &startDate, EndDate: &startDate},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate()
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAccount_getPeersByPolicySchedule(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	account := &Account{
+		Peers: map[string]*nbpeer.Peer{
+			"peerA": {ID: "peerA", IP: net.ParseIP("100.65.14.88"), Status: &nbpeer.PeerStatus{}},
+			"peerB": {ID: "peerB", IP: net.ParseIP("100.65.80.39"), Status: &nbpeer.PeerStatus{}},
+		},
+		Groups: map[string]*nbgroup.Group{
+			"GroupAll": {ID: "GroupAll", Name: "All", Peers: []string{"peerA", "peerB"}},
+			"GroupA":   {ID: "GroupA", Name: "A", Peers: []string{"peerA"}},
+			"GroupB":   {ID: "GroupB", Name: "B", Peers: []string{"peerB"}},
+		},
+		Policies: []*Policy{
+			{
+				ID:       "ExpiredPolicy",
+				Enabled:  true,
+				Schedule: &PolicySchedule{EndDate: &past},
+				Rules: []*PolicyRule{
+					{
+						ID:            "ExpiredRule",
+						Enabled:       true,
+						Action:        PolicyTrafficActionAccept,
+						Protocol:      PolicyRuleProtocolALL,
+						Bidirectional: true,
+						Sources:       []string{"GroupA"},
+						Destinations:  []string{"GroupB"},
+					},
+				},
+			},
+		},
+	}
+
+	validatedPeers := map[string]struct{}{"peerA": {}, "peerB": {}}
+
+	peers, rules := account.getPeerConnectionResources(context.Background(), "peerA", validatedPeers)
+	assert.Len(t, peers, 0, "expired scheduled policy should not connect peers")
+	assert.Len(t, rules, 0)
+
+	account.Policies[0].Schedule = &PolicySchedule{StartDate: &past}
+	peers, rules = account.getPeerConnectionResources(context.Background(), "peerA", validatedPeers)
+	assert.Len(t, peers, 1, "active scheduled policy should connect peers")
+	assert.Len(t, rules, 2)
+
+	_, ok := account.GetNextPolicyScheduleTransition()
+	assert.False(t, ok, "policy already started has no upcoming transitions")
+
+	future := time.Now().Add(time.Hour)
+	account.Policies[0].Schedule = &PolicySchedule{EndDate: &future}
+	next, ok := account.GetNextPolicyScheduleTransition()
+	assert.True(t, ok)
+	assert.InDelta(t, time.Hour, next, float64(time.Minute))
+}
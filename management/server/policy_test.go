@@ -488,6 +488,87 @@ func TestAccount_getPeersByPolicyDirect(t *testing.T) {
 	})
 }
 
+func TestAccount_getPeersByPolicyTagSelector(t *testing.T) {
+	account := &Account{
+		Peers: map[string]*nbpeer.Peer{
+			"peerA": {
+				ID:     "peerA",
+				IP:     net.ParseIP("100.65.14.88"),
+				Status: &nbpeer.PeerStatus{},
+				Tags:   map[string]string{"role": "web"},
+			},
+			"peerB": {
+				ID:     "peerB",
+				IP:     net.ParseIP("100.65.80.39"),
+				Status: &nbpeer.PeerStatus{},
+				Tags:   map[string]string{"env": "prod", "role": "db"},
+			},
+			"peerC": {
+				ID:     "peerC",
+				IP:     net.ParseIP("100.65.254.139"),
+				Status: &nbpeer.PeerStatus{},
+				Meta:   nbpeer.PeerSystemMeta{Tags: map[string]string{"env": "prod", "role": "db"}},
+			},
+		},
+		Groups: map[string]*nbgroup.Group{
+			"GroupWeb": {
+				ID:    "GroupWeb",
+				Name:  "web",
+				Peers: []string{"peerA"},
+			},
+		},
+		Policies: []*Policy{
+			{
+				ID:      "RuleDatabase",
+				Name:    "Database",
+				Enabled: true,
+				Rules: []*PolicyRule{
+					{
+						ID:                     "RuleDatabase",
+						Name:                   "Database",
+						Bidirectional:          false,
+						Enabled:                true,
+						Protocol:               PolicyRuleProtocolTCP,
+						Action:                 PolicyTrafficActionAccept,
+						Ports:                  []string{"5432"},
+						Sources:                []string{"GroupWeb"},
+						DestinationTagSelector: "env=prod,role in (db,cache)",
+					},
+				},
+			},
+		},
+	}
+
+	validatedPeers := make(map[string]struct{})
+	for p := range account.Peers {
+		validatedPeers[p] = struct{}{}
+	}
+
+	t.Run("source peer gets peers matching the destination selector", func(t *testing.T) {
+		peers, firewallRules := account.getPeerConnectionResources(context.Background(), "peerA", validatedPeers)
+		assert.Len(t, peers, 1)
+		assert.Contains(t, peers, account.Peers["peerB"])
+		assert.Len(t, firewallRules, 1)
+		assert.Equal(t, "100.65.80.39", firewallRules[0].PeerIP)
+		assert.Equal(t, firewallRuleDirectionOUT, firewallRules[0].Direction)
+	})
+
+	t.Run("matching destination peer gets the source peers", func(t *testing.T) {
+		peers, firewallRules := account.getPeerConnectionResources(context.Background(), "peerB", validatedPeers)
+		assert.Len(t, peers, 1)
+		assert.Contains(t, peers, account.Peers["peerA"])
+		assert.Len(t, firewallRules, 1)
+		assert.Equal(t, "100.65.14.88", firewallRules[0].PeerIP)
+		assert.Equal(t, firewallRuleDirectionIN, firewallRules[0].Direction)
+	})
+
+	t.Run("reported tags are not matched", func(t *testing.T) {
+		peers, firewallRules := account.getPeerConnectionResources(context.Background(), "peerC", validatedPeers)
+		assert.Len(t, peers, 0)
+		assert.Len(t, firewallRules, 0)
+	})
+}
+
 func TestAccount_getPeersByPolicyPostureChecks(t *testing.T) {
 	account := &Account{
 		Peers: map[string]*nbpeer.Peer{
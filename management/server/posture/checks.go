@@ -5,6 +5,8 @@ import (
 	"errors"
 	"net/netip"
 	"regexp"
+	"slices"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"github.com/netbirdio/netbird/management/server/http/api"
@@ -18,6 +20,9 @@ const (
 	GeoLocationCheckName      = "GeoLocationCheck"
 	PeerNetworkRangeCheckName = "PeerNetworkRangeCheck"
 	ProcessCheckName          = "ProcessCheck"
+	DiskEncryptionCheckName   = "DiskEncryptionCheck"
+	FirewallCheckName         = "FirewallCheck"
+	FileContentCheckName      = "FileContentCheck"
 
 	CheckActionAllow string = "allow"
 	CheckActionDeny  string = "deny"
@@ -49,6 +54,9 @@ type Checks struct {
 
 	// Checks is a set of objects that perform the actual checks
 	Checks ChecksDefinition `gorm:"serializer:json"`
+
+	// GracePeriod is how long peers failing the checks keep the access before being considered non-compliant
+	GracePeriod time.Duration `json:",omitempty"`
 }
 
 // ChecksDefinition contains definition of actual check
@@ -58,6 +66,9 @@ type ChecksDefinition struct {
 	GeoLocationCheck      *GeoLocationCheck      `json:",omitempty"`
 	PeerNetworkRangeCheck *PeerNetworkRangeCheck `json:",omitempty"`
 	ProcessCheck          *ProcessCheck          `json:",omitempty"`
+	DiskEncryptionCheck   *DiskEncryptionCheck   `json:",omitempty"`
+	FirewallCheck         *FirewallCheck         `json:",omitempty"`
+	FileContentCheck      *FileContentCheck      `json:",omitempty"`
 }
 
 // Copy returns a copy of a checks definition.
@@ -110,6 +121,19 @@ func (cd ChecksDefinition) Copy() ChecksDefinition {
 		}
 		copy(cdCopy.ProcessCheck.Processes, processCheck.Processes)
 	}
+	if cd.DiskEncryptionCheck != nil {
+		cdCopy.DiskEncryptionCheck = &DiskEncryptionCheck{
+			Volumes: slices.Clone(cd.DiskEncryptionCheck.Volumes),
+		}
+	}
+	if cd.FirewallCheck != nil {
+		cdCopy.FirewallCheck = &FirewallCheck{}
+	}
+	if cd.FileContentCheck != nil {
+		cdCopy.FileContentCheck = &FileContentCheck{
+			Files: slices.Clone(cd.FileContentCheck.Files),
+		}
+	}
 	return cdCopy
 }
 
@@ -126,6 +150,7 @@ func (pc *Checks) Copy() *Checks {
 		Description: pc.Description,
 		AccountID:   pc.AccountID,
 		Checks:      pc.Checks.Copy(),
+		GracePeriod: pc.GracePeriod,
 	}
 	return checks
 }
@@ -153,6 +178,15 @@ func (pc *Checks) GetChecks() []Check {
 	if pc.Checks.ProcessCheck != nil {
 		checks = append(checks, pc.Checks.ProcessCheck)
 	}
+	if pc.Checks.DiskEncryptionCheck != nil {
+		checks = append(checks, pc.Checks.DiskEncryptionCheck)
+	}
+	if pc.Checks.FirewallCheck != nil {
+		checks = append(checks, pc.Checks.FirewallCheck)
+	}
+	if pc.Checks.FileContentCheck != nil {
+		checks = append(checks, pc.Checks.FileContentCheck)
+	}
 	return checks
 }
 
@@ -162,20 +196,27 @@ func NewChecksFromAPIPostureCheck(source api.PostureCheck) (*Checks, error) {
 		description = *source.Description
 	}
 
-	return buildPostureCheck(source.Id, source.Name, description, source.Checks)
+	return buildPostureCheck(source.Id, source.Name, description, source.GracePeriod, source.Checks)
 }
 
 func NewChecksFromAPIPostureCheckUpdate(source api.PostureCheckUpdate, postureChecksID string) (*Checks, error) {
-	return buildPostureCheck(postureChecksID, source.Name, source.Description, *source.Checks)
+	return buildPostureCheck(postureChecksID, source.Name, source.Description, source.GracePeriod, *source.Checks)
 }
 
-func buildPostureCheck(postureChecksID string, name string, description string, checks api.Checks) (*Checks, error) {
+func buildPostureCheck(postureChecksID string, name string, description string, gracePeriod *int, checks api.Checks) (*Checks, error) {
 	postureChecks := Checks{
 		ID:          postureChecksID,
 		Name:        name,
 		Description: description,
 	}
 
+	if gracePeriod != nil {
+		if *gracePeriod < 0 {
+			return nil, status.Errorf(status.InvalidArgument, "grace period shouldn't be negative")
+		}
+		postureChecks.GracePeriod = time.Duration(*gracePeriod) * time.Second
+	}
+
 	if nbVersionCheck := checks.NbVersionCheck; nbVersionCheck != nil {
 		postureChecks.Checks.NBVersionCheck = &NBVersionCheck{
 			MinVersion: nbVersionCheck.MinVersion,
@@ -208,6 +249,21 @@ func buildPostureCheck(postureChecksID string, name string, description string,
 		postureChecks.Checks.ProcessCheck = toProcessCheck(processCheck)
 	}
 
+	if diskEncryptionCheck := checks.DiskEncryptionCheck; diskEncryptionCheck != nil {
+		postureChecks.Checks.DiskEncryptionCheck = &DiskEncryptionCheck{}
+		if diskEncryptionCheck.Volumes != nil {
+			postureChecks.Checks.DiskEncryptionCheck.Volumes = *diskEncryptionCheck.Volumes
+		}
+	}
+
+	if checks.FirewallCheck != nil {
+		postureChecks.Checks.FirewallCheck = &FirewallCheck{}
+	}
+
+	if fileContentCheck := checks.FileContentCheck; fileContentCheck != nil {
+		postureChecks.Checks.FileContentCheck = toFileContentCheck(fileContentCheck)
+	}
+
 	return &postureChecks, nil
 }
 
@@ -242,12 +298,32 @@ func (pc *Checks) ToAPIResponse() *api.PostureCheck {
 		checks.ProcessCheck = toProcessCheckResponse(pc.Checks.ProcessCheck)
 	}
 
-	return &api.PostureCheck{
+	if pc.Checks.DiskEncryptionCheck != nil {
+		volumes := append(make([]string, 0, len(pc.Checks.DiskEncryptionCheck.Volumes)), pc.Checks.DiskEncryptionCheck.Volumes...)
+		checks.DiskEncryptionCheck = &api.DiskEncryptionCheck{
+			Volumes: &volumes,
+		}
+	}
+
+	if pc.Checks.FirewallCheck != nil {
+		checks.FirewallCheck = &api.FirewallCheck{}
+	}
+
+	if pc.Checks.FileContentCheck != nil {
+		checks.FileContentCheck = toFileContentCheckResponse(pc.Checks.FileContentCheck)
+	}
+
+	response := &api.PostureCheck{
 		Id:          pc.ID,
 		Name:        pc.Name,
 		Description: &pc.Description,
 		Checks:      checks,
 	}
+	if pc.GracePeriod > 0 {
+		gracePeriod := int(pc.GracePeriod.Seconds())
+		response.GracePeriod = &gracePeriod
+	}
+	return response
 }
 
 // Validate checks the validity of a posture checks.
@@ -386,3 +462,53 @@ func toProcessCheck(check *api.ProcessCheck) *ProcessCheck {
 		Processes: processes,
 	}
 }
+
+func toFileContentCheckResponse(check *FileContentCheck) *api.FileContentCheck {
+	files := make([]api.FileContent, 0, len(check.Files))
+	for i := range check.Files {
+		file := api.FileContent{
+			LinuxPath:   &check.Files[i].LinuxPath,
+			MacPath:     &check.Files[i].MacPath,
+			WindowsPath: &check.Files[i].WindowsPath,
+		}
+		if check.Files[i].SHA256 != "" {
+			file.Sha256 = &check.Files[i].SHA256
+		}
+		if check.Files[i].ContentRegex != "" {
+			file.ContentRegex = &check.Files[i].ContentRegex
+		}
+		files = append(files, file)
+	}
+
+	return &api.FileContentCheck{
+		Files: files,
+	}
+}
+
+func toFileContentCheck(check *api.FileContentCheck) *FileContentCheck {
+	files := make([]FileContent, 0, len(check.Files))
+	for _, file := range check.Files {
+		var f FileContent
+		if file.LinuxPath != nil {
+			f.LinuxPath = *file.LinuxPath
+		}
+		if file.MacPath != nil {
+			f.MacPath = *file.MacPath
+		}
+		if file.WindowsPath != nil {
+			f.WindowsPath = *file.WindowsPath
+		}
+		if file.Sha256 != nil {
+			f.SHA256 = *file.Sha256
+		}
+		if file.ContentRegex != nil {
+			f.ContentRegex = *file.ContentRegex
+		}
+
+		files = append(files, f)
+	}
+
+	return &FileContentCheck{
+		Files: files,
+	}
+}
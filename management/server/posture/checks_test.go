@@ -283,6 +283,10 @@ func TestChecks_Copy(t *testing.T) {
 					},
 				},
 			},
+			DiskEncryptionCheck: &DiskEncryptionCheck{
+				Volumes: []string{"/"},
+			},
+			FirewallCheck: &FirewallCheck{},
 		},
 	}
 	checkCopy := check.Copy()
@@ -297,4 +301,7 @@ func TestChecks_Copy(t *testing.T) {
 	// Updating the original check should not take effect on copy
 	check.Name = "name"
 	assert.NotSame(t, check, checkCopy)
+
+	check.Checks.DiskEncryptionCheck.Volumes[0] = "/home"
+	assert.Equal(t, []string{"/"}, checkCopy.Checks.DiskEncryptionCheck.Volumes)
 }
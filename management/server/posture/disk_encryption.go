@@ -0,0 +1,60 @@
+package posture
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+// DiskEncryptionCheck checks that the volumes of the peer are protected by full-disk encryption.
+type DiskEncryptionCheck struct {
+	// Volumes are the mount points that must be encrypted, e.g. / or C:.
+	// When empty, the system volume of the peer must be encrypted.
+	Volumes []string
+}
+
+var _ Check = (*DiskEncryptionCheck)(nil)
+
+func (d *DiskEncryptionCheck) Check(ctx context.Context, peer nbpeer.Peer) (bool, error) {
+	volumes := d.Volumes
+	if len(volumes) == 0 {
+		volumes = []string{systemVolume(peer.Meta.GoOS)}
+	}
+
+	encrypted := make(map[string]bool, len(peer.Meta.DiskEncryption))
+	for _, volume := range peer.Meta.DiskEncryption {
+		encrypted[volume.Path] = volume.Encrypted
+	}
+
+	for _, path := range volumes {
+		if !encrypted[path] {
+			log.WithContext(ctx).Debugf("peer %s volume %s is not encrypted or not reported", peer.ID, path)
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (d *DiskEncryptionCheck) Name() string {
+	return DiskEncryptionCheckName
+}
+
+func (d *DiskEncryptionCheck) Validate() error {
+	for _, path := range d.Volumes {
+		if path == "" {
+			return fmt.Errorf("%s volume path shouldn't be empty", d.Name())
+		}
+	}
+	return nil
+}
+
+// systemVolume returns the mount point of the volume holding the operating system.
+func systemVolume(goOS string) string {
+	if goOS == "windows" {
+		return "C:"
+	}
+	return "/"
+}
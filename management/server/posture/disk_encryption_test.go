@@ -0,0 +1,106 @@
+package posture
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/management/server/peer"
+)
+
+func TestDiskEncryptionCheck_Check(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   peer.Peer
+		check   DiskEncryptionCheck
+		isValid bool
+	}{
+		{
+			name: "linux system volume encrypted",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					GoOS: "linux",
+					DiskEncryption: []peer.DiskEncryptionVolume{
+						{Path: "/", Encrypted: true},
+						{Path: "/boot", Encrypted: false},
+					},
+				},
+			},
+			check:   DiskEncryptionCheck{},
+			isValid: true,
+		},
+		{
+			name: "windows system volume not encrypted",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					GoOS: "windows",
+					DiskEncryption: []peer.DiskEncryptionVolume{
+						{Path: "C:", Encrypted: false},
+						{Path: "D:", Encrypted: true},
+					},
+				},
+			},
+			check:   DiskEncryptionCheck{},
+			isValid: false,
+		},
+		{
+			name:    "no reported volumes",
+			input:   peer.Peer{Meta: peer.PeerSystemMeta{GoOS: "darwin"}},
+			check:   DiskEncryptionCheck{},
+			isValid: false,
+		},
+		{
+			name: "required volume encrypted",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					DiskEncryption: []peer.DiskEncryptionVolume{
+						{Path: "C:", Encrypted: true},
+						{Path: "D:", Encrypted: false},
+					},
+				},
+			},
+			check:   DiskEncryptionCheck{Volumes: []string{"C:"}},
+			isValid: true,
+		},
+		{
+			name: "required volume not encrypted",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					DiskEncryption: []peer.DiskEncryptionVolume{
+						{Path: "C:", Encrypted: true},
+						{Path: "D:", Encrypted: false},
+					},
+				},
+			},
+			check:   DiskEncryptionCheck{Volumes: []string{"C:", "D:"}},
+			isValid: false,
+		},
+		{
+			name: "required volume not reported",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					DiskEncryption: []peer.DiskEncryptionVolume{
+						{Path: "/", Encrypted: true},
+					},
+				},
+			},
+			check:   DiskEncryptionCheck{Volumes: []string{"/home"}},
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isValid, err := tt.check.Check(context.Background(), tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.isValid, isValid)
+		})
+	}
+}
+
+func TestDiskEncryptionCheck_Validate(t *testing.T) {
+	assert.NoError(t, (&DiskEncryptionCheck{}).Validate())
+	assert.NoError(t, (&DiskEncryptionCheck{Volumes: []string{"/"}}).Validate())
+	assert.Error(t, (&DiskEncryptionCheck{Volumes: []string{""}}).Validate())
+}
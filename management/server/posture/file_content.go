@@ -0,0 +1,112 @@
+package posture
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+// FileContent is a file that must exist on the peer with the expected hash and/or content.
+type FileContent struct {
+	LinuxPath   string
+	MacPath     string
+	WindowsPath string
+	// SHA256 is the hex encoded hash the file must have
+	SHA256 string
+	// ContentRegex is a regular expression the file content must match, e.g. a setting in an EDR agent config file
+	ContentRegex string
+}
+
+// FileContentCheck checks the hash or the content of files on the peer.
+type FileContentCheck struct {
+	Files []FileContent
+}
+
+var _ Check = (*FileContentCheck)(nil)
+
+func (f *FileContentCheck) Check(ctx context.Context, peer nbpeer.Peer) (bool, error) {
+	var pathSelector func(FileContent) string
+	switch peer.Meta.GoOS {
+	case "linux":
+		pathSelector = func(file FileContent) string { return file.LinuxPath }
+	case "darwin":
+		pathSelector = func(file FileContent) string { return file.MacPath }
+	case "windows":
+		pathSelector = func(file FileContent) string { return file.WindowsPath }
+	default:
+		return false, fmt.Errorf("unsupported peer's operating system: %s", peer.Meta.GoOS)
+	}
+
+	hashes := make(map[string]string, len(peer.Meta.FileHashes))
+	for _, file := range peer.Meta.FileHashes {
+		hashes[file.Path] = file.SHA256
+	}
+
+	for _, file := range f.Files {
+		path := pathSelector(file)
+		if path == "" {
+			return false, nil
+		}
+
+		if file.SHA256 != "" && !strings.EqualFold(hashes[path], file.SHA256) {
+			log.WithContext(ctx).Debugf("peer %s file %s hash doesn't match", peer.ID, path)
+			return false, nil
+		}
+
+		if file.ContentRegex != "" && !isFileContentMatched(peer.Meta.FileContents, path, file.ContentRegex) {
+			log.WithContext(ctx).Debugf("peer %s file %s content doesn't match %s", peer.ID, path, file.ContentRegex)
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (f *FileContentCheck) Name() string {
+	return FileContentCheckName
+}
+
+func (f *FileContentCheck) Validate() error {
+	if len(f.Files) == 0 {
+		return fmt.Errorf("%s files shouldn't be empty", f.Name())
+	}
+
+	for _, file := range f.Files {
+		if file.LinuxPath == "" && file.MacPath == "" && file.WindowsPath == "" {
+			return fmt.Errorf("%s path shouldn't be empty", f.Name())
+		}
+
+		if file.SHA256 == "" && file.ContentRegex == "" {
+			return fmt.Errorf("%s sha256 or content regex should be set", f.Name())
+		}
+
+		if file.SHA256 != "" {
+			if hash, err := hex.DecodeString(file.SHA256); err != nil || len(hash) != 32 {
+				return fmt.Errorf("%s sha256 %s is not valid", f.Name(), file.SHA256)
+			}
+		}
+
+		if file.ContentRegex != "" {
+			if _, err := regexp.Compile(file.ContentRegex); err != nil {
+				return fmt.Errorf("%s content regex %s is not valid: %v", f.Name(), file.ContentRegex, err)
+			}
+		}
+	}
+	return nil
+}
+
+// isFileContentMatched checks if the peer reported the file content as matching the pattern.
+func isFileContentMatched(contents []nbpeer.FileContentMatch, path, pattern string) bool {
+	for _, content := range contents {
+		if content.Path == path && content.Pattern == pattern {
+			return content.Matched
+		}
+	}
+	return false
+}
@@ -0,0 +1,202 @@
+package posture
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/management/server/peer"
+)
+
+const testSHA256 = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+func TestFileContentCheck_Check(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   peer.Peer
+		check   FileContentCheck
+		wantErr bool
+		isValid bool
+	}{
+		{
+			name: "linux with matching hash",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					GoOS:       "linux",
+					FileHashes: []peer.FileHash{{Path: "/usr/bin/edr", SHA256: testSHA256}},
+				},
+			},
+			check: FileContentCheck{
+				Files: []FileContent{{LinuxPath: "/usr/bin/edr", SHA256: "9F86D081884C7D659A2FEAA0C55AD015A3BF4F1B2B0B822CD15D6C15B0F00A08"}},
+			},
+			isValid: true,
+		},
+		{
+			name: "linux with different hash",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					GoOS:       "linux",
+					FileHashes: []peer.FileHash{{Path: "/usr/bin/edr", SHA256: "abcd"}},
+				},
+			},
+			check: FileContentCheck{
+				Files: []FileContent{{LinuxPath: "/usr/bin/edr", SHA256: testSHA256}},
+			},
+			isValid: false,
+		},
+		{
+			name: "linux with unreadable file",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					GoOS:       "linux",
+					FileHashes: []peer.FileHash{{Path: "/usr/bin/edr"}},
+				},
+			},
+			check: FileContentCheck{
+				Files: []FileContent{{LinuxPath: "/usr/bin/edr", SHA256: testSHA256}},
+			},
+			isValid: false,
+		},
+		{
+			name: "windows with matching content",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					GoOS: "windows",
+					FileContents: []peer.FileContentMatch{
+						{Path: "C:\\edr\\agent.conf", Pattern: "enabled=true", Matched: true},
+					},
+				},
+			},
+			check: FileContentCheck{
+				Files: []FileContent{{WindowsPath: "C:\\edr\\agent.conf", ContentRegex: "enabled=true"}},
+			},
+			isValid: true,
+		},
+		{
+			name: "darwin with content not matching",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					GoOS: "darwin",
+					FileContents: []peer.FileContentMatch{
+						{Path: "/etc/edr.conf", Pattern: "enabled=true", Matched: false},
+					},
+				},
+			},
+			check: FileContentCheck{
+				Files: []FileContent{{MacPath: "/etc/edr.conf", ContentRegex: "enabled=true"}},
+			},
+			isValid: false,
+		},
+		{
+			name: "darwin with pattern not reported",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					GoOS: "darwin",
+					FileContents: []peer.FileContentMatch{
+						{Path: "/etc/edr.conf", Pattern: "enabled=false", Matched: true},
+					},
+				},
+			},
+			check: FileContentCheck{
+				Files: []FileContent{{MacPath: "/etc/edr.conf", ContentRegex: "enabled=true"}},
+			},
+			isValid: false,
+		},
+		{
+			name: "linux without path for the peer os",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{GoOS: "linux"},
+			},
+			check: FileContentCheck{
+				Files: []FileContent{{WindowsPath: "C:\\edr\\agent.conf", SHA256: testSHA256}},
+			},
+			isValid: false,
+		},
+		{
+			name: "unsupported os",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{GoOS: "ios"},
+			},
+			check: FileContentCheck{
+				Files: []FileContent{{LinuxPath: "/usr/bin/edr", SHA256: testSHA256}},
+			},
+			wantErr: true,
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isValid, err := tt.check.Check(context.Background(), tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.isValid, isValid)
+		})
+	}
+}
+
+func TestFileContentCheck_Validate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		check         FileContentCheck
+		expectedError bool
+	}{
+		{
+			name: "valid hash and content regex",
+			check: FileContentCheck{
+				Files: []FileContent{
+					{LinuxPath: "/usr/bin/edr", SHA256: testSHA256},
+					{WindowsPath: "C:\\edr\\agent.conf", ContentRegex: `(?m)^enabled\s*=\s*true$`},
+				},
+			},
+		},
+		{
+			name:          "empty files",
+			check:         FileContentCheck{},
+			expectedError: true,
+		},
+		{
+			name: "missing path",
+			check: FileContentCheck{
+				Files: []FileContent{{SHA256: testSHA256}},
+			},
+			expectedError: true,
+		},
+		{
+			name: "missing hash and content regex",
+			check: FileContentCheck{
+				Files: []FileContent{{LinuxPath: "/usr/bin/edr"}},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid hash",
+			check: FileContentCheck{
+				Files: []FileContent{{LinuxPath: "/usr/bin/edr", SHA256: "abcd"}},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid content regex",
+			check: FileContentCheck{
+				Files: []FileContent{{LinuxPath: "/etc/edr.conf", ContentRegex: "enabled=(true"}},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.check.Validate()
+			if tc.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
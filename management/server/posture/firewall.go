@@ -0,0 +1,30 @@
+package posture
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+// FirewallCheck checks that the host firewall of the peer is enabled.
+type FirewallCheck struct{}
+
+var _ Check = (*FirewallCheck)(nil)
+
+func (f *FirewallCheck) Check(ctx context.Context, peer nbpeer.Peer) (bool, error) {
+	if !peer.Meta.FirewallEnabled {
+		log.WithContext(ctx).Debugf("peer %s host firewall is not enabled", peer.ID)
+		return false, nil
+	}
+	return true, nil
+}
+
+func (f *FirewallCheck) Name() string {
+	return FirewallCheckName
+}
+
+func (f *FirewallCheck) Validate() error {
+	return nil
+}
@@ -0,0 +1,22 @@
+package posture
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/management/server/peer"
+)
+
+func TestFirewallCheck_Check(t *testing.T) {
+	check := FirewallCheck{}
+
+	isValid, err := check.Check(context.Background(), peer.Peer{Meta: peer.PeerSystemMeta{FirewallEnabled: true}})
+	assert.NoError(t, err)
+	assert.True(t, isValid)
+
+	isValid, err = check.Check(context.Background(), peer.Peer{})
+	assert.NoError(t, err)
+	assert.False(t, isValid)
+}
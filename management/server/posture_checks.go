@@ -57,6 +57,10 @@ func (am *DefaultAccountManager) SavePostureChecks(ctx context.Context, accountI
 		}
 
 		if isUpdate {
+			if err = validateSetupKeysPostureChecksUpdate(ctx, transaction, accountID, postureChecks); err != nil {
+				return err
+			}
+
 			updateAccountPeers, err = arePostureCheckChangesAffectPeers(ctx, transaction, accountID, postureChecks.ID)
 			if err != nil {
 				return err
@@ -79,6 +83,7 @@ func (am *DefaultAccountManager) SavePostureChecks(ctx context.Context, accountI
 	am.StoreEvent(ctx, userID, postureChecks.ID, accountID, action, postureChecks.EventMeta())
 
 	if updateAccountPeers {
+		am.checkAccountPostureStatuses(ctx, accountID)
 		am.updateAccountPeers(ctx, accountID)
 	}
 
@@ -115,6 +120,10 @@ func (am *DefaultAccountManager) DeletePostureChecks(ctx context.Context, accoun
 			return err
 		}
 
+		if err = isPostureCheckLinkedToSetupKey(ctx, transaction, postureChecksID, accountID); err != nil {
+			return err
+		}
+
 		if err = transaction.IncrementNetworkSerial(ctx, LockingStrengthUpdate, accountID); err != nil {
 			return err
 		}
@@ -0,0 +1,180 @@
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Resource is an account resource protected by the role permissions
+type Resource string
+
+const (
+	ResourcePeers     Resource = "peers"
+	ResourceGroups    Resource = "groups"
+	ResourcePolicies  Resource = "policies"
+	ResourceRoutes    Resource = "routes"
+	ResourceDNS       Resource = "dns"
+	ResourceSetupKeys Resource = "setup_keys"
+	ResourceUsers     Resource = "users"
+	ResourceEvents    Resource = "events"
+)
+
+// Resources are all resources a role can grant permissions on
+var Resources = []Resource{
+	ResourcePeers, ResourceGroups, ResourcePolicies, ResourceRoutes,
+	ResourceDNS, ResourceSetupKeys, ResourceUsers, ResourceEvents,
+}
+
+// Operation is an action performed on a resource
+type Operation string
+
+const (
+	OperationRead   Operation = "read"
+	OperationCreate Operation = "create"
+	OperationUpdate Operation = "update"
+	OperationDelete Operation = "delete"
+)
+
+// Operations are all operations a role can grant
+var Operations = []Operation{OperationRead, OperationCreate, OperationUpdate, OperationDelete}
+
+// Permission grants operations on a resource
+type Permission struct {
+	Resource   Resource    `json:"resource"`
+	Operations []Operation `json:"operations"`
+}
+
+// Role is a custom account role granting fine-grained permissions on the account resources.
+// Custom roles extend the permissions of the built-in user role they are assigned together with.
+type Role struct {
+	// ID of the role
+	ID string `gorm:"primaryKey"`
+
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `json:"-" gorm:"index"`
+
+	// Name of the role
+	Name string
+
+	// Description of the role
+	Description string
+
+	// Permissions granted by the role
+	Permissions []Permission `gorm:"serializer:json"`
+}
+
+// TableName returns the name of the table for the Role model in the database.
+func (*Role) TableName() string {
+	return "custom_roles"
+}
+
+// NewRoleFromAPIRequest creates a role from the API request
+func NewRoleFromAPIRequest(req *api.RoleRequest, roleID string) *Role {
+	role := &Role{
+		ID:          roleID,
+		Name:        req.Name,
+		Permissions: make([]Permission, 0, len(req.Permissions)),
+	}
+
+	if req.Description != nil {
+		role.Description = *req.Description
+	}
+
+	for _, permission := range req.Permissions {
+		operations := make([]Operation, 0, len(permission.Operations))
+		for _, operation := range permission.Operations {
+			operations = append(operations, Operation(operation))
+		}
+		role.Permissions = append(role.Permissions, Permission{
+			Resource:   Resource(permission.Resource),
+			Operations: operations,
+		})
+	}
+
+	return role
+}
+
+// ToAPIResponse converts the role to the API response
+func (r *Role) ToAPIResponse() *api.Role {
+	permissions := make([]api.RolePermission, 0, len(r.Permissions))
+	for _, permission := range r.Permissions {
+		operations := make([]api.RolePermissionOperations, 0, len(permission.Operations))
+		for _, operation := range permission.Operations {
+			operations = append(operations, api.RolePermissionOperations(operation))
+		}
+		permissions = append(permissions, api.RolePermission{
+			Resource:   api.RolePermissionResource(permission.Resource),
+			Operations: operations,
+		})
+	}
+
+	return &api.Role{
+		Id:          r.ID,
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: permissions,
+	}
+}
+
+// Copy returns a copy of the role
+func (r *Role) Copy() *Role {
+	c := *r
+	c.Permissions = make([]Permission, 0, len(r.Permissions))
+	for _, permission := range r.Permissions {
+		c.Permissions = append(c.Permissions, Permission{
+			Resource:   permission.Resource,
+			Operations: slices.Clone(permission.Operations),
+		})
+	}
+	return &c
+}
+
+// EventMeta returns activity event meta related to the role
+func (r *Role) EventMeta() map[string]any {
+	return map[string]any{"name": r.Name}
+}
+
+// Validate checks that the role has a name and grants only known operations on known resources
+func (r *Role) Validate() error {
+	if r.Name == "" {
+		return errors.New("role name shouldn't be empty")
+	}
+
+	seen := make(map[Resource]struct{}, len(r.Permissions))
+	for _, permission := range r.Permissions {
+		if !slices.Contains(Resources, permission.Resource) {
+			return fmt.Errorf("unknown resource %s", permission.Resource)
+		}
+
+		if _, ok := seen[permission.Resource]; ok {
+			return fmt.Errorf("duplicate permission for resource %s", permission.Resource)
+		}
+		seen[permission.Resource] = struct{}{}
+
+		for _, operation := range permission.Operations {
+			if !slices.Contains(Operations, operation) {
+				return fmt.Errorf("unknown operation %s on resource %s", operation, permission.Resource)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Allows returns true if the role grants the operation on the resource. A nil role allows nothing.
+func (r *Role) Allows(resource Resource, operation Operation) bool {
+	if r == nil {
+		return false
+	}
+
+	for _, permission := range r.Permissions {
+		if permission.Resource == resource {
+			return slices.Contains(permission.Operations, operation)
+		}
+	}
+
+	return false
+}
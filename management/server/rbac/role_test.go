@@ -0,0 +1,75 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRole_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    *Role
+		wantErr bool
+	}{
+		{
+			name: "valid role",
+			role: &Role{Name: "operator", Permissions: []Permission{
+				{Resource: ResourcePeers, Operations: []Operation{OperationRead, OperationUpdate}},
+				{Resource: ResourceSetupKeys, Operations: []Operation{OperationCreate}},
+			}},
+		},
+		{
+			name: "role without permissions",
+			role: &Role{Name: "viewer"},
+		},
+		{
+			name:    "empty name",
+			role:    &Role{},
+			wantErr: true,
+		},
+		{
+			name:    "unknown resource",
+			role:    &Role{Name: "operator", Permissions: []Permission{{Resource: "accounts", Operations: []Operation{OperationRead}}}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown operation",
+			role:    &Role{Name: "operator", Permissions: []Permission{{Resource: ResourcePeers, Operations: []Operation{"execute"}}}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate resource",
+			role: &Role{Name: "operator", Permissions: []Permission{
+				{Resource: ResourcePeers, Operations: []Operation{OperationRead}},
+				{Resource: ResourcePeers, Operations: []Operation{OperationDelete}},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.role.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRole_Allows(t *testing.T) {
+	role := &Role{Name: "operator", Permissions: []Permission{
+		{Resource: ResourceRoutes, Operations: []Operation{OperationRead, OperationUpdate}},
+	}}
+
+	assert.True(t, role.Allows(ResourceRoutes, OperationRead))
+	assert.True(t, role.Allows(ResourceRoutes, OperationUpdate))
+	assert.False(t, role.Allows(ResourceRoutes, OperationDelete))
+	assert.False(t, role.Allows(ResourcePeers, OperationRead))
+
+	var noRole *Role
+	assert.False(t, noRole.Allows(ResourceRoutes, OperationRead))
+}
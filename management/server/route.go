@@ -7,6 +7,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"github.com/rs/xid"
@@ -17,6 +18,7 @@ import (
 	"github.com/netbirdio/netbird/management/domain"
 	"github.com/netbirdio/netbird/management/proto"
 	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/rbac"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/route"
 )
@@ -52,7 +54,7 @@ func (am *DefaultAccountManager) GetRoute(ctx context.Context, accountID string,
 		return nil, err
 	}
 
-	if !user.IsAdminOrServiceUser() || user.AccountID != accountID {
+	if user.AccountID != accountID || (!user.IsAdminOrServiceUser() && !am.hasPermission(ctx, user, rbac.ResourceRoutes, rbac.OperationRead)) {
 		return nil, status.Errorf(status.PermissionDenied, "only users with admin power can view Network Routes")
 	}
 
@@ -141,8 +143,19 @@ func getRouteDescriptor(prefix netip.Prefix, domains domain.List) string {
 	return fmt.Sprintf("prefix %s", prefix.String())
 }
 
+// CreateRouteOptions holds the optional properties of a new route
+type CreateRouteOptions struct {
+	// PeerTagSelector selects the routing peers by their tags instead of a peer or peer groups
+	PeerTagSelector string
+}
+
 // CreateRoute creates and saves a new route
 func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool) (*route.Route, error) {
+	return am.CreateRouteWithOptions(ctx, accountID, prefix, networkType, domains, peerID, peerGroupIDs, description, netID, masquerade, metric, groups, accessControlGroupIDs, enabled, userID, keepRoute, CreateRouteOptions{})
+}
+
+// CreateRouteWithOptions creates and saves a new route like CreateRoute with the optional properties of the route
+func (am *DefaultAccountManager) CreateRouteWithOptions(ctx context.Context, accountID string, prefix netip.Prefix, networkType route.NetworkType, domains domain.List, peerID string, peerGroupIDs []string, description string, netID route.NetID, masquerade bool, metric int, groups, accessControlGroupIDs []string, enabled bool, userID string, keepRoute bool, opts CreateRouteOptions) (*route.Route, error) {
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
@@ -151,13 +164,6 @@ func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID stri
 		return nil, err
 	}
 
-	// Do not allow non-Linux peers
-	if peer := account.GetPeer(peerID); peer != nil {
-		if peer.Meta.GoOS != "linux" {
-			return nil, status.Errorf(status.InvalidArgument, "non-linux peers are not supported as network routes")
-		}
-	}
-
 	if len(domains) > 0 && prefix.IsValid() {
 		return nil, status.Errorf(status.InvalidArgument, "domains and network should not be provided at the same time")
 	}
@@ -170,62 +176,28 @@ func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID stri
 		prefix = getPlaceholderIP()
 	}
 
-	if peerID != "" && len(peerGroupIDs) != 0 {
-		return nil, status.Errorf(
-			status.InvalidArgument,
-			"peer with ID %s and peers group %s should not be provided at the same time",
-			peerID, peerGroupIDs)
-	}
-
-	var newRoute route.Route
-	newRoute.ID = route.ID(xid.New().String())
-
-	if len(peerGroupIDs) > 0 {
-		err = validateGroups(peerGroupIDs, account.Groups)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if len(accessControlGroupIDs) > 0 {
-		err = validateGroups(accessControlGroupIDs, account.Groups)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	err = am.checkRoutePrefixOrDomainsExistForPeers(account, peerID, newRoute.ID, peerGroupIDs, prefix, domains)
-	if err != nil {
+	newRoute := route.Route{
+		ID:                  route.ID(xid.New().String()),
+		Peer:                peerID,
+		PeerGroups:          peerGroupIDs,
+		PeerTagSelector:     opts.PeerTagSelector,
+		Network:             prefix,
+		Domains:             domains,
+		NetworkType:         networkType,
+		Description:         description,
+		NetID:               netID,
+		Masquerade:          masquerade,
+		Metric:              metric,
+		Enabled:             enabled,
+		Groups:              groups,
+		KeepRoute:           keepRoute,
+		AccessControlGroups: accessControlGroupIDs,
+	}
+
+	if err = am.validateRoute(account, &newRoute); err != nil {
 		return nil, err
 	}
 
-	if metric < route.MinMetric || metric > route.MaxMetric {
-		return nil, status.Errorf(status.InvalidArgument, "metric should be between %d and %d", route.MinMetric, route.MaxMetric)
-	}
-
-	if utf8.RuneCountInString(string(netID)) > route.MaxNetIDChar || netID == "" {
-		return nil, status.Errorf(status.InvalidArgument, "identifier should be between 1 and %d", route.MaxNetIDChar)
-	}
-
-	err = validateGroups(groups, account.Groups)
-	if err != nil {
-		return nil, err
-	}
-
-	newRoute.Peer = peerID
-	newRoute.PeerGroups = peerGroupIDs
-	newRoute.Network = prefix
-	newRoute.Domains = domains
-	newRoute.NetworkType = networkType
-	newRoute.Description = description
-	newRoute.NetID = netID
-	newRoute.Masquerade = masquerade
-	newRoute.Metric = metric
-	newRoute.Enabled = enabled
-	newRoute.Groups = groups
-	newRoute.KeepRoute = keepRoute
-	newRoute.AccessControlGroups = accessControlGroupIDs
-
 	if account.Routes == nil {
 		account.Routes = make(map[route.ID]*route.Route)
 	}
@@ -246,75 +218,86 @@ func (am *DefaultAccountManager) CreateRoute(ctx context.Context, accountID stri
 	return &newRoute, nil
 }
 
-// SaveRoute saves route
-func (am *DefaultAccountManager) SaveRoute(ctx context.Context, accountID, userID string, routeToSave *route.Route) error {
-	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
-	defer unlock()
-
-	if routeToSave == nil {
-		return status.Errorf(status.InvalidArgument, "route provided is nil")
-	}
-
-	if routeToSave.Metric < route.MinMetric || routeToSave.Metric > route.MaxMetric {
+// validateRoute checks the route fields and that the route peers, groups and prefix or domains are valid
+// within the account
+func (am *DefaultAccountManager) validateRoute(account *Account, newRoute *route.Route) error {
+	if newRoute.Metric < route.MinMetric || newRoute.Metric > route.MaxMetric {
 		return status.Errorf(status.InvalidArgument, "metric should be between %d and %d", route.MinMetric, route.MaxMetric)
 	}
 
-	if utf8.RuneCountInString(string(routeToSave.NetID)) > route.MaxNetIDChar || routeToSave.NetID == "" {
+	if utf8.RuneCountInString(string(newRoute.NetID)) > route.MaxNetIDChar || newRoute.NetID == "" {
 		return status.Errorf(status.InvalidArgument, "identifier should be between 1 and %d", route.MaxNetIDChar)
 	}
 
-	account, err := am.Store.GetAccount(ctx, accountID)
-	if err != nil {
-		return err
-	}
-
 	// Do not allow non-Linux peers
-	if peer := account.GetPeer(routeToSave.Peer); peer != nil {
+	if peer := account.GetPeer(newRoute.Peer); peer != nil {
 		if peer.Meta.GoOS != "linux" {
 			return status.Errorf(status.InvalidArgument, "non-linux peers are not supported as network routes")
 		}
 	}
 
-	if len(routeToSave.Domains) > 0 && routeToSave.Network.IsValid() {
-		return status.Errorf(status.InvalidArgument, "domains and network should not be provided at the same time")
-	}
-
-	if len(routeToSave.Domains) == 0 && !routeToSave.Network.IsValid() {
-		return status.Errorf(status.InvalidArgument, "invalid Prefix")
+	if newRoute.Peer != "" && len(newRoute.PeerGroups) != 0 {
+		return status.Errorf(status.InvalidArgument, "peer with ID and peer groups should not be provided at the same time")
 	}
 
-	if len(routeToSave.Domains) > 0 {
-		routeToSave.Network = getPlaceholderIP()
+	if newRoute.PeerTagSelector != "" && (newRoute.Peer != "" || len(newRoute.PeerGroups) != 0) {
+		return status.Errorf(status.InvalidArgument, "peer tag selector should not be provided together with peer or peer groups")
 	}
 
-	if routeToSave.Peer != "" && len(routeToSave.PeerGroups) != 0 {
-		return status.Errorf(status.InvalidArgument, "peer with ID and peer groups should not be provided at the same time")
+	if err := validateTagSelector(newRoute.PeerTagSelector); err != nil {
+		return err
 	}
 
-	if len(routeToSave.PeerGroups) > 0 {
-		err = validateGroups(routeToSave.PeerGroups, account.Groups)
-		if err != nil {
+	if len(newRoute.PeerGroups) > 0 {
+		if err := validateGroups(newRoute.PeerGroups, account.Groups); err != nil {
 			return err
 		}
 	}
 
-	if len(routeToSave.AccessControlGroups) > 0 {
-		err = validateGroups(routeToSave.AccessControlGroups, account.Groups)
-		if err != nil {
+	if len(newRoute.AccessControlGroups) > 0 {
+		if err := validateGroups(newRoute.AccessControlGroups, account.Groups); err != nil {
 			return err
 		}
 	}
 
-	err = am.checkRoutePrefixOrDomainsExistForPeers(account, routeToSave.Peer, routeToSave.ID, routeToSave.Copy().PeerGroups, routeToSave.Network, routeToSave.Domains)
+	err := am.checkRoutePrefixOrDomainsExistForPeers(account, newRoute.Peer, newRoute.ID, newRoute.PeerGroups, newRoute.Network, newRoute.Domains)
 	if err != nil {
 		return err
 	}
 
-	err = validateGroups(routeToSave.Groups, account.Groups)
+	return validateGroups(newRoute.Groups, account.Groups)
+}
+
+// SaveRoute saves route
+func (am *DefaultAccountManager) SaveRoute(ctx context.Context, accountID, userID string, routeToSave *route.Route) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	if routeToSave == nil {
+		return status.Errorf(status.InvalidArgument, "route provided is nil")
+	}
+
+	account, err := am.Store.GetAccount(ctx, accountID)
 	if err != nil {
 		return err
 	}
 
+	if len(routeToSave.Domains) > 0 && routeToSave.Network.IsValid() {
+		return status.Errorf(status.InvalidArgument, "domains and network should not be provided at the same time")
+	}
+
+	if len(routeToSave.Domains) == 0 && !routeToSave.Network.IsValid() {
+		return status.Errorf(status.InvalidArgument, "invalid Prefix")
+	}
+
+	if len(routeToSave.Domains) > 0 {
+		routeToSave.Network = getPlaceholderIP()
+	}
+
+	if err = am.validateRoute(account, routeToSave); err != nil {
+		return err
+	}
+
 	oldRoute := account.Routes[routeToSave.ID]
 	account.Routes[routeToSave.ID] = routeToSave
 
@@ -369,7 +352,7 @@ func (am *DefaultAccountManager) ListRoutes(ctx context.Context, accountID, user
 		return nil, err
 	}
 
-	if !user.IsAdminOrServiceUser() || user.AccountID != accountID {
+	if user.AccountID != accountID || (!user.IsAdminOrServiceUser() && !am.hasPermission(ctx, user, rbac.ResourceRoutes, rbac.OperationRead)) {
 		return nil, status.Errorf(status.PermissionDenied, "only users with admin power can view Network Routes")
 	}
 
@@ -407,6 +390,7 @@ func getPlaceholderIP() netip.Prefix {
 // getPeerRoutesFirewallRules gets the routes firewall rules associated with a routing peer ID for the account.
 func (a *Account) getPeerRoutesFirewallRules(ctx context.Context, peerID string, validatedPeersMap map[string]struct{}) []*RouteFirewallRule {
 	routesFirewallRules := make([]*RouteFirewallRule, 0, len(a.Routes))
+	now := time.Now()
 
 	enabledRoutes, _ := a.getRoutingPeerRoutes(ctx, peerID)
 	for _, route := range enabledRoutes {
@@ -419,18 +403,22 @@ func (a *Account) getPeerRoutesFirewallRules(ctx context.Context, peerID string,
 
 		policies := getAllRoutePoliciesFromGroups(a, route.AccessControlGroups)
 		for _, policy := range policies {
-			if !policy.Enabled {
+			if !policy.IsActiveAt(now) {
 				continue
 			}
 
 			for _, rule := range policy.Rules {
-				if !rule.Enabled {
+				// audit rules are only supported by the peer firewall
+				if !rule.Enabled || rule.Action == PolicyTrafficActionAudit {
 					continue
 				}
 
-				distributionGroupPeers, _ := a.getAllPeersFromGroups(ctx, route.Groups, peerID, nil, validatedPeersMap)
-				rules := generateRouteFirewallRules(ctx, route, rule, distributionGroupPeers, firewallRuleDirectionIN)
-				routesFirewallRules = append(routesFirewallRules, rules...)
+				// the source posture checks apply to the peers the route is distributed to
+				distributionGroupPeers, _ := a.getAllPeersFromGroups(ctx, route.Groups, peerID, policy.SourcePostureChecks, validatedPeersMap)
+				for _, rule := range a.expandRuleServices(rule) {
+					rules := generateRouteFirewallRules(ctx, route, rule, distributionGroupPeers, firewallRuleDirectionIN)
+					routesFirewallRules = append(routesFirewallRules, rules...)
+				}
 			}
 		}
 	}
@@ -512,13 +500,9 @@ func generateRouteFirewallRules(ctx context.Context, route *route.Route, rule *P
 		IsDynamic:    route.IsDynamic(),
 	}
 
-	// generate rule for port range
-	if len(rule.Ports) == 0 {
-		rules = append(rules, generateRulesWithPortRanges(baseRule, rule, rulesExists)...)
-	} else {
-		rules = append(rules, generateRulesWithPorts(ctx, baseRule, rule, rulesExists)...)
-
-	}
+	// generate rules for the ports and the port ranges, a rule without both allows all the ports
+	rules = append(rules, generateRulesWithPorts(ctx, baseRule, rule, rulesExists)...)
+	rules = append(rules, generateRulesWithPortRanges(baseRule, rule, rulesExists)...)
 
 	// TODO: generate IPv6 rules for dynamic routes
 
@@ -535,26 +519,26 @@ func generateRulesWithPortRanges(baseRule RouteFirewallRule, rule *PolicyRule, r
 	rules := make([]*RouteFirewallRule, 0)
 
 	ruleIDBase := generateRuleIDBase(rule, baseRule)
-	if len(rule.Ports) == 0 {
-		if len(rule.PortRanges) == 0 {
+	if len(rule.PortRanges) == 0 {
+		if len(rule.Ports) == 0 {
 			if _, ok := rulesExists[ruleIDBase]; !ok {
 				rulesExists[ruleIDBase] = struct{}{}
 				rules = append(rules, &baseRule)
 			}
-		} else {
-			for _, portRange := range rule.PortRanges {
-				ruleID := fmt.Sprintf("%s%d-%d", ruleIDBase, portRange.Start, portRange.End)
-				if _, ok := rulesExists[ruleID]; !ok {
-					rulesExists[ruleID] = struct{}{}
-					pr := baseRule
-					pr.PortRange = portRange
-					rules = append(rules, &pr)
-				}
-			}
 		}
 		return rules
 	}
 
+	for _, portRange := range rule.PortRanges {
+		ruleID := fmt.Sprintf("%s%d-%d", ruleIDBase, portRange.Start, portRange.End)
+		if _, ok := rulesExists[ruleID]; !ok {
+			rulesExists[ruleID] = struct{}{}
+			pr := baseRule
+			pr.PortRange = portRange
+			rules = append(rules, &pr)
+		}
+	}
+
 	return rules
 }
 
@@ -611,10 +595,14 @@ func getProtoDirection(direction int) proto.RuleDirection {
 
 // getProtoAction converts the action to proto.RuleAction.
 func getProtoAction(action string) proto.RuleAction {
-	if action == string(PolicyTrafficActionDrop) {
+	switch action {
+	case string(PolicyTrafficActionDrop):
 		return proto.RuleAction_DROP
+	case string(PolicyTrafficActionAudit):
+		return proto.RuleAction_AUDIT
+	default:
+		return proto.RuleAction_ACCEPT
 	}
-	return proto.RuleAction_ACCEPT
 }
 
 // getProtoProtocol converts the protocol to proto.RuleProtocol.
@@ -652,5 +640,5 @@ func getProtoPortInfo(rule *RouteFirewallRule) *proto.PortInfo {
 // isRouteChangeAffectPeers checks if a given route affects peers by determining
 // if it has a routing peer, distribution, or peer groups that include peers
 func (am *DefaultAccountManager) isRouteChangeAffectPeers(account *Account, route *route.Route) bool {
-	return am.anyGroupHasPeers(account, route.Groups) || am.anyGroupHasPeers(account, route.PeerGroups) || route.Peer != ""
+	return am.anyGroupHasPeers(account, route.Groups) || am.anyGroupHasPeers(account, route.PeerGroups) || route.Peer != "" || route.PeerTagSelector != ""
 }
@@ -459,6 +459,114 @@ func TestCreateRoute(t *testing.T) {
 	}
 }
 
+func TestCreateRouteWithOptions(t *testing.T) {
+	type input struct {
+		network         netip.Prefix
+		networkType     route.NetworkType
+		netID           route.NetID
+		peerKey         string
+		peerTagSelector string
+		description     string
+		masquerade      bool
+		metric          int
+		enabled         bool
+		groups          []string
+	}
+
+	testCases := []struct {
+		name          string
+		inputArgs     input
+		shouldCreate  bool
+		errFunc       require.ErrorAssertionFunc
+		expectedRoute *route.Route
+	}{
+		{
+			name: "Happy Path Peer Tag Selector",
+			inputArgs: input{
+				network:         netip.MustParsePrefix("192.168.0.0/16"),
+				networkType:     route.IPv4Network,
+				netID:           "happy",
+				peerTagSelector: "env=prod,role in (router)",
+				description:     "super",
+				masquerade:      false,
+				metric:          9999,
+				enabled:         true,
+				groups:          []string{routeGroup1},
+			},
+			errFunc:      require.NoError,
+			shouldCreate: true,
+			expectedRoute: &route.Route{
+				Network:         netip.MustParsePrefix("192.168.0.0/16"),
+				NetworkType:     route.IPv4Network,
+				NetID:           "happy",
+				PeerTagSelector: "env=prod,role in (router)",
+				Description:     "super",
+				Masquerade:      false,
+				Metric:          9999,
+				Enabled:         true,
+				Groups:          []string{routeGroup1},
+			},
+		},
+		{
+			name: "Both Peer and Peer Tag Selector Should Fail",
+			inputArgs: input{
+				network:         netip.MustParsePrefix("192.168.0.0/16"),
+				networkType:     route.IPv4Network,
+				netID:           "happy",
+				peerKey:         peer1ID,
+				peerTagSelector: "env=prod",
+				description:     "super",
+				masquerade:      false,
+				metric:          9999,
+				enabled:         true,
+				groups:          []string{routeGroup1},
+			},
+			errFunc:      require.Error,
+			shouldCreate: false,
+		},
+		{
+			name: "Invalid Peer Tag Selector Should Fail",
+			inputArgs: input{
+				network:         netip.MustParsePrefix("192.168.0.0/16"),
+				networkType:     route.IPv4Network,
+				netID:           "happy",
+				peerTagSelector: "role in (router",
+				description:     "super",
+				masquerade:      false,
+				metric:          9999,
+				enabled:         true,
+				groups:          []string{routeGroup1},
+			},
+			errFunc:      require.Error,
+			shouldCreate: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			am, err := createRouterManager(t)
+			require.NoError(t, err, "failed to create account manager")
+
+			account, err := initTestRouteAccount(t, am)
+			require.NoError(t, err, "failed to init testing account")
+
+			outRoute, err := am.CreateRouteWithOptions(context.Background(), account.Id, testCase.inputArgs.network, testCase.inputArgs.networkType, nil, testCase.inputArgs.peerKey, nil, testCase.inputArgs.description, testCase.inputArgs.netID, testCase.inputArgs.masquerade, testCase.inputArgs.metric, testCase.inputArgs.groups, nil, testCase.inputArgs.enabled, userID, false, CreateRouteOptions{PeerTagSelector: testCase.inputArgs.peerTagSelector})
+
+			testCase.errFunc(t, err)
+
+			if !testCase.shouldCreate {
+				return
+			}
+
+			// assign generated ID
+			testCase.expectedRoute.ID = outRoute.ID
+
+			if !testCase.expectedRoute.IsEqual(outRoute) {
+				t.Errorf("new route didn't match expected route:\nGot %#v\nExpected:%#v\n", outRoute, testCase.expectedRoute)
+			}
+		})
+	}
+}
+
 func TestSaveRoute(t *testing.T) {
 	validPeer := peer2ID
 	validUsedPeer := peer5ID
@@ -1779,6 +1887,9 @@ func TestAccount_getPeersRoutesFirewall(t *testing.T) {
 }
 
 func TestRouteAccountPeersUpdate(t *testing.T) {
+	// the test expects the update of each change to be sent right away
+	t.Setenv("NB_PEERS_UPDATE_BUFFER_INTERVAL", "0")
+
 	manager, err := createRouterManager(t)
 	require.NoError(t, err, "failed to create account manager")
 
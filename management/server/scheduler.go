@@ -38,6 +38,15 @@ func (mock *MockScheduler) Schedule(ctx context.Context, in time.Duration, ID st
 	log.WithContext(ctx).Errorf("MockScheduler doesn't have Schedule function defined")
 }
 
+// noopScheduler is a Scheduler dropping the jobs, used where no background jobs should run
+type noopScheduler struct{}
+
+// Cancel does nothing as no job is scheduled
+func (noopScheduler) Cancel(context.Context, []string) {}
+
+// Schedule drops the job
+func (noopScheduler) Schedule(context.Context, time.Duration, string, func() (time.Duration, bool)) {}
+
 // DefaultScheduler is a generic structure that allows to schedule jobs (functions) to run in the future and cancel them.
 type DefaultScheduler struct {
 	// jobs map holds cancellation channels indexed by the job ID
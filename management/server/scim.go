@@ -0,0 +1,559 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/xid"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	"github.com/netbirdio/netbird/management/server/integration_reference"
+	"github.com/netbirdio/netbird/management/server/scim"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// scimServiceUserName is the name of the service user the SCIM provisioning requests are performed as
+const scimServiceUserName = "SCIM"
+
+// CreateSCIMToken creates the token authenticating the SCIM provisioning requests of the account.
+// An existing token is replaced. The SCIM requests are performed as a dedicated admin service user.
+func (am *DefaultAccountManager) CreateSCIMToken(ctx context.Context, accountID, userID string) (*scim.TokenGenerated, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := account.FindUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.HasAdminPower() {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	var serviceUserID string
+	existingToken, err := am.Store.GetAccountSCIMToken(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		if s, ok := status.FromError(err); !ok || s.Type() != status.NotFound {
+			return nil, err
+		}
+	} else if account.Users[existingToken.ServiceUserID] != nil {
+		serviceUserID = existingToken.ServiceUserID
+	}
+
+	if serviceUserID == "" {
+		serviceUser := NewUser(uuid.New().String(), UserRoleAdmin, true, true, scimServiceUserName, []string{}, UserIssuedAPI)
+		account.Users[serviceUser.Id] = serviceUser
+		if err = am.Store.SaveAccount(ctx, account); err != nil {
+			return nil, err
+		}
+
+		serviceUserID = serviceUser.Id
+		am.StoreEvent(ctx, userID, serviceUser.Id, accountID, activity.ServiceUserCreated, map[string]any{"name": serviceUser.ServiceUserName})
+	}
+
+	token, err := scim.NewToken(accountID, serviceUserID, userID)
+	if err != nil {
+		return nil, status.Errorf(status.Internal, "failed to create SCIM token: %v", err)
+	}
+
+	if err = am.Store.SaveSCIMToken(ctx, LockingStrengthUpdate, &token.Token); err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, token.ID, accountID, activity.SCIMTokenCreated, nil)
+
+	return token, nil
+}
+
+// GetSCIMToken returns the SCIM provisioning token of the account
+func (am *DefaultAccountManager) GetSCIMToken(ctx context.Context, accountID, userID string) (*scim.Token, error) {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if !user.HasAdminPower() {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	return am.Store.GetAccountSCIMToken(ctx, LockingStrengthShare, accountID)
+}
+
+// DeleteSCIMToken deletes the SCIM provisioning token of the account together with its service user.
+// The users and groups provisioned over SCIM are kept.
+func (am *DefaultAccountManager) DeleteSCIMToken(ctx context.Context, accountID, userID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	user, err := account.FindUser(userID)
+	if err != nil {
+		return err
+	}
+
+	if !user.HasAdminPower() {
+		return status.NewAdminPermissionError()
+	}
+
+	token, err := am.Store.GetAccountSCIMToken(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return err
+	}
+
+	if err = am.Store.DeleteSCIMToken(ctx, LockingStrengthUpdate, accountID); err != nil {
+		return err
+	}
+
+	am.StoreEvent(ctx, userID, token.ID, accountID, activity.SCIMTokenDeleted, nil)
+
+	if serviceUser := account.Users[token.ServiceUserID]; serviceUser != nil {
+		am.deleteServiceUser(ctx, account, userID, serviceUser)
+		return am.Store.SaveAccount(ctx, account)
+	}
+
+	return nil
+}
+
+// GetAccountIDFromSCIMToken returns the account ID and the ID of the service user the SCIM requests authenticated by the token are performed as
+func (am *DefaultAccountManager) GetAccountIDFromSCIMToken(ctx context.Context, token string) (string, string, error) {
+	if !strings.HasPrefix(token, scim.TokenPrefix) {
+		return "", "", status.Errorf(status.Unauthorized, "invalid SCIM token")
+	}
+
+	scimToken, err := am.Store.GetSCIMTokenByHashedToken(ctx, LockingStrengthShare, scim.HashToken(token))
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Type() == status.NotFound {
+			return "", "", status.Errorf(status.Unauthorized, "invalid SCIM token")
+		}
+		return "", "", err
+	}
+
+	serviceUser, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, scimToken.ServiceUserID)
+	if err != nil || serviceUser.AccountID != scimToken.AccountID || serviceUser.IsBlocked() {
+		return "", "", status.Errorf(status.Unauthorized, "SCIM service user is not available")
+	}
+
+	return scimToken.AccountID, scimToken.ServiceUserID, nil
+}
+
+// ListSCIMUsers returns the account users as SCIM resources. Service users are not exposed over SCIM.
+func (am *DefaultAccountManager) ListSCIMUsers(ctx context.Context, accountID string) ([]*scim.User, error) {
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := am.getSCIMProfiles(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*scim.User, 0, len(account.Users))
+	for _, user := range account.Users {
+		if user.IsServiceUser {
+			continue
+		}
+		users = append(users, toSCIMUser(account, user, profiles[user.Id]))
+	}
+
+	slices.SortFunc(users, func(a, b *scim.User) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return users, nil
+}
+
+// GetSCIMUser returns the account user as a SCIM resource
+func (am *DefaultAccountManager) GetSCIMUser(ctx context.Context, accountID, userID string) (*scim.User, error) {
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	user := account.Users[userID]
+	if user == nil || user.IsServiceUser {
+		return nil, status.NewUserNotFoundError(userID)
+	}
+
+	profiles, err := am.getSCIMProfiles(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSCIMUser(account, user, profiles[userID]), nil
+}
+
+// SaveSCIMUser provisions a new user if the ID of the SCIM user is empty or replaces the attributes of an existing one.
+// New users get the external ID as their ID, so it has to match the user ID claim of the IdP tokens.
+// Existing account users without a SCIM profile are taken over by the provisioning.
+func (am *DefaultAccountManager) SaveSCIMUser(ctx context.Context, accountID, initiatorUserID string, scimUser *scim.User) (*scim.User, error) {
+	if scimUser.UserName == "" {
+		return nil, status.Errorf(status.InvalidArgument, "userName is required")
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := am.getSCIMProfiles(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := scimUser.ID
+	isNewUser := userID == ""
+	if isNewUser {
+		userID = scimUser.ExternalID
+		if userID == "" {
+			userID = xid.New().String()
+		}
+
+		if profiles[userID] != nil {
+			return nil, status.Errorf(status.AlreadyExists, "user %s is already provisioned", userID)
+		}
+	}
+
+	for _, profile := range profiles {
+		if profile.UserID != userID && strings.EqualFold(profile.UserName, scimUser.UserName) {
+			return nil, status.Errorf(status.AlreadyExists, "user with userName %s already exists", scimUser.UserName)
+		}
+	}
+
+	var update *User
+	existingUser := account.Users[userID]
+	switch {
+	case existingUser != nil && existingUser.IsServiceUser:
+		return nil, status.Errorf(status.AlreadyExists, "service user %s can't be provisioned", userID)
+	case existingUser != nil:
+		update = existingUser.Copy()
+	case !isNewUser:
+		return nil, status.NewUserNotFoundError(userID)
+	default:
+		otherAccountUser, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+		if err == nil && otherAccountUser.AccountID != accountID {
+			return nil, status.Errorf(status.AlreadyExists, "user %s already exists", userID)
+		}
+
+		update = NewUser(userID, UserRoleUser, false, false, "", []string{}, UserIssuedIntegration)
+		update.AccountID = accountID
+		update.IntegrationReference = integration_reference.IntegrationReference{IntegrationType: scim.IntegrationType}
+	}
+
+	if update.Role == UserRoleOwner && !scimUser.IsActive() {
+		return nil, status.Errorf(status.PermissionDenied, "unable to block owner user")
+	}
+	update.Blocked = !scimUser.IsActive()
+
+	if _, err = am.SaveOrAddUsers(ctx, accountID, initiatorUserID, []*User{update}, true); err != nil {
+		return nil, err
+	}
+
+	profile := scim.NewProfile(accountID, userID, scimUser)
+	if err = am.Store.SaveSCIMProfile(ctx, LockingStrengthUpdate, profile); err != nil {
+		return nil, err
+	}
+
+	if isNewUser {
+		am.StoreEvent(ctx, initiatorUserID, userID, accountID, activity.UserProvisioned, map[string]any{"name": profile.Name(), "email": profile.Email})
+	}
+
+	account, err = am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSCIMUser(account, account.Users[userID], profile), nil
+}
+
+// DeleteSCIMUser deletes the user deprovisioned over SCIM together with its peers.
+// Only the users created by the SCIM provisioning can be deleted, the other account users are not found.
+func (am *DefaultAccountManager) DeleteSCIMUser(ctx context.Context, accountID, initiatorUserID, userID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	user := account.Users[userID]
+	if user == nil || user.IsServiceUser || !isSCIMUser(user) {
+		return status.NewUserNotFoundError(userID)
+	}
+
+	if user.Role == UserRoleOwner {
+		return status.Errorf(status.PermissionDenied, "unable to delete a user with owner role")
+	}
+
+	if err = am.deleteRegularUser(ctx, account, initiatorUserID, userID); err != nil {
+		return err
+	}
+
+	return am.Store.DeleteSCIMProfile(ctx, LockingStrengthUpdate, accountID, userID)
+}
+
+// ListSCIMGroups returns the groups provisioned over SCIM
+func (am *DefaultAccountManager) ListSCIMGroups(ctx context.Context, accountID string) ([]*scim.Group, error) {
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*scim.Group, 0)
+	for _, group := range account.Groups {
+		if isSCIMGroup(group) {
+			groups = append(groups, toSCIMGroup(account, group))
+		}
+	}
+
+	slices.SortFunc(groups, func(a, b *scim.Group) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return groups, nil
+}
+
+// GetSCIMGroup returns the group provisioned over SCIM
+func (am *DefaultAccountManager) GetSCIMGroup(ctx context.Context, accountID, groupID string) (*scim.Group, error) {
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	group := account.Groups[groupID]
+	if group == nil || !isSCIMGroup(group) {
+		return nil, status.NewGroupNotFoundError(groupID)
+	}
+
+	return toSCIMGroup(account, group), nil
+}
+
+// SaveSCIMGroup provisions a new group if the ID of the SCIM group is empty or replaces an existing one.
+// Group members are mapped to the auto groups of the users.
+func (am *DefaultAccountManager) SaveSCIMGroup(ctx context.Context, accountID, initiatorUserID string, scimGroup *scim.Group) (*scim.Group, error) {
+	if scimGroup.DisplayName == "" {
+		return nil, status.Errorf(status.InvalidArgument, "displayName is required")
+	}
+
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var group *nbgroup.Group
+	if scimGroup.ID == "" {
+		group = &nbgroup.Group{
+			ID:                   xid.New().String(),
+			Issued:               nbgroup.GroupIssuedIntegration,
+			Peers:                []string{},
+			IntegrationReference: integration_reference.IntegrationReference{IntegrationType: scim.IntegrationType},
+		}
+	} else {
+		existingGroup := account.Groups[scimGroup.ID]
+		if existingGroup == nil || !isSCIMGroup(existingGroup) {
+			return nil, status.NewGroupNotFoundError(scimGroup.ID)
+		}
+		group = existingGroup.Copy()
+	}
+	group.Name = scimGroup.DisplayName
+
+	if group.IsGroupAll() {
+		return nil, status.Errorf(status.InvalidArgument, "group name All is reserved")
+	}
+
+	for _, g := range account.Groups {
+		if g.ID != group.ID && isSCIMGroup(g) && g.Name == group.Name {
+			return nil, status.Errorf(status.AlreadyExists, "group with displayName %s already exists", group.Name)
+		}
+	}
+
+	memberIDs := scimGroup.MemberIDs()
+	for _, memberID := range memberIDs {
+		if user := account.Users[memberID]; user == nil || user.IsServiceUser {
+			return nil, status.Errorf(status.InvalidArgument, "group member %s doesn't exist", memberID)
+		}
+	}
+
+	if err = am.SaveGroups(ctx, accountID, initiatorUserID, []*nbgroup.Group{group}); err != nil {
+		return nil, err
+	}
+
+	if err = am.setSCIMGroupMembers(ctx, account, initiatorUserID, group.ID, memberIDs); err != nil {
+		return nil, err
+	}
+
+	account, err = am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSCIMGroup(account, account.Groups[group.ID]), nil
+}
+
+// DeleteSCIMGroup removes the group deprovisioned over SCIM from its members and deletes it
+func (am *DefaultAccountManager) DeleteSCIMGroup(ctx context.Context, accountID, initiatorUserID, groupID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	group := account.Groups[groupID]
+	if group == nil || !isSCIMGroup(group) {
+		return status.NewGroupNotFoundError(groupID)
+	}
+
+	if err = am.setSCIMGroupMembers(ctx, account, initiatorUserID, groupID, nil); err != nil {
+		return err
+	}
+
+	return am.DeleteGroups(ctx, accountID, initiatorUserID, []string{groupID})
+}
+
+// setSCIMGroupMembers adds the group to the auto groups of the members and removes it from the other users of the account
+func (am *DefaultAccountManager) setSCIMGroupMembers(ctx context.Context, account *Account, initiatorUserID, groupID string, memberIDs []string) error {
+	var updates []*User
+	for _, user := range account.Users {
+		if user.IsServiceUser {
+			continue
+		}
+
+		isMember := slices.Contains(memberIDs, user.Id)
+		hasGroup := slices.Contains(user.AutoGroups, groupID)
+		if isMember == hasGroup {
+			continue
+		}
+
+		update := user.Copy()
+		if isMember {
+			update.AutoGroups = append(update.AutoGroups, groupID)
+		} else {
+			update.AutoGroups = slices.DeleteFunc(update.AutoGroups, func(id string) bool { return id == groupID })
+		}
+		updates = append(updates, update)
+	}
+
+	if len(updates) == 0 {
+		return nil
+	}
+
+	_, err := am.SaveOrAddUsers(ctx, account.Id, initiatorUserID, updates, false)
+	return err
+}
+
+// getSCIMProfiles returns the SCIM profiles of the account users mapped by the user ID
+func (am *DefaultAccountManager) getSCIMProfiles(ctx context.Context, accountID string) (map[string]*scim.Profile, error) {
+	profiles, err := am.Store.GetAccountSCIMProfiles(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	profilesMap := make(map[string]*scim.Profile, len(profiles))
+	for _, profile := range profiles {
+		profilesMap[profile.UserID] = profile
+	}
+
+	return profilesMap, nil
+}
+
+// fillUserInfosFromSCIMProfiles sets the name and email of the users provisioned over SCIM when the IdP doesn't provide them
+func (am *DefaultAccountManager) fillUserInfosFromSCIMProfiles(ctx context.Context, accountID string, userInfos []*UserInfo) error {
+	profiles, err := am.getSCIMProfiles(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, info := range userInfos {
+		profile := profiles[info.ID]
+		if profile == nil {
+			continue
+		}
+
+		if info.Email == "" {
+			info.Email = profile.Email
+		}
+		if info.Name == "" {
+			info.Name = profile.Name()
+		}
+	}
+
+	return nil
+}
+
+// isSCIMUser returns true if the user was created by the SCIM provisioning
+func isSCIMUser(user *User) bool {
+	return user.Issued == UserIssuedIntegration && user.IntegrationReference.IntegrationType == scim.IntegrationType
+}
+
+// isSCIMGroup returns true if the group was provisioned over SCIM
+func isSCIMGroup(group *nbgroup.Group) bool {
+	return group.Issued == nbgroup.GroupIssuedIntegration && group.IntegrationReference.IntegrationType == scim.IntegrationType
+}
+
+// toSCIMUser converts the account user to a SCIM resource. Users without a profile use their ID as the user name.
+func toSCIMUser(account *Account, user *User, profile *scim.Profile) *scim.User {
+	if profile == nil {
+		profile = &scim.Profile{UserID: user.Id, UserName: user.Id}
+	}
+
+	scimUser := profile.ToUser(!user.IsBlocked())
+	if !user.CreatedAt.IsZero() {
+		createdAt := user.CreatedAt
+		scimUser.Meta.Created = &createdAt
+	}
+
+	for _, groupID := range user.AutoGroups {
+		if group := account.Groups[groupID]; group != nil {
+			scimUser.Groups = append(scimUser.Groups, scim.GroupRef{Value: group.ID, Display: group.Name})
+		}
+	}
+
+	return scimUser
+}
+
+// toSCIMGroup converts the account group to a SCIM resource. The group members are the users having the group in their auto groups.
+func toSCIMGroup(account *Account, group *nbgroup.Group) *scim.Group {
+	scimGroup := &scim.Group{
+		Schemas:     []string{scim.SchemaGroup},
+		ID:          group.ID,
+		DisplayName: group.Name,
+		Meta:        &scim.Meta{ResourceType: scim.ResourceTypeGroup},
+	}
+
+	for _, user := range account.Users {
+		if !user.IsServiceUser && slices.Contains(user.AutoGroups, group.ID) {
+			scimGroup.Members = append(scimGroup.Members, scim.Member{Value: user.Id})
+		}
+	}
+
+	slices.SortFunc(scimGroup.Members, func(a, b scim.Member) int {
+		return strings.Compare(a.Value, b.Value)
+	})
+
+	return scimGroup
+}
@@ -0,0 +1,88 @@
+package scim
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// filterRegexp matches the equality filter expressions, e.g. userName eq "alice@example.com"
+var filterRegexp = regexp.MustCompile(`^\s*([A-Za-z][\w.]*)\s+(?i:eq)\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// Filter is an equality filter on a resource attribute. Only the "eq" operator is supported.
+type Filter struct {
+	// Attribute is the lower-cased name of the filtered attribute
+	Attribute string
+	// Value the attribute has to be equal to
+	Value string
+}
+
+// ParseFilter parses the filter expression. An empty expression returns a nil filter.
+func ParseFilter(expression string) (*Filter, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	matches := filterRegexp.FindStringSubmatch(expression)
+	if matches == nil {
+		return nil, fmt.Errorf("unsupported filter expression %q, only equality filters are supported", expression)
+	}
+
+	return &Filter{
+		Attribute: strings.ToLower(matches[1]),
+		Value:     strings.ReplaceAll(matches[2], `\"`, `"`),
+	}, nil
+}
+
+// MatchUser returns true if the user matches the filter. A nil filter matches all users.
+func (f *Filter) MatchUser(user *User) bool {
+	if f == nil {
+		return true
+	}
+
+	switch f.Attribute {
+	case "id":
+		return user.ID == f.Value
+	case "username":
+		// user names are case-insensitive according to RFC 7643
+		return strings.EqualFold(user.UserName, f.Value)
+	case "externalid":
+		return user.ExternalID == f.Value
+	case "displayname":
+		return user.DisplayName == f.Value
+	case "emails", "emails.value":
+		for _, email := range user.Emails {
+			if strings.EqualFold(email.Value, f.Value) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// MatchGroup returns true if the group matches the filter. A nil filter matches all groups.
+func (f *Filter) MatchGroup(group *Group) bool {
+	if f == nil {
+		return true
+	}
+
+	switch f.Attribute {
+	case "id":
+		return group.ID == f.Value
+	case "displayname":
+		return group.DisplayName == f.Value
+	case "externalid":
+		return group.ExternalID == f.Value
+	case "members", "members.value":
+		for _, member := range group.Members {
+			if member.Value == f.Value {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
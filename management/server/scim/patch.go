@@ -0,0 +1,241 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	patchOpAdd     = "add"
+	patchOpReplace = "replace"
+	patchOpRemove  = "remove"
+)
+
+// PatchOperation is a single modification of a resource
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// PatchRequest is the SCIM request to modify a resource
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// ApplyToUser applies the operations to the user. Attributes that aren't stored are ignored.
+func (r *PatchRequest) ApplyToUser(user *User) error {
+	for _, operation := range r.Operations {
+		op := strings.ToLower(operation.Op)
+		if op != patchOpAdd && op != patchOpReplace && op != patchOpRemove {
+			return fmt.Errorf("unsupported patch operation %q", operation.Op)
+		}
+
+		if operation.Path != "" {
+			if err := patchUserAttribute(user, op, operation.Path, operation.Value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(operation.Value, &values); err != nil {
+			return fmt.Errorf("patch operation without path requires an object value: %w", err)
+		}
+
+		for path, value := range values {
+			if err := patchUserAttribute(user, op, path, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func patchUserAttribute(user *User, op, path string, value json.RawMessage) error {
+	if user.Name == nil {
+		user.Name = &Name{}
+	}
+
+	var err error
+	switch strings.ToLower(path) {
+	case "active":
+		var active bool
+		if active, err = parseBool(op, value); err == nil {
+			user.Active = &active
+		}
+	case "username":
+		err = parseString(op, value, &user.UserName)
+	case "displayname":
+		err = parseString(op, value, &user.DisplayName)
+	case "externalid":
+		err = parseString(op, value, &user.ExternalID)
+	case "name.givenname":
+		err = parseString(op, value, &user.Name.GivenName)
+	case "name.familyname":
+		err = parseString(op, value, &user.Name.FamilyName)
+	case "name.formatted":
+		err = parseString(op, value, &user.Name.Formatted)
+	case "name":
+		if op == patchOpRemove {
+			user.Name = &Name{}
+			return nil
+		}
+		err = json.Unmarshal(value, user.Name)
+	case "emails":
+		if op == patchOpRemove {
+			user.Emails = nil
+			return nil
+		}
+		err = json.Unmarshal(value, &user.Emails)
+	default:
+		if strings.HasPrefix(strings.ToLower(path), "emails[") {
+			var email string
+			if err = parseString(op, value, &email); err == nil {
+				user.Emails = nil
+				if email != "" {
+					user.Emails = []Email{{Value: email, Type: "work", Primary: true}}
+				}
+			}
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid value of the %s attribute: %w", path, err)
+	}
+
+	return nil
+}
+
+// ApplyToGroup applies the operations to the group
+func (r *PatchRequest) ApplyToGroup(group *Group) error {
+	for _, operation := range r.Operations {
+		op := strings.ToLower(operation.Op)
+		if op != patchOpAdd && op != patchOpReplace && op != patchOpRemove {
+			return fmt.Errorf("unsupported patch operation %q", operation.Op)
+		}
+
+		if operation.Path != "" {
+			if err := patchGroupAttribute(group, op, operation.Path, operation.Value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(operation.Value, &values); err != nil {
+			return fmt.Errorf("patch operation without path requires an object value: %w", err)
+		}
+
+		for path, value := range values {
+			if err := patchGroupAttribute(group, op, path, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func patchGroupAttribute(group *Group, op, path string, value json.RawMessage) error {
+	lowerPath := strings.ToLower(path)
+
+	var err error
+	switch {
+	case lowerPath == "displayname":
+		err = parseString(op, value, &group.DisplayName)
+	case lowerPath == "externalid":
+		err = parseString(op, value, &group.ExternalID)
+	case lowerPath == "members":
+		err = patchGroupMembers(group, op, value)
+	case strings.HasPrefix(lowerPath, "members[") && strings.HasSuffix(lowerPath, "]"):
+		if op != patchOpRemove {
+			return fmt.Errorf("unsupported patch operation %q on path %s", op, path)
+		}
+
+		filter, err := ParseFilter(path[len("members[") : len(path)-1])
+		if err != nil {
+			return err
+		}
+		if filter == nil || filter.Attribute != "value" {
+			return fmt.Errorf("unsupported members filter %s", path)
+		}
+
+		group.Members = slices.DeleteFunc(group.Members, func(member Member) bool {
+			return member.Value == filter.Value
+		})
+	default:
+		return fmt.Errorf("unsupported patch path %s", path)
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid value of the %s attribute: %w", path, err)
+	}
+
+	return nil
+}
+
+func patchGroupMembers(group *Group, op string, value json.RawMessage) error {
+	var members []Member
+	if len(value) > 0 {
+		if err := json.Unmarshal(value, &members); err != nil {
+			return err
+		}
+	}
+
+	switch op {
+	case patchOpReplace:
+		group.Members = members
+	case patchOpAdd:
+		for _, member := range members {
+			if !slices.ContainsFunc(group.Members, func(m Member) bool { return m.Value == member.Value }) {
+				group.Members = append(group.Members, member)
+			}
+		}
+	case patchOpRemove:
+		if len(members) == 0 {
+			group.Members = nil
+			return nil
+		}
+
+		group.Members = slices.DeleteFunc(group.Members, func(m Member) bool {
+			return slices.ContainsFunc(members, func(member Member) bool { return m.Value == member.Value })
+		})
+	}
+
+	return nil
+}
+
+// parseString sets the target to the string value or clears it when the attribute is removed
+func parseString(op string, value json.RawMessage, target *string) error {
+	if op == patchOpRemove {
+		*target = ""
+		return nil
+	}
+
+	return json.Unmarshal(value, target)
+}
+
+// parseBool parses a boolean value. Some clients send booleans as strings, e.g. "False".
+func parseBool(op string, value json.RawMessage) (bool, error) {
+	if op == patchOpRemove {
+		return false, nil
+	}
+
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return false, err
+	}
+
+	return strconv.ParseBool(strings.ToLower(s))
+}
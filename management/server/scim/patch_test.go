@@ -0,0 +1,173 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		name        string
+		expression  string
+		expected    *Filter
+		expectError bool
+	}{
+		{
+			name:       "empty expression",
+			expression: " ",
+		},
+		{
+			name:       "equality filter",
+			expression: `userName eq "alice@example.com"`,
+			expected:   &Filter{Attribute: "username", Value: "alice@example.com"},
+		},
+		{
+			name:       "case-insensitive operator with escaped quotes",
+			expression: `displayName EQ "the \"team\""`,
+			expected:   &Filter{Attribute: "displayname", Value: `the "team"`},
+		},
+		{
+			name:        "unsupported operator",
+			expression:  `userName co "alice"`,
+			expectError: true,
+		},
+		{
+			name:        "logical expression",
+			expression:  `userName eq "alice" and active eq "true"`,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := ParseFilter(tc.expression)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, filter)
+		})
+	}
+}
+
+func TestFilter_Match(t *testing.T) {
+	user := &User{
+		ID:         "user1",
+		ExternalID: "ext1",
+		UserName:   "Alice@example.com",
+		Emails:     []Email{{Value: "alice@example.com", Primary: true}},
+	}
+	group := &Group{
+		ID:          "group1",
+		DisplayName: "Engineering",
+		Members:     []Member{{Value: "user1"}},
+	}
+
+	var nilFilter *Filter
+	assert.True(t, nilFilter.MatchUser(user))
+	assert.True(t, nilFilter.MatchGroup(group))
+
+	assert.True(t, (&Filter{Attribute: "username", Value: "alice@EXAMPLE.com"}).MatchUser(user))
+	assert.True(t, (&Filter{Attribute: "externalid", Value: "ext1"}).MatchUser(user))
+	assert.True(t, (&Filter{Attribute: "emails.value", Value: "alice@example.com"}).MatchUser(user))
+	assert.False(t, (&Filter{Attribute: "id", Value: "user2"}).MatchUser(user))
+
+	assert.True(t, (&Filter{Attribute: "displayname", Value: "Engineering"}).MatchGroup(group))
+	assert.True(t, (&Filter{Attribute: "members", Value: "user1"}).MatchGroup(group))
+	assert.False(t, (&Filter{Attribute: "members", Value: "user2"}).MatchGroup(group))
+}
+
+func TestPatchRequest_ApplyToUser(t *testing.T) {
+	user := &User{
+		ID:       "user1",
+		UserName: "alice@example.com",
+		Name:     &Name{GivenName: "Alice"},
+	}
+
+	req := &PatchRequest{}
+	err := json.Unmarshal([]byte(`{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "Replace", "path": "active", "value": "False"},
+			{"op": "replace", "path": "name.familyName", "value": "Smith"},
+			{"op": "replace", "value": {"displayName": "Alice Smith"}}
+		]
+	}`), req)
+	require.NoError(t, err)
+
+	require.NoError(t, req.ApplyToUser(user))
+	assert.False(t, user.IsActive())
+	assert.Equal(t, "Alice", user.Name.GivenName)
+	assert.Equal(t, "Smith", user.Name.FamilyName)
+	assert.Equal(t, "Alice Smith", user.DisplayName)
+	assert.Equal(t, "alice@example.com", user.UserName)
+}
+
+func TestPatchRequest_ApplyToGroup(t *testing.T) {
+	group := &Group{
+		ID:          "group1",
+		DisplayName: "Engineering",
+		Members:     []Member{{Value: "user1"}, {Value: "user2"}},
+	}
+
+	tests := []struct {
+		name            string
+		operations      string
+		expectedMembers []string
+		expectedName    string
+		expectError     bool
+	}{
+		{
+			name:            "add members",
+			operations:      `[{"op": "add", "path": "members", "value": [{"value": "user2"}, {"value": "user3"}]}]`,
+			expectedMembers: []string{"user1", "user2", "user3"},
+			expectedName:    "Engineering",
+		},
+		{
+			name:            "remove member by filter",
+			operations:      `[{"op": "remove", "path": "members[value eq \"user1\"]"}]`,
+			expectedMembers: []string{"user2"},
+			expectedName:    "Engineering",
+		},
+		{
+			name:            "replace members and name",
+			operations:      `[{"op": "replace", "path": "members", "value": [{"value": "user3"}]}, {"op": "replace", "path": "displayName", "value": "Platform"}]`,
+			expectedMembers: []string{"user3"},
+			expectedName:    "Platform",
+		},
+		{
+			name:            "remove all members",
+			operations:      `[{"op": "remove", "path": "members"}]`,
+			expectedMembers: []string{},
+			expectedName:    "Engineering",
+		},
+		{
+			name:        "add by filter is not supported",
+			operations:  `[{"op": "add", "path": "members[value eq \"user1\"]"}]`,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &PatchRequest{}
+			require.NoError(t, json.Unmarshal([]byte(`{"Operations": `+tc.operations+`}`), req))
+
+			patched := *group
+			patched.Members = append([]Member{}, group.Members...)
+
+			err := req.ApplyToGroup(&patched)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMembers, patched.MemberIDs())
+			assert.Equal(t, tc.expectedName, patched.DisplayName)
+		})
+	}
+}
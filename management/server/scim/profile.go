@@ -0,0 +1,93 @@
+package scim
+
+// Profile holds the SCIM attributes of a provisioned user that aren't part of the account user
+type Profile struct {
+	// UserID is a reference to the provisioned User
+	UserID string `gorm:"primaryKey"`
+
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `json:"-" gorm:"index"`
+
+	// ExternalID is the identifier of the user in the provisioning client
+	ExternalID string
+
+	// UserName is the unique name of the user used for sign in
+	UserName string
+
+	// DisplayName of the user
+	DisplayName string
+
+	// GivenName of the user
+	GivenName string
+
+	// FamilyName of the user
+	FamilyName string
+
+	// Email is the primary email of the user
+	Email string
+}
+
+// TableName returns the name of the table for the Profile model in the database.
+func (*Profile) TableName() string {
+	return "scim_profiles"
+}
+
+// NewProfile creates the profile of the provisioned user
+func NewProfile(accountID, userID string, user *User) *Profile {
+	profile := &Profile{
+		UserID:      userID,
+		AccountID:   accountID,
+		ExternalID:  user.ExternalID,
+		UserName:    user.UserName,
+		DisplayName: user.DisplayName,
+		Email:       user.PrimaryEmail(),
+	}
+
+	if user.Name != nil {
+		profile.GivenName = user.Name.GivenName
+		profile.FamilyName = user.Name.FamilyName
+		if profile.DisplayName == "" {
+			profile.DisplayName = user.Name.Formatted
+		}
+	}
+
+	return profile
+}
+
+// Name returns the display name of the user falling back to the name components and the user name
+func (p *Profile) Name() string {
+	switch {
+	case p.DisplayName != "":
+		return p.DisplayName
+	case p.GivenName != "" || p.FamilyName != "":
+		if p.GivenName == "" || p.FamilyName == "" {
+			return p.GivenName + p.FamilyName
+		}
+		return p.GivenName + " " + p.FamilyName
+	default:
+		return p.UserName
+	}
+}
+
+// ToUser converts the profile to the SCIM user resource
+func (p *Profile) ToUser(active bool) *User {
+	user := &User{
+		Schemas:     []string{SchemaUser},
+		ID:          p.UserID,
+		ExternalID:  p.ExternalID,
+		UserName:    p.UserName,
+		DisplayName: p.DisplayName,
+		Active:      &active,
+		Meta:        &Meta{ResourceType: ResourceTypeUser},
+	}
+
+	if p.GivenName != "" || p.FamilyName != "" {
+		user.Name = &Name{GivenName: p.GivenName, FamilyName: p.FamilyName}
+	}
+
+	if p.Email != "" {
+		user.Emails = []Email{{Value: p.Email, Type: "work", Primary: true}}
+	}
+
+	return user
+}
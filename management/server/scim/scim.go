@@ -0,0 +1,186 @@
+package scim
+
+import (
+	"time"
+)
+
+const (
+	// SchemaUser is the SCIM core user schema
+	SchemaUser = "urn:ietf:params:scim:schemas:core:2.0:User"
+	// SchemaGroup is the SCIM core group schema
+	SchemaGroup = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	// SchemaListResponse is the SCIM list response message schema
+	SchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	// SchemaPatchOp is the SCIM patch operation message schema
+	SchemaPatchOp = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	// SchemaError is the SCIM error message schema
+	SchemaError = "urn:ietf:params:scim:api:messages:2.0:Error"
+	// SchemaServiceProviderConfig is the SCIM service provider configuration schema
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	// ContentType is the media type of the SCIM messages
+	ContentType = "application/scim+json"
+
+	// IntegrationType is the integration reference type of the users and groups provisioned over SCIM
+	IntegrationType = "scim"
+
+	// ResourceTypeUser is the SCIM user resource type
+	ResourceTypeUser = "User"
+	// ResourceTypeGroup is the SCIM group resource type
+	ResourceTypeGroup = "Group"
+)
+
+// Meta holds the SCIM resource metadata
+type Meta struct {
+	ResourceType string     `json:"resourceType"`
+	Created      *time.Time `json:"created,omitempty"`
+	Location     string     `json:"location,omitempty"`
+}
+
+// Name holds the components of the user's name
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// Email is an email address of the user
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// GroupRef is a reference to a group the user belongs to
+type GroupRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// User is the SCIM user resource
+type User struct {
+	Schemas     []string   `json:"schemas"`
+	ID          string     `json:"id,omitempty"`
+	ExternalID  string     `json:"externalId,omitempty"`
+	UserName    string     `json:"userName"`
+	Name        *Name      `json:"name,omitempty"`
+	DisplayName string     `json:"displayName,omitempty"`
+	Emails      []Email    `json:"emails,omitempty"`
+	Active      *bool      `json:"active,omitempty"`
+	Groups      []GroupRef `json:"groups,omitempty"`
+	Meta        *Meta      `json:"meta,omitempty"`
+}
+
+// IsActive returns true if the user is active. Users are active unless stated otherwise.
+func (u *User) IsActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+// PrimaryEmail returns the primary email of the user or the first one if none is marked as primary
+func (u *User) PrimaryEmail() string {
+	for _, email := range u.Emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+
+	return ""
+}
+
+// Member is a reference to a user that belongs to a group
+type Member struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// Group is the SCIM group resource
+type Group struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []Member `json:"members,omitempty"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+// MemberIDs returns the IDs of the group members
+func (g *Group) MemberIDs() []string {
+	ids := make([]string, 0, len(g.Members))
+	for _, member := range g.Members {
+		ids = append(ids, member.Value)
+	}
+	return ids
+}
+
+// ListResponse is the SCIM response to a resource query
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+// NewListResponse creates a list response with the page of the resources starting at the 1-based startIndex.
+// A negative count returns all resources after the start index.
+func NewListResponse[T any](resources []T, startIndex, count int) *ListResponse {
+	if startIndex < 1 {
+		startIndex = 1
+	}
+
+	page := make([]any, 0)
+	for i := startIndex - 1; i < len(resources) && (count < 0 || len(page) < count); i++ {
+		page = append(page, resources[i])
+	}
+
+	return &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}
+}
+
+// Error is the SCIM error response
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// Supported describes whether an optional SCIM feature is supported
+type Supported struct {
+	Supported bool `json:"supported"`
+}
+
+// FilterSupported describes the filtering support of the service provider
+type FilterSupported struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+// ServiceProviderConfig describes the SCIM features supported by the service provider
+type ServiceProviderConfig struct {
+	Schemas        []string        `json:"schemas"`
+	Patch          Supported       `json:"patch"`
+	Bulk           Supported       `json:"bulk"`
+	Filter         FilterSupported `json:"filter"`
+	ChangePassword Supported       `json:"changePassword"`
+	Sort           Supported       `json:"sort"`
+	Etag           Supported       `json:"etag"`
+}
+
+// NewServiceProviderConfig returns the configuration of the supported SCIM features
+func NewServiceProviderConfig() *ServiceProviderConfig {
+	return &ServiceProviderConfig{
+		Schemas: []string{SchemaServiceProviderConfig},
+		Patch:   Supported{Supported: true},
+		Filter:  FilterSupported{Supported: true, MaxResults: 1000},
+	}
+}
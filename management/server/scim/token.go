@@ -0,0 +1,76 @@
+package scim
+
+import (
+	"crypto/sha256"
+	b64 "encoding/base64"
+	"time"
+
+	b "github.com/hashicorp/go-secure-stdlib/base62"
+	"github.com/rs/xid"
+)
+
+const (
+	// TokenPrefix is the prefix of the SCIM provisioning tokens
+	TokenPrefix = "nbs_"
+	// TokenSecretLength number of characters used for the secret inside the token
+	TokenSecretLength = 36
+)
+
+// Token authenticates the SCIM requests of an account. Only a hashed version of the token is stored.
+type Token struct {
+	// ID of the token
+	ID string `gorm:"primaryKey"`
+
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `json:"-" gorm:"uniqueIndex"`
+
+	// ServiceUserID is the service user the SCIM requests are performed as
+	ServiceUserID string
+
+	// HashedToken is the base64 encoded SHA-256 hash of the plain token
+	HashedToken string `gorm:"index"`
+
+	// CreatedBy is the user that created the token
+	CreatedBy string
+
+	// CreatedAt is the time the token was created
+	CreatedAt time.Time
+}
+
+// TableName returns the name of the table for the Token model in the database.
+func (*Token) TableName() string {
+	return "scim_tokens"
+}
+
+// TokenGenerated holds the new Token and the plain text version of it
+type TokenGenerated struct {
+	PlainToken string
+	Token
+}
+
+// NewToken generates a new token of the account. The plain text token is returned only once.
+func NewToken(accountID, serviceUserID, createdBy string) (*TokenGenerated, error) {
+	secret, err := b.Random(TokenSecretLength)
+	if err != nil {
+		return nil, err
+	}
+
+	plainToken := TokenPrefix + secret
+	return &TokenGenerated{
+		PlainToken: plainToken,
+		Token: Token{
+			ID:            xid.New().String(),
+			AccountID:     accountID,
+			ServiceUserID: serviceUserID,
+			HashedToken:   HashToken(plainToken),
+			CreatedBy:     createdBy,
+			CreatedAt:     time.Now().UTC(),
+		},
+	}, nil
+}
+
+// HashToken returns the hashed version of the plain token that is stored
+func HashToken(plainToken string) string {
+	hashedToken := sha256.Sum256([]byte(plainToken))
+	return b64.StdEncoding.EncodeToString(hashedToken[:])
+}
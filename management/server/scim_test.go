@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/scim"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+func TestDefaultAccountManager_SCIMToken(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+
+	account, err := initTestPostureChecksAccount(am)
+	require.NoError(t, err)
+
+	_, err = am.CreateSCIMToken(context.Background(), account.Id, regularUserID)
+	assert.Error(t, err, "regular user should not be able to create a SCIM token")
+
+	generated, err := am.CreateSCIMToken(context.Background(), account.Id, adminUserID)
+	require.NoError(t, err)
+	assert.Contains(t, generated.PlainToken, scim.TokenPrefix)
+
+	accountID, serviceUserID, err := am.GetAccountIDFromSCIMToken(context.Background(), generated.PlainToken)
+	require.NoError(t, err)
+	assert.Equal(t, account.Id, accountID)
+	assert.Equal(t, generated.ServiceUserID, serviceUserID)
+
+	serviceUser, err := am.Store.GetUserByUserID(context.Background(), LockingStrengthShare, serviceUserID)
+	require.NoError(t, err)
+	assert.True(t, serviceUser.IsServiceUser)
+	assert.True(t, serviceUser.NonDeletable)
+	assert.Equal(t, UserRoleAdmin, serviceUser.Role)
+
+	regenerated, err := am.CreateSCIMToken(context.Background(), account.Id, adminUserID)
+	require.NoError(t, err)
+	assert.Equal(t, serviceUserID, regenerated.ServiceUserID, "regenerated token should reuse the service user")
+
+	_, _, err = am.GetAccountIDFromSCIMToken(context.Background(), generated.PlainToken)
+	assert.Error(t, err, "replaced token should not authenticate")
+
+	err = am.DeleteSCIMToken(context.Background(), account.Id, adminUserID)
+	require.NoError(t, err)
+
+	_, _, err = am.GetAccountIDFromSCIMToken(context.Background(), regenerated.PlainToken)
+	assert.Error(t, err, "deleted token should not authenticate")
+
+	_, err = am.Store.GetUserByUserID(context.Background(), LockingStrengthShare, serviceUserID)
+	assert.Error(t, err, "service user should be deleted with the token")
+
+	_, err = am.GetSCIMToken(context.Background(), account.Id, adminUserID)
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.NotFound, sErr.Type())
+}
+
+func TestDefaultAccountManager_SCIMProvisioning(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+
+	account, err := initTestPostureChecksAccount(am)
+	require.NoError(t, err)
+
+	generated, err := am.CreateSCIMToken(context.Background(), account.Id, adminUserID)
+	require.NoError(t, err)
+	initiatorID := generated.ServiceUserID
+
+	user, err := am.SaveSCIMUser(context.Background(), account.Id, initiatorID, &scim.User{
+		ExternalID: "idp-alice",
+		UserName:   "alice@example.com",
+		Name:       &scim.Name{GivenName: "Alice", FamilyName: "Smith"},
+		Emails:     []scim.Email{{Value: "alice@example.com", Primary: true}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "idp-alice", user.ID)
+	assert.True(t, user.IsActive())
+
+	storedUser, err := am.Store.GetUserByUserID(context.Background(), LockingStrengthShare, "idp-alice")
+	require.NoError(t, err)
+	assert.Equal(t, UserIssuedIntegration, storedUser.Issued)
+	assert.Equal(t, scim.IntegrationType, storedUser.IntegrationReference.IntegrationType)
+
+	_, err = am.SaveSCIMUser(context.Background(), account.Id, initiatorID, &scim.User{UserName: "ALICE@example.com"})
+	assert.Error(t, err, "duplicate userName should be rejected")
+
+	users, err := am.GetUsersFromAccount(context.Background(), account.Id, adminUserID)
+	require.NoError(t, err)
+	var aliceInfo *UserInfo
+	for _, info := range users {
+		if info.ID == "idp-alice" {
+			aliceInfo = info
+		}
+	}
+	require.NotNil(t, aliceInfo)
+	assert.Equal(t, "alice@example.com", aliceInfo.Email)
+	assert.Equal(t, "Alice Smith", aliceInfo.Name)
+
+	group, err := am.SaveSCIMGroup(context.Background(), account.Id, initiatorID, &scim.Group{
+		DisplayName: "Engineering",
+		Members:     []scim.Member{{Value: "idp-alice"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"idp-alice"}, group.MemberIDs())
+
+	storedUser, err = am.Store.GetUserByUserID(context.Background(), LockingStrengthShare, "idp-alice")
+	require.NoError(t, err)
+	assert.Contains(t, storedUser.AutoGroups, group.ID)
+
+	_, err = am.SaveSCIMGroup(context.Background(), account.Id, initiatorID, &scim.Group{
+		DisplayName: "Engineering",
+	})
+	assert.Error(t, err, "duplicate displayName should be rejected")
+
+	_, err = am.SaveSCIMGroup(context.Background(), account.Id, initiatorID, &scim.Group{
+		DisplayName: "Unknown members",
+		Members:     []scim.Member{{Value: "missing"}},
+	})
+	assert.Error(t, err, "unknown members should be rejected")
+
+	group.Members = nil
+	group, err = am.SaveSCIMGroup(context.Background(), account.Id, initiatorID, group)
+	require.NoError(t, err)
+	assert.Empty(t, group.Members)
+
+	storedUser, err = am.Store.GetUserByUserID(context.Background(), LockingStrengthShare, "idp-alice")
+	require.NoError(t, err)
+	assert.NotContains(t, storedUser.AutoGroups, group.ID)
+
+	inactive := false
+	user.Active = &inactive
+	user, err = am.SaveSCIMUser(context.Background(), account.Id, initiatorID, user)
+	require.NoError(t, err)
+	assert.False(t, user.IsActive())
+
+	storedUser, err = am.Store.GetUserByUserID(context.Background(), LockingStrengthShare, "idp-alice")
+	require.NoError(t, err)
+	assert.True(t, storedUser.Blocked)
+
+	groups, err := am.ListSCIMGroups(context.Background(), account.Id)
+	require.NoError(t, err)
+	assert.Len(t, groups, 1)
+
+	err = am.DeleteSCIMGroup(context.Background(), account.Id, initiatorID, group.ID)
+	require.NoError(t, err)
+
+	_, err = am.GetSCIMGroup(context.Background(), account.Id, group.ID)
+	assert.Error(t, err)
+
+	err = am.DeleteSCIMUser(context.Background(), account.Id, initiatorID, adminUserID)
+	sErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.NotFound, sErr.Type(), "users not provisioned over SCIM shouldn't be deleted")
+
+	_, err = am.Store.GetUserByUserID(context.Background(), LockingStrengthShare, adminUserID)
+	require.NoError(t, err)
+
+	err = am.DeleteSCIMUser(context.Background(), account.Id, initiatorID, "idp-alice")
+	require.NoError(t, err)
+
+	_, err = am.GetSCIMUser(context.Background(), account.Id, "idp-alice")
+	assert.Error(t, err)
+
+	scimUsers, err := am.ListSCIMUsers(context.Background(), account.Id)
+	require.NoError(t, err)
+	for _, u := range scimUsers {
+		assert.NotEqual(t, initiatorID, u.ID, "service users should not be listed")
+	}
+}
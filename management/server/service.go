@@ -0,0 +1,212 @@
+package server
+
+import (
+	"context"
+
+	"github.com/rs/xid"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/rbac"
+	nbservice "github.com/netbirdio/netbird/management/server/service"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// GetService returns a service of the account services catalog.
+func (am *DefaultAccountManager) GetService(ctx context.Context, accountID, serviceID, userID string) (*nbservice.Service, error) {
+	if err := am.validateServiceUserPermissions(ctx, accountID, userID, rbac.OperationRead); err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetServiceByID(ctx, LockingStrengthShare, accountID, serviceID)
+}
+
+// ListServices returns the account services catalog.
+func (am *DefaultAccountManager) ListServices(ctx context.Context, accountID, userID string) ([]*nbservice.Service, error) {
+	if err := am.validateServiceUserPermissions(ctx, accountID, userID, rbac.OperationRead); err != nil {
+		return nil, err
+	}
+
+	return am.Store.GetAccountServices(ctx, LockingStrengthShare, accountID)
+}
+
+// SaveService creates or updates a service. Peers are updated if the service is used by a policy.
+func (am *DefaultAccountManager) SaveService(ctx context.Context, accountID, userID string, service *nbservice.Service) (*nbservice.Service, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	var isUpdate = service.ID != ""
+
+	operation := rbac.OperationCreate
+	if isUpdate {
+		operation = rbac.OperationUpdate
+	}
+
+	if err := am.validateServiceUserPermissions(ctx, accountID, userID, operation); err != nil {
+		return nil, err
+	}
+
+	var updateAccountPeers bool
+	var action = activity.ServiceCreated
+
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		if err := validateService(ctx, transaction, accountID, service); err != nil {
+			return err
+		}
+
+		if isUpdate {
+			policies, err := getServicePolicies(ctx, transaction, accountID, service.ID)
+			if err != nil {
+				return err
+			}
+			updateAccountPeers = len(policies) > 0
+
+			if err = transaction.IncrementNetworkSerial(ctx, LockingStrengthUpdate, accountID); err != nil {
+				return err
+			}
+
+			action = activity.ServiceUpdated
+		}
+
+		service.AccountID = accountID
+		return transaction.SaveService(ctx, LockingStrengthUpdate, service)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, service.ID, accountID, action, service.EventMeta())
+
+	if updateAccountPeers {
+		am.updateAccountPeers(ctx, accountID)
+	}
+
+	return service, nil
+}
+
+// DeleteService deletes a service. Services used by policies can't be deleted.
+func (am *DefaultAccountManager) DeleteService(ctx context.Context, accountID, serviceID, userID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	if err := am.validateServiceUserPermissions(ctx, accountID, userID, rbac.OperationDelete); err != nil {
+		return err
+	}
+
+	var service *nbservice.Service
+
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		var err error
+		service, err = transaction.GetServiceByID(ctx, LockingStrengthShare, accountID, serviceID)
+		if err != nil {
+			return err
+		}
+
+		policies, err := getServicePolicies(ctx, transaction, accountID, serviceID)
+		if err != nil {
+			return err
+		}
+
+		if len(policies) > 0 {
+			return status.Errorf(status.PreconditionFailed, "service has been linked to policy: %s", policies[0].Name)
+		}
+
+		return transaction.DeleteService(ctx, LockingStrengthUpdate, accountID, serviceID)
+	})
+	if err != nil {
+		return err
+	}
+
+	am.StoreEvent(ctx, userID, service.ID, accountID, activity.ServiceDeleted, service.EventMeta())
+
+	return nil
+}
+
+// GetServicePolicies returns the policies that have rules referencing the service.
+func (am *DefaultAccountManager) GetServicePolicies(ctx context.Context, accountID, serviceID, userID string) ([]*Policy, error) {
+	if err := am.validateServiceUserPermissions(ctx, accountID, userID, rbac.OperationRead); err != nil {
+		return nil, err
+	}
+
+	var policies []*Policy
+
+	err := am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		if _, err := transaction.GetServiceByID(ctx, LockingStrengthShare, accountID, serviceID); err != nil {
+			return err
+		}
+
+		var err error
+		policies, err = getServicePolicies(ctx, transaction, accountID, serviceID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// validateServiceUserPermissions checks that the user belongs to the account and is allowed to run the operation.
+// Services are a part of the access control configuration, so they share the permissions of the policies.
+func (am *DefaultAccountManager) validateServiceUserPermissions(ctx context.Context, accountID, userID string, operation rbac.Operation) error {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.AccountID != accountID {
+		return status.NewUserNotPartOfAccountError()
+	}
+
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourcePolicies, operation) {
+		return status.NewAdminPermissionError()
+	}
+
+	return nil
+}
+
+// validateService validates the service and makes sure its name is unique within the account.
+func validateService(ctx context.Context, transaction Store, accountID string, service *nbservice.Service) error {
+	if err := service.Validate(); err != nil {
+		return status.Errorf(status.InvalidArgument, err.Error()) //nolint
+	}
+
+	if service.ID != "" {
+		if _, err := transaction.GetServiceByID(ctx, LockingStrengthShare, accountID, service.ID); err != nil {
+			return err
+		}
+	}
+
+	services, err := transaction.GetAccountServices(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range services {
+		if s.Name == service.Name && s.ID != service.ID {
+			return status.Errorf(status.InvalidArgument, "service with name %s already exists", service.Name)
+		}
+	}
+
+	if service.ID == "" {
+		service.ID = xid.New().String()
+	}
+
+	return nil
+}
+
+// getServicePolicies returns the account policies that have rules referencing the service.
+func getServicePolicies(ctx context.Context, transaction Store, accountID, serviceID string) ([]*Policy, error) {
+	policies, err := transaction.GetAccountPolicies(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	servicePolicies := make([]*Policy, 0)
+	for _, policy := range policies {
+		if policy.usesService(serviceID) {
+			servicePolicies = append(servicePolicies, policy)
+		}
+	}
+
+	return servicePolicies, nil
+}
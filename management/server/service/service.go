@@ -0,0 +1,148 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Protocol of the service traffic
+type Protocol string
+
+const (
+	// ProtocolTCP type of traffic
+	ProtocolTCP = Protocol("tcp")
+	// ProtocolUDP type of traffic
+	ProtocolUDP = Protocol("udp")
+)
+
+// PortRange is an inclusive range of ports
+type PortRange struct {
+	Start uint16
+	End   uint16
+}
+
+// Service is a named protocol with a set of ports and port ranges that policy rules can reference,
+// e.g. "postgres" for tcp/5432, instead of repeating the ports in every rule.
+type Service struct {
+	// ID of the service
+	ID string `gorm:"primaryKey"`
+
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `json:"-" gorm:"index"`
+
+	// Name of the service visible in the UI
+	Name string
+
+	// Description of the service visible in the UI
+	Description string
+
+	// Protocol of the service traffic
+	Protocol Protocol
+
+	// Ports of the service
+	Ports []string `gorm:"serializer:json"`
+
+	// PortRanges of the service
+	PortRanges []PortRange `gorm:"serializer:json"`
+}
+
+// NewServiceFromAPIRequest creates a service from the API request. It returns an error if a port range of the request
+// is out of the 1..65535 range.
+func NewServiceFromAPIRequest(req *api.ServiceRequest, serviceID string) (*Service, error) {
+	service := &Service{
+		ID:       serviceID,
+		Name:     req.Name,
+		Protocol: Protocol(req.Protocol),
+	}
+
+	if req.Description != nil {
+		service.Description = *req.Description
+	}
+
+	if req.Ports != nil {
+		service.Ports = slices.Clone(*req.Ports)
+	}
+
+	if req.PortRanges != nil {
+		for _, portRange := range *req.PortRanges {
+			if portRange.Start < 1 || portRange.Start > portRange.End || portRange.End > 65535 {
+				return nil, fmt.Errorf("invalid port range %d-%d, valid port value is in 1..65535 range", portRange.Start, portRange.End)
+			}
+			service.PortRanges = append(service.PortRanges, PortRange{Start: uint16(portRange.Start), End: uint16(portRange.End)})
+		}
+	}
+
+	return service, nil
+}
+
+// ToAPIResponse converts the service to the API response
+func (s *Service) ToAPIResponse() *api.Service {
+	ports := slices.Clone(s.Ports)
+	if ports == nil {
+		ports = []string{}
+	}
+
+	portRanges := make([]api.RulePortRange, 0, len(s.PortRanges))
+	for _, portRange := range s.PortRanges {
+		portRanges = append(portRanges, api.RulePortRange{Start: int(portRange.Start), End: int(portRange.End)})
+	}
+
+	return &api.Service{
+		Id:          s.ID,
+		Name:        s.Name,
+		Description: &s.Description,
+		Protocol:    api.ServiceProtocol(s.Protocol),
+		Ports:       &ports,
+		PortRanges:  &portRanges,
+	}
+}
+
+// Copy returns a copy of the service
+func (s *Service) Copy() *Service {
+	c := *s
+	c.Ports = slices.Clone(s.Ports)
+	c.PortRanges = slices.Clone(s.PortRanges)
+	return &c
+}
+
+// EventMeta returns activity event meta related to the service
+func (s *Service) EventMeta() map[string]any {
+	return map[string]any{"name": s.Name}
+}
+
+// Validate checks that the service has a name, a supported protocol and either valid ports or valid port ranges
+func (s *Service) Validate() error {
+	if s.Name == "" {
+		return errors.New("service name shouldn't be empty")
+	}
+
+	if s.Protocol != ProtocolTCP && s.Protocol != ProtocolUDP {
+		return fmt.Errorf("unsupported service protocol %s, only tcp and udp are allowed", s.Protocol)
+	}
+
+	if len(s.Ports) == 0 && len(s.PortRanges) == 0 {
+		return errors.New("service should have at least one port or port range")
+	}
+
+	if len(s.Ports) > 0 && len(s.PortRanges) > 0 {
+		return errors.New("service should have either ports or port ranges, not both")
+	}
+
+	for _, port := range s.Ports {
+		if value, err := strconv.Atoi(port); err != nil || value < 1 || value > 65535 {
+			return fmt.Errorf("invalid port %s, valid port value is in 1..65535 range", port)
+		}
+	}
+
+	for _, portRange := range s.PortRanges {
+		if portRange.Start < 1 || portRange.Start > portRange.End {
+			return fmt.Errorf("invalid port range %d-%d", portRange.Start, portRange.End)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		service Service
+		wantErr bool
+	}{
+		{
+			name:    "port",
+			service: Service{Name: "postgres", Protocol: ProtocolTCP, Ports: []string{"5432"}},
+		},
+		{
+			name:    "port range",
+			service: Service{Name: "web", Protocol: ProtocolUDP, PortRanges: []PortRange{{Start: 8000, End: 8100}}},
+		},
+		{
+			name:    "missing name",
+			service: Service{Protocol: ProtocolTCP, Ports: []string{"5432"}},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported protocol",
+			service: Service{Name: "ping", Protocol: "icmp", Ports: []string{"1"}},
+			wantErr: true,
+		},
+		{
+			name:    "no ports",
+			service: Service{Name: "postgres", Protocol: ProtocolTCP},
+			wantErr: true,
+		},
+		{
+			name:    "invalid port",
+			service: Service{Name: "postgres", Protocol: ProtocolTCP, Ports: []string{"70000"}},
+			wantErr: true,
+		},
+		{
+			name:    "inverted port range",
+			service: Service{Name: "web", Protocol: ProtocolTCP, PortRanges: []PortRange{{Start: 8100, End: 8000}}},
+			wantErr: true,
+		},
+		{
+			name:    "ports and port ranges",
+			service: Service{Name: "web", Protocol: ProtocolTCP, Ports: []string{"80"}, PortRanges: []PortRange{{Start: 8000, End: 8100}}},
+			wantErr: true,
+		},
+		{
+			name:    "zero port range",
+			service: Service{Name: "web", Protocol: ProtocolTCP, PortRanges: []PortRange{{Start: 0, End: 80}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.service.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
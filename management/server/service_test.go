@@ -0,0 +1,203 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	nbservice "github.com/netbirdio/netbird/management/server/service"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/route"
+)
+
+func TestDefaultAccountManager_Service(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err, "failed to create account manager")
+
+	account, err := initTestPostureChecksAccount(am)
+	require.NoError(t, err, "failed to init testing account")
+
+	groupAll, err := account.GetGroupAll()
+	require.NoError(t, err)
+
+	// regular users can not create services
+	_, err = am.SaveService(context.Background(), account.Id, regularUserID, &nbservice.Service{
+		Name:     "postgres",
+		Protocol: nbservice.ProtocolTCP,
+		Ports:    []string{"5432"},
+	})
+	assert.Error(t, err)
+
+	service, err := am.SaveService(context.Background(), account.Id, adminUserID, &nbservice.Service{
+		Name:     "postgres",
+		Protocol: nbservice.ProtocolTCP,
+		Ports:    []string{"5432"},
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, service.ID)
+
+	_, err = am.SaveService(context.Background(), account.Id, adminUserID, &nbservice.Service{
+		Name:     "postgres",
+		Protocol: nbservice.ProtocolTCP,
+		Ports:    []string{"5433"},
+	})
+	assert.Error(t, err, "service names should be unique")
+
+	_, err = am.SaveService(context.Background(), account.Id, adminUserID, &nbservice.Service{
+		Name:     "empty",
+		Protocol: nbservice.ProtocolUDP,
+	})
+	assert.Error(t, err, "service without ports should be rejected")
+
+	newPolicy := func(rule *PolicyRule) *Policy {
+		rule.Name = "db access"
+		rule.Enabled = true
+		rule.Action = PolicyTrafficActionAccept
+		rule.Protocol = PolicyRuleProtocolTCP
+		rule.Sources = []string{groupAll.ID}
+		rule.Destinations = []string{groupAll.ID}
+		return &Policy{Name: "db access", Enabled: true, Rules: []*PolicyRule{rule}}
+	}
+
+	_, err = am.SavePolicy(context.Background(), account.Id, adminUserID, newPolicy(&PolicyRule{Services: []string{"unknown"}}))
+	assert.Error(t, err, "policy should not reference missing services")
+
+	_, err = am.SavePolicy(context.Background(), account.Id, adminUserID, newPolicy(&PolicyRule{Services: []string{service.ID}, Ports: []string{"80"}}))
+	assert.Error(t, err, "services should not be combined with ports")
+
+	policy, err := am.SavePolicy(context.Background(), account.Id, adminUserID, newPolicy(&PolicyRule{Services: []string{service.ID}}))
+	require.NoError(t, err)
+
+	policies, err := am.GetServicePolicies(context.Background(), account.Id, service.ID, adminUserID)
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+	assert.Equal(t, policy.ID, policies[0].ID)
+
+	err = am.DeleteService(context.Background(), account.Id, service.ID, adminUserID)
+	sErr, ok := status.FromError(err)
+	require.True(t, ok, "service linked to a policy should not be deleted")
+	assert.Equal(t, status.PreconditionFailed, sErr.Type())
+
+	service.Ports = []string{"5433"}
+	_, err = am.SaveService(context.Background(), account.Id, adminUserID, service)
+	require.NoError(t, err)
+
+	require.NoError(t, am.DeletePolicy(context.Background(), account.Id, policy.ID, adminUserID))
+	require.NoError(t, am.DeleteService(context.Background(), account.Id, service.ID, adminUserID))
+
+	services, err := am.ListServices(context.Background(), account.Id, adminUserID)
+	require.NoError(t, err)
+	assert.Empty(t, services)
+}
+
+func TestAccount_ServiceRules(t *testing.T) {
+	account := &Account{
+		Peers: map[string]*nbpeer.Peer{
+			"peerA": {ID: "peerA", IP: net.ParseIP("100.64.0.1"), Status: &nbpeer.PeerStatus{}},
+			"peerB": {ID: "peerB", IP: net.ParseIP("100.64.0.2"), Status: &nbpeer.PeerStatus{}},
+			"peerR": {ID: "peerR", IP: net.ParseIP("100.64.0.3"), Status: &nbpeer.PeerStatus{}, Meta: nbpeer.PeerSystemMeta{GoOS: "linux"}},
+		},
+		Groups: map[string]*nbgroup.Group{
+			"all":     {ID: "all", Name: "All", Peers: []string{"peerA", "peerB", "peerR"}},
+			"dev":     {ID: "dev", Name: "dev", Peers: []string{"peerA"}},
+			"servers": {ID: "servers", Name: "servers", Peers: []string{"peerB"}},
+			"routers": {ID: "routers", Name: "routers", Peers: []string{"peerR"}},
+		},
+		Routes: map[route.ID]*route.Route{
+			"route1": {
+				ID:                  "route1",
+				Network:             netip.MustParsePrefix("10.0.0.0/24"),
+				Enabled:             true,
+				PeerGroups:          []string{"routers"},
+				Groups:              []string{"dev"},
+				AccessControlGroups: []string{"servers"},
+			},
+		},
+		Services: []*nbservice.Service{
+			{ID: "postgres", Name: "postgres", Protocol: nbservice.ProtocolTCP, Ports: []string{"5432"}},
+			{ID: "web", Name: "web", Protocol: nbservice.ProtocolTCP, PortRanges: []nbservice.PortRange{{Start: 8000, End: 8100}}},
+			{ID: "dns", Name: "dns", Protocol: nbservice.ProtocolUDP, PortRanges: []nbservice.PortRange{{Start: 53, End: 53}}},
+		},
+		Policies: []*Policy{
+			{
+				ID:      "policy1",
+				Enabled: true,
+				Rules: []*PolicyRule{
+					{
+						ID:           "rule1",
+						Enabled:      true,
+						Action:       PolicyTrafficActionAccept,
+						Protocol:     PolicyRuleProtocolALL,
+						Sources:      []string{"dev"},
+						Destinations: []string{"servers"},
+						Services:     []string{"postgres", "web", "dns"},
+					},
+				},
+			},
+			{
+				ID:      "policy2",
+				Enabled: true,
+				Rules: []*PolicyRule{
+					{
+						ID:           "rule2",
+						Enabled:      true,
+						Action:       PolicyTrafficActionAccept,
+						Protocol:     PolicyRuleProtocolUDP,
+						Sources:      []string{"dev"},
+						Destinations: []string{"servers"},
+						Ports:        []string{"500"},
+						PortRanges:   []RulePortRange{{Start: 4500, End: 4510}},
+					},
+				},
+			},
+		},
+	}
+
+	validatedPeers := make(map[string]struct{})
+	for p := range account.Peers {
+		validatedPeers[p] = struct{}{}
+	}
+
+	t.Run("firewall rules", func(t *testing.T) {
+		_, firewallRules := account.getPeerConnectionResources(context.Background(), "peerB", validatedPeers)
+
+		expectedFirewallRules := []*FirewallRule{
+			{PeerIP: "100.64.0.1", Direction: firewallRuleDirectionIN, Action: "accept", Protocol: "tcp", Port: "5432"},
+			{PeerIP: "100.64.0.1", Direction: firewallRuleDirectionIN, Action: "accept", Protocol: "tcp", PortRange: RulePortRange{Start: 8000, End: 8100}},
+			{PeerIP: "100.64.0.1", Direction: firewallRuleDirectionIN, Action: "accept", Protocol: "udp", Port: "53"},
+			{PeerIP: "100.64.0.1", Direction: firewallRuleDirectionIN, Action: "accept", Protocol: "udp", Port: "500"},
+			{PeerIP: "100.64.0.1", Direction: firewallRuleDirectionIN, Action: "accept", Protocol: "udp", PortRange: RulePortRange{Start: 4500, End: 4510}},
+		}
+		assert.ElementsMatch(t, expectedFirewallRules, firewallRules, "both the ports and the port ranges of a rule should be allowed")
+
+		protoRules := toProtocolFirewallRules(firewallRules)
+		require.Len(t, protoRules, 5)
+		for _, rule := range protoRules {
+			if rule.Port == "" {
+				require.NotNil(t, rule.PortInfo.GetRange())
+				assert.Contains(t, []uint32{8000, 4500}, rule.PortInfo.GetRange().Start)
+			}
+		}
+	})
+
+	t.Run("route firewall rules", func(t *testing.T) {
+		routesFirewallRules := account.getPeerRoutesFirewallRules(context.Background(), "peerR", validatedPeers)
+
+		sourceRanges := []string{fmt.Sprintf(AllowedIPsFormat, "100.64.0.1")}
+		expectedRoutesFirewallRules := []*RouteFirewallRule{
+			{SourceRanges: sourceRanges, Action: "accept", Destination: "10.0.0.0/24", Protocol: "tcp", Port: 5432},
+			{SourceRanges: sourceRanges, Action: "accept", Destination: "10.0.0.0/24", Protocol: "tcp", PortRange: RulePortRange{Start: 8000, End: 8100}},
+			{SourceRanges: sourceRanges, Action: "accept", Destination: "10.0.0.0/24", Protocol: "udp", PortRange: RulePortRange{Start: 53, End: 53}},
+			{SourceRanges: sourceRanges, Action: "accept", Destination: "10.0.0.0/24", Protocol: "udp", Port: 500},
+			{SourceRanges: sourceRanges, Action: "accept", Destination: "10.0.0.0/24", Protocol: "udp", PortRange: RulePortRange{Start: 4500, End: 4510}},
+		}
+		assert.ElementsMatch(t, expectedRoutesFirewallRules, routesFirewallRules)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	b64 "encoding/base64"
 	"hash/fnv"
+	"maps"
 	"slices"
 	"strconv"
 	"strings"
@@ -15,6 +16,8 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/management/server/activity"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/rbac"
 	"github.com/netbirdio/netbird/management/server/status"
 )
 
@@ -95,6 +98,10 @@ type SetupKey struct {
 	UsageLimit int
 	// Ephemeral indicate if the peers will be ephemeral or not
 	Ephemeral bool
+	// Tags are key/value labels assigned to a Peer when it uses this key to register
+	Tags map[string]string `gorm:"serializer:json"`
+	// Restrictions limit the machines that can register with this key
+	Restrictions SetupKeyRestrictions `gorm:"embedded;embeddedPrefix:restrictions_"`
 }
 
 // Copy copies SetupKey to a new object
@@ -105,21 +112,23 @@ func (key *SetupKey) Copy() *SetupKey {
 		key.UpdatedAt = key.CreatedAt
 	}
 	return &SetupKey{
-		Id:         key.Id,
-		AccountID:  key.AccountID,
-		Key:        key.Key,
-		KeySecret:  key.KeySecret,
-		Name:       key.Name,
-		Type:       key.Type,
-		CreatedAt:  key.CreatedAt,
-		ExpiresAt:  key.ExpiresAt,
-		UpdatedAt:  key.UpdatedAt,
-		Revoked:    key.Revoked,
-		UsedTimes:  key.UsedTimes,
-		LastUsed:   key.LastUsed,
-		AutoGroups: autoGroups,
-		UsageLimit: key.UsageLimit,
-		Ephemeral:  key.Ephemeral,
+		Id:           key.Id,
+		AccountID:    key.AccountID,
+		Key:          key.Key,
+		KeySecret:    key.KeySecret,
+		Name:         key.Name,
+		Type:         key.Type,
+		CreatedAt:    key.CreatedAt,
+		ExpiresAt:    key.ExpiresAt,
+		UpdatedAt:    key.UpdatedAt,
+		Revoked:      key.Revoked,
+		UsedTimes:    key.UsedTimes,
+		LastUsed:     key.LastUsed,
+		AutoGroups:   autoGroups,
+		UsageLimit:   key.UsageLimit,
+		Ephemeral:    key.Ephemeral,
+		Tags:         maps.Clone(key.Tags),
+		Restrictions: key.Restrictions.Copy(),
 	}
 }
 
@@ -222,10 +231,24 @@ func Hash(s string) uint32 {
 	return h.Sum32()
 }
 
+// SetupKeyOptions holds the optional properties of a setup key applied to the peers registered with it
+type SetupKeyOptions struct {
+	// Tags are assigned to the peers registered with the key
+	Tags map[string]string
+	// Restrictions limit which peers can register with the key
+	Restrictions SetupKeyRestrictions
+}
+
 // CreateSetupKey generates a new setup key with a given name, type, list of groups IDs to auto-assign to peers registered with this key,
 // and adds it to the specified account. A list of autoGroups IDs can be empty.
 func (am *DefaultAccountManager) CreateSetupKey(ctx context.Context, accountID string, keyName string, keyType SetupKeyType,
 	expiresIn time.Duration, autoGroups []string, usageLimit int, userID string, ephemeral bool) (*SetupKey, error) {
+	return am.CreateSetupKeyWithOptions(ctx, accountID, keyName, keyType, expiresIn, autoGroups, usageLimit, userID, ephemeral, SetupKeyOptions{})
+}
+
+// CreateSetupKeyWithOptions generates a new setup key like CreateSetupKey with the optional properties of the key.
+func (am *DefaultAccountManager) CreateSetupKeyWithOptions(ctx context.Context, accountID string, keyName string, keyType SetupKeyType,
+	expiresIn time.Duration, autoGroups []string, usageLimit int, userID string, ephemeral bool, opts SetupKeyOptions) (*SetupKey, error) {
 	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
 	defer unlock()
 
@@ -238,10 +261,14 @@ func (am *DefaultAccountManager) CreateSetupKey(ctx context.Context, accountID s
 		return nil, status.NewUserNotPartOfAccountError()
 	}
 
-	if user.IsRegularUser() {
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourceSetupKeys, rbac.OperationCreate) {
 		return nil, status.NewAdminPermissionError()
 	}
 
+	if err = nbpeer.ValidateTags(opts.Tags); err != nil {
+		return nil, status.Errorf(status.InvalidArgument, "%s", err.Error())
+	}
+
 	var setupKey *SetupKey
 	var plainKey string
 	var eventsToStore []func()
@@ -251,8 +278,14 @@ func (am *DefaultAccountManager) CreateSetupKey(ctx context.Context, accountID s
 			return err
 		}
 
+		if err = validateSetupKeyRestrictions(ctx, transaction, accountID, opts.Restrictions); err != nil {
+			return err
+		}
+
 		setupKey, plainKey = GenerateSetupKey(keyName, keyType, expiresIn, autoGroups, usageLimit, ephemeral)
 		setupKey.AccountID = accountID
+		setupKey.Tags = opts.Tags
+		setupKey.Restrictions = opts.Restrictions
 
 		events := am.prepareSetupKeyEvents(ctx, transaction, accountID, userID, autoGroups, nil, setupKey)
 		eventsToStore = append(eventsToStore, events...)
@@ -277,8 +310,21 @@ func (am *DefaultAccountManager) CreateSetupKey(ctx context.Context, accountID s
 // SaveSetupKey saves the provided SetupKey to the database overriding the existing one.
 // Due to the unique nature of a SetupKey certain properties must not be overwritten
 // (e.g. the key itself, creation date, ID, etc).
-// These properties are overwritten: AutoGroups, Revoked (only from false to true), and the UpdatedAt. The rest is copied from the existing key.
+// These properties are overwritten: AutoGroups, Tags (when provided), Restrictions, Revoked (only from false to true), and the UpdatedAt.
+// The rest is copied from the existing key.
 func (am *DefaultAccountManager) SaveSetupKey(ctx context.Context, accountID string, keyToSave *SetupKey, userID string) (*SetupKey, error) {
+	return am.SaveSetupKeyWithOptions(ctx, accountID, keyToSave, userID, SaveSetupKeyOptions{})
+}
+
+// SaveSetupKeyOptions holds the optional behaviour of a setup key update
+type SaveSetupKeyOptions struct {
+	// KeepRestrictions keeps the restrictions of the stored key instead of overwriting them with the ones of the
+	// provided key, so a key update that doesn't provide restrictions never lifts them
+	KeepRestrictions bool
+}
+
+// SaveSetupKeyWithOptions saves the provided SetupKey like SaveSetupKey with the optional behaviour of the update.
+func (am *DefaultAccountManager) SaveSetupKeyWithOptions(ctx context.Context, accountID string, keyToSave *SetupKey, userID string, opts SaveSetupKeyOptions) (*SetupKey, error) {
 	if keyToSave == nil {
 		return nil, status.Errorf(status.InvalidArgument, "provided setup key to update is nil")
 	}
@@ -295,10 +341,14 @@ func (am *DefaultAccountManager) SaveSetupKey(ctx context.Context, accountID str
 		return nil, status.NewUserNotPartOfAccountError()
 	}
 
-	if user.IsRegularUser() {
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourceSetupKeys, rbac.OperationUpdate) {
 		return nil, status.NewAdminPermissionError()
 	}
 
+	if err = nbpeer.ValidateTags(keyToSave.Tags); err != nil {
+		return nil, status.Errorf(status.InvalidArgument, "%s", err.Error())
+	}
+
 	var oldKey *SetupKey
 	var newKey *SetupKey
 	var eventsToStore []func()
@@ -308,6 +358,12 @@ func (am *DefaultAccountManager) SaveSetupKey(ctx context.Context, accountID str
 			return err
 		}
 
+		if !opts.KeepRestrictions {
+			if err = validateSetupKeyRestrictions(ctx, transaction, accountID, keyToSave.Restrictions); err != nil {
+				return err
+			}
+		}
+
 		oldKey, err = transaction.GetSetupKeyByID(ctx, LockingStrengthShare, accountID, keyToSave.Id)
 		if err != nil {
 			return err
@@ -317,10 +373,16 @@ func (am *DefaultAccountManager) SaveSetupKey(ctx context.Context, accountID str
 			return status.Errorf(status.InvalidArgument, "can't un-revoke a revoked setup key")
 		}
 
-		// only auto groups, revoked status (from false to true) can be updated
+		// only auto groups, tags, restrictions, revoked status (from false to true) can be updated
 		newKey = oldKey.Copy()
 		newKey.AutoGroups = keyToSave.AutoGroups
 		newKey.Revoked = keyToSave.Revoked
+		if keyToSave.Tags != nil {
+			newKey.Tags = keyToSave.Tags
+		}
+		if !opts.KeepRestrictions {
+			newKey.Restrictions = keyToSave.Restrictions.Copy()
+		}
 		newKey.UpdatedAt = time.Now().UTC()
 
 		addedGroups := difference(newKey.AutoGroups, oldKey.AutoGroups)
@@ -357,7 +419,7 @@ func (am *DefaultAccountManager) ListSetupKeys(ctx context.Context, accountID, u
 		return nil, status.NewUserNotPartOfAccountError()
 	}
 
-	if user.IsRegularUser() {
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourceSetupKeys, rbac.OperationRead) {
 		return nil, status.NewAdminPermissionError()
 	}
 
@@ -375,7 +437,7 @@ func (am *DefaultAccountManager) GetSetupKey(ctx context.Context, accountID, use
 		return nil, status.NewUserNotPartOfAccountError()
 	}
 
-	if user.IsRegularUser() {
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourceSetupKeys, rbac.OperationRead) {
 		return nil, status.NewAdminPermissionError()
 	}
 
@@ -403,7 +465,7 @@ func (am *DefaultAccountManager) DeleteSetupKey(ctx context.Context, accountID,
 		return status.NewUserNotPartOfAccountError()
 	}
 
-	if user.IsRegularUser() {
+	if user.IsRegularUser() && !am.hasPermission(ctx, user, rbac.ResourceSetupKeys, rbac.OperationDelete) {
 		return status.NewAdminPermissionError()
 	}
 
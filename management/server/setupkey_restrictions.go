@@ -0,0 +1,224 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+// setupKeyRestrictionOS are the operating systems that can be allowed by the setup key restrictions
+var setupKeyRestrictionOS = []string{"android", "darwin", "freebsd", "ios", "linux", "windows"}
+
+// enrollmentPostureChecks are the posture checks that can be evaluated with the meta sent by the peer on registration.
+// The other checks need values the client collects only after receiving the checks from the management service.
+var enrollmentPostureChecks = []string{
+	posture.NBVersionCheckName,
+	posture.OSVersionCheckName,
+	posture.GeoLocationCheckName,
+	posture.PeerNetworkRangeCheckName,
+}
+
+// SetupKeyRestrictions limit the machines that can enroll with a setup key, so a leaked reusable key can't be used
+// to enroll any machine from anywhere. Empty restrictions allow any machine.
+type SetupKeyRestrictions struct {
+	// AllowedSourceRanges are the CIDRs the peers must connect from to enroll with the key
+	AllowedSourceRanges []string `gorm:"serializer:json"`
+	// HostnamePattern is a regular expression the whole hostname of the peers must match to enroll with the key
+	HostnamePattern string
+	// AllowedOS are the operating systems (e.g. linux, windows) of the peers allowed to enroll with the key
+	AllowedOS []string `gorm:"serializer:json"`
+	// PostureChecks are the IDs of the posture checks the peers must pass to enroll with the key
+	PostureChecks []string `gorm:"serializer:json"`
+}
+
+// Copy returns a copy of the setup key restrictions
+func (r SetupKeyRestrictions) Copy() SetupKeyRestrictions {
+	return SetupKeyRestrictions{
+		AllowedSourceRanges: slices.Clone(r.AllowedSourceRanges),
+		HostnamePattern:     r.HostnamePattern,
+		AllowedOS:           slices.Clone(r.AllowedOS),
+		PostureChecks:       slices.Clone(r.PostureChecks),
+	}
+}
+
+// Validate checks the format of the restrictions, the posture checks are validated against the account
+func (r SetupKeyRestrictions) Validate() error {
+	for _, sourceRange := range r.AllowedSourceRanges {
+		if _, err := netip.ParsePrefix(sourceRange); err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid allowed source range %s", sourceRange)
+		}
+	}
+
+	if r.HostnamePattern != "" {
+		if _, err := compileHostnamePattern(r.HostnamePattern); err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid hostname pattern: %v", err)
+		}
+	}
+
+	for _, os := range r.AllowedOS {
+		if !slices.Contains(setupKeyRestrictionOS, os) {
+			return status.Errorf(status.InvalidArgument, "unsupported operating system %s, allowed values are: %v", os, setupKeyRestrictionOS)
+		}
+	}
+
+	return nil
+}
+
+// violation returns the reason why the peer isn't allowed to enroll, or an empty string when the peer is allowed.
+func (r SetupKeyRestrictions) violation(ctx context.Context, peer *nbpeer.Peer, postureChecks []*posture.Checks) string {
+	if len(r.AllowedSourceRanges) > 0 && !r.isSourceAllowed(peer) {
+		return fmt.Sprintf("connection IP %s is not allowed", peer.Location.ConnectionIP)
+	}
+
+	if r.HostnamePattern != "" {
+		hostnameRegexp, err := compileHostnamePattern(r.HostnamePattern)
+		if err != nil || !hostnameRegexp.MatchString(peer.Meta.Hostname) {
+			return fmt.Sprintf("hostname %s doesn't match the allowed pattern", peer.Meta.Hostname)
+		}
+	}
+
+	if len(r.AllowedOS) > 0 && !slices.Contains(r.AllowedOS, peer.Meta.GoOS) {
+		return fmt.Sprintf("operating system %s is not allowed", peer.Meta.GoOS)
+	}
+
+	for _, postureCheck := range postureChecks {
+		for _, check := range postureCheck.GetChecks() {
+			passed, err := check.Check(ctx, *peer)
+			if err != nil || !passed {
+				return fmt.Sprintf("posture check %s failed", postureCheck.Name)
+			}
+		}
+	}
+
+	return ""
+}
+
+// compileHostnamePattern compiles the hostname pattern anchored to the whole hostname, so a pattern like web-[0-9]+
+// doesn't allow a hostname only containing a match, e.g. web-1.attacker.example
+func compileHostnamePattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+func (r SetupKeyRestrictions) isSourceAllowed(peer *nbpeer.Peer) bool {
+	addr, ok := netip.AddrFromSlice(peer.Location.ConnectionIP)
+	if !ok {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, sourceRange := range r.AllowedSourceRanges {
+		prefix, err := netip.ParsePrefix(sourceRange)
+		if err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateSetupKeyRestrictions checks the restrictions and that their posture checks exist and can be evaluated on enrollment.
+func validateSetupKeyRestrictions(ctx context.Context, transaction Store, accountID string, restrictions SetupKeyRestrictions) error {
+	if err := restrictions.Validate(); err != nil {
+		return err
+	}
+
+	if len(restrictions.PostureChecks) == 0 {
+		return nil
+	}
+
+	postureChecks, err := transaction.GetPostureChecksByIDs(ctx, LockingStrengthShare, accountID, restrictions.PostureChecks)
+	if err != nil {
+		return err
+	}
+
+	for _, postureChecksID := range restrictions.PostureChecks {
+		postureCheck, ok := postureChecks[postureChecksID]
+		if !ok {
+			return status.Errorf(status.NotFound, "posture checks not found: %s", postureChecksID)
+		}
+
+		if name, ok := unsupportedEnrollmentCheck(postureCheck); ok {
+			return status.Errorf(status.InvalidArgument, "posture checks %s can't be evaluated on enrollment, %s is not supported",
+				postureCheck.Name, name)
+		}
+	}
+
+	return nil
+}
+
+// validateSetupKeysPostureChecksUpdate checks that the updated posture checks can still be evaluated on enrollment
+// when they are required by a setup key of the account.
+func validateSetupKeysPostureChecksUpdate(ctx context.Context, transaction Store, accountID string, postureChecks *posture.Checks) error {
+	name, ok := unsupportedEnrollmentCheck(postureChecks)
+	if !ok {
+		return nil
+	}
+
+	setupKeys, err := transaction.GetAccountSetupKeys(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, setupKey := range setupKeys {
+		if slices.Contains(setupKey.Restrictions.PostureChecks, postureChecks.ID) {
+			return status.Errorf(status.InvalidArgument, "posture checks are required by setup key %s and can't be evaluated on enrollment, %s is not supported",
+				setupKey.Name, name)
+		}
+	}
+
+	return nil
+}
+
+// unsupportedEnrollmentCheck returns the name of the first check of the posture checks that can't be evaluated
+// on enrollment
+func unsupportedEnrollmentCheck(postureChecks *posture.Checks) (string, bool) {
+	for _, check := range postureChecks.GetChecks() {
+		if !slices.Contains(enrollmentPostureChecks, check.Name()) {
+			return check.Name(), true
+		}
+	}
+	return "", false
+}
+
+// getSetupKeyRestrictionViolation returns the reason why the peer isn't allowed to enroll with the setup key,
+// or an empty string when the peer is allowed.
+func getSetupKeyRestrictionViolation(ctx context.Context, transaction Store, setupKey *SetupKey, peer *nbpeer.Peer) (string, error) {
+	var postureChecks []*posture.Checks
+	if len(setupKey.Restrictions.PostureChecks) > 0 {
+		checks, err := transaction.GetPostureChecksByIDs(ctx, LockingStrengthShare, setupKey.AccountID, setupKey.Restrictions.PostureChecks)
+		if err != nil {
+			return "", err
+		}
+
+		for _, postureChecksID := range setupKey.Restrictions.PostureChecks {
+			postureCheck, ok := checks[postureChecksID]
+			if !ok {
+				return fmt.Sprintf("posture checks %s not found", postureChecksID), nil
+			}
+			postureChecks = append(postureChecks, postureCheck)
+		}
+	}
+
+	return setupKey.Restrictions.violation(ctx, peer, postureChecks), nil
+}
+
+// isPostureCheckLinkedToSetupKey checks whether the posture check is required by any account setup key.
+func isPostureCheckLinkedToSetupKey(ctx context.Context, transaction Store, postureChecksID, accountID string) error {
+	setupKeys, err := transaction.GetAccountSetupKeys(ctx, LockingStrengthShare, accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, setupKey := range setupKeys {
+		if slices.Contains(setupKey.Restrictions.PostureChecks, postureChecksID) {
+			return status.Errorf(status.PreconditionFailed, "posture checks have been linked to setup key: %s", setupKey.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,278 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/status"
+)
+
+func TestSetupKeyRestrictions_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		restrictions SetupKeyRestrictions
+		wantErr      bool
+	}{
+		{
+			name: "empty restrictions",
+		},
+		{
+			name: "valid restrictions",
+			restrictions: SetupKeyRestrictions{
+				AllowedSourceRanges: []string{"203.0.113.0/24", "2001:db8::/32"},
+				HostnamePattern:     "^web-[0-9]+$",
+				AllowedOS:           []string{"linux", "windows"},
+			},
+		},
+		{
+			name:         "invalid source range",
+			restrictions: SetupKeyRestrictions{AllowedSourceRanges: []string{"203.0.113.0"}},
+			wantErr:      true,
+		},
+		{
+			name:         "invalid hostname pattern",
+			restrictions: SetupKeyRestrictions{HostnamePattern: "web-[0-9"},
+			wantErr:      true,
+		},
+		{
+			name:         "unsupported operating system",
+			restrictions: SetupKeyRestrictions{AllowedOS: []string{"plan9"}},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.restrictions.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSetupKeyRestrictions_Violation(t *testing.T) {
+	restrictions := SetupKeyRestrictions{
+		AllowedSourceRanges: []string{"203.0.113.0/24"},
+		HostnamePattern:     "web-[0-9]+",
+		AllowedOS:           []string{"linux"},
+	}
+	versionCheck := &posture.Checks{
+		Name:   "version",
+		Checks: posture.ChecksDefinition{NBVersionCheck: &posture.NBVersionCheck{MinVersion: "0.30.0"}},
+	}
+
+	tests := []struct {
+		name          string
+		peer          *nbpeer.Peer
+		postureChecks []*posture.Checks
+		expected      string
+	}{
+		{
+			name: "allowed peer",
+			peer: &nbpeer.Peer{
+				Meta:     nbpeer.PeerSystemMeta{Hostname: "web-1", GoOS: "linux", WtVersion: "0.30.1"},
+				Location: nbpeer.Location{ConnectionIP: net.ParseIP("203.0.113.10")},
+			},
+			postureChecks: []*posture.Checks{versionCheck},
+		},
+		{
+			name: "IPv4-mapped connection IP",
+			peer: &nbpeer.Peer{
+				Meta:     nbpeer.PeerSystemMeta{Hostname: "web-1", GoOS: "linux"},
+				Location: nbpeer.Location{ConnectionIP: net.ParseIP("::ffff:203.0.113.10")},
+			},
+		},
+		{
+			name: "connection IP out of the allowed ranges",
+			peer: &nbpeer.Peer{
+				Meta:     nbpeer.PeerSystemMeta{Hostname: "web-1", GoOS: "linux"},
+				Location: nbpeer.Location{ConnectionIP: net.ParseIP("198.51.100.10")},
+			},
+			expected: "connection IP 198.51.100.10 is not allowed",
+		},
+		{
+			name: "unknown connection IP",
+			peer: &nbpeer.Peer{
+				Meta: nbpeer.PeerSystemMeta{Hostname: "web-1", GoOS: "linux"},
+			},
+			expected: "connection IP <nil> is not allowed",
+		},
+		{
+			name: "hostname doesn't match",
+			peer: &nbpeer.Peer{
+				Meta:     nbpeer.PeerSystemMeta{Hostname: "db-1", GoOS: "linux"},
+				Location: nbpeer.Location{ConnectionIP: net.ParseIP("203.0.113.10")},
+			},
+			expected: "hostname db-1 doesn't match the allowed pattern",
+		},
+		{
+			name: "hostname only contains a match",
+			peer: &nbpeer.Peer{
+				Meta:     nbpeer.PeerSystemMeta{Hostname: "web-1.attacker.example", GoOS: "linux"},
+				Location: nbpeer.Location{ConnectionIP: net.ParseIP("203.0.113.10")},
+			},
+			expected: "hostname web-1.attacker.example doesn't match the allowed pattern",
+		},
+		{
+			name: "operating system not allowed",
+			peer: &nbpeer.Peer{
+				Meta:     nbpeer.PeerSystemMeta{Hostname: "web-1", GoOS: "windows"},
+				Location: nbpeer.Location{ConnectionIP: net.ParseIP("203.0.113.10")},
+			},
+			expected: "operating system windows is not allowed",
+		},
+		{
+			name: "posture check failed",
+			peer: &nbpeer.Peer{
+				Meta:     nbpeer.PeerSystemMeta{Hostname: "web-1", GoOS: "linux", WtVersion: "0.29.0"},
+				Location: nbpeer.Location{ConnectionIP: net.ParseIP("203.0.113.10")},
+			},
+			postureChecks: []*posture.Checks{versionCheck},
+			expected:      "posture check version failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, restrictions.violation(context.Background(), tt.peer, tt.postureChecks))
+		})
+	}
+}
+
+func TestDefaultAccountManager_SetupKeyRestrictions(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	account, err := createAccount(manager, "test_account", userID, "")
+	require.NoError(t, err)
+
+	processChecks, err := manager.SavePostureChecks(context.Background(), account.Id, userID, &posture.Checks{
+		Name: "process",
+		Checks: posture.ChecksDefinition{ProcessCheck: &posture.ProcessCheck{
+			Processes: []posture.Process{{LinuxPath: "/usr/bin/agent"}},
+		}},
+	})
+	require.NoError(t, err)
+
+	versionChecks, err := manager.SavePostureChecks(context.Background(), account.Id, userID, &posture.Checks{
+		Name:   "version",
+		Checks: posture.ChecksDefinition{NBVersionCheck: &posture.NBVersionCheck{MinVersion: "0.30.0"}},
+	})
+	require.NoError(t, err)
+
+	t.Run("posture checks that can't be evaluated on enrollment are rejected", func(t *testing.T) {
+		_, err := manager.CreateSetupKeyWithOptions(context.Background(), account.Id, "key", SetupKeyReusable, time.Hour, nil, 0, userID, false,
+			SetupKeyOptions{Restrictions: SetupKeyRestrictions{PostureChecks: []string{processChecks.ID}}})
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.InvalidArgument, sErr.Type())
+	})
+
+	setupKey, err := manager.CreateSetupKeyWithOptions(context.Background(), account.Id, "key", SetupKeyReusable, time.Hour, nil, 0, userID, false,
+		SetupKeyOptions{Restrictions: SetupKeyRestrictions{
+			AllowedSourceRanges: []string{"203.0.113.0/24"},
+			AllowedOS:           []string{"linux"},
+			PostureChecks:       []string{versionChecks.ID},
+		}})
+	require.NoError(t, err)
+
+	addPeer := func(t *testing.T, meta nbpeer.PeerSystemMeta, connectionIP string) error {
+		t.Helper()
+		peerKey, err := wgtypes.GeneratePrivateKey()
+		require.NoError(t, err)
+
+		_, _, _, err = manager.AddPeer(context.Background(), setupKey.Key, "", &nbpeer.Peer{
+			Key:      peerKey.PublicKey().String(),
+			Meta:     meta,
+			Location: nbpeer.Location{ConnectionIP: net.ParseIP(connectionIP)},
+		})
+		return err
+	}
+
+	t.Run("peer matching the restrictions is added", func(t *testing.T) {
+		err := addPeer(t, nbpeer.PeerSystemMeta{Hostname: "allowed", GoOS: "linux", WtVersion: "0.30.0"}, "203.0.113.10")
+		require.NoError(t, err)
+	})
+
+	t.Run("peer violating the restrictions is rejected", func(t *testing.T) {
+		err := addPeer(t, nbpeer.PeerSystemMeta{Hostname: "rejected", GoOS: "linux", WtVersion: "0.30.0"}, "198.51.100.10")
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.PreconditionFailed, sErr.Type())
+		assert.Contains(t, sErr.Message, "connection IP 198.51.100.10 is not allowed")
+
+		peers, err := manager.Store.GetAccountPeers(context.Background(), LockingStrengthShare, account.Id)
+		require.NoError(t, err)
+		for _, peer := range peers {
+			assert.NotEqual(t, "rejected", peer.Meta.Hostname, "rejected peer should not be added")
+		}
+
+		key, err := manager.Store.GetSetupKeyByID(context.Background(), LockingStrengthShare, account.Id, setupKey.Id)
+		require.NoError(t, err)
+		assert.Equal(t, 1, key.UsedTimes, "rejected peer should not use the setup key")
+
+		assert.Eventually(t, func() bool {
+			events, err := manager.eventStore.Get(context.Background(), account.Id, 0, 100, false)
+			if err != nil {
+				return false
+			}
+			for _, event := range events {
+				if event.Activity == activity.SetupKeyRestrictionViolated && event.TargetID == setupKey.Id {
+					return event.Meta["reason"] == "connection IP 198.51.100.10 is not allowed"
+				}
+			}
+			return false
+		}, time.Second, 10*time.Millisecond, "the violation should be recorded as an activity event")
+	})
+
+	t.Run("peer failing the posture checks is rejected", func(t *testing.T) {
+		err := addPeer(t, nbpeer.PeerSystemMeta{Hostname: "outdated", GoOS: "linux", WtVersion: "0.29.0"}, "203.0.113.11")
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.PreconditionFailed, sErr.Type())
+		assert.Contains(t, sErr.Message, "posture check version failed")
+	})
+
+	t.Run("posture checks required by a setup key can't require checks evaluated after enrollment", func(t *testing.T) {
+		updated := versionChecks.Copy()
+		updated.Checks.ProcessCheck = &posture.ProcessCheck{Processes: []posture.Process{{LinuxPath: "/usr/bin/agent"}}}
+
+		_, err := manager.SavePostureChecks(context.Background(), account.Id, userID, updated)
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.InvalidArgument, sErr.Type())
+
+		stored, err := manager.Store.GetPostureChecksByID(context.Background(), LockingStrengthShare, account.Id, versionChecks.ID)
+		require.NoError(t, err)
+		assert.Nil(t, stored.Checks.ProcessCheck, "posture checks shouldn't be updated")
+	})
+
+	t.Run("setup key update without restrictions keeps them", func(t *testing.T) {
+		key, err := manager.Store.GetSetupKeyByID(context.Background(), LockingStrengthShare, account.Id, setupKey.Id)
+		require.NoError(t, err)
+		key.Restrictions = SetupKeyRestrictions{}
+
+		updated, err := manager.SaveSetupKeyWithOptions(context.Background(), account.Id, key, userID, SaveSetupKeyOptions{KeepRestrictions: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{versionChecks.ID}, updated.Restrictions.PostureChecks)
+	})
+
+	t.Run("posture checks required by a setup key can't be deleted", func(t *testing.T) {
+		err := manager.DeletePostureChecks(context.Background(), account.Id, versionChecks.ID, userID)
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.PreconditionFailed, sErr.Type())
+	})
+}
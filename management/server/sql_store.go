@@ -25,10 +25,15 @@ import (
 	nbdns "github.com/netbirdio/netbird/dns"
 	"github.com/netbirdio/netbird/management/server/account"
 	nbgroup "github.com/netbirdio/netbird/management/server/group"
+	"github.com/netbirdio/netbird/management/server/organization"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/rbac"
+	"github.com/netbirdio/netbird/management/server/scim"
+	nbservice "github.com/netbirdio/netbird/management/server/service"
 	"github.com/netbirdio/netbird/management/server/status"
 	"github.com/netbirdio/netbird/management/server/telemetry"
+	"github.com/netbirdio/netbird/management/server/webhook"
 	"github.com/netbirdio/netbird/route"
 )
 
@@ -89,6 +94,9 @@ func NewSqlStore(ctx context.Context, db *gorm.DB, storeEngine StoreEngine, metr
 		&SetupKey{}, &nbpeer.Peer{}, &User{}, &PersonalAccessToken{}, &nbgroup.Group{},
 		&Account{}, &Policy{}, &PolicyRule{}, &route.Route{}, &nbdns.NameServerGroup{},
 		&installation{}, &account.ExtraSettings{}, &posture.Checks{}, &nbpeer.NetworkAddress{},
+		&AccessRequest{}, &webhook.Endpoint{}, &webhook.Delivery{},
+		&rbac.Role{}, &scim.Token{}, &scim.Profile{}, &nbservice.Service{},
+		&PolicyAuditCount{}, &organization.Organization{}, &organization.Admin{}, &RejectedPeer{},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("auto migrate: %w", err)
@@ -266,6 +274,58 @@ func (s *SqlStore) DeleteAccount(ctx context.Context, account *Account) error {
 			return result.Error
 		}
 
+		result = tx.Delete(&AccessRequest{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&PolicyAuditCount{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&RejectedPeer{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&webhook.Endpoint{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&webhook.Delivery{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&rbac.Role{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&scim.Token{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&scim.Profile{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		var organizationIDs []string
+		result = tx.Model(&organization.Organization{}).Where(accountIDCondition, account.Id).Pluck("id", &organizationIDs)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		for _, organizationID := range organizationIDs {
+			if err := deleteOrganization(tx, LockingStrengthUpdate, organizationID, nil); err != nil {
+				return err
+			}
+		}
+
 		result = tx.Select(clause.Associations).Delete(account)
 		if result.Error != nil {
 			return result.Error
@@ -361,7 +421,7 @@ func (s *SqlStore) SavePeerStatus(accountID, peerID string, peerStatus nbpeer.Pe
 
 	fieldsToUpdate := []string{
 		"peer_status_last_seen", "peer_status_connected",
-		"peer_status_login_expired", "peer_status_required_approval",
+		"peer_status_login_expired", "peer_status_requires_approval",
 	}
 	result := s.db.Model(&nbpeer.Peer{}).
 		Select(fieldsToUpdate).
@@ -378,6 +438,27 @@ func (s *SqlStore) SavePeerStatus(accountID, peerID string, peerStatus nbpeer.Pe
 	return nil
 }
 
+// SavePeerPostureStatus saves the posture compliance status of a peer.
+func (s *SqlStore) SavePeerPostureStatus(ctx context.Context, lockStrength LockingStrength, accountID, peerID string, postureStatus nbpeer.PostureStatus) error {
+	var peerCopy nbpeer.Peer
+	peerCopy.PostureStatus = postureStatus
+
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Model(&nbpeer.Peer{}).
+		Select("posture_status").
+		Where(accountAndIDQueryCondition, accountID, peerID).
+		Updates(&peerCopy)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save peer posture status to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to save peer posture status to store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.Errorf(status.NotFound, peerNotFoundFMT, peerID)
+	}
+
+	return nil
+}
+
 func (s *SqlStore) SavePeerLocation(accountID string, peerWithLocation *nbpeer.Peer) error {
 	// To maintain data integrity, we create a copy of the peer's location to prevent unintended updates to other fields.
 	var peerCopy nbpeer.Peer
@@ -672,6 +753,8 @@ func (s *SqlStore) GetAccount(ctx context.Context, accountID string) (*Account,
 	}
 	account.NameServerGroupsG = nil
 
+	account.policyIndexCache = &policyIndexCache{}
+
 	return &account, nil
 }
 
@@ -1071,6 +1154,11 @@ func (s *SqlStore) GetUserPeers(ctx context.Context, lockStrength LockingStrengt
 	return getRecords[*nbpeer.Peer](s.db.Where("user_id = ?", userID), lockStrength, accountID)
 }
 
+// GetAccountPeers retrieves all peers of an account.
+func (s *SqlStore) GetAccountPeers(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*nbpeer.Peer, error) {
+	return getRecords[*nbpeer.Peer](s.db, lockStrength, accountID)
+}
+
 func (s *SqlStore) AddPeerToAccount(ctx context.Context, peer *nbpeer.Peer) error {
 	if err := s.db.Create(peer).Error; err != nil {
 		return status.Errorf(status.Internal, "issue adding peer to account: %s", err)
@@ -1344,6 +1432,19 @@ func (s *SqlStore) SavePolicy(ctx context.Context, lockStrength LockingStrength,
 	return nil
 }
 
+// DeletePolicyRules deletes the rules of the policy other than the given ones.
+func (s *SqlStore) DeletePolicyRules(ctx context.Context, lockStrength LockingStrength, policyID string, keepRuleIDs []string) error {
+	query := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Where("policy_id = ?", policyID)
+	if len(keepRuleIDs) > 0 {
+		query = query.Where("id NOT IN ?", keepRuleIDs)
+	}
+	if err := query.Delete(&PolicyRule{}).Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to delete policy rules from the store: %s", err)
+		return status.Errorf(status.Internal, "failed to delete policy rules from store")
+	}
+	return nil
+}
+
 func (s *SqlStore) DeletePolicy(ctx context.Context, lockStrength LockingStrength, accountID, policyID string) error {
 	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
 		Delete(&Policy{}, accountAndIDQueryCondition, accountID, policyID)
@@ -1431,6 +1532,54 @@ func (s *SqlStore) DeletePostureChecks(ctx context.Context, lockStrength Locking
 	return nil
 }
 
+// GetAccountServices retrieves services for an account.
+func (s *SqlStore) GetAccountServices(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*nbservice.Service, error) {
+	return getRecords[*nbservice.Service](s.db, lockStrength, accountID)
+}
+
+// GetServiceByID retrieves a service by its ID and account ID.
+func (s *SqlStore) GetServiceByID(ctx context.Context, lockStrength LockingStrength, accountID, serviceID string) (*nbservice.Service, error) {
+	var service *nbservice.Service
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&service, accountAndIDQueryCondition, accountID, serviceID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewServiceNotFoundError(serviceID)
+		}
+		log.WithContext(ctx).Errorf("failed to get service from store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get service from store")
+	}
+
+	return service, nil
+}
+
+// SaveService saves a service to the database.
+func (s *SqlStore) SaveService(ctx context.Context, lockStrength LockingStrength, service *nbservice.Service) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(service)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save service to store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to save service to store")
+	}
+
+	return nil
+}
+
+// DeleteService deletes a service from the database.
+func (s *SqlStore) DeleteService(ctx context.Context, lockStrength LockingStrength, accountID, serviceID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&nbservice.Service{}, accountAndIDQueryCondition, accountID, serviceID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete service from store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to delete service from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewServiceNotFoundError(serviceID)
+	}
+
+	return nil
+}
+
 // GetAccountRoutes retrieves network routes for an account.
 func (s *SqlStore) GetAccountRoutes(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*route.Route, error) {
 	return getRecords[*route.Route](s.db, lockStrength, accountID)
@@ -1441,6 +1590,31 @@ func (s *SqlStore) GetRouteByID(ctx context.Context, lockStrength LockingStrengt
 	return getRecordByID[route.Route](s.db, lockStrength, routeID, accountID)
 }
 
+// SaveRoute saves a route to the database.
+func (s *SqlStore) SaveRoute(ctx context.Context, lockStrength LockingStrength, routeToSave *route.Route) error {
+	result := s.db.WithContext(ctx).Clauses(clause.Locking{Strength: string(lockStrength)}).Save(routeToSave)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to save route to the store: %s", err)
+		return status.Errorf(status.Internal, "failed to save route to store")
+	}
+	return nil
+}
+
+// DeleteRoute deletes a route from the database.
+func (s *SqlStore) DeleteRoute(ctx context.Context, lockStrength LockingStrength, accountID, routeID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Delete(&route.Route{}, accountAndIDQueryCondition, accountID, routeID)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to delete route from the store: %s", err)
+		return status.Errorf(status.Internal, "failed to delete route from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewRouteNotFoundError(routeID)
+	}
+
+	return nil
+}
+
 // GetAccountSetupKeys retrieves setup keys for an account.
 func (s *SqlStore) GetAccountSetupKeys(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*SetupKey, error) {
 	var setupKeys []*SetupKey
@@ -1597,3 +1771,711 @@ func (s *SqlStore) SaveDNSSettings(ctx context.Context, lockStrength LockingStre
 
 	return nil
 }
+
+// GetAccountAccessRequests retrieves access requests for an account.
+func (s *SqlStore) GetAccountAccessRequests(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*AccessRequest, error) {
+	var requests []*AccessRequest
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Order("created_at desc").Find(&requests, accountIDCondition, accountID)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to get access requests from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get access requests from store")
+	}
+
+	return requests, nil
+}
+
+// GetAccessRequestByID retrieves an access request by its ID and account ID.
+func (s *SqlStore) GetAccessRequestByID(ctx context.Context, lockStrength LockingStrength, accountID, requestID string) (*AccessRequest, error) {
+	var request *AccessRequest
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&request, accountAndIDQueryCondition, accountID, requestID)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.NewAccessRequestNotFoundError(requestID)
+		}
+		log.WithContext(ctx).Errorf("failed to get access request from store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get access request from store")
+	}
+
+	return request, nil
+}
+
+// SaveAccessRequest saves an access request to the database.
+func (s *SqlStore) SaveAccessRequest(ctx context.Context, lockStrength LockingStrength, request *AccessRequest) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(request)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save access request to store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to save access request to store")
+	}
+
+	return nil
+}
+
+// GetPolicyAuditCounts retrieves the audit counts of a policy reported by the account peers.
+func (s *SqlStore) GetPolicyAuditCounts(ctx context.Context, lockStrength LockingStrength, accountID, policyID string) ([]*PolicyAuditCount, error) {
+	var counts []*PolicyAuditCount
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Find(&counts, "account_id = ? and policy_id = ?", accountID, policyID)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to get policy audit counts from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get policy audit counts from store")
+	}
+
+	return counts, nil
+}
+
+// IncrementPolicyAuditCount adds the packets of the count to the packets already reported by the peer for the policy.
+func (s *SqlStore) IncrementPolicyAuditCount(ctx context.Context, lockStrength LockingStrength, count *PolicyAuditCount) error {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+
+		existing := &PolicyAuditCount{PolicyID: count.PolicyID, PeerID: count.PeerID, AccountID: count.AccountID}
+		if err := tx.FirstOrCreate(existing).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(existing).Updates(map[string]any{
+			"packets":    gorm.Expr("packets + ?", count.Packets),
+			"updated_at": count.UpdatedAt,
+		}).Error
+	})
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to increment policy audit count in store: %s", err)
+		return status.Errorf(status.Internal, "failed to increment policy audit count in store")
+	}
+
+	return nil
+}
+
+// DeletePolicyAuditCounts deletes the audit counts of a policy.
+func (s *SqlStore) DeletePolicyAuditCounts(ctx context.Context, lockStrength LockingStrength, accountID, policyID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&PolicyAuditCount{}, "account_id = ? and policy_id = ?", accountID, policyID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete policy audit counts from store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to delete policy audit counts from store")
+	}
+
+	return nil
+}
+
+// GetRejectedPeerByKey retrieves a peer rejected in the account by its WireGuard public key.
+func (s *SqlStore) GetRejectedPeerByKey(ctx context.Context, lockStrength LockingStrength, accountID, peerKey string) (*RejectedPeer, error) {
+	var rejectedPeer RejectedPeer
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&rejectedPeer, "account_id = ? and key = ?", accountID, peerKey)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(status.NotFound, "rejected peer not found")
+		}
+		log.WithContext(ctx).Errorf("failed to get rejected peer from store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get rejected peer from store")
+	}
+
+	return &rejectedPeer, nil
+}
+
+// SaveRejectedPeer saves a peer rejected in the account.
+func (s *SqlStore) SaveRejectedPeer(ctx context.Context, lockStrength LockingStrength, rejectedPeer *RejectedPeer) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(rejectedPeer)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save rejected peer to store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to save rejected peer to store")
+	}
+
+	return nil
+}
+
+// GetAccountWebhookEndpoints retrieves webhook endpoints for an account.
+func (s *SqlStore) GetAccountWebhookEndpoints(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*webhook.Endpoint, error) {
+	var endpoints []*webhook.Endpoint
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Find(&endpoints, accountIDCondition, accountID)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to get webhook endpoints from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get webhook endpoints from store")
+	}
+
+	return endpoints, nil
+}
+
+// GetWebhookEndpointByID retrieves a webhook endpoint by its ID and account ID.
+func (s *SqlStore) GetWebhookEndpointByID(ctx context.Context, lockStrength LockingStrength, accountID, endpointID string) (*webhook.Endpoint, error) {
+	var endpoint *webhook.Endpoint
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&endpoint, accountAndIDQueryCondition, accountID, endpointID)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.NewWebhookEndpointNotFoundError(endpointID)
+		}
+		log.WithContext(ctx).Errorf("failed to get webhook endpoint from store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get webhook endpoint from store")
+	}
+
+	return endpoint, nil
+}
+
+// SaveWebhookEndpoint saves a webhook endpoint to the database.
+func (s *SqlStore) SaveWebhookEndpoint(ctx context.Context, lockStrength LockingStrength, endpoint *webhook.Endpoint) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(endpoint)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save webhook endpoint to store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to save webhook endpoint to store")
+	}
+
+	return nil
+}
+
+// DeleteWebhookEndpoint deletes a webhook endpoint and its pending deliveries from the database.
+func (s *SqlStore) DeleteWebhookEndpoint(ctx context.Context, lockStrength LockingStrength, accountID, endpointID string) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.Locking{Strength: string(lockStrength)}).
+			Delete(&webhook.Endpoint{}, accountAndIDQueryCondition, accountID, endpointID)
+		if result.Error != nil {
+			log.WithContext(ctx).Errorf("failed to delete webhook endpoint from store: %s", result.Error)
+			return status.Errorf(status.Internal, "failed to delete webhook endpoint from store")
+		}
+
+		if result.RowsAffected == 0 {
+			return status.NewWebhookEndpointNotFoundError(endpointID)
+		}
+
+		result = tx.Clauses(clause.Locking{Strength: string(lockStrength)}).
+			Delete(&webhook.Delivery{}, "account_id = ? AND endpoint_id = ?", accountID, endpointID)
+		if result.Error != nil {
+			log.WithContext(ctx).Errorf("failed to delete webhook deliveries from store: %s", result.Error)
+			return status.Errorf(status.Internal, "failed to delete webhook deliveries from store")
+		}
+
+		return nil
+	})
+}
+
+// GetDueWebhookDeliveries retrieves up to limit pending webhook deliveries of all accounts due at the given time.
+func (s *SqlStore) GetDueWebhookDeliveries(ctx context.Context, lockStrength LockingStrength, dueAt time.Time, limit int) ([]*webhook.Delivery, error) {
+	var deliveries []*webhook.Delivery
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Where("status = ? AND next_attempt_at <= ?", webhook.DeliveryStatusPending, dueAt).
+		Order("next_attempt_at").Limit(limit).Find(&deliveries)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to get webhook deliveries from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get webhook deliveries from store")
+	}
+
+	return deliveries, nil
+}
+
+// ClaimDueWebhookDeliveries retrieves up to limit pending webhook deliveries of all accounts due at the given time and
+// postpones their next attempt until the lease expires, so other management instances don't send them concurrently.
+// Deliveries locked by another transaction are skipped.
+func (s *SqlStore) ClaimDueWebhookDeliveries(ctx context.Context, dueAt time.Time, lease time.Duration, limit int) ([]*webhook.Delivery, error) {
+	var deliveries []*webhook.Delivery
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.Locking{Strength: string(LockingStrengthUpdate), Options: clause.LockingOptionsSkipLocked}).
+			Where("status = ? AND next_attempt_at <= ?", webhook.DeliveryStatusPending, dueAt).
+			Order("next_attempt_at").Limit(limit).Find(&deliveries)
+		if err := result.Error; err != nil {
+			log.WithContext(ctx).Errorf("failed to get webhook deliveries from the store: %s", err)
+			return status.Errorf(status.Internal, "failed to get webhook deliveries from store")
+		}
+
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		ids := make([]string, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			ids = append(ids, delivery.ID)
+			delivery.NextAttemptAt = dueAt.Add(lease)
+		}
+
+		result = tx.Model(&webhook.Delivery{}).Where("id IN ?", ids).Update("next_attempt_at", dueAt.Add(lease))
+		if err := result.Error; err != nil {
+			log.WithContext(ctx).Errorf("failed to claim webhook deliveries in the store: %s", err)
+			return status.Errorf(status.Internal, "failed to claim webhook deliveries in store")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// CreateWebhookDeliveries adds webhook deliveries to the database. Deliveries that already exist are left untouched,
+// so an event queued again doesn't reset the state of its deliveries.
+func (s *SqlStore) CreateWebhookDeliveries(ctx context.Context, lockStrength LockingStrength, deliveries []*webhook.Delivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}, clause.OnConflict{DoNothing: true}).Create(&deliveries)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to create webhook deliveries in store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to create webhook deliveries in store")
+	}
+
+	return nil
+}
+
+// DeleteFailedWebhookDeliveries deletes the failed webhook deliveries created before the given time from the database.
+func (s *SqlStore) DeleteFailedWebhookDeliveries(ctx context.Context, lockStrength LockingStrength, createdBefore time.Time) (int64, error) {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&webhook.Delivery{}, "status = ? AND created_at < ?", webhook.DeliveryStatusFailed, createdBefore)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete failed webhook deliveries from store: %s", result.Error)
+		return 0, status.Errorf(status.Internal, "failed to delete failed webhook deliveries from store")
+	}
+
+	return result.RowsAffected, nil
+}
+
+// SaveWebhookDelivery saves a webhook delivery to the database.
+func (s *SqlStore) SaveWebhookDelivery(ctx context.Context, lockStrength LockingStrength, delivery *webhook.Delivery) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(delivery)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save webhook delivery to store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to save webhook delivery to store")
+	}
+
+	return nil
+}
+
+// DeleteWebhookDelivery deletes a webhook delivery from the database.
+func (s *SqlStore) DeleteWebhookDelivery(ctx context.Context, lockStrength LockingStrength, deliveryID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&webhook.Delivery{}, idQueryCondition, deliveryID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete webhook delivery from store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to delete webhook delivery from store")
+	}
+
+	return nil
+}
+
+// GetAccountCustomRoles retrieves custom roles for an account.
+func (s *SqlStore) GetAccountCustomRoles(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*rbac.Role, error) {
+	var roles []*rbac.Role
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Find(&roles, accountIDCondition, accountID)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to get custom roles from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get custom roles from store")
+	}
+
+	return roles, nil
+}
+
+// GetCustomRoleByID retrieves a custom role by its ID and account ID.
+func (s *SqlStore) GetCustomRoleByID(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) (*rbac.Role, error) {
+	var role *rbac.Role
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&role, accountAndIDQueryCondition, accountID, roleID)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.NewCustomRoleNotFoundError(roleID)
+		}
+		log.WithContext(ctx).Errorf("failed to get custom role from store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get custom role from store")
+	}
+
+	return role, nil
+}
+
+// SaveCustomRole saves a custom role to the database.
+func (s *SqlStore) SaveCustomRole(ctx context.Context, lockStrength LockingStrength, role *rbac.Role) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(role)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save custom role to store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to save custom role to store")
+	}
+
+	return nil
+}
+
+// DeleteCustomRole deletes a custom role from the database.
+func (s *SqlStore) DeleteCustomRole(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&rbac.Role{}, accountAndIDQueryCondition, accountID, roleID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete custom role from store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to delete custom role from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewCustomRoleNotFoundError(roleID)
+	}
+
+	return nil
+}
+
+// GetAccountSCIMToken retrieves the SCIM token of an account.
+func (s *SqlStore) GetAccountSCIMToken(ctx context.Context, lockStrength LockingStrength, accountID string) (*scim.Token, error) {
+	var token *scim.Token
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).First(&token, accountIDCondition, accountID)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.NewSCIMTokenNotFoundError()
+		}
+		log.WithContext(ctx).Errorf("failed to get SCIM token from store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get SCIM token from store")
+	}
+
+	return token, nil
+}
+
+// GetSCIMTokenByHashedToken retrieves the SCIM token by its hashed value.
+func (s *SqlStore) GetSCIMTokenByHashedToken(ctx context.Context, lockStrength LockingStrength, hashedToken string) (*scim.Token, error) {
+	var token *scim.Token
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).First(&token, "hashed_token = ?", hashedToken)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.NewSCIMTokenNotFoundError()
+		}
+		log.WithContext(ctx).Errorf("failed to get SCIM token from store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get SCIM token from store")
+	}
+
+	return token, nil
+}
+
+// SaveSCIMToken saves the SCIM token of an account replacing the existing one.
+func (s *SqlStore) SaveSCIMToken(ctx context.Context, lockStrength LockingStrength, token *scim.Token) error {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.Locking{Strength: string(lockStrength)}).Delete(&scim.Token{}, accountIDCondition, token.AccountID)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		return tx.Clauses(clause.Locking{Strength: string(lockStrength)}).Create(token).Error
+	})
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to save SCIM token to store: %s", err)
+		return status.Errorf(status.Internal, "failed to save SCIM token to store")
+	}
+
+	return nil
+}
+
+// DeleteSCIMToken deletes the SCIM token of an account.
+func (s *SqlStore) DeleteSCIMToken(ctx context.Context, lockStrength LockingStrength, accountID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Delete(&scim.Token{}, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete SCIM token from store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to delete SCIM token from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewSCIMTokenNotFoundError()
+	}
+
+	return nil
+}
+
+// GetAccountSCIMProfiles retrieves the profiles of the users provisioned over SCIM for an account.
+func (s *SqlStore) GetAccountSCIMProfiles(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*scim.Profile, error) {
+	var profiles []*scim.Profile
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Find(&profiles, accountIDCondition, accountID)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to get SCIM profiles from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get SCIM profiles from store")
+	}
+
+	return profiles, nil
+}
+
+// SaveSCIMProfile saves the profile of a user provisioned over SCIM.
+func (s *SqlStore) SaveSCIMProfile(ctx context.Context, lockStrength LockingStrength, profile *scim.Profile) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(profile)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save SCIM profile to store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to save SCIM profile to store")
+	}
+
+	return nil
+}
+
+// DeleteSCIMProfile deletes the profile of a user provisioned over SCIM.
+func (s *SqlStore) DeleteSCIMProfile(ctx context.Context, lockStrength LockingStrength, accountID, userID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&scim.Profile{}, "account_id = ? and user_id = ?", accountID, userID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete SCIM profile from store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to delete SCIM profile from store")
+	}
+
+	return nil
+}
+
+// GetOrganizationByID retrieves an organization by its ID.
+func (s *SqlStore) GetOrganizationByID(ctx context.Context, lockStrength LockingStrength, organizationID string) (*organization.Organization, error) {
+	var org *organization.Organization
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).First(&org, idQueryCondition, organizationID)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.NewOrganizationNotFoundError(organizationID)
+		}
+		log.WithContext(ctx).Errorf("failed to get organization from store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get organization from store")
+	}
+
+	return org, nil
+}
+
+// GetUserOrganizations retrieves the organizations administered by a user.
+func (s *SqlStore) GetUserOrganizations(ctx context.Context, lockStrength LockingStrength, userID string) ([]*organization.Organization, error) {
+	var orgs []*organization.Organization
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Joins("JOIN organization_admins ON organization_admins.organization_id = organizations.id").
+		Where("organization_admins.user_id = ?", userID).
+		Order("organizations.created_at").
+		Find(&orgs)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to get user organizations from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get user organizations from store")
+	}
+
+	return orgs, nil
+}
+
+// SaveOrganization saves an organization to the database.
+func (s *SqlStore) SaveOrganization(ctx context.Context, lockStrength LockingStrength, org *organization.Organization) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(org)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save organization to store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to save organization to store")
+	}
+
+	return nil
+}
+
+// DeleteOrganization deletes an organization with its admins and detaches its accounts.
+func (s *SqlStore) DeleteOrganization(ctx context.Context, lockStrength LockingStrength, organizationID string) error {
+	var rowsAffected int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		return deleteOrganization(tx, lockStrength, organizationID, &rowsAffected)
+	})
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to delete organization from store: %s", err)
+		return status.Errorf(status.Internal, "failed to delete organization from store")
+	}
+
+	if rowsAffected == 0 {
+		return status.NewOrganizationNotFoundError(organizationID)
+	}
+
+	return nil
+}
+
+// deleteOrganization deletes the organization, its admins and detaches its accounts within the transaction.
+func deleteOrganization(tx *gorm.DB, lockStrength LockingStrength, organizationID string, rowsAffected *int64) error {
+	result := tx.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&organization.Admin{}, "organization_id = ?", organizationID)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = tx.Clauses(clause.Locking{Strength: string(lockStrength)}).Model(&Account{}).Where("organization_id = ?", organizationID).Update("organization_id", "")
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = tx.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&organization.Organization{}, idQueryCondition, organizationID)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if rowsAffected != nil {
+		*rowsAffected = result.RowsAffected
+	}
+	return nil
+}
+
+// GetOrganizationAdmins retrieves the admins of an organization.
+func (s *SqlStore) GetOrganizationAdmins(ctx context.Context, lockStrength LockingStrength, organizationID string) ([]*organization.Admin, error) {
+	var admins []*organization.Admin
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Order("created_at").
+		Find(&admins, "organization_id = ?", organizationID)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to get organization admins from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get organization admins from store")
+	}
+
+	return admins, nil
+}
+
+// GetOrganizationAdmin retrieves an admin of an organization.
+func (s *SqlStore) GetOrganizationAdmin(ctx context.Context, lockStrength LockingStrength, organizationID, userID string) (*organization.Admin, error) {
+	var admin *organization.Admin
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		First(&admin, "organization_id = ? and user_id = ?", organizationID, userID)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(status.NotFound, "user %s is not an admin of the organization %s", userID, organizationID)
+		}
+		log.WithContext(ctx).Errorf("failed to get organization admin from store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get organization admin from store")
+	}
+
+	return admin, nil
+}
+
+// SaveOrganizationAdmin saves an admin of an organization.
+func (s *SqlStore) SaveOrganizationAdmin(ctx context.Context, lockStrength LockingStrength, admin *organization.Admin) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Save(admin)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save organization admin to store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to save organization admin to store")
+	}
+
+	return nil
+}
+
+// DeleteOrganizationAdmin deletes an admin of an organization.
+func (s *SqlStore) DeleteOrganizationAdmin(ctx context.Context, lockStrength LockingStrength, organizationID, userID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).
+		Delete(&organization.Admin{}, "organization_id = ? and user_id = ?", organizationID, userID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete organization admin from store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to delete organization admin from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.Errorf(status.NotFound, "user %s is not an admin of the organization %s", userID, organizationID)
+	}
+
+	return nil
+}
+
+// GetOrganizationAccountIDs retrieves the IDs of the accounts managed by an organization.
+func (s *SqlStore) GetOrganizationAccountIDs(ctx context.Context, lockStrength LockingStrength, organizationID string) ([]string, error) {
+	var accountIDs []string
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Model(&Account{}).
+		Where("organization_id = ?", organizationID).Pluck("id", &accountIDs)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to get organization accounts from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get organization accounts from store")
+	}
+
+	return accountIDs, nil
+}
+
+// GetOrganizationAccountsSummary retrieves the accounts managed by an organization with their peer and user counts.
+// The counts are aggregated by the database so the accounts don't have to be loaded.
+func (s *SqlStore) GetOrganizationAccountsSummary(ctx context.Context, lockStrength LockingStrength, organizationID string) ([]*organization.AccountSummary, error) {
+	var summaries []*organization.AccountSummary
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Model(&Account{}).
+		Select("id", "domain", "created_at").
+		Where("organization_id = ?", organizationID).
+		Order("created_at").
+		Find(&summaries)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to get organization accounts from the store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to get organization accounts from store")
+	}
+
+	if len(summaries) == 0 {
+		return summaries, nil
+	}
+
+	accountIDs := make([]string, 0, len(summaries))
+	for _, summary := range summaries {
+		accountIDs = append(accountIDs, summary.ID)
+	}
+
+	var peerCounts []struct {
+		AccountID           string
+		PeersCount          int
+		ConnectedPeersCount int
+	}
+	result = s.db.Model(&nbpeer.Peer{}).
+		Select("account_id, COUNT(*) AS peers_count, SUM(CASE WHEN peer_status_connected = ? THEN 1 ELSE 0 END) AS connected_peers_count", true).
+		Where("account_id IN ?", accountIDs).
+		Group("account_id").
+		Scan(&peerCounts)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to count organization account peers in the store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to count organization account peers in store")
+	}
+
+	var userCounts []struct {
+		AccountID  string
+		UsersCount int
+	}
+	result = s.db.Model(&User{}).
+		Select("account_id, COUNT(*) AS users_count").
+		Where("account_id IN ? AND is_service_user = ?", accountIDs, false).
+		Group("account_id").
+		Scan(&userCounts)
+	if err := result.Error; err != nil {
+		log.WithContext(ctx).Errorf("failed to count organization account users in the store: %s", err)
+		return nil, status.Errorf(status.Internal, "failed to count organization account users in store")
+	}
+
+	summariesByID := make(map[string]*organization.AccountSummary, len(summaries))
+	for _, summary := range summaries {
+		summariesByID[summary.ID] = summary
+	}
+
+	for _, count := range peerCounts {
+		summariesByID[count.AccountID].PeersCount = count.PeersCount
+		summariesByID[count.AccountID].ConnectedPeersCount = count.ConnectedPeersCount
+	}
+
+	for _, count := range userCounts {
+		summariesByID[count.AccountID].UsersCount = count.UsersCount
+	}
+
+	return summaries, nil
+}
+
+// GetAccountOrganizationID retrieves the ID of the organization managing an account.
+func (s *SqlStore) GetAccountOrganizationID(ctx context.Context, lockStrength LockingStrength, accountID string) (string, error) {
+	var account Account
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Model(&Account{}).Select("organization_id").
+		Where(idQueryCondition, accountID).First(&account)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", status.NewAccountNotFoundError(accountID)
+		}
+		log.WithContext(ctx).Errorf("failed to get account organization from store: %s", err)
+		return "", status.Errorf(status.Internal, "failed to get account organization from store")
+	}
+
+	return account.OrganizationID, nil
+}
+
+// SaveAccountOrganization sets the organization managing an account, an empty organization ID detaches the account.
+func (s *SqlStore) SaveAccountOrganization(ctx context.Context, lockStrength LockingStrength, accountID, organizationID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Model(&Account{}).
+		Where(idQueryCondition, accountID).Update("organization_id", organizationID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save account organization to store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to save account organization to store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewAccountNotFoundError(accountID)
+	}
+
+	return nil
+}
+
+// DeleteUser deletes a user with its personal access tokens.
+func (s *SqlStore) DeleteUser(ctx context.Context, lockStrength LockingStrength, accountID, userID string) error {
+	result := s.db.Clauses(clause.Locking{Strength: string(lockStrength)}).Select(clause.Associations).
+		Delete(&User{Id: userID, AccountID: accountID}, accountAndIDQueryCondition, accountID, userID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete user from store: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to delete user from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewUserNotFoundError(userID)
+	}
+
+	return nil
+}
@@ -150,7 +150,42 @@ func NewPolicyNotFoundError(policyID string) error {
 	return Errorf(NotFound, "policy: %s not found", policyID)
 }
 
+// NewAccessRequestNotFoundError creates a new Error with NotFound type for a missing access request
+func NewAccessRequestNotFoundError(requestID string) error {
+	return Errorf(NotFound, "access request: %s not found", requestID)
+}
+
+// NewWebhookEndpointNotFoundError creates a new Error with NotFound type for a missing webhook endpoint
+func NewWebhookEndpointNotFoundError(endpointID string) error {
+	return Errorf(NotFound, "webhook endpoint: %s not found", endpointID)
+}
+
+// NewCustomRoleNotFoundError creates a new Error with NotFound type for a missing custom role
+func NewCustomRoleNotFoundError(roleID string) error {
+	return Errorf(NotFound, "custom role: %s not found", roleID)
+}
+
+// NewServiceNotFoundError creates a new Error with NotFound type for a missing service
+func NewServiceNotFoundError(serviceID string) error {
+	return Errorf(NotFound, "service: %s not found", serviceID)
+}
+
+// NewOrganizationNotFoundError creates a new Error with NotFound type for a missing organization
+func NewOrganizationNotFoundError(organizationID string) error {
+	return Errorf(NotFound, "organization: %s not found", organizationID)
+}
+
+// NewSCIMTokenNotFoundError creates a new Error with NotFound type for a missing SCIM token
+func NewSCIMTokenNotFoundError() error {
+	return Errorf(NotFound, "SCIM token not found")
+}
+
 // NewNameServerGroupNotFoundError creates a new Error with NotFound type for a missing name server group
 func NewNameServerGroupNotFoundError(nsGroupID string) error {
 	return Errorf(NotFound, "nameserver group: %s not found", nsGroupID)
 }
+
+// NewRouteNotFoundError creates a new Error with NotFound type for a missing route
+func NewRouteNotFoundError(routeID string) error {
+	return Errorf(NotFound, "route: %s not found", routeID)
+}
@@ -25,9 +25,14 @@ import (
 	"github.com/netbirdio/netbird/util"
 
 	"github.com/netbirdio/netbird/management/server/migration"
+	"github.com/netbirdio/netbird/management/server/organization"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/rbac"
+	"github.com/netbirdio/netbird/management/server/scim"
+	nbservice "github.com/netbirdio/netbird/management/server/service"
 	"github.com/netbirdio/netbird/management/server/testutil"
+	"github.com/netbirdio/netbird/management/server/webhook"
 	"github.com/netbirdio/netbird/route"
 )
 
@@ -67,6 +72,7 @@ type Store interface {
 	SaveUsers(accountID string, users map[string]*User) error
 	SaveUser(ctx context.Context, lockStrength LockingStrength, user *User) error
 	SaveUserLastLogin(ctx context.Context, accountID, userID string, lastLogin time.Time) error
+	DeleteUser(ctx context.Context, lockStrength LockingStrength, accountID, userID string) error
 	GetTokenIDByHashedToken(ctx context.Context, secret string) (string, error)
 	DeleteHashedPAT2TokenIDIndex(hashedToken string) error
 	DeleteTokenID2UserIDIndex(tokenID string) error
@@ -84,6 +90,7 @@ type Store interface {
 	GetPolicyByID(ctx context.Context, lockStrength LockingStrength, accountID, policyID string) (*Policy, error)
 	CreatePolicy(ctx context.Context, lockStrength LockingStrength, policy *Policy) error
 	SavePolicy(ctx context.Context, lockStrength LockingStrength, policy *Policy) error
+	DeletePolicyRules(ctx context.Context, lockStrength LockingStrength, policyID string, keepRuleIDs []string) error
 	DeletePolicy(ctx context.Context, lockStrength LockingStrength, accountID, policyID string) error
 
 	GetPostureCheckByChecksDefinition(accountID string, checks *posture.ChecksDefinition) (*posture.Checks, error)
@@ -93,17 +100,24 @@ type Store interface {
 	SavePostureChecks(ctx context.Context, lockStrength LockingStrength, postureCheck *posture.Checks) error
 	DeletePostureChecks(ctx context.Context, lockStrength LockingStrength, accountID, postureChecksID string) error
 
+	GetAccountServices(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*nbservice.Service, error)
+	GetServiceByID(ctx context.Context, lockStrength LockingStrength, accountID, serviceID string) (*nbservice.Service, error)
+	SaveService(ctx context.Context, lockStrength LockingStrength, service *nbservice.Service) error
+	DeleteService(ctx context.Context, lockStrength LockingStrength, accountID, serviceID string) error
+
 	GetPeerLabelsInAccount(ctx context.Context, lockStrength LockingStrength, accountId string) ([]string, error)
 	AddPeerToAllGroup(ctx context.Context, accountID string, peerID string) error
 	AddPeerToGroup(ctx context.Context, accountId string, peerId string, groupID string) error
 	AddPeerToAccount(ctx context.Context, peer *nbpeer.Peer) error
 	GetPeerByPeerPubKey(ctx context.Context, lockStrength LockingStrength, peerKey string) (*nbpeer.Peer, error)
 	GetUserPeers(ctx context.Context, lockStrength LockingStrength, accountID, userID string) ([]*nbpeer.Peer, error)
+	GetAccountPeers(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*nbpeer.Peer, error)
 	GetPeerByID(ctx context.Context, lockStrength LockingStrength, accountID string, peerID string) (*nbpeer.Peer, error)
 	GetPeersByIDs(ctx context.Context, lockStrength LockingStrength, accountID string, peerIDs []string) (map[string]*nbpeer.Peer, error)
 	SavePeer(ctx context.Context, accountID string, peer *nbpeer.Peer) error
 	SavePeerStatus(accountID, peerID string, status nbpeer.PeerStatus) error
 	SavePeerLocation(accountID string, peer *nbpeer.Peer) error
+	SavePeerPostureStatus(ctx context.Context, lockStrength LockingStrength, accountID, peerID string, postureStatus nbpeer.PostureStatus) error
 
 	GetSetupKeyBySecret(ctx context.Context, lockStrength LockingStrength, key string) (*SetupKey, error)
 	IncrementSetupKeyUsage(ctx context.Context, setupKeyID string) error
@@ -114,6 +128,8 @@ type Store interface {
 
 	GetAccountRoutes(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*route.Route, error)
 	GetRouteByID(ctx context.Context, lockStrength LockingStrength, routeID string, accountID string) (*route.Route, error)
+	SaveRoute(ctx context.Context, lockStrength LockingStrength, routeToSave *route.Route) error
+	DeleteRoute(ctx context.Context, lockStrength LockingStrength, accountID, routeID string) error
 
 	GetAccountNameServerGroups(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*dns.NameServerGroup, error)
 	GetNameServerGroupByID(ctx context.Context, lockStrength LockingStrength, nameServerGroupID string, accountID string) (*dns.NameServerGroup, error)
@@ -124,6 +140,54 @@ type Store interface {
 	IncrementNetworkSerial(ctx context.Context, lockStrength LockingStrength, accountId string) error
 	GetAccountNetwork(ctx context.Context, lockStrength LockingStrength, accountId string) (*Network, error)
 
+	GetAccountAccessRequests(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*AccessRequest, error)
+	GetAccessRequestByID(ctx context.Context, lockStrength LockingStrength, accountID, requestID string) (*AccessRequest, error)
+	SaveAccessRequest(ctx context.Context, lockStrength LockingStrength, request *AccessRequest) error
+
+	GetPolicyAuditCounts(ctx context.Context, lockStrength LockingStrength, accountID, policyID string) ([]*PolicyAuditCount, error)
+	IncrementPolicyAuditCount(ctx context.Context, lockStrength LockingStrength, count *PolicyAuditCount) error
+	DeletePolicyAuditCounts(ctx context.Context, lockStrength LockingStrength, accountID, policyID string) error
+
+	GetRejectedPeerByKey(ctx context.Context, lockStrength LockingStrength, accountID, peerKey string) (*RejectedPeer, error)
+	SaveRejectedPeer(ctx context.Context, lockStrength LockingStrength, rejectedPeer *RejectedPeer) error
+
+	GetAccountWebhookEndpoints(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*webhook.Endpoint, error)
+	GetWebhookEndpointByID(ctx context.Context, lockStrength LockingStrength, accountID, endpointID string) (*webhook.Endpoint, error)
+	SaveWebhookEndpoint(ctx context.Context, lockStrength LockingStrength, endpoint *webhook.Endpoint) error
+	DeleteWebhookEndpoint(ctx context.Context, lockStrength LockingStrength, accountID, endpointID string) error
+	GetDueWebhookDeliveries(ctx context.Context, lockStrength LockingStrength, dueAt time.Time, limit int) ([]*webhook.Delivery, error)
+	ClaimDueWebhookDeliveries(ctx context.Context, dueAt time.Time, lease time.Duration, limit int) ([]*webhook.Delivery, error)
+	CreateWebhookDeliveries(ctx context.Context, lockStrength LockingStrength, deliveries []*webhook.Delivery) error
+	SaveWebhookDelivery(ctx context.Context, lockStrength LockingStrength, delivery *webhook.Delivery) error
+	DeleteFailedWebhookDeliveries(ctx context.Context, lockStrength LockingStrength, createdBefore time.Time) (int64, error)
+	DeleteWebhookDelivery(ctx context.Context, lockStrength LockingStrength, deliveryID string) error
+
+	GetAccountCustomRoles(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*rbac.Role, error)
+	GetCustomRoleByID(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) (*rbac.Role, error)
+	SaveCustomRole(ctx context.Context, lockStrength LockingStrength, role *rbac.Role) error
+	DeleteCustomRole(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) error
+
+	GetAccountSCIMToken(ctx context.Context, lockStrength LockingStrength, accountID string) (*scim.Token, error)
+	GetSCIMTokenByHashedToken(ctx context.Context, lockStrength LockingStrength, hashedToken string) (*scim.Token, error)
+	SaveSCIMToken(ctx context.Context, lockStrength LockingStrength, token *scim.Token) error
+	DeleteSCIMToken(ctx context.Context, lockStrength LockingStrength, accountID string) error
+	GetAccountSCIMProfiles(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*scim.Profile, error)
+	SaveSCIMProfile(ctx context.Context, lockStrength LockingStrength, profile *scim.Profile) error
+	DeleteSCIMProfile(ctx context.Context, lockStrength LockingStrength, accountID, userID string) error
+
+	GetOrganizationByID(ctx context.Context, lockStrength LockingStrength, organizationID string) (*organization.Organization, error)
+	GetUserOrganizations(ctx context.Context, lockStrength LockingStrength, userID string) ([]*organization.Organization, error)
+	SaveOrganization(ctx context.Context, lockStrength LockingStrength, org *organization.Organization) error
+	DeleteOrganization(ctx context.Context, lockStrength LockingStrength, organizationID string) error
+	GetOrganizationAdmins(ctx context.Context, lockStrength LockingStrength, organizationID string) ([]*organization.Admin, error)
+	GetOrganizationAdmin(ctx context.Context, lockStrength LockingStrength, organizationID, userID string) (*organization.Admin, error)
+	SaveOrganizationAdmin(ctx context.Context, lockStrength LockingStrength, admin *organization.Admin) error
+	DeleteOrganizationAdmin(ctx context.Context, lockStrength LockingStrength, organizationID, userID string) error
+	GetOrganizationAccountIDs(ctx context.Context, lockStrength LockingStrength, organizationID string) ([]string, error)
+	GetOrganizationAccountsSummary(ctx context.Context, lockStrength LockingStrength, organizationID string) ([]*organization.AccountSummary, error)
+	GetAccountOrganizationID(ctx context.Context, lockStrength LockingStrength, accountID string) (string, error)
+	SaveAccountOrganization(ctx context.Context, lockStrength LockingStrength, accountID, organizationID string) error
+
 	GetInstallationID() string
 	SaveInstallationID(ctx context.Context, ID string) error
 
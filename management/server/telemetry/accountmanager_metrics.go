@@ -14,6 +14,8 @@ type AccountManagerMetrics struct {
 	getPeerNetworkMapDurationMs  metric.Float64Histogram
 	networkMapObjectCount        metric.Int64Histogram
 	peerMetaUpdateCount          metric.Int64Counter
+	updateAccountPeersComputed   metric.Int64Counter
+	updateAccountPeersCoalesced  metric.Int64Counter
 }
 
 // NewAccountManagerMetrics creates an instance of AccountManagerMetrics
@@ -50,12 +52,24 @@ func NewAccountManagerMetrics(ctx context.Context, meter metric.Meter) (*Account
 		return nil, err
 	}
 
+	updateAccountPeersComputed, err := meter.Int64Counter("management.account.update.account.peers.computed.counter", metric.WithUnit("1"))
+	if err != nil {
+		return nil, err
+	}
+
+	updateAccountPeersCoalesced, err := meter.Int64Counter("management.account.update.account.peers.coalesced.counter", metric.WithUnit("1"))
+	if err != nil {
+		return nil, err
+	}
+
 	return &AccountManagerMetrics{
 		ctx:                          ctx,
 		getPeerNetworkMapDurationMs:  getPeerNetworkMapDurationMs,
 		updateAccountPeersDurationMs: updateAccountPeersDurationMs,
 		networkMapObjectCount:        networkMapObjectCount,
 		peerMetaUpdateCount:          peerMetaUpdateCount,
+		updateAccountPeersComputed:   updateAccountPeersComputed,
+		updateAccountPeersCoalesced:  updateAccountPeersCoalesced,
 	}, nil
 
 }
@@ -79,3 +93,13 @@ func (metrics *AccountManagerMetrics) CountNetworkMapObjects(count int64) {
 func (metrics *AccountManagerMetrics) CountPeerMetUpdate() {
 	metrics.peerMetaUpdateCount.Add(metrics.ctx, 1)
 }
+
+// CountUpdateAccountPeersComputed counts the number of account peers updates computed and sent to the peers
+func (metrics *AccountManagerMetrics) CountUpdateAccountPeersComputed() {
+	metrics.updateAccountPeersComputed.Add(metrics.ctx, 1)
+}
+
+// CountUpdateAccountPeersCoalesced counts the number of account peers update requests coalesced into another update
+func (metrics *AccountManagerMetrics) CountUpdateAccountPeersCoalesced() {
+	metrics.updateAccountPeersCoalesced.Add(metrics.ctx, 1)
+}
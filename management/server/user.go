@@ -18,6 +18,7 @@ import (
 	"github.com/netbirdio/netbird/management/server/integration_reference"
 	"github.com/netbirdio/netbird/management/server/jwtclaims"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/rbac"
 	"github.com/netbirdio/netbird/management/server/status"
 )
 
@@ -34,6 +35,8 @@ const (
 
 	UserIssuedAPI         = "api"
 	UserIssuedIntegration = "integration"
+	// UserIssuedOrganization is issued to the service users acting on behalf of the organization admins
+	UserIssuedOrganization = "organization"
 )
 
 // StrRoleToUserRole returns UserRole for a given strRole or UserRoleUnknown if the specified role is unknown
@@ -62,8 +65,10 @@ type UserRole string
 type User struct {
 	Id string `gorm:"primaryKey"`
 	// AccountID is a reference to Account that this object belongs
-	AccountID     string `json:"-" gorm:"index"`
-	Role          UserRole
+	AccountID string `json:"-" gorm:"index"`
+	Role      UserRole
+	// CustomRoleID is a reference to the custom role extending the permissions of the Role
+	CustomRoleID  string
 	IsServiceUser bool
 	// NonDeletable indicates whether the service user can be deleted
 	NonDeletable bool
@@ -131,6 +136,7 @@ func (u *User) ToUserInfo(userData *idp.UserData, settings *Settings) (*UserInfo
 			Email:         "",
 			Name:          u.ServiceUserName,
 			Role:          string(u.Role),
+			CustomRoleID:  u.CustomRoleID,
 			AutoGroups:    u.AutoGroups,
 			Status:        string(UserStatusActive),
 			IsServiceUser: u.IsServiceUser,
@@ -156,6 +162,7 @@ func (u *User) ToUserInfo(userData *idp.UserData, settings *Settings) (*UserInfo
 		Email:         userData.Email,
 		Name:          userData.Name,
 		Role:          string(u.Role),
+		CustomRoleID:  u.CustomRoleID,
 		AutoGroups:    autoGroups,
 		Status:        string(userStatus),
 		IsServiceUser: u.IsServiceUser,
@@ -180,6 +187,7 @@ func (u *User) Copy() *User {
 		Id:                   u.Id,
 		AccountID:            u.AccountID,
 		Role:                 u.Role,
+		CustomRoleID:         u.CustomRoleID,
 		AutoGroups:           autoGroups,
 		IsServiceUser:        u.IsServiceUser,
 		NonDeletable:         u.NonDeletable,
@@ -236,7 +244,7 @@ func (am *DefaultAccountManager) createServiceUser(ctx context.Context, accountI
 	if executingUser == nil {
 		return nil, status.Errorf(status.NotFound, "user not found")
 	}
-	if !executingUser.HasAdminPower() {
+	if !executingUser.HasAdminPower() && !am.canManageUserWithRole(ctx, executingUser, role, rbac.OperationCreate) {
 		return nil, status.Errorf(status.PermissionDenied, "only users with admin power can create service users")
 	}
 
@@ -313,6 +321,10 @@ func (am *DefaultAccountManager) inviteNewUser(ctx context.Context, accountID, u
 		return nil, status.Errorf(status.NotFound, "initiator user with ID %s doesn't exist", userID)
 	}
 
+	if !initiatorUser.HasAdminPower() && !am.canManageUserWithRole(ctx, initiatorUser, invitedRole, rbac.OperationCreate) {
+		return nil, status.Errorf(status.PermissionDenied, "only users with admin power can invite users")
+	}
+
 	inviterID := userID
 	if initiatorUser.IsServiceUser {
 		inviterID = account.CreatedBy
@@ -449,30 +461,15 @@ func (am *DefaultAccountManager) DeleteUser(ctx context.Context, accountID, init
 	if executingUser == nil {
 		return status.Errorf(status.NotFound, "user not found")
 	}
-	if !executingUser.HasAdminPower() {
-		return status.Errorf(status.PermissionDenied, "only users with admin power can delete users")
-	}
 
+	canDeleteUsers := executingUser.HasAdminPower() || am.hasPermission(ctx, executingUser, rbac.ResourceUsers, rbac.OperationDelete)
 	targetUser := account.Users[targetUserID]
-	if targetUser == nil {
-		return status.Errorf(status.NotFound, "target user not found")
-	}
-
-	if targetUser.Role == UserRoleOwner {
-		return status.Errorf(status.PermissionDenied, "unable to delete a user with owner role")
-	}
-
-	// disable deleting integration user if the initiator is not admin service user
-	if targetUser.Issued == UserIssuedIntegration && !executingUser.IsServiceUser {
-		return status.Errorf(status.PermissionDenied, "only integration service user can delete this user")
+	if err = validateUserDeletion(executingUser, canDeleteUsers, targetUser); err != nil {
+		return err
 	}
 
 	// handle service user first and exit, no need to fetch extra data from IDP, etc
 	if targetUser.IsServiceUser {
-		if targetUser.NonDeletable {
-			return status.Errorf(status.PermissionDenied, "service user is marked as non-deletable")
-		}
-
 		am.deleteServiceUser(ctx, account, initiatorUserID, targetUser)
 		return am.Store.SaveAccount(ctx, account)
 	}
@@ -516,7 +513,7 @@ func (am *DefaultAccountManager) deleteUserPeers(ctx context.Context, initiatorU
 		peerIDs = append(peerIDs, peer.ID)
 	}
 
-	return hadPeers, am.deletePeers(ctx, account, peerIDs, initiatorUserID)
+	return hadPeers, am.deletePeers(ctx, account, peerIDs, initiatorUserID, activity.PeerRemovedByUser)
 }
 
 // InviteUser resend invitations to users who haven't activated their accounts prior to the expiration period.
@@ -753,15 +750,17 @@ func (am *DefaultAccountManager) SaveOrAddUsers(ctx context.Context, accountID,
 		return nil, err
 	}
 
-	if !initiatorUser.HasAdminPower() || initiatorUser.IsBlocked() {
+	canUpdateUsers := initiatorUser.HasAdminPower() || am.hasPermission(ctx, initiatorUser, rbac.ResourceUsers, rbac.OperationUpdate)
+	if !canUpdateUsers || initiatorUser.IsBlocked() {
 		return nil, status.Errorf(status.PermissionDenied, "only users with admin power are authorized to perform user update operations")
 	}
 
 	updatedUsers := make([]*UserInfo, 0, len(updates))
 	var (
-		expiredPeers  []*nbpeer.Peer
-		userIDs       []string
-		eventsToStore []func()
+		expiredPeers   []*nbpeer.Peer
+		userIDs        []string
+		roleUpdatedIDs []string
+		eventsToStore  []func()
 	)
 
 	for _, update := range updates {
@@ -771,54 +770,20 @@ func (am *DefaultAccountManager) SaveOrAddUsers(ctx context.Context, accountID,
 
 		userIDs = append(userIDs, update.Id)
 
-		oldUser := account.Users[update.Id]
-		if oldUser == nil {
-			if !addIfNotExists {
-				return nil, status.Errorf(status.NotFound, "user to update doesn't exist: %s", update.Id)
-			}
-			// when addIfNotExists is set to true, the newUser will use all fields from the update input
-			oldUser = update
+		var oldRole UserRole
+		if oldUser, ok := account.Users[update.Id]; ok {
+			oldRole = oldUser.Role
 		}
 
-		if err := validateUserUpdate(account, initiatorUser, oldUser, update); err != nil {
+		newUser, blockedPeers, userEvents, err := am.applyUserUpdate(ctx, am.Store, account, initiatorUser, update, addIfNotExists)
+		if err != nil {
 			return nil, err
 		}
-
-		// only auto groups, revoked status, and integration reference can be updated for now
-		newUser := oldUser.Copy()
-		newUser.Role = update.Role
-		newUser.Blocked = update.Blocked
-		newUser.AutoGroups = update.AutoGroups
-		// these two fields can't be set via API, only via direct call to the method
-		newUser.Issued = update.Issued
-		newUser.IntegrationReference = update.IntegrationReference
-
-		transferredOwnerRole := handleOwnerRoleTransfer(account, initiatorUser, update)
-		account.Users[newUser.Id] = newUser
-
-		if !oldUser.IsBlocked() && update.IsBlocked() {
-			// expire peers that belong to the user who's getting blocked
-			blockedPeers, err := account.FindUserPeers(update.Id)
-			if err != nil {
-				return nil, err
-			}
-			expiredPeers = append(expiredPeers, blockedPeers...)
-		}
-
-		peerGroupsAdded := make(map[string][]string)
-		peerGroupsRemoved := make(map[string][]string)
-		if update.AutoGroups != nil && account.Settings.GroupsPropagationEnabled {
-			removedGroups := difference(oldUser.AutoGroups, update.AutoGroups)
-			// need force update all auto groups in any case they will not be duplicated
-			peerGroupsAdded = account.UserGroupsAddToPeers(oldUser.Id, update.AutoGroups...)
-			peerGroupsRemoved = account.UserGroupsRemoveFromPeers(oldUser.Id, removedGroups...)
+		if newUser.Role != oldRole {
+			roleUpdatedIDs = append(roleUpdatedIDs, newUser.Id)
 		}
-
-		userUpdateEvents := am.prepareUserUpdateEvents(ctx, initiatorUser.Id, oldUser, newUser, account, transferredOwnerRole)
-		eventsToStore = append(eventsToStore, userUpdateEvents...)
-
-		userGroupsEvents := am.prepareUserGroupsEvents(ctx, initiatorUser.Id, oldUser, newUser, account, peerGroupsAdded, peerGroupsRemoved)
-		eventsToStore = append(eventsToStore, userGroupsEvents...)
+		expiredPeers = append(expiredPeers, blockedPeers...)
+		eventsToStore = append(eventsToStore, userEvents...)
 
 		updatedUserInfo, err := getUserInfo(ctx, am, newUser, account)
 		if err != nil {
@@ -834,12 +799,21 @@ func (am *DefaultAccountManager) SaveOrAddUsers(ctx context.Context, accountID,
 		}
 	}
 
+	// the role of the users is matched by the rules of the dynamic groups
+	updatedGroupIDs, groupEvents := am.updateUsersPeersDynamicGroups(ctx, account, roleUpdatedIDs)
+	eventsToStore = append(eventsToStore, groupEvents...)
+
 	account.Network.IncSerial()
 	if err = am.Store.SaveAccount(ctx, account); err != nil {
 		return nil, err
 	}
 
-	if account.Settings.GroupsPropagationEnabled && areUsersLinkedToPeers(account, userIDs) {
+	groupsAffectPeers, err := areGroupChangesAffectPeers(ctx, am.Store, account.Id, updatedGroupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if groupsAffectPeers || account.Settings.GroupsPropagationEnabled && areUsersLinkedToPeers(account, userIDs) {
 		am.updateAccountPeers(ctx, account.Id)
 	}
 
@@ -850,6 +824,66 @@ func (am *DefaultAccountManager) SaveOrAddUsers(ctx context.Context, accountID,
 	return updatedUsers, nil
 }
 
+// applyUserUpdate applies the update to the user of the account without saving the account.
+// It returns the updated user, the peers to expire when the user gets blocked and the events to store once the account is saved.
+func (am *DefaultAccountManager) applyUserUpdate(ctx context.Context, transaction Store, account *Account, initiatorUser, update *User, addIfNotExists bool) (*User, []*nbpeer.Peer, []func(), error) {
+	oldUser := account.Users[update.Id]
+	if oldUser == nil {
+		if !addIfNotExists {
+			return nil, nil, nil, status.Errorf(status.NotFound, "user to update doesn't exist: %s", update.Id)
+		}
+		// when addIfNotExists is set to true, the newUser will use all fields from the update input
+		oldUser = update
+	}
+
+	if err := validateUserUpdate(account, initiatorUser, oldUser, update); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if update.CustomRoleID != "" && update.CustomRoleID != oldUser.CustomRoleID {
+		if _, err := transaction.GetCustomRoleByID(ctx, LockingStrengthShare, account.Id, update.CustomRoleID); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	// only auto groups, revoked status, and integration reference can be updated for now
+	newUser := oldUser.Copy()
+	newUser.Role = update.Role
+	newUser.Blocked = update.Blocked
+	newUser.AutoGroups = update.AutoGroups
+	newUser.CustomRoleID = update.CustomRoleID
+	// these two fields can't be set via API, only via direct call to the method
+	newUser.Issued = update.Issued
+	newUser.IntegrationReference = update.IntegrationReference
+
+	transferredOwnerRole := handleOwnerRoleTransfer(account, initiatorUser, update)
+	account.Users[newUser.Id] = newUser
+
+	var blockedPeers []*nbpeer.Peer
+	if !oldUser.IsBlocked() && update.IsBlocked() {
+		// expire peers that belong to the user who's getting blocked
+		var err error
+		blockedPeers, err = account.FindUserPeers(update.Id)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	peerGroupsAdded := make(map[string][]string)
+	peerGroupsRemoved := make(map[string][]string)
+	if update.AutoGroups != nil && account.Settings.GroupsPropagationEnabled {
+		removedGroups := difference(oldUser.AutoGroups, update.AutoGroups)
+		// need force update all auto groups in any case they will not be duplicated
+		peerGroupsAdded = account.UserGroupsAddToPeers(oldUser.Id, update.AutoGroups...)
+		peerGroupsRemoved = account.UserGroupsRemoveFromPeers(oldUser.Id, removedGroups...)
+	}
+
+	eventsToStore := am.prepareUserUpdateEvents(ctx, initiatorUser.Id, oldUser, newUser, account, transferredOwnerRole)
+	eventsToStore = append(eventsToStore, am.prepareUserGroupsEvents(ctx, initiatorUser.Id, oldUser, newUser, account, peerGroupsAdded, peerGroupsRemoved)...)
+
+	return newUser, blockedPeers, eventsToStore, nil
+}
+
 // prepareUserUpdateEvents prepares a list user update events based on the changes between the old and new user data.
 func (am *DefaultAccountManager) prepareUserUpdateEvents(ctx context.Context, initiatorUserID string, oldUser, newUser *User, account *Account, transferredOwnerRole bool) []func() {
 	var eventsToStore []func()
@@ -877,6 +911,12 @@ func (am *DefaultAccountManager) prepareUserUpdateEvents(ctx context.Context, in
 		})
 	}
 
+	if oldUser.CustomRoleID != newUser.CustomRoleID {
+		eventsToStore = append(eventsToStore, func() {
+			am.StoreEvent(ctx, initiatorUserID, oldUser.Id, account.Id, activity.UserCustomRoleUpdated, map[string]any{"custom_role_id": newUser.CustomRoleID})
+		})
+	}
+
 	return eventsToStore
 }
 
@@ -962,6 +1002,58 @@ func handleOwnerRoleTransfer(account *Account, initiatorUser, update *User) bool
 	return false
 }
 
+// canManageUserWithRole checks whether the custom role of the initiator user allows the operation on users with the given role.
+// Users without admin power can't manage users with admin power.
+func (am *DefaultAccountManager) canManageUserWithRole(ctx context.Context, initiatorUser *User, role UserRole, operation rbac.Operation) bool {
+	customRole, err := am.GetUserCustomRole(ctx, initiatorUser)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get custom role %s of user %s: %v", initiatorUser.CustomRoleID, initiatorUser.Id, err)
+		return false
+	}
+
+	return customRoleCanManageUserWithRole(customRole, role, operation)
+}
+
+// customRoleCanManageUserWithRole checks whether the custom role allows the operation on users with the given role
+func customRoleCanManageUserWithRole(customRole *rbac.Role, role UserRole, operation rbac.Operation) bool {
+	if role == UserRoleAdmin || role == UserRoleOwner {
+		return false
+	}
+
+	return customRole.Allows(rbac.ResourceUsers, operation)
+}
+
+// validateUserDeletion checks that the initiator user can delete the target user.
+// canDeleteUsers tells whether the role or the custom role of the initiator user allows deleting users.
+func validateUserDeletion(initiatorUser *User, canDeleteUsers bool, targetUser *User) error {
+	if !canDeleteUsers {
+		return status.Errorf(status.PermissionDenied, "only users with admin power can delete users")
+	}
+
+	if targetUser == nil {
+		return status.Errorf(status.NotFound, "target user not found")
+	}
+
+	if !initiatorUser.HasAdminPower() && targetUser.HasAdminPower() {
+		return status.Errorf(status.PermissionDenied, "only users with admin power can delete users with admin power")
+	}
+
+	if targetUser.Role == UserRoleOwner {
+		return status.Errorf(status.PermissionDenied, "unable to delete a user with owner role")
+	}
+
+	// disable deleting integration user if the initiator is not admin service user
+	if targetUser.Issued == UserIssuedIntegration && !initiatorUser.IsServiceUser {
+		return status.Errorf(status.PermissionDenied, "only integration service user can delete this user")
+	}
+
+	if targetUser.IsServiceUser && targetUser.NonDeletable {
+		return status.Errorf(status.PermissionDenied, "service user is marked as non-deletable")
+	}
+
+	return nil
+}
+
 // getUserInfo retrieves the UserInfo for a given User and Account.
 // If the AccountManager has a non-nil idpManager and the User is not a service user,
 // it will attempt to look up the UserData from the cache.
@@ -978,6 +1070,14 @@ func getUserInfo(ctx context.Context, am *DefaultAccountManager, user *User, acc
 
 // validateUserUpdate validates the update operation for a user.
 func validateUserUpdate(account *Account, initiatorUser, oldUser, update *User) error {
+	if !initiatorUser.HasAdminPower() {
+		if oldUser.HasAdminPower() {
+			return status.Errorf(status.PermissionDenied, "only users with admin power can update users with admin power")
+		}
+		if update.Role != oldUser.Role || update.CustomRoleID != oldUser.CustomRoleID {
+			return status.Errorf(status.PermissionDenied, "only users with admin power can change user roles")
+		}
+	}
 	if initiatorUser.HasAdminPower() && initiatorUser.Id == update.Id && oldUser.Blocked != update.Blocked {
 		return status.Errorf(status.PermissionDenied, "admins can't block or unblock themselves")
 	}
@@ -1063,6 +1163,8 @@ func (am *DefaultAccountManager) GetUsersFromAccount(ctx context.Context, accoun
 		return nil, err
 	}
 
+	canReadUsers := user.HasAdminPower() || user.IsServiceUser || am.hasPermission(ctx, user, rbac.ResourceUsers, rbac.OperationRead)
+
 	queriedUsers := make([]*idp.UserData, 0)
 	if !isNil(am.idpManager) {
 		users := make(map[string]userLoggedInOnce, len(account.Users))
@@ -1097,7 +1199,7 @@ func (am *DefaultAccountManager) GetUsersFromAccount(ctx context.Context, accoun
 	// in case of self-hosted, or IDP doesn't return anything, we will return the locally stored userInfo
 	if len(queriedUsers) == 0 {
 		for _, accountUser := range account.Users {
-			if !(user.HasAdminPower() || user.IsServiceUser || user.Id == accountUser.Id) {
+			if !(canReadUsers || user.Id == accountUser.Id) {
 				// if user is not an admin then show only current user and do not show other users
 				continue
 			}
@@ -1107,11 +1209,16 @@ func (am *DefaultAccountManager) GetUsersFromAccount(ctx context.Context, accoun
 			}
 			userInfos = append(userInfos, info)
 		}
+
+		if err = am.fillUserInfosFromSCIMProfiles(ctx, accountID, userInfos); err != nil {
+			return nil, err
+		}
+
 		return userInfos, nil
 	}
 
 	for _, localUser := range account.Users {
-		if !(user.HasAdminPower() || user.IsServiceUser) && user.Id != localUser.Id {
+		if !canReadUsers && user.Id != localUser.Id {
 			// if user is not an admin then show only current user and do not show other users
 			continue
 		}
@@ -1151,6 +1258,10 @@ func (am *DefaultAccountManager) GetUsersFromAccount(ctx context.Context, accoun
 		userInfos = append(userInfos, info)
 	}
 
+	if err = am.fillUserInfosFromSCIMProfiles(ctx, accountID, userInfos); err != nil {
+		return nil, err
+	}
+
 	return userInfos, nil
 }
 
@@ -1238,7 +1349,7 @@ func (am *DefaultAccountManager) DeleteRegularUsers(ctx context.Context, account
 	if executingUser == nil {
 		return status.Errorf(status.NotFound, "user not found")
 	}
-	if !executingUser.HasAdminPower() {
+	if !executingUser.HasAdminPower() && !am.hasPermission(ctx, executingUser, rbac.ResourceUsers, rbac.OperationDelete) {
 		return status.Errorf(status.PermissionDenied, "only users with admin power can delete users")
 	}
 
@@ -1265,6 +1376,11 @@ func (am *DefaultAccountManager) DeleteRegularUsers(ctx context.Context, account
 			continue
 		}
 
+		if !executingUser.HasAdminPower() && targetUser.HasAdminPower() {
+			allErrors = errors.Join(allErrors, fmt.Errorf("unable to delete a user: %s with admin power", targetUserID))
+			continue
+		}
+
 		// disable deleting integration user if the initiator is not admin service user
 		if targetUser.Issued == UserIssuedIntegration && !executingUser.IsServiceUser {
 			allErrors = errors.Join(allErrors, errors.New("only integration service user can delete this user"))
@@ -1308,19 +1424,8 @@ func (am *DefaultAccountManager) prepareUserDeletion(ctx context.Context, accoun
 		return nil, false, err
 	}
 
-	if !isNil(am.idpManager) {
-		// Delete if the user already exists in the IdP. Necessary in cases where a user account
-		// was created where a user account was provisioned but the user did not sign in
-		_, err = am.idpManager.GetUserDataByID(ctx, targetUserID, idp.AppMetadata{WTAccountID: account.Id})
-		if err == nil {
-			err = am.deleteUserFromIDP(ctx, targetUserID, account.Id)
-			if err != nil {
-				log.WithContext(ctx).Debugf("failed to delete user from IDP: %s", targetUserID)
-				return nil, false, err
-			}
-		} else {
-			log.WithContext(ctx).Debugf("skipped deleting user %s from IDP, error: %v", targetUserID, err)
-		}
+	if err = am.deleteExistingUserFromIDP(ctx, targetUserID, account.Id); err != nil {
+		return nil, false, err
 	}
 
 	hadPeers, err := am.deleteUserPeers(ctx, initiatorUserID, targetUserID, account)
@@ -1341,6 +1446,27 @@ func (am *DefaultAccountManager) prepareUserDeletion(ctx context.Context, accoun
 	return map[string]any{"name": tuName, "email": tuEmail, "created_at": tuCreatedAt}, hadPeers, nil
 }
 
+// deleteExistingUserFromIDP deletes the user from the IdP if the user exists there. Necessary in cases where a user account
+// was provisioned but the user did not sign in
+func (am *DefaultAccountManager) deleteExistingUserFromIDP(ctx context.Context, targetUserID, accountID string) error {
+	if isNil(am.idpManager) {
+		return nil
+	}
+
+	_, err := am.idpManager.GetUserDataByID(ctx, targetUserID, idp.AppMetadata{WTAccountID: accountID})
+	if err != nil {
+		log.WithContext(ctx).Debugf("skipped deleting user %s from IDP, error: %v", targetUserID, err)
+		return nil
+	}
+
+	if err = am.deleteUserFromIDP(ctx, targetUserID, accountID); err != nil {
+		log.WithContext(ctx).Debugf("failed to delete user from IDP: %s", targetUserID)
+		return err
+	}
+
+	return nil
+}
+
 // updateUserPeersInGroups updates the user's peers in the specified groups by adding or removing them.
 func (am *DefaultAccountManager) updateUserPeersInGroups(accountGroups map[string]*nbgroup.Group, peers []*nbpeer.Peer, groupsToAdd,
 	groupsToRemove []string) (groupsToUpdate []*nbgroup.Group, err error) {
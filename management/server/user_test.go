@@ -300,6 +300,7 @@ func TestUser_Copy(t *testing.T) {
 			ID:              0,
 			IntegrationType: "test",
 		},
+		CustomRoleID: "customRoleId",
 	}
 
 	err := validateStruct(user)
@@ -0,0 +1,382 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/activity/sqlite"
+	"github.com/netbirdio/netbird/management/server/status"
+	"github.com/netbirdio/netbird/management/server/webhook"
+)
+
+const (
+	// webhookDispatchInterval is the interval the outbox is checked for deliveries due for a retry
+	webhookDispatchInterval = 10 * time.Second
+	// webhookDispatchBatchSize is the maximum number of deliveries sent in one dispatch run
+	webhookDispatchBatchSize = 100
+	// webhookDeliveryLease is the time a claimed delivery isn't sent by other management instances.
+	// It covers sending a full batch to a single endpoint that times out on every delivery.
+	webhookDeliveryLease = 30 * time.Minute
+	// webhookFailedDeliveryRetention is the time failed deliveries are kept in the outbox for troubleshooting
+	webhookFailedDeliveryRetention = 7 * 24 * time.Hour
+	// webhookCleanupInterval is the interval failed deliveries past their retention are removed
+	webhookCleanupInterval = time.Hour
+)
+
+// StartWebhookDispatcher starts delivering the activity events to the webhook endpoints of the accounts.
+// The endpoint secrets are encrypted with the given key, the same key the event store encrypts its data with.
+// Unless private targets are allowed, endpoints on loopback, link-local and private addresses are rejected.
+func (am *DefaultAccountManager) StartWebhookDispatcher(ctx context.Context, encryptionKey string, allowPrivateTargets bool) error {
+	secrets, err := sqlite.NewFieldEncrypt(encryptionKey)
+	if err != nil {
+		return fmt.Errorf("create webhook secrets encryption: %w", err)
+	}
+
+	am.webhooks = newWebhookDispatcher(ctx, am.Store, secrets, allowPrivateTargets)
+	am.AddEventSink(am.webhooks)
+	go am.relayEventOutbox(ctx)
+
+	return nil
+}
+
+// GetWebhookEndpoint returns a webhook endpoint of the account
+func (am *DefaultAccountManager) GetWebhookEndpoint(ctx context.Context, accountID, endpointID, userID string) (*webhook.Endpoint, error) {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if !user.HasAdminPower() {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	return am.Store.GetWebhookEndpointByID(ctx, LockingStrengthShare, accountID, endpointID)
+}
+
+// ListWebhookEndpoints returns the webhook endpoints of the account
+func (am *DefaultAccountManager) ListWebhookEndpoints(ctx context.Context, accountID, userID string) ([]*webhook.Endpoint, error) {
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if !user.HasAdminPower() {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	return am.Store.GetAccountWebhookEndpoints(ctx, LockingStrengthShare, accountID)
+}
+
+// SaveWebhookEndpoint creates a webhook endpoint or updates an existing one if the ID is set.
+// An empty secret keeps the secret of the existing endpoint.
+func (am *DefaultAccountManager) SaveWebhookEndpoint(ctx context.Context, accountID, userID string, endpoint *webhook.Endpoint) (*webhook.Endpoint, error) {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountID != accountID {
+		return nil, status.NewUserNotPartOfAccountError()
+	}
+
+	if !user.HasAdminPower() {
+		return nil, status.NewAdminPermissionError()
+	}
+
+	if am.webhooks == nil {
+		return nil, status.Errorf(status.PreconditionFailed, "webhooks are not enabled")
+	}
+
+	isUpdate := endpoint.ID != ""
+	action := activity.WebhookEndpointCreated
+
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		// the secret of the existing endpoint is already encrypted
+		keepSecret := isUpdate && endpoint.Secret == ""
+		if isUpdate {
+			existing, err := transaction.GetWebhookEndpointByID(ctx, LockingStrengthUpdate, accountID, endpoint.ID)
+			if err != nil {
+				return err
+			}
+
+			if keepSecret {
+				endpoint.Secret = existing.Secret
+			}
+			endpoint.CreatedAt = existing.CreatedAt
+			action = activity.WebhookEndpointUpdated
+		} else {
+			endpoint.ID = xid.New().String()
+			endpoint.CreatedAt = time.Now().UTC()
+		}
+
+		if err := endpoint.Validate(am.webhooks.allowPrivateTargets); err != nil {
+			return status.Errorf(status.InvalidArgument, "%s", err.Error())
+		}
+
+		if !keepSecret {
+			secret, err := am.webhooks.secrets.Encrypt(endpoint.Secret)
+			if err != nil {
+				return status.Errorf(status.Internal, "failed to encrypt webhook endpoint secret: %s", err)
+			}
+			endpoint.Secret = secret
+		}
+
+		endpoint.AccountID = accountID
+		return transaction.SaveWebhookEndpoint(ctx, LockingStrengthUpdate, endpoint)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	am.StoreEvent(ctx, userID, endpoint.ID, accountID, action, endpoint.EventMeta())
+
+	return endpoint, nil
+}
+
+// DeleteWebhookEndpoint deletes a webhook endpoint together with its pending deliveries
+func (am *DefaultAccountManager) DeleteWebhookEndpoint(ctx context.Context, accountID, endpointID, userID string) error {
+	unlock := am.Store.AcquireWriteLockByUID(ctx, accountID)
+	defer unlock()
+
+	user, err := am.Store.GetUserByUserID(ctx, LockingStrengthShare, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.AccountID != accountID {
+		return status.NewUserNotPartOfAccountError()
+	}
+
+	if !user.HasAdminPower() {
+		return status.NewAdminPermissionError()
+	}
+
+	var endpoint *webhook.Endpoint
+	err = am.Store.ExecuteInTransaction(ctx, func(transaction Store) error {
+		endpoint, err = transaction.GetWebhookEndpointByID(ctx, LockingStrengthUpdate, accountID, endpointID)
+		if err != nil {
+			return err
+		}
+
+		return transaction.DeleteWebhookEndpoint(ctx, LockingStrengthUpdate, accountID, endpointID)
+	})
+	if err != nil {
+		return err
+	}
+
+	am.StoreEvent(ctx, userID, endpoint.ID, accountID, activity.WebhookEndpointDeleted, endpoint.EventMeta())
+
+	return nil
+}
+
+// webhookDispatcher is an activity.Sink that puts events into a persistent outbox and posts them to the
+// subscribed webhook endpoints of the account, retrying failed deliveries with an exponential backoff.
+type webhookDispatcher struct {
+	store   Store
+	sender  *webhook.Sender
+	secrets *sqlite.FieldEncrypt
+	notify  chan struct{}
+
+	// allowPrivateTargets allows endpoints on loopback, link-local and private addresses
+	allowPrivateTargets bool
+	// lastCleanup is the time failed deliveries past their retention were last removed
+	lastCleanup time.Time
+}
+
+// newWebhookDispatcher creates a webhook dispatcher and starts processing the outbox.
+// Deliveries left in the outbox by a previous run are sent right away.
+func newWebhookDispatcher(ctx context.Context, store Store, secrets *sqlite.FieldEncrypt, allowPrivateTargets bool) *webhookDispatcher {
+	d := &webhookDispatcher{
+		store:               store,
+		sender:              webhook.NewSender(allowPrivateTargets),
+		secrets:             secrets,
+		notify:              make(chan struct{}, 1),
+		allowPrivateTargets: allowPrivateTargets,
+	}
+
+	go d.run(ctx)
+	d.trigger()
+
+	return d
+}
+
+// Deliver adds a delivery of the event to the outbox for every subscribed endpoint of the event account.
+// Deliveries are identified by the endpoint and the event, so delivering an event again doesn't queue it twice.
+func (d *webhookDispatcher) Deliver(ctx context.Context, event *activity.Event) error {
+	endpoints, err := d.store.GetAccountWebhookEndpoints(ctx, LockingStrengthShare, event.AccountID)
+	if err != nil {
+		return err
+	}
+
+	var deliveries []*webhook.Delivery
+	for _, endpoint := range endpoints {
+		if !endpoint.IsSubscribed(event.Activity.StringCode()) {
+			continue
+		}
+
+		delivery, err := webhook.NewDelivery(endpoint, event)
+		if err != nil {
+			return err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	if err = d.store.CreateWebhookDeliveries(ctx, LockingStrengthUpdate, deliveries); err != nil {
+		return err
+	}
+
+	d.trigger()
+
+	return nil
+}
+
+// trigger wakes up the dispatcher without blocking if a dispatch run is already pending
+func (d *webhookDispatcher) trigger() {
+	select {
+	case d.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (d *webhookDispatcher) run(ctx context.Context) {
+	ticker := time.NewTicker(webhookDispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.notify:
+		}
+
+		d.dispatch(ctx)
+		d.cleanup(ctx)
+	}
+}
+
+// dispatch claims and sends the deliveries that are due. Deliveries of different endpoints are sent concurrently,
+// so a slow endpoint doesn't hold back the others.
+func (d *webhookDispatcher) dispatch(ctx context.Context) {
+	deliveries, err := d.store.ClaimDueWebhookDeliveries(ctx, time.Now().UTC(), webhookDeliveryLease, webhookDispatchBatchSize)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get webhook deliveries: %v", err)
+		return
+	}
+
+	deliveriesByEndpoint := make(map[string][]*webhook.Delivery)
+	for _, delivery := range deliveries {
+		deliveriesByEndpoint[delivery.EndpointID] = append(deliveriesByEndpoint[delivery.EndpointID], delivery)
+	}
+
+	var wg sync.WaitGroup
+	for _, endpointDeliveries := range deliveriesByEndpoint {
+		wg.Add(1)
+		go func(endpointDeliveries []*webhook.Delivery) {
+			defer wg.Done()
+			d.sendEndpointDeliveries(ctx, endpointDeliveries)
+		}(endpointDeliveries)
+	}
+	wg.Wait()
+
+	// there might be more deliveries due
+	if len(deliveries) == webhookDispatchBatchSize {
+		d.trigger()
+	}
+}
+
+// sendEndpointDeliveries sends the deliveries of a single endpoint in order
+func (d *webhookDispatcher) sendEndpointDeliveries(ctx context.Context, deliveries []*webhook.Delivery) {
+	first := deliveries[0]
+	endpoint, err := d.store.GetWebhookEndpointByID(ctx, LockingStrengthShare, first.AccountID, first.EndpointID)
+	if err != nil {
+		if s, ok := status.FromError(err); !ok || s.Type() != status.NotFound {
+			log.WithContext(ctx).Errorf("failed to get webhook endpoint %s: %v", first.EndpointID, err)
+			return
+		}
+	}
+
+	var secretErr error
+	if endpoint != nil {
+		endpoint.Secret, secretErr = d.secrets.Decrypt(endpoint.Secret)
+		if secretErr != nil {
+			secretErr = fmt.Errorf("decrypt webhook endpoint secret: %w", secretErr)
+		}
+	}
+
+	for _, delivery := range deliveries {
+		// the endpoint was removed or disabled after the events were queued
+		if endpoint == nil || !endpoint.Enabled {
+			d.deleteDelivery(ctx, delivery)
+			continue
+		}
+
+		err = secretErr
+		if err == nil {
+			err = d.sender.Send(ctx, endpoint, delivery)
+		}
+		if err == nil {
+			d.deleteDelivery(ctx, delivery)
+			continue
+		}
+
+		delivery.MarkFailedAttempt(err, time.Now().UTC())
+		if delivery.Status == webhook.DeliveryStatusFailed {
+			log.WithContext(ctx).Warnf("giving up webhook delivery %s to endpoint %s after %d attempts: %v",
+				delivery.ID, endpoint.ID, delivery.Attempts, err)
+		} else {
+			log.WithContext(ctx).Debugf("webhook delivery %s to endpoint %s failed, retrying at %s: %v",
+				delivery.ID, endpoint.ID, delivery.NextAttemptAt, err)
+		}
+
+		if err = d.store.SaveWebhookDelivery(ctx, LockingStrengthUpdate, delivery); err != nil {
+			log.WithContext(ctx).Errorf("failed to save webhook delivery %s: %v", delivery.ID, err)
+		}
+	}
+}
+
+func (d *webhookDispatcher) deleteDelivery(ctx context.Context, delivery *webhook.Delivery) {
+	if err := d.store.DeleteWebhookDelivery(ctx, LockingStrengthUpdate, delivery.ID); err != nil {
+		log.WithContext(ctx).Errorf("failed to delete webhook delivery %s: %v", delivery.ID, err)
+	}
+}
+
+// cleanup removes the failed deliveries past their retention, at most once per webhookCleanupInterval
+func (d *webhookDispatcher) cleanup(ctx context.Context) {
+	now := time.Now().UTC()
+	if now.Sub(d.lastCleanup) < webhookCleanupInterval {
+		return
+	}
+	d.lastCleanup = now
+
+	deleted, err := d.store.DeleteFailedWebhookDeliveries(ctx, LockingStrengthUpdate, now.Add(-webhookFailedDeliveryRetention))
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to remove failed webhook deliveries: %v", err)
+		return
+	}
+
+	if deleted > 0 {
+		log.WithContext(ctx).Debugf("removed %d failed webhook deliveries older than %s", deleted, webhookFailedDeliveryRetention)
+	}
+}
@@ -0,0 +1,127 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+)
+
+const (
+	// MaxAttempts is the number of delivery attempts after which a delivery is marked as failed
+	MaxAttempts = 10
+	// initialBackoff is the delay before the first retry, doubled for every following attempt
+	initialBackoff = 30 * time.Second
+	// maxBackoff is the maximum delay between two attempts
+	maxBackoff = time.Hour
+)
+
+// DeliveryStatus is the state of a delivery in the outbox
+type DeliveryStatus string
+
+const (
+	// DeliveryStatusPending indicates that the delivery waits for its next attempt
+	DeliveryStatusPending DeliveryStatus = "pending"
+	// DeliveryStatusFailed indicates that all attempts failed and the delivery won't be retried
+	DeliveryStatusFailed DeliveryStatus = "failed"
+)
+
+// Delivery is an outbox entry holding an event payload that has to be posted to an endpoint.
+// Deliveries are persisted, so pending events survive management restarts.
+type Delivery struct {
+	// ID of the delivery, derived from the endpoint and the event IDs and sent to the endpoint to allow deduplication
+	ID string `gorm:"primaryKey"`
+
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `gorm:"index"`
+
+	// EndpointID is the ID of the endpoint the payload is posted to
+	EndpointID string `gorm:"index"`
+
+	// Payload is the JSON encoded event
+	Payload []byte
+
+	// Status of the delivery
+	Status DeliveryStatus `gorm:"index"`
+
+	// Attempts is the number of failed attempts so far
+	Attempts int
+
+	// NextAttemptAt is the time of the next attempt
+	NextAttemptAt time.Time `gorm:"index"`
+
+	// LastError is the error of the last failed attempt
+	LastError string
+
+	// CreatedAt is the time the delivery was created
+	CreatedAt time.Time
+}
+
+// TableName returns the name of the table for the Delivery model in the database.
+func (*Delivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// Payload is the JSON body posted to the endpoints
+type Payload struct {
+	ID           uint64         `json:"id"`
+	Timestamp    time.Time      `json:"timestamp"`
+	AccountID    string         `json:"account_id"`
+	Activity     string         `json:"activity"`
+	ActivityCode string         `json:"activity_code"`
+	InitiatorID  string         `json:"initiator_id"`
+	TargetID     string         `json:"target_id"`
+	Meta         map[string]any `json:"meta"`
+}
+
+// NewDelivery creates a pending delivery of the event to the endpoint
+func NewDelivery(endpoint *Endpoint, event *activity.Event) (*Delivery, error) {
+	payload, err := json.Marshal(&Payload{
+		ID:           event.ID,
+		Timestamp:    event.Timestamp,
+		AccountID:    event.AccountID,
+		Activity:     event.Activity.Message(),
+		ActivityCode: event.Activity.StringCode(),
+		InitiatorID:  event.InitiatorID,
+		TargetID:     event.TargetID,
+		Meta:         event.Meta,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal webhook payload: %w", err)
+	}
+
+	now := time.Now().UTC()
+	return &Delivery{
+		ID:            fmt.Sprintf("%s-%d", endpoint.ID, event.ID),
+		AccountID:     endpoint.AccountID,
+		EndpointID:    endpoint.ID,
+		Payload:       payload,
+		Status:        DeliveryStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}, nil
+}
+
+// MarkFailedAttempt records a failed attempt and schedules the next one with an exponential backoff.
+// After MaxAttempts the delivery is marked as failed.
+func (d *Delivery) MarkFailedAttempt(err error, now time.Time) {
+	d.Attempts++
+	d.LastError = err.Error()
+
+	if d.Attempts >= MaxAttempts {
+		d.Status = DeliveryStatusFailed
+		return
+	}
+
+	d.NextAttemptAt = now.Add(backoff(d.Attempts))
+}
+
+// backoff returns the delay before the next attempt after the given number of failed attempts
+func backoff(attempts int) time.Duration {
+	delay := initialBackoff
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff)
+}
@@ -0,0 +1,126 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Endpoint is an HTTP endpoint that receives the activity events of an account
+type Endpoint struct {
+	// ID of the endpoint
+	ID string `gorm:"primaryKey"`
+
+	// AccountID is a reference to Account that this object belongs
+	AccountID string `json:"-" gorm:"index"`
+
+	// Name of the endpoint
+	Name string
+
+	// URL the events are posted to
+	URL string
+
+	// Secret is the key used to sign the delivered payloads, stored encrypted
+	Secret string
+
+	// Events are the activity codes the endpoint is subscribed to. Empty means all events.
+	Events []string `gorm:"serializer:json"`
+
+	// Enabled indicates whether events are delivered to the endpoint
+	Enabled bool
+
+	// CreatedAt is the time the endpoint was created
+	CreatedAt time.Time
+}
+
+// TableName returns the name of the table for the Endpoint model in the database.
+func (*Endpoint) TableName() string {
+	return "webhook_endpoints"
+}
+
+// NewEndpointFromAPIRequest creates an endpoint from the API request. An empty secret keeps the existing one on updates.
+func NewEndpointFromAPIRequest(req *api.WebhookEndpointRequest, endpointID string) *Endpoint {
+	endpoint := &Endpoint{
+		ID:      endpointID,
+		Name:    req.Name,
+		URL:     req.Url,
+		Enabled: req.Enabled,
+	}
+
+	if req.Secret != nil {
+		endpoint.Secret = *req.Secret
+	}
+
+	if req.Events != nil {
+		endpoint.Events = *req.Events
+	}
+
+	return endpoint
+}
+
+// ToAPIResponse converts the endpoint to the API response. The secret is never returned.
+func (e *Endpoint) ToAPIResponse() *api.WebhookEndpoint {
+	events := e.Events
+	if events == nil {
+		events = []string{}
+	}
+
+	return &api.WebhookEndpoint{
+		Id:        e.ID,
+		Name:      e.Name,
+		Url:       e.URL,
+		Events:    events,
+		Enabled:   e.Enabled,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+// Copy returns a copy of the endpoint
+func (e *Endpoint) Copy() *Endpoint {
+	c := *e
+	c.Events = slices.Clone(e.Events)
+	return &c
+}
+
+// EventMeta returns activity event meta related to the endpoint
+func (e *Endpoint) EventMeta() map[string]any {
+	return map[string]any{"name": e.Name, "url": e.URL}
+}
+
+// Validate checks that the endpoint has a name, a secret and a valid HTTP(S) URL.
+// Unless private targets are allowed, URLs with loopback, link-local and private hosts are rejected.
+func (e *Endpoint) Validate(allowPrivateTargets bool) error {
+	if e.Name == "" {
+		return errors.New("webhook endpoint name shouldn't be empty")
+	}
+
+	if e.Secret == "" {
+		return errors.New("webhook endpoint secret shouldn't be empty")
+	}
+
+	u, err := url.Parse(e.URL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook endpoint URL: %w", err)
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook endpoint URL %s, only absolute http and https URLs are supported", e.URL)
+	}
+
+	if !allowPrivateTargets {
+		if err = validateTargetHost(u.Hostname()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsSubscribed returns true if the endpoint is enabled and receives events with the given activity code
+func (e *Endpoint) IsSubscribed(activityCode string) bool {
+	return e.Enabled && (len(e.Events) == 0 || slices.Contains(e.Events, activityCode))
+}
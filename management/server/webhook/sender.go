@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// SignatureHeader holds the HMAC-SHA256 signature of the timestamp and the payload
+	SignatureHeader = "X-NetBird-Signature"
+	// TimestampHeader holds the unix time the request was signed at
+	TimestampHeader = "X-NetBird-Timestamp"
+	// DeliveryHeader holds the delivery ID, identical across retries of the same delivery
+	DeliveryHeader = "X-NetBird-Delivery"
+
+	signaturePrefix = "sha256="
+	defaultTimeout  = 10 * time.Second
+)
+
+// Sign returns the signature of the payload. The signed message is the unix timestamp and the payload joined by a dot,
+// so receivers can reject replayed requests.
+func Sign(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sender posts deliveries to webhook endpoints
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender returns a new Sender. Unless private targets are allowed, the sender refuses to connect to loopback,
+// link-local and private addresses, so webhooks can't be used to reach the internal network of the management server.
+// The environment proxy is then ignored, as the connections to the proxy would hide the address of the target.
+func NewSender(allowPrivateTargets bool) *Sender {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !allowPrivateTargets {
+		transport.Proxy = nil
+		dialer := &net.Dialer{
+			Timeout:   defaultTimeout,
+			KeepAlive: 30 * time.Second,
+			Control:   denyPrivateTargets,
+		}
+		transport.DialContext = dialer.DialContext
+	}
+
+	return &Sender{
+		client: &http.Client{Timeout: defaultTimeout, Transport: transport},
+	}
+}
+
+// Send posts the delivery payload to the endpoint. Any response status other than 2xx is considered a failure.
+func (s *Sender) Send(ctx context.Context, endpoint *Endpoint, delivery *Delivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, delivery.ID)
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(endpoint.Secret, timestamp, delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post event: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// drain the body to allow connection reuse
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("endpoint responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
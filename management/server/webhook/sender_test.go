@@ -0,0 +1,133 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSender_Send(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	responseCode := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(responseCode)
+	}))
+	defer server.Close()
+
+	endpoint := &Endpoint{ID: "endpoint", URL: server.URL, Secret: "secret"}
+	delivery := &Delivery{ID: "delivery", Payload: []byte(`{"id":1}`)}
+
+	err := NewSender(true).Send(context.Background(), endpoint, delivery)
+	require.NoError(t, err)
+
+	assert.Equal(t, delivery.Payload, body)
+	assert.Equal(t, "delivery", received.Header.Get(DeliveryHeader))
+
+	timestamp, err := strconv.ParseInt(received.Header.Get(TimestampHeader), 10, 64)
+	require.NoError(t, err)
+	assert.Equal(t, Sign("secret", timestamp, delivery.Payload), received.Header.Get(SignatureHeader))
+	assert.NotEqual(t, Sign("other", timestamp, delivery.Payload), received.Header.Get(SignatureHeader))
+
+	responseCode = http.StatusInternalServerError
+	err = NewSender(true).Send(context.Background(), endpoint, delivery)
+	assert.Error(t, err, "non 2xx responses should fail the delivery")
+}
+
+func TestSender_DeniesPrivateTargets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	endpoint := &Endpoint{ID: "endpoint", URL: server.URL, Secret: "secret"}
+	delivery := &Delivery{ID: "delivery", Payload: []byte(`{"id":1}`)}
+
+	err := NewSender(false).Send(context.Background(), endpoint, delivery)
+	assert.ErrorIs(t, err, errPrivateTarget, "loopback targets should be refused when connecting")
+}
+
+func TestSender_DeniesPrivateTargetsWithProxy(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	t.Setenv("HTTP_PROXY", proxy.URL)
+	t.Setenv("HTTPS_PROXY", proxy.URL)
+
+	sender := NewSender(false)
+	assert.Nil(t, sender.client.Transport.(*http.Transport).Proxy, "the proxy would hide the target address from the dialer")
+	assert.NotNil(t, NewSender(true).client.Transport.(*http.Transport).Proxy, "the proxy should be kept when private targets are allowed")
+
+	endpoint := &Endpoint{ID: "endpoint", URL: "http://10.0.0.1/events", Secret: "secret"}
+	delivery := &Delivery{ID: "delivery", Payload: []byte(`{"id":1}`)}
+
+	err := sender.Send(context.Background(), endpoint, delivery)
+	assert.ErrorIs(t, err, errPrivateTarget)
+	assert.ErrorContains(t, err, "connect to 10.0.0.1:", "the target should be checked instead of the proxy")
+}
+
+func TestEndpoint_Validate(t *testing.T) {
+	testCases := []struct {
+		url          string
+		allowPrivate bool
+		valid        bool
+	}{
+		{url: "https://siem.example.com/events", valid: true},
+		{url: "ftp://siem.example.com"},
+		{url: "https://"},
+		{url: "http://localhost:8080"},
+		{url: "http://127.0.0.1"},
+		{url: "http://[::1]:8080"},
+		{url: "http://169.254.169.254/latest/meta-data"},
+		{url: "http://10.0.0.1"},
+		{url: "http://192.168.1.10"},
+		{url: "http://100.64.0.1"},
+		{url: "http://[::ffff:10.0.0.1]"},
+		{url: "http://10.0.0.1", allowPrivate: true, valid: true},
+		{url: "http://localhost:8080", allowPrivate: true, valid: true},
+	}
+
+	for _, tc := range testCases {
+		endpoint := &Endpoint{Name: "siem", URL: tc.url, Secret: "secret"}
+		err := endpoint.Validate(tc.allowPrivate)
+		if tc.valid {
+			assert.NoError(t, err, tc.url)
+		} else {
+			assert.Error(t, err, tc.url)
+		}
+	}
+}
+
+func TestDelivery_MarkFailedAttempt(t *testing.T) {
+	now := time.Now()
+	delivery := &Delivery{Status: DeliveryStatusPending}
+
+	delivery.MarkFailedAttempt(errors.New("timeout"), now)
+	assert.Equal(t, DeliveryStatusPending, delivery.Status)
+	assert.Equal(t, now.Add(initialBackoff), delivery.NextAttemptAt)
+
+	delivery.MarkFailedAttempt(errors.New("timeout"), now)
+	assert.Equal(t, now.Add(2*initialBackoff), delivery.NextAttemptAt)
+
+	for delivery.Attempts < MaxAttempts-1 {
+		delivery.MarkFailedAttempt(errors.New("timeout"), now)
+	}
+	assert.Equal(t, now.Add(maxBackoff), delivery.NextAttemptAt, "backoff should be capped")
+	assert.Equal(t, DeliveryStatusPending, delivery.Status)
+
+	delivery.MarkFailedAttempt(errors.New("timeout"), now)
+	assert.Equal(t, DeliveryStatusFailed, delivery.Status)
+	assert.Equal(t, "timeout", delivery.LastError)
+}
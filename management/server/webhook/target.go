@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"syscall"
+)
+
+// errPrivateTarget is returned for endpoints on addresses that webhooks can only target when private targets are allowed
+var errPrivateTarget = errors.New("webhook endpoints on loopback, link-local and private addresses are not allowed")
+
+// cgnatPrefix is the shared address space used by the NetBird network
+var cgnatPrefix = netip.MustParsePrefix("100.64.0.0/10")
+
+// isPrivateAddr returns true for the addresses of the management host and its internal networks,
+// which webhooks must not reach unless the administrator allows it
+func isPrivateAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() ||
+		cgnatPrefix.Contains(addr)
+}
+
+// validateTargetHost rejects the host names and literal IP addresses known to point to private targets.
+// Host names resolving to private addresses are rejected when connecting.
+func validateTargetHost(host string) error {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errPrivateTarget
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil && isPrivateAddr(addr) {
+		return errPrivateTarget
+	}
+
+	return nil
+}
+
+// denyPrivateTargets is a net.Dialer control function refusing connections to private addresses.
+// It runs after the host name was resolved, so DNS names pointing to private addresses are refused as well.
+func denyPrivateTargets(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid address %s: %w", address, err)
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return fmt.Errorf("invalid address %s: %w", address, err)
+	}
+
+	if isPrivateAddr(addr) {
+		return fmt.Errorf("connect to %s: %w", addr, errPrivateTarget)
+	}
+
+	return nil
+}
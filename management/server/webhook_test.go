@@ -0,0 +1,196 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/activity/sqlite"
+	"github.com/netbirdio/netbird/management/server/webhook"
+)
+
+func TestDefaultAccountManager_SaveWebhookEndpoint(t *testing.T) {
+	am, err := createManager(t)
+	require.NoError(t, err)
+
+	account, err := initTestPostureChecksAccount(am)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err = am.SaveWebhookEndpoint(ctx, account.Id, adminUserID, &webhook.Endpoint{Name: "siem", URL: "https://siem.example.com", Secret: "secret"})
+	assert.Error(t, err, "webhooks can't be managed before the dispatcher is started")
+
+	key, err := sqlite.GenerateKey()
+	require.NoError(t, err)
+	require.NoError(t, am.StartWebhookDispatcher(ctx, key, false))
+
+	secrets, err := sqlite.NewFieldEncrypt(key)
+	require.NoError(t, err)
+
+	_, err = am.SaveWebhookEndpoint(ctx, account.Id, regularUserID, &webhook.Endpoint{Name: "siem", URL: "https://siem.example.com", Secret: "secret"})
+	assert.Error(t, err, "regular users can't manage webhooks")
+
+	_, err = am.SaveWebhookEndpoint(ctx, account.Id, adminUserID, &webhook.Endpoint{Name: "siem", URL: "http://169.254.169.254/latest", Secret: "secret"})
+	assert.Error(t, err, "private targets are not allowed by default")
+
+	_, err = am.SaveWebhookEndpoint(ctx, account.Id, adminUserID, &webhook.Endpoint{Name: "siem", URL: "ftp://siem.example.com", Secret: "secret"})
+	assert.Error(t, err, "only http and https URLs are supported")
+
+	_, err = am.SaveWebhookEndpoint(ctx, account.Id, adminUserID, &webhook.Endpoint{Name: "siem", URL: "https://siem.example.com"})
+	assert.Error(t, err, "secret is required")
+
+	endpoint, err := am.SaveWebhookEndpoint(ctx, account.Id, adminUserID, &webhook.Endpoint{Name: "siem", URL: "https://siem.example.com", Secret: "secret", Enabled: true})
+	require.NoError(t, err)
+	assert.NotEmpty(t, endpoint.ID)
+
+	stored, err := am.Store.GetWebhookEndpointByID(ctx, LockingStrengthShare, account.Id, endpoint.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, "secret", stored.Secret, "secret should be stored encrypted")
+
+	updated, err := am.SaveWebhookEndpoint(ctx, account.Id, adminUserID, &webhook.Endpoint{ID: endpoint.ID, Name: "siem-eu", URL: "https://siem.example.com"})
+	require.NoError(t, err)
+	secret, err := secrets.Decrypt(updated.Secret)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", secret, "secret should be kept if not provided")
+	assert.False(t, updated.Enabled)
+
+	endpoints, err := am.ListWebhookEndpoints(ctx, account.Id, adminUserID)
+	require.NoError(t, err)
+	assert.Len(t, endpoints, 1)
+
+	err = am.DeleteWebhookEndpoint(ctx, account.Id, endpoint.ID, adminUserID)
+	require.NoError(t, err)
+
+	_, err = am.GetWebhookEndpoint(ctx, account.Id, endpoint.ID, adminUserID)
+	assert.Error(t, err)
+}
+
+func TestWebhookDispatcher(t *testing.T) {
+	store, err := createStore(t)
+	require.NoError(t, err)
+
+	var received atomic.Int32
+	var failing atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var payload webhook.Payload
+		if json.Unmarshal(body, &payload) != nil || payload.ActivityCode != activity.PeerLoginExpired.StringCode() {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		received.Add(1)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	key, err := sqlite.GenerateKey()
+	require.NoError(t, err)
+	secrets, err := sqlite.NewFieldEncrypt(key)
+	require.NoError(t, err)
+	secret, err := secrets.Encrypt("secret")
+	require.NoError(t, err)
+
+	accountID := "bf1c8084-ba50-4ce7-9439-34653001fc3b"
+	endpoint := &webhook.Endpoint{
+		ID:        "endpoint",
+		AccountID: accountID,
+		Name:      "siem",
+		URL:       server.URL,
+		Secret:    secret,
+		Events:    []string{activity.PeerLoginExpired.StringCode()},
+		Enabled:   true,
+	}
+	require.NoError(t, store.SaveWebhookEndpoint(ctx, LockingStrengthUpdate, endpoint))
+
+	dispatcher := &webhookDispatcher{
+		store:   store,
+		sender:  webhook.NewSender(true),
+		secrets: secrets,
+		notify:  make(chan struct{}, 1),
+	}
+
+	event := &activity.Event{ID: 1, Timestamp: time.Now().UTC(), Activity: activity.PeerLoginExpired, AccountID: accountID}
+	require.NoError(t, dispatcher.Deliver(ctx, event))
+	// events relayed again are not queued twice
+	require.NoError(t, dispatcher.Deliver(ctx, event))
+
+	// not subscribed events are not queued
+	require.NoError(t, dispatcher.Deliver(ctx, &activity.Event{Activity: activity.PeerRenamed, AccountID: accountID}))
+
+	failing.Store(true)
+	dispatcher.dispatch(ctx)
+	assert.Equal(t, int32(0), received.Load())
+
+	deliveries, err := store.GetDueWebhookDeliveries(ctx, LockingStrengthShare, time.Now().Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1, "failed delivery should stay in the outbox")
+	assert.Equal(t, 1, deliveries[0].Attempts)
+	assert.True(t, deliveries[0].NextAttemptAt.After(time.Now()), "retry should be postponed")
+
+	// the retry is not due yet
+	failing.Store(false)
+	dispatcher.dispatch(ctx)
+	assert.Equal(t, int32(0), received.Load())
+
+	deliveries[0].NextAttemptAt = time.Now().UTC().Add(-time.Second)
+	require.NoError(t, store.SaveWebhookDelivery(ctx, LockingStrengthUpdate, deliveries[0]))
+
+	// a delivery claimed by another management instance isn't sent until its lease expires
+	claimed, err := store.ClaimDueWebhookDeliveries(ctx, time.Now().UTC(), time.Minute, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	dispatcher.dispatch(ctx)
+	assert.Equal(t, int32(0), received.Load())
+
+	claimed[0].NextAttemptAt = time.Now().UTC().Add(-time.Second)
+	require.NoError(t, store.SaveWebhookDelivery(ctx, LockingStrengthUpdate, claimed[0]))
+
+	dispatcher.dispatch(ctx)
+	assert.Equal(t, int32(1), received.Load())
+
+	deliveries, err = store.GetDueWebhookDeliveries(ctx, LockingStrengthShare, time.Now().Add(time.Hour), 10)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries, "delivered events should be removed from the outbox")
+}
+
+func TestWebhookDispatcher_Cleanup(t *testing.T) {
+	store, err := createStore(t)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	deliveries := []*webhook.Delivery{
+		{ID: "failed-old", Status: webhook.DeliveryStatusFailed, CreatedAt: now.Add(-webhookFailedDeliveryRetention - time.Hour)},
+		{ID: "failed-recent", Status: webhook.DeliveryStatusFailed, CreatedAt: now.Add(-time.Hour)},
+		{ID: "pending-old", Status: webhook.DeliveryStatusPending, CreatedAt: now.Add(-webhookFailedDeliveryRetention - time.Hour), NextAttemptAt: now.Add(time.Hour)},
+	}
+	require.NoError(t, store.CreateWebhookDeliveries(ctx, LockingStrengthUpdate, deliveries))
+
+	dispatcher := &webhookDispatcher{store: store}
+	dispatcher.cleanup(ctx)
+
+	deleted, err := store.DeleteFailedWebhookDeliveries(ctx, LockingStrengthUpdate, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted, "only the recent failed delivery should be left after the cleanup")
+
+	pending, err := store.GetDueWebhookDeliveries(ctx, LockingStrengthShare, now.Add(2*time.Hour), 10)
+	require.NoError(t, err)
+	assert.Len(t, pending, 1, "pending deliveries should be kept")
+}